@@ -1,14 +1,24 @@
 package api
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
+	"github.com/onkernel/kernel-images/server/cmd/api/circuits"
 	"github.com/onkernel/kernel-images/server/cmd/config"
 	"github.com/onkernel/kernel-images/server/lib/devtoolsproxy"
 	"github.com/onkernel/kernel-images/server/lib/logger"
@@ -17,6 +27,7 @@ import (
 	"github.com/onkernel/kernel-images/server/lib/policy"
 	"github.com/onkernel/kernel-images/server/lib/recorder"
 	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/onkernel/kernel-images/server/lib/xdisplay"
 )
 
 type ApiService struct {
@@ -32,6 +43,16 @@ type ApiService struct {
 	watchMu sync.RWMutex
 	watches map[string]*fsWatch
 
+	// keepalives stops stopOnDisconnect recordings once their progress streams close
+	keepalives *keepaliveRegistry
+	// startKeys remembers StartRecording idempotency keys
+	startKeys *startIdempotency
+	// tenantMu serializes starts of recordings with a tenant quota, so each sees the
+	// space reserved by those started before it
+	tenantMu sync.Mutex
+	// recordingQueue bounds concurrent recordings and queues starts waiting for a slot
+	recordingQueue *recordingQueue
+
 	// Process management
 	procMu sync.RWMutex
 	procs  map[string]*processHandle
@@ -42,10 +63,16 @@ type ApiService struct {
 	// DevTools upstream manager (Chromium supervisord log tailer)
 	upstreamMgr *devtoolsproxy.UpstreamManager
 	stz         scaletozero.Controller
+	// stzPauseMu guards stzPaused, set while PauseScaleToZero holds scale-to-zero off.
+	stzPauseMu sync.Mutex
+	stzPaused  bool
 
 	// inputMu serializes input-related operations (mouse, keyboard, screenshot)
 	inputMu sync.Mutex
 
+	// warmupMu serializes WarmupChromium so concurrent calls open at most one page
+	warmupMu sync.Mutex
+
 	// playwrightMu serializes Playwright code execution (only one execution at a time)
 	playwrightMu sync.Mutex
 
@@ -58,6 +85,28 @@ type ApiService struct {
 	// policy management
 	policy *policy.Policy
 
+	// proveSem bounds the number of concurrent ReclaimProve executions.
+	proveSem chan struct{}
+	// proofPool bounds how many admitted proofs run the protocol at once.
+	proofPool *proofPool
+	// newReclaimClient, proveTimeout and proveGracePeriod drive ReclaimProve; tests override them.
+	newReclaimClient func(providerParamsJSON, configJSON string) (reclaimProtocolClient, error)
+	proveTimeout     time.Duration
+	proveGracePeriod time.Duration
+	// proofStats aggregates the outcomes of ReclaimProve for GetProofStats.
+	proofStats *proofStats
+	// pendingCircuits reports ZK circuits still initializing; consulted when
+	// config.ReclaimWaitForCircuits is set.
+	pendingCircuits func() []string
+	// circuitsReady is closed once the circuits preloaded at startup have finished
+	// initializing; /readyz waits on it when config.ReclaimWaitForCircuits is set.
+	circuitsReady <-chan struct{}
+	// circuitStatuses reports ZK circuit state and memory for GetCircuitStatus.
+	circuitStatuses func() []circuits.Status
+	// chainVerifier checks claims on chain for ReclaimProve's verify_on_chain; nil unless
+	// configured.
+	chainVerifier *chainVerifier
+
 	// viewportOverride stores the last viewport dimensions set via CDP so
 	// that getCurrentResolution can return consistent values even while
 	// Xvfb is restarting in the background.
@@ -72,6 +121,19 @@ type ApiService struct {
 	// xvfbResizeMu serializes background Xvfb restarts to prevent races
 	// when multiple CDP fast-path resizes fire in quick succession.
 	xvfbResizeMu sync.Mutex
+
+	// displayGeometry caches the X display's size; invalidated whenever it is resized.
+	displayGeometry *xdisplay.Cache
+
+	// draining is set once shutdown begins; new recordings and proofs are refused.
+	draining atomic.Bool
+
+	// browserCheckMu guards browserCheck, the last CDP liveness result reported by /readyz.
+	browserCheckMu sync.Mutex
+	browserCheck   browserCheck
+
+	// logRing holds the server's recent log entries for GetLogs; nil when disabled.
+	logRing *logger.Ring
 }
 
 var _ oapi.StrictServerInterface = (*ApiService)(nil)
@@ -90,61 +152,241 @@ func New(cfg *config.Config, recordManager recorder.RecordManager, factory recor
 		return nil, fmt.Errorf("nekoAuthClient cannot be nil")
 	}
 
-	return &ApiService{
+	s := &ApiService{
 		config:            cfg,
 		recordManager:     recordManager,
 		factory:           factory,
-		defaultRecorderID: "default",
+		defaultRecorderID: cfg.RecordingDefaultID,
 		watches:           make(map[string]*fsWatch),
 		procs:             make(map[string]*processHandle),
+		keepalives:        newKeepaliveRegistry(recordingDisconnectGrace),
+		startKeys:         newStartIdempotency(startIdempotencyTTL),
+		recordingQueue:    newRecordingQueue(cfg.RecordingMaxConcurrent, cfg.RecordingStartQueueDepth, recordManager),
 		upstreamMgr:       upstreamMgr,
 		stz:               stz,
 		nekoAuthClient:    nekoAuthClient,
 		policy:            &policy.Policy{},
-	}, nil
+		proveSem:          make(chan struct{}, max(cfg.ReclaimMaxConcurrent, 1)),
+		proofPool:         newProofPool(cmp.Or(cfg.ReclaimProofWorkers, cfg.ReclaimMaxConcurrent)),
+		newReclaimClient:  newReclaimProtocolClient,
+		proveTimeout:      reclaimProveTimeout,
+		proveGracePeriod:  reclaimProveGracePeriod,
+		proofStats:        newProofStats(),
+		pendingCircuits:   circuits.Pending,
+		circuitsReady:     circuits.Ready(),
+		circuitStatuses:   circuits.Statuses,
+		chainVerifier:     newChainVerifier(cfg.ReclaimChainRPCURL, cfg.ReclaimVerifierContract),
+	}
+	s.displayGeometry = xdisplay.NewCache(s.resolveDisplayFromEnv(), xdisplay.Geometry{
+		Width:  cfg.DisplayWidth,
+		Height: cfg.DisplayHeight,
+		Depth:  cfg.DisplayDepth,
+	})
+	return s, nil
+}
+
+// invalidRecorderIDError is returned for recorder IDs that aren't safe to use in a filename.
+func invalidRecorderIDError() oapi.BadRequestErrorJSONResponse {
+	return oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.InvalidRecorderId), Message: "recorder id must be 1-64 letters, digits, hyphens or underscores"}
 }
 
 func (s *ApiService) StartRecording(ctx context.Context, req oapi.StartRecordingRequestObject) (oapi.StartRecordingResponseObject, error) {
 	log := logger.FromContext(ctx)
 
+	// Determine recorder ID (use default if none provided)
+	recorderID := s.defaultRecorderID
+	if req.Body != nil && req.Body.Id != nil && *req.Body.Id != "" {
+		recorderID = *req.Body.Id
+	}
+	if !recorder.ValidID(recorderID) {
+		return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
+
+	key := req.Params.IdempotencyKey
+	if dryRun := req.Params.DryRun; dryRun != nil && *dryRun {
+		// nothing is started, so there is nothing to deduplicate
+		key = nil
+	}
+	if key == nil || *key == "" {
+		return s.startRecording(ctx, req, recorderID)
+	}
+	if len(*key) > 255 {
+		return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.IdempotencyKeyInvalid), Message: "Idempotency-Key exceeds maximum length of 255 characters"}}, nil
+	}
+
+	for {
+		attempt, first := s.startKeys.begin(*key, recorderID)
+		if first {
+			resp, err := s.startRecording(ctx, req, recorderID)
+			s.startKeys.finish(*key, attempt, resp)
+			return resp, err
+		}
+		if attempt.recorderID != recorderID {
+			log.Error("idempotency key reused for a different recording", "recorder_id", recorderID, "original_recorder_id", attempt.recorderID)
+			return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.IdempotencyKeyReused), Message: "Idempotency-Key was already used to start a different recording"}}, nil
+		}
+		select {
+		case <-attempt.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if attempt.resp != nil {
+			log.Info("returning result of earlier start with the same idempotency key", "recorder_id", recorderID)
+			return attempt.resp, nil
+		}
+		// the earlier request went away without a result (e.g. cancelled while queued),
+		// which released the key, so try the start again in its place
+		log.Info("earlier start with the same idempotency key was abandoned, retrying", "recorder_id", recorderID)
+	}
+}
+
+// startRecording creates, registers and starts the recorder for a StartRecording request.
+func (s *ApiService) startRecording(ctx context.Context, req oapi.StartRecordingRequestObject, recorderID string) (oapi.StartRecordingResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if s.draining.Load() {
+		log.Warn("rejecting recording start, server is draining", "recorder_id", recorderID)
+		return oapi.StartRecording503JSONResponse{Code: ptrOf(oapi.Draining), Message: "server is shutting down"}, nil
+	}
+
 	var params recorder.FFmpegRecordingParams
 	if req.Body != nil {
 		params.FrameRate = req.Body.Framerate
 		params.MaxSizeInMB = req.Body.MaxFileSizeInMB
 		params.MaxDurationInSeconds = req.Body.MaxDurationInSeconds
-	}
-
-	// Determine recorder ID (use default if none provided)
-	recorderID := s.defaultRecorderID
-	if req.Body != nil && req.Body.Id != nil && *req.Body.Id != "" {
-		recorderID = *req.Body.Id
+		params.KeyframeIntervalSeconds = req.Body.KeyframeIntervalSeconds
+		params.DrawMouse = req.Body.DrawMouse
+		params.CRF = req.Body.Crf
+		params.QP = req.Body.Qp
+		if req.Body.Preset != nil {
+			params.Preset = *req.Body.Preset
+		}
+		if req.Body.Tenant != nil {
+			params.Tenant = *req.Body.Tenant
+		}
+		if req.Body.Label != nil {
+			params.Label = *req.Body.Label
+		}
+		if req.Body.Tags != nil {
+			params.Tags = *req.Body.Tags
+		}
+		if req.Body.ExtraArgs != nil && len(*req.Body.ExtraArgs) > 0 {
+			if !s.config.AllowFFmpegExtraArgs {
+				log.Error("extra ffmpeg args not allowed", "recorder_id", recorderID)
+				return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.InvalidRecordingParams), Message: "extraArgs requires the server to set ALLOW_FFMPEG_EXTRA_ARGS"}}, nil
+			}
+			params.ExtraArgs = *req.Body.ExtraArgs
+		}
+		if req.Body.Mode != nil {
+			params.Mode = recorder.CaptureMode(*req.Body.Mode)
+		}
+		if req.Body.DropDuplicateFrames != nil {
+			params.DropDuplicateFrames = *req.Body.DropDuplicateFrames
+		}
+		if d := req.Body.DisplayNum; d != nil && *d != s.config.DisplayNum && !slices.Contains(s.config.RecordingAllowedDisplays, *d) {
+			log.Error("recording display not allowed", "display", *d, "recorder_id", recorderID)
+			return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.InvalidRecordingParams), Message: fmt.Sprintf("display :%d is not allowed for recording", *d)}}, nil
+		}
+		params.DisplayNum = req.Body.DisplayNum
 	}
 
 	// Create, register, and start a new recorder
 	rec, err := s.factory(recorderID, params)
+	if errors.Is(err, recorder.ErrInvalidParams) {
+		log.Error("invalid recording parameters", "err", err, "recorder_id", recorderID)
+		return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.InvalidRecordingParams), Message: err.Error()}}, nil
+	}
 	if err != nil {
 		log.Error("failed to create recorder", "err", err, "recorder_id", recorderID)
 		return oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to create recording"}}, nil
 	}
+	if dryRun := req.Params.DryRun; dryRun != nil && *dryRun {
+		return s.dryRunRecording(ctx, rec)
+	}
+	// ffmpeg only reports an unwritable output after it has started, and then opaquely
+	if ffmpegRec, ok := rec.(*recorder.FFmpegRecorder); ok {
+		if err := recorder.CheckWritable(*ffmpegRec.Params().OutputDir); err != nil {
+			log.Error("output directory is not writable", "err", err, "recorder_id", recorderID)
+			if errors.Is(err, syscall.ENOSPC) {
+				return oapi.StartRecording507JSONResponse{Code: ptrOf(oapi.OutputDirFull), Message: err.Error()}, nil
+			}
+			return oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Code: ptrOf(oapi.OutputDirUnwritable), Message: err.Error()}}, nil
+		}
+	}
+	wait := req.Params.Wait != nil && *req.Params.Wait
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(s.config.RecordingStartQueueTimeoutSeconds)*time.Second)
+	release, err := s.recordingQueue.acquire(waitCtx, recorderID, wait)
+	cancel()
+	switch {
+	case errors.Is(err, errTooManyRecordings), errors.Is(err, errRecordingQueueFull):
+		code, msg := oapi.TooManyRecordings, "too many recordings in progress, please try again later"
+		if errors.Is(err, errRecordingQueueFull) {
+			code, msg = oapi.RecordingQueueFull, "recording start queue is full, please try again later"
+		}
+		log.Warn("rejecting recording start, concurrent recording limit reached", "recorder_id", recorderID, "wait", wait, "err", err)
+		return oapi.StartRecording429JSONResponse{
+			Body:    oapi.Error{Code: ptrOf(code), Message: msg},
+			Headers: oapi.StartRecording429ResponseHeaders{RetryAfter: s.config.RecordingQueueRetryAfterSeconds},
+		}, nil
+	case err != nil && ctx.Err() == nil:
+		log.Warn("recording start timed out waiting for a slot", "recorder_id", recorderID)
+		return oapi.StartRecording503JSONResponse{Code: ptrOf(oapi.RecordingQueueTimeout), Message: "timed out waiting for a running recording to end"}, nil
+	case err != nil:
+		return nil, err
+	}
+	defer release()
+	// a start may have waited in the queue past the beginning of a shutdown
+	if wait && s.draining.Load() {
+		log.Warn("rejecting queued recording start, server is draining", "recorder_id", recorderID)
+		return oapi.StartRecording503JSONResponse{Code: ptrOf(oapi.Draining), Message: "server is shutting down"}, nil
+	}
+	if params.Tenant != "" && s.config.RecordingTenantQuotaMB > 0 {
+		s.tenantMu.Lock()
+		defer s.tenantMu.Unlock()
+		var resp oapi.StartRecordingResponseObject
+		if rec, resp = s.fitTenantQuota(ctx, rec, recorderID, params); resp != nil {
+			return resp, nil
+		}
+	}
 	if err := s.recordManager.RegisterRecorder(ctx, rec); err != nil {
 		if rec, exists := s.recordManager.GetRecorder(recorderID); exists {
-			if rec.IsRecording(ctx) {
+			// a recorder that hasn't finished may still be starting in a concurrent request
+			if rec.IsRecording(ctx) || rec.Metadata().EndTime.IsZero() {
 				log.Error("attempted to start recording while one is already active", "recorder_id", recorderID)
-				return oapi.StartRecording409JSONResponse{ConflictErrorJSONResponse: oapi.ConflictErrorJSONResponse{Message: "recording already in progress"}}, nil
+				return oapi.StartRecording409JSONResponse{ConflictErrorJSONResponse: oapi.ConflictErrorJSONResponse{Code: ptrOf(oapi.RecordingInProgress), Message: "recording already in progress"}}, nil
 			} else {
 				log.Error("attempted to restart recording", "recorder_id", recorderID)
-				return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "recording already completed"}}, nil
+				return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.RecordingCompleted), Message: "recording already completed"}}, nil
 			}
 		}
 		log.Error("failed to register recorder", "err", err, "recorder_id", recorderID)
 		return oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to register recording"}}, nil
 	}
 
+	// watch before starting, as a progress stream may be opened as soon as the recorder is
+	// registered, and Start can take a while
+	stopOnDisconnect := req.Body != nil && req.Body.StopOnDisconnect != nil && *req.Body.StopOnDisconnect
+	if stopOnDisconnect {
+		s.keepalives.watch(recorderID, rec, log)
+	}
+
 	if err := rec.Start(ctx); err != nil {
 		log.Error("failed to start recording", "err", err, "recorder_id", recorderID)
+		if stopOnDisconnect {
+			s.keepalives.drop(recorderID)
+		}
 		// ensure the recorder is deregistered
 		defer s.recordManager.DeregisterRecorder(ctx, rec)
-		return oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to start recording"}}, nil
+		msg := "failed to start recording"
+		if errors.Is(err, recorder.ErrStartTimeout) {
+			msg = "ffmpeg did not start recording in time; check that the display is available"
+		}
+		return oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: msg}}, nil
+	}
+
+	if stopOnDisconnect {
+		log.Info("recording will stop when its progress stream disconnects", "recorder_id", recorderID)
+		s.keepalives.arm(recorderID)
 	}
 
 	return oapi.StartRecording201Response{}, nil
@@ -158,11 +400,14 @@ func (s *ApiService) StopRecording(ctx context.Context, req oapi.StopRecordingRe
 	if req.Body != nil && req.Body.Id != nil && *req.Body.Id != "" {
 		recorderID = *req.Body.Id
 	}
+	if !recorder.ValidID(recorderID) {
+		return oapi.StopRecording400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
 
 	rec, exists := s.recordManager.GetRecorder(recorderID)
 	if !exists {
 		log.Error("attempted to stop recording when none is active", "recorder_id", recorderID)
-		return oapi.StopRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "no active recording to stop"}}, nil
+		return oapi.StopRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no active recording to stop"}}, nil
 	}
 	// Always call Stop() even if IsRecording() is false. Recordings that exit naturally
 	// (max duration, max file size, etc.) finalize automatically, but Stop() is still
@@ -202,16 +447,19 @@ func (s *ApiService) DownloadRecording(ctx context.Context, req oapi.DownloadRec
 	if req.Params.Id != nil && *req.Params.Id != "" {
 		recorderID = *req.Params.Id
 	}
+	if !recorder.ValidID(recorderID) {
+		return oapi.DownloadRecording400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
 
 	// Get the recorder to access its output path
 	rec, exists := s.recordManager.GetRecorder(recorderID)
 	if !exists {
 		log.Error("attempted to download non-existent recording", "recorder_id", recorderID)
-		return oapi.DownloadRecording404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "no recording found"}}, nil
+		return oapi.DownloadRecording404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
 	}
 	if rec.IsDeleted(ctx) {
 		log.Error("attempted to download deleted recording", "recorder_id", recorderID)
-		return oapi.DownloadRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "requested recording has been deleted"}}, nil
+		return oapi.DownloadRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.RecordingDeleted), Message: "requested recording has been deleted"}}, nil
 	}
 
 	out, meta, err := rec.Recording(ctx)
@@ -246,22 +494,409 @@ func (s *ApiService) DownloadRecording(ctx context.Context, req oapi.DownloadRec
 		out.Close() // Close the file handle to prevent descriptor leak
 		return oapi.DownloadRecording202Response{
 			Headers: oapi.DownloadRecording202ResponseHeaders{
-				RetryAfter: 300,
+				RetryAfter: s.config.RecordingRetryAfterSeconds,
 			},
 		}, nil
 	}
 
+	// A snapshot is cut at the last complete fragment of the file ffmpeg is still
+	// appending to, so it plays on its own; the recorder keeps running.
+	if req.Params.Snapshot != nil && *req.Params.Snapshot && rec.IsRecording(ctx) {
+		size, err := snapshotSize(out, meta.Size)
+		if err != nil {
+			out.Close()
+			log.Error("failed to find complete fragments of recording", "err", err, "recorder_id", recorderID)
+			return oapi.DownloadRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to read recording"}}, nil
+		}
+		if size == 0 {
+			out.Close()
+			return oapi.DownloadRecording202Response{
+				Headers: oapi.DownloadRecording202ResponseHeaders{
+					RetryAfter: s.config.RecordingRetryAfterSeconds,
+				},
+			}, nil
+		}
+		body, err := sectionOf(out, 0, size)
+		if err != nil {
+			out.Close()
+			log.Error("failed to seek recording file", "err", err, "recorder_id", recorderID)
+			return oapi.DownloadRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to read recording"}}, nil
+		}
+		meta.Size = size
+		etag, lastModified := recordingValidators(meta, false)
+		log.Info("serving recording snapshot for download", "size", size, "recorder_id", recorderID)
+		return oapi.DownloadRecording200Videomp4Response{
+			Body: body,
+			Headers: oapi.DownloadRecording200ResponseHeaders{
+				AcceptRanges:         "bytes",
+				ETag:                 etag,
+				LastModified:         lastModified,
+				XRecordingStartedAt:  meta.StartTime.Format(time.RFC3339),
+				XRecordingFinishedAt: meta.EndTime.Format(time.RFC3339),
+				XRecordingPartial:    "true",
+			},
+			ContentLength: size,
+		}, nil
+	}
+
+	// Finalized recordings are immutable, so their validators can answer conditional
+	// requests; a file still being written is always sent.
+	finalized := !rec.IsRecording(ctx)
+	etag, lastModified := recordingValidators(meta, finalized)
+	if finalized && notModified(req.Params.IfNoneMatch, req.Params.IfModifiedSince, etag, meta.ModTime) {
+		out.Close()
+		return oapi.DownloadRecording304Response{
+			Headers: oapi.DownloadRecording304ResponseHeaders{ETag: etag, LastModified: lastModified},
+		}, nil
+	}
+
+	if req.Params.Range != nil && (req.Params.IfRange == nil || ifRangeMatches(*req.Params.IfRange, etag, meta.ModTime)) {
+		start, length, ok, err := parseByteRange(*req.Params.Range, meta.Size)
+		if errors.Is(err, errRangeNotSatisfiable) {
+			out.Close()
+			return oapi.DownloadRecording416Response{
+				Headers: oapi.DownloadRecording416ResponseHeaders{ContentRange: fmt.Sprintf("bytes */%d", meta.Size)},
+			}, nil
+		}
+		if ok {
+			body, err := sectionOf(out, start, length)
+			if err != nil {
+				out.Close()
+				log.Error("failed to seek recording file", "err", err, "recorder_id", recorderID)
+				return oapi.DownloadRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to read recording"}}, nil
+			}
+			log.Info("serving recording file range for download", "size", meta.Size, "start", start, "length", length, "recorder_id", recorderID)
+			return oapi.DownloadRecording206Videomp4Response{
+				Body: body,
+				Headers: oapi.DownloadRecording206ResponseHeaders{
+					AcceptRanges:         "bytes",
+					ContentRange:         fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, meta.Size),
+					ETag:                 etag,
+					LastModified:         lastModified,
+					XRecordingStartedAt:  meta.StartTime.Format(time.RFC3339),
+					XRecordingFinishedAt: meta.EndTime.Format(time.RFC3339),
+				},
+				ContentLength: length,
+			}, nil
+		}
+	}
+
 	log.Info("serving recording file for download", "size", meta.Size, "recorder_id", recorderID)
 	return oapi.DownloadRecording200Videomp4Response{
 		Body: out,
 		Headers: oapi.DownloadRecording200ResponseHeaders{
+			AcceptRanges:         "bytes",
+			ETag:                 etag,
+			LastModified:         lastModified,
 			XRecordingStartedAt:  meta.StartTime.Format(time.RFC3339),
 			XRecordingFinishedAt: meta.EndTime.Format(time.RFC3339),
+			XRecordingPartial:    "false",
 		},
 		ContentLength: meta.Size,
 	}, nil
 }
 
+// dryRunRecording describes the ffmpeg command and effective parameters rec would record
+// with, for StartRecording with dryRun set. rec is not registered or started.
+func (s *ApiService) dryRunRecording(ctx context.Context, rec recorder.Recorder) (oapi.StartRecordingResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		log.Error("failed to cast recorder to FFmpegRecorder", "recorder_id", rec.ID())
+		return oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "internal error"}}, nil
+	}
+	command, args, err := ffmpegRec.Command()
+	if err != nil {
+		log.Error("failed to build ffmpeg command", "err", err, "recorder_id", rec.ID())
+		return oapi.StartRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.InvalidRecordingParams), Message: err.Error()}}, nil
+	}
+	params := ffmpegRec.Params()
+	mode := params.Mode
+	if mode == "" {
+		mode = recorder.CaptureScreen
+	}
+	dryRun := oapi.StartRecording200JSONResponse{
+		Id:      rec.ID(),
+		Command: command,
+		Args:    args,
+		Params: oapi.RecordingParams{
+			Framerate:               *params.FrameRate,
+			DisplayNum:              *params.DisplayNum,
+			MaxFileSizeInMB:         *params.MaxSizeInMB,
+			MaxDurationInSeconds:    params.MaxDurationInSeconds,
+			Mode:                    string(mode),
+			Fragmented:              params.Fragmented,
+			DropDuplicateFrames:     params.DropDuplicateFrames,
+			KeyframeIntervalSeconds: params.KeyframeIntervalSeconds,
+			DrawMouse:               params.DrawMouse,
+			Crf:                     params.CRF,
+			Qp:                      params.QP,
+		},
+	}
+	if params.Preset != "" {
+		dryRun.Params.Preset = &params.Preset
+	}
+	return dryRun, nil
+}
+
+// recordingProgressInterval is how often progress events are emitted while a recording runs.
+const recordingProgressInterval = time.Second
+
+// StreamRecordingProgress emits periodic SSE progress events for a recorder until it is finalized.
+// (GET /recordings/{id}/progress)
+func (s *ApiService) StreamRecordingProgress(ctx context.Context, req oapi.StreamRecordingProgressRequestObject) (oapi.StreamRecordingProgressResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if !recorder.ValidID(req.Id) {
+		return oapi.StreamRecordingProgress400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
+	rec, exists := s.recordManager.GetRecorder(req.Id)
+	if !exists {
+		return oapi.StreamRecordingProgress404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
+	}
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		log.Error("failed to cast recorder to FFmpegRecorder", "recorder_id", req.Id)
+		return oapi.StreamRecordingProgress500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "internal error"}}, nil
+	}
+
+	// holding the stream open keeps stopOnDisconnect recordings alive
+	detach := s.keepalives.attach(req.Id)
+
+	pr, pw := io.Pipe()
+	// unblocks a write the client will never read once the request is gone
+	stop := context.AfterFunc(ctx, func() { pw.CloseWithError(ctx.Err()) })
+	go func() {
+		defer stop()
+		defer pw.Close()
+		defer detach()
+
+		ticker := time.NewTicker(recordingProgressInterval)
+		defer ticker.Stop()
+
+		for {
+			p, err := ffmpegRec.Progress()
+			if err != nil {
+				log.Error("failed to read recording progress", "err", err, "recorder_id", req.Id)
+				return
+			}
+			ev := oapi.RecordingProgressEvent{
+				Event:          oapi.Progress,
+				Bytes:          p.Bytes,
+				ElapsedSeconds: float32(p.Elapsed.Seconds()),
+				Encoder:        encoderStats(p.Encoder),
+			}
+			switch {
+			case p.Finished:
+				ev.Event = oapi.Finished
+			case !p.Started:
+				ev.Event = oapi.NotStarted
+			}
+
+			// Build SSE formatted event: data: <json>\n\n using a buffer and write in a single call
+			data, err := json.Marshal(ev)
+			if err != nil {
+				log.Error("failed to marshal recording progress event", "err", err)
+				return
+			}
+			var buf bytes.Buffer
+			buf.Grow(len("data: ") + len(data) + 2) // 2 for the separating newlines
+			buf.WriteString("data: ")
+			buf.Write(data)
+			buf.WriteString("\n\n")
+			if _, err := pw.Write(buf.Bytes()); err != nil || p.Finished {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	headers := oapi.StreamRecordingProgress200ResponseHeaders{XSSEContentType: "application/json"}
+	return oapi.StreamRecordingProgress200TexteventStreamResponse{Body: pr, Headers: headers, ContentLength: 0}, nil
+}
+
+// GetRecordingStatus reports a recorder's state along with a resource sample of its ffmpeg process.
+// (GET /recordings/{id}/status)
+func (s *ApiService) GetRecordingStatus(ctx context.Context, req oapi.GetRecordingStatusRequestObject) (oapi.GetRecordingStatusResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if !recorder.ValidID(req.Id) {
+		return oapi.GetRecordingStatus400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
+	rec, exists := s.recordManager.GetRecorder(req.Id)
+	if !exists {
+		return oapi.GetRecordingStatus404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
+	}
+
+	m := rec.Metadata()
+	status := oapi.RecordingStatus{
+		Id:          rec.ID(),
+		IsRecording: rec.IsRecording(ctx),
+	}
+	if !m.StartTime.IsZero() {
+		status.StartedAt = &m.StartTime
+	}
+	if !m.EndTime.IsZero() {
+		status.FinishedAt = &m.EndTime
+	}
+
+	// resource sampling is only available for ffmpeg-backed recorders
+	if ffmpegRec, ok := rec.(*recorder.FFmpegRecorder); ok {
+		usage, err := ffmpegRec.ResourceUsage()
+		if err != nil {
+			log.Error("failed to sample recorder resource usage", "err", err, "recorder_id", req.Id)
+			return oapi.GetRecordingStatus500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to sample resource usage"}}, nil
+		}
+		if usage != nil {
+			resources := oapi.RecorderResourceUsage{
+				CpuSeconds: float32(usage.CPUSeconds),
+				RssBytes:   usage.RSSBytes,
+				Threads:    usage.Threads,
+			}
+			if elapsed := time.Since(m.StartTime).Seconds(); !m.StartTime.IsZero() && elapsed > 0 {
+				resources.CpuPercent = float32(usage.CPUSeconds / elapsed * 100)
+			}
+			status.Resources = &resources
+		}
+		status.Encoder = encoderStats(ffmpegRec.EncoderStats())
+	}
+
+	return oapi.GetRecordingStatus200JSONResponse(status), nil
+}
+
+// GetRecordingLocation reports where a recording is stored. Recordings only live in
+// OUTPUT_DIR, so the path is relative to it and the recording is never uploaded.
+// (GET /recordings/{id}/location)
+func (s *ApiService) GetRecordingLocation(ctx context.Context, req oapi.GetRecordingLocationRequestObject) (oapi.GetRecordingLocationResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if !recorder.ValidID(req.Id) {
+		return oapi.GetRecordingLocation400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
+	rec, exists := s.recordManager.GetRecorder(req.Id)
+	if !exists {
+		return oapi.GetRecordingLocation404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
+	}
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		log.Error("failed to cast recorder to FFmpegRecorder", "recorder_id", req.Id)
+		return oapi.GetRecordingLocation500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "internal error"}}, nil
+	}
+	path, err := ffmpegRec.RelativePath()
+	if err != nil {
+		log.Error("failed to resolve recording path", "err", err, "recorder_id", req.Id)
+		return oapi.GetRecordingLocation500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to resolve recording path"}}, nil
+	}
+	return oapi.GetRecordingLocation200JSONResponse{
+		Id:       rec.ID(),
+		Backend:  oapi.Local,
+		Path:     filepath.ToSlash(path),
+		Uploaded: false,
+	}, nil
+}
+
+// GetRecordingManifest returns the manifest written next to a finalized recording.
+// (GET /recordings/{id}/manifest)
+func (s *ApiService) GetRecordingManifest(ctx context.Context, req oapi.GetRecordingManifestRequestObject) (oapi.GetRecordingManifestResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if !recorder.ValidID(req.Id) {
+		return oapi.GetRecordingManifest400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
+	rec, exists := s.recordManager.GetRecorder(req.Id)
+	if !exists {
+		return oapi.GetRecordingManifest404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
+	}
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		log.Error("failed to cast recorder to FFmpegRecorder", "recorder_id", req.Id)
+		return oapi.GetRecordingManifest500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "internal error"}}, nil
+	}
+	if rec.IsRecording(ctx) {
+		return oapi.GetRecordingManifest409JSONResponse{ConflictErrorJSONResponse: oapi.ConflictErrorJSONResponse{Code: ptrOf(oapi.RecordingInProgress), Message: "recording is still in progress; its manifest is written once it is finalized"}}, nil
+	}
+
+	m, err := ffmpegRec.Manifest()
+	if errors.Is(err, recorder.ErrRecordingFinalizing) {
+		// the manifest records a failed finalization, so its error is not fatal here
+		_ = ffmpegRec.WaitForFinalization(ctx)
+		m, err = ffmpegRec.Manifest()
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return oapi.GetRecordingManifest404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "recording has no manifest"}}, nil
+	}
+	if err != nil {
+		log.Error("failed to read recording manifest", "err", err, "recorder_id", req.Id)
+		return oapi.GetRecordingManifest500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to read recording manifest"}}, nil
+	}
+	return oapi.GetRecordingManifest200JSONResponse(recordingManifest(m)), nil
+}
+
+// recordingManifest converts a recorder manifest to its API form.
+func recordingManifest(m *recorder.Manifest) oapi.RecordingManifest {
+	out := oapi.RecordingManifest{
+		Id:   m.ID,
+		File: m.File,
+		Params: oapi.RecordingParams{
+			Framerate:               m.Params.FrameRate,
+			DisplayNum:              m.Params.DisplayNum,
+			MaxFileSizeInMB:         m.Params.MaxSizeInMB,
+			MaxDurationInSeconds:    m.Params.MaxDurationInSeconds,
+			Mode:                    m.Params.Mode,
+			Fragmented:              m.Params.Fragmented,
+			DropDuplicateFrames:     m.Params.DropDuplicateFrames,
+			KeyframeIntervalSeconds: m.Params.KeyframeIntervalSeconds,
+			DrawMouse:               m.Params.DrawMouse,
+			Crf:                     m.Params.CRF,
+			Qp:                      m.Params.QP,
+		},
+		StartedAt:  m.StartTime,
+		FinishedAt: m.EndTime,
+		Size:       m.Size,
+		Codec:      m.Codec,
+		ExitCode:   m.ExitCode,
+		ExitReason: oapi.RecordingManifestExitReason(m.ExitReason),
+	}
+	if m.FinalizeError != "" {
+		out.FinalizeError = &m.FinalizeError
+	}
+	if m.Tenant != "" {
+		out.Tenant = &m.Tenant
+	}
+	if m.Label != "" {
+		out.Label = &m.Label
+	}
+	if m.Params.Preset != "" {
+		out.Params.Preset = &m.Params.Preset
+	}
+	if len(m.Tags) > 0 {
+		out.Tags = &m.Tags
+	}
+	return out
+}
+
+// encoderStats converts ffmpeg's progress report to its API form.
+func encoderStats(stats *recorder.EncoderStats) *oapi.EncoderStats {
+	if stats == nil {
+		return nil
+	}
+	return &oapi.EncoderStats{
+		Frames:           stats.Frames,
+		Fps:              float32(stats.FPS),
+		BitrateKbps:      float32(stats.BitrateKbps),
+		Speed:            float32(stats.Speed),
+		OutTimeSeconds:   float32(stats.OutTime.Seconds()),
+		DroppedFrames:    stats.DroppedFrames,
+		DuplicatedFrames: stats.DuplicatedFrames,
+	}
+}
+
 func (s *ApiService) DeleteRecording(ctx context.Context, req oapi.DeleteRecordingRequestObject) (oapi.DeleteRecordingResponseObject, error) {
 	log := logger.FromContext(ctx)
 
@@ -269,21 +904,29 @@ func (s *ApiService) DeleteRecording(ctx context.Context, req oapi.DeleteRecordi
 	if req.Body != nil && req.Body.Id != nil && *req.Body.Id != "" {
 		recorderID = *req.Body.Id
 	}
+	if !recorder.ValidID(recorderID) {
+		return oapi.DeleteRecording400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
 	rec, exists := s.recordManager.GetRecorder(recorderID)
 	if !exists {
 		log.Error("attempted to delete non-existent recording", "recorder_id", recorderID)
-		return oapi.DeleteRecording404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "no recording found"}}, nil
+		return oapi.DeleteRecording404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
 	}
 
 	if rec.IsRecording(ctx) {
 		log.Error("attempted to delete recording while still in progress", "recorder_id", recorderID)
-		return oapi.DeleteRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "recording must be stopped first"}}, nil
+		return oapi.DeleteRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.RecordingNotStopped), Message: "recording must be stopped first"}}, nil
 	}
 
 	if err := rec.Delete(ctx); err != nil {
 		if errors.Is(err, recorder.ErrRecordingFinalizing) {
 			log.Info("recording is being finalized, client should retry", "recorder_id", recorderID)
-			return oapi.DeleteRecording409JSONResponse{ConflictErrorJSONResponse: oapi.ConflictErrorJSONResponse{Message: "recording is being finalized, please retry in a few seconds"}}, nil
+			return oapi.DeleteRecording409JSONResponse{
+				Body: oapi.Error{Code: ptrOf(oapi.RecordingFinalizing), Message: "recording is being finalized, please retry in a few seconds"},
+				Headers: oapi.DeleteRecording409ResponseHeaders{
+					RetryAfter: s.config.RecordingFinalizingRetryAfterSeconds,
+				},
+			}, nil
 		}
 		if !errors.Is(err, os.ErrNotExist) {
 			log.Error("failed to delete recording", "err", err, "recorder_id", recorderID)
@@ -296,7 +939,7 @@ func (s *ApiService) DeleteRecording(ctx context.Context, req oapi.DeleteRecordi
 }
 
 // ListRecorders returns a list of all registered recorders and whether each one is currently recording.
-func (s *ApiService) ListRecorders(ctx context.Context, _ oapi.ListRecordersRequestObject) (oapi.ListRecordersResponseObject, error) {
+func (s *ApiService) ListRecorders(ctx context.Context, req oapi.ListRecordersRequestObject) (oapi.ListRecordersResponseObject, error) {
 	infos := []oapi.RecorderInfo{}
 
 	timeOrNil := func(t time.Time) *time.Time {
@@ -309,16 +952,54 @@ func (s *ApiService) ListRecorders(ctx context.Context, _ oapi.ListRecordersRequ
 	recs := s.recordManager.ListActiveRecorders(ctx)
 	for _, r := range recs {
 		m := r.Metadata()
-		infos = append(infos, oapi.RecorderInfo{
+		healthy := true
+		var tenant, label string
+		var tags map[string]string
+		if ffmpegRec, ok := r.(*recorder.FFmpegRecorder); ok {
+			healthy = ffmpegRec.Healthy()
+			p := ffmpegRec.Params()
+			tenant, label, tags = p.Tenant, p.Label, p.Tags
+		}
+		if req.Params.Tenant != nil && *req.Params.Tenant != tenant {
+			continue
+		}
+		if req.Params.Tag != nil && !matchTags(tags, *req.Params.Tag) {
+			continue
+		}
+		info := oapi.RecorderInfo{
 			Id:          r.ID(),
 			IsRecording: r.IsRecording(ctx),
+			Healthy:     healthy,
 			StartedAt:   timeOrNil(m.StartTime),
 			FinishedAt:  timeOrNil(m.EndTime),
-		})
+		}
+		if tenant != "" {
+			info.Tenant = &tenant
+		}
+		if label != "" {
+			info.Label = &label
+		}
+		if len(tags) > 0 {
+			info.Tags = &tags
+		}
+		infos = append(infos, info)
 	}
 	return oapi.ListRecorders200JSONResponse(infos), nil
 }
 
+// matchTags reports whether tags satisfy every filter, each either "key=value" or "key"
+// to match any value of key.
+func matchTags(tags map[string]string, filters []string) bool {
+	for _, f := range filters {
+		key, value, hasValue := strings.Cut(f, "=")
+		v, ok := tags[key]
+		if !ok || (hasValue && v != value) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *ApiService) Shutdown(ctx context.Context) error {
 	return s.recordManager.StopAll(ctx)
 }
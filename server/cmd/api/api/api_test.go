@@ -1,13 +1,21 @@
 package api
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"log/slog"
 
@@ -38,6 +46,23 @@ func TestApiService_StartRecording(t *testing.T) {
 		require.True(t, rec.IsRecording(ctx), "recorder should be recording after Start")
 	})
 
+	t.Run("configured default id", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		cfg := newTestConfig()
+		cfg.RecordingDefaultID = "instance-a"
+		svc, err := New(cfg, mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+
+		_, exists := mgr.GetRecorder("instance-a")
+		require.True(t, exists, "recorder was not registered under the configured default id")
+		_, exists = mgr.GetRecorder("default")
+		require.False(t, exists)
+	})
+
 	t.Run("already recording", func(t *testing.T) {
 		mgr := recorder.NewFFmpegManager()
 		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
@@ -50,7 +75,10 @@ func TestApiService_StartRecording(t *testing.T) {
 		// Second start should return conflict
 		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{})
 		require.NoError(t, err)
-		require.IsType(t, oapi.StartRecording409JSONResponse{}, resp)
+		conflict, ok := resp.(oapi.StartRecording409JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.NotNil(t, conflict.Code)
+		assert.Equal(t, oapi.RecordingInProgress, *conflict.Code)
 	})
 
 	t.Run("custom ids don't collide", func(t *testing.T) {
@@ -81,6 +109,301 @@ func TestApiService_StartRecording(t *testing.T) {
 		out = mgr.ListActiveRecorders(ctx)
 		assert.Equal(t, 5, len(out))
 	})
+
+	t.Run("already starting", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		// registered by another request that hasn't started it yet
+		require.NoError(t, mgr.RegisterRecorder(ctx, &mockRecorder{id: "default"}))
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording409JSONResponse{}, resp)
+	})
+
+	t.Run("concurrent starts", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		disp, fr, size := 0, 5, 1
+		params := recorder.FFmpegRecordingParams{FrameRate: &fr, DisplayNum: &disp, MaxSizeInMB: &size, OutputDir: ptrOf(t.TempDir())}
+		factory := recorder.NewFFmpegRecorderFactory(mockFFmpegBin, params, nil, scaletozero.NewNoopController())
+		svc, err := New(newTestConfig(), mgr, factory, newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		// duplicate starts racing the first must see it as in progress, even before it has started
+		ids := []string{"full", "region"}
+		var (
+			mu      sync.Mutex
+			created = map[string]int{}
+			wg      sync.WaitGroup
+		)
+		for _, id := range ids {
+			for range 3 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{Id: &id}})
+					require.NoError(t, err)
+					switch resp.(type) {
+					case oapi.StartRecording201Response:
+						mu.Lock()
+						created[id]++
+						mu.Unlock()
+					case oapi.StartRecording409JSONResponse:
+					default:
+						t.Errorf("unexpected response type for %q: %T", id, resp)
+					}
+				}()
+			}
+		}
+		wg.Wait()
+		for _, id := range ids {
+			assert.Equal(t, 1, created[id], "id %q", id)
+		}
+
+		for _, id := range ids {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := svc.StopRecording(ctx, oapi.StopRecordingRequestObject{Body: &oapi.StopRecordingJSONRequestBody{Id: &id, ForceStop: ptrOf(true)}})
+				require.NoError(t, err)
+				assert.IsType(t, oapi.StopRecording200Response{}, resp)
+			}()
+		}
+		wg.Wait()
+		for _, id := range ids {
+			rec, ok := mgr.GetRecorder(id)
+			require.True(t, ok)
+			assert.False(t, rec.IsRecording(ctx), "id %q", id)
+		}
+	})
+
+	t.Run("unsafe id", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		for _, id := range []string{"../../etc/cron", "a/b", "rec.mp4", strings.Repeat("a", 65)} {
+			resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{Id: &id}})
+			require.NoError(t, err)
+			bad, ok := resp.(oapi.StartRecording400JSONResponse)
+			require.True(t, ok, "unexpected response type for %q: %T", id, resp)
+			require.NotNil(t, bad.Code)
+			assert.Equal(t, oapi.InvalidRecorderId, *bad.Code)
+		}
+		assert.Empty(t, mgr.ListActiveRecorders(ctx))
+	})
+
+	t.Run("ffmpeg start timeout", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		factory := func(id string, _ recorder.FFmpegRecordingParams) (recorder.Recorder, error) {
+			return &mockRecorder{id: id, startErr: fmt.Errorf("%w: no output after 10s", recorder.ErrStartTimeout)}, nil
+		}
+		svc, err := New(newTestConfig(), mgr, factory, newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{})
+		require.NoError(t, err)
+		failed, ok := resp.(oapi.StartRecording500JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Contains(t, failed.Message, "did not start recording in time")
+		_, exists := mgr.GetRecorder("default")
+		assert.False(t, exists, "recorder should be deregistered")
+	})
+
+	t.Run("invalid framerate", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, t.TempDir()), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		zero := 0
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{Framerate: &zero}})
+		require.NoError(t, err)
+		invalid, ok := resp.(oapi.StartRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.NotNil(t, invalid.Code)
+		assert.Equal(t, oapi.InvalidRecordingParams, *invalid.Code)
+		assert.Contains(t, invalid.Message, "libx264")
+		assert.Empty(t, mgr.ListActiveRecorders(ctx))
+	})
+
+	t.Run("unknown capture mode", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, t.TempDir()), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		mode := oapi.StartRecordingRequestMode("webcam")
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{Mode: &mode}})
+		require.NoError(t, err)
+		invalid, ok := resp.(oapi.StartRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Contains(t, invalid.Message, "capture mode")
+		assert.Empty(t, mgr.ListActiveRecorders(ctx))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, t.TempDir()), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		dryRun := true
+		seven := 7
+		key := "dry-run"
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{DryRun: &dryRun, IdempotencyKey: &key},
+			Body:   &oapi.StartRecordingJSONRequestBody{Framerate: &seven},
+		})
+		require.NoError(t, err)
+		result, ok := resp.(oapi.StartRecording200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Equal(t, "default", result.Id)
+		assert.Equal(t, testMockFFmpegBin, result.Command)
+		assert.Contains(t, strings.Join(result.Args, " "), "-framerate 7")
+		assert.Equal(t, oapi.RecordingParams{Framerate: 7, DisplayNum: 0, MaxFileSizeInMB: 1, Mode: "screen"}, result.Params)
+		assert.Empty(t, mgr.ListActiveRecorders(ctx), "a dry run must not register a recorder")
+
+		// invalid parameters are reported as they would be for a real start
+		zero := 0
+		resp, err = svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{DryRun: &dryRun},
+			Body:   &oapi.StartRecordingJSONRequestBody{Framerate: &zero},
+		})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording400JSONResponse{}, resp)
+
+		// the key of a dry run is not remembered
+		resp, err = svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Params: oapi.StartRecordingParams{IdempotencyKey: &key}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+		rec, ok := mgr.GetRecorder("default")
+		require.True(t, ok)
+		require.NoError(t, rec.ForceStop(ctx))
+	})
+
+	t.Run("extra args", func(t *testing.T) {
+		cfg := newTestConfig()
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(cfg, mgr, testFFmpegFactory(t, t.TempDir()), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		dryRun := true
+		req := oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{DryRun: &dryRun},
+			Body:   &oapi.StartRecordingJSONRequestBody{ExtraArgs: &[]string{"-tune", "zerolatency"}},
+		}
+		resp, err := svc.StartRecording(ctx, req)
+		require.NoError(t, err)
+		denied, ok := resp.(oapi.StartRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Contains(t, denied.Message, "ALLOW_FFMPEG_EXTRA_ARGS")
+
+		cfg.AllowFFmpegExtraArgs = true
+		resp, err = svc.StartRecording(ctx, req)
+		require.NoError(t, err)
+		result, ok := resp.(oapi.StartRecording200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Contains(t, strings.Join(result.Args, " "), "-tune zerolatency")
+
+		req.Body.ExtraArgs = &[]string{"-metadata", "title=`id`"}
+		resp, err = svc.StartRecording(ctx, req)
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording400JSONResponse{}, resp)
+	})
+
+	t.Run("encoder quality", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, t.TempDir()), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		dryRun := true
+		preset, crf := "slow", 30
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{DryRun: &dryRun},
+			Body:   &oapi.StartRecordingJSONRequestBody{Preset: &preset, Crf: &crf},
+		})
+		require.NoError(t, err)
+		result, ok := resp.(oapi.StartRecording200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Contains(t, strings.Join(result.Args, " "), "-preset slow -crf 30")
+		require.NotNil(t, result.Params.Preset)
+		assert.Equal(t, "slow", *result.Params.Preset)
+		assert.Equal(t, &crf, result.Params.Crf)
+
+		preset = "placebo"
+		resp, err = svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{DryRun: &dryRun},
+			Body:   &oapi.StartRecordingJSONRequestBody{Preset: &preset},
+		})
+		require.NoError(t, err)
+		invalid, ok := resp.(oapi.StartRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Contains(t, invalid.Message, "ultrafast")
+	})
+
+	t.Run("display override", func(t *testing.T) {
+		cfg := newTestConfig()
+		cfg.RecordingAllowedDisplays = []int{2}
+		var gotDisplay *int
+		factory := func(id string, params recorder.FFmpegRecordingParams) (recorder.Recorder, error) {
+			gotDisplay = params.DisplayNum
+			return &mockRecorder{id: id}, nil
+		}
+		svc, err := New(cfg, recorder.NewFFmpegManager(), factory, newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		three := 3
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{DisplayNum: &three}})
+		require.NoError(t, err)
+		invalid, ok := resp.(oapi.StartRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Equal(t, oapi.InvalidRecordingParams, *invalid.Code)
+		assert.Contains(t, invalid.Message, "display :3")
+
+		two := 2
+		resp, err = svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{DisplayNum: &two}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+		require.NotNil(t, gotDisplay)
+		assert.Equal(t, 2, *gotDisplay)
+	})
+
+	t.Run("idempotency key", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		key := "retry-1"
+		req := oapi.StartRecordingRequestObject{Params: oapi.StartRecordingParams{IdempotencyKey: &key}}
+
+		resp, err := svc.StartRecording(ctx, req)
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+
+		// a retry with the same key returns the original result rather than a conflict
+		resp, err = svc.StartRecording(ctx, req)
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+
+		// a different key still conflicts with the running recording
+		other := "retry-2"
+		resp, err = svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Params: oapi.StartRecordingParams{IdempotencyKey: &other}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording409JSONResponse{}, resp)
+
+		// the same key cannot be reused for a different recorder
+		customID := "rec-other"
+		resp, err = svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{IdempotencyKey: &key},
+			Body:   &oapi.StartRecordingJSONRequestBody{Id: &customID},
+		})
+		require.NoError(t, err)
+		reused, ok := resp.(oapi.StartRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.NotNil(t, reused.Code)
+		assert.Equal(t, oapi.IdempotencyKeyReused, *reused.Code)
+		_, exists := mgr.GetRecorder(customID)
+		assert.False(t, exists)
+	})
 }
 
 func TestApiService_StopRecording(t *testing.T) {
@@ -93,7 +416,10 @@ func TestApiService_StopRecording(t *testing.T) {
 
 		resp, err := svc.StopRecording(ctx, oapi.StopRecordingRequestObject{})
 		require.NoError(t, err)
-		require.IsType(t, oapi.StopRecording400JSONResponse{}, resp)
+		notFound, ok := resp.(oapi.StopRecording400JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.NotNil(t, notFound.Code)
+		assert.Equal(t, oapi.RecorderNotFound, *notFound.Code)
 	})
 
 	t.Run("graceful stop", func(t *testing.T) {
@@ -155,7 +481,9 @@ func TestApiService_DownloadRecording(t *testing.T) {
 		// will return a 202 when the recording is too small
 		resp, err := svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{})
 		require.NoError(t, err)
-		require.IsType(t, oapi.DownloadRecording202Response{}, resp)
+		accepted, ok := resp.(oapi.DownloadRecording202Response)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, 300, accepted.Headers.RetryAfter)
 
 		// mimic writing more data to the recording
 		data := randomBytes(minRecordingSizeInBytes * 2)
@@ -191,9 +519,314 @@ func TestApiService_DownloadRecording(t *testing.T) {
 		require.NoError(t, copyErr)
 		require.Equal(t, data, buf.Bytes(), "response body mismatch")
 		require.Equal(t, int64(len(data)), r.ContentLength, "content length mismatch")
+		require.Equal(t, "bytes", r.Headers.AcceptRanges)
+		require.NotEmpty(t, r.Headers.ETag)
+	})
+
+	t.Run("conditional", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		modTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		rec := &mockRecorder{id: "default", recordingData: []byte("dummy video data"), modTime: modTime}
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec), "failed to register recorder")
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{})
+		require.NoError(t, err)
+		full := resp.(oapi.DownloadRecording200Videomp4Response)
+		etag := full.Headers.ETag
+		require.False(t, strings.HasPrefix(etag, "W/"), "finalized recordings get strong ETags")
+		require.Equal(t, "Thu, 02 Jan 2025 03:04:05 GMT", full.Headers.LastModified)
+
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{IfNoneMatch: ptrOf(`"other", ` + etag)}})
+		require.NoError(t, err)
+		notModified, ok := resp.(oapi.DownloadRecording304Response)
+		require.True(t, ok, "expected 304, got %T", resp)
+		require.Equal(t, etag, notModified.Headers.ETag)
+
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{IfModifiedSince: ptrOf("Thu, 02 Jan 2025 03:04:05 GMT")}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.DownloadRecording304Response{}, resp)
+
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{IfModifiedSince: ptrOf("Wed, 01 Jan 2025 00:00:00 GMT")}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.DownloadRecording200Videomp4Response{}, resp)
+
+		// a recording still being written is always sent, with a weak ETag
+		rec.isRecordingFlag = true
+		rec.recordingData = make([]byte, minRecordingSizeInBytes*2)
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{IfNoneMatch: ptrOf("*")}})
+		require.NoError(t, err)
+		inProgress, ok := resp.(oapi.DownloadRecording200Videomp4Response)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.True(t, strings.HasPrefix(inProgress.Headers.ETag, "W/"))
+	})
+
+	t.Run("range", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		data := []byte("0123456789")
+		rec := &mockRecorder{id: "default", recordingData: data, modTime: time.Now()}
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec), "failed to register recorder")
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{Range: ptrOf("bytes=2-5")}})
+		require.NoError(t, err)
+		partial, ok := resp.(oapi.DownloadRecording206Videomp4Response)
+		require.True(t, ok, "expected 206, got %T", resp)
+		body, err := io.ReadAll(partial.Body)
+		require.NoError(t, err)
+		require.Equal(t, "2345", string(body))
+		require.Equal(t, int64(4), partial.ContentLength)
+		require.Equal(t, "bytes 2-5/10", partial.Headers.ContentRange)
+
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{Range: ptrOf("bytes=10-")}})
+		require.NoError(t, err)
+		unsatisfiable, ok := resp.(oapi.DownloadRecording416Response)
+		require.True(t, ok, "expected 416, got %T", resp)
+		require.Equal(t, "bytes */10", unsatisfiable.Headers.ContentRange)
+
+		// a stale If-Range gets the whole file
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{Range: ptrOf("bytes=2-5"), IfRange: ptrOf(`"stale"`)}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.DownloadRecording200Videomp4Response{}, resp)
+		etag := resp.(oapi.DownloadRecording200Videomp4Response).Headers.ETag
+
+		resp, err = svc.DownloadRecording(ctx, oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{Range: ptrOf("bytes=-3"), IfRange: &etag}})
+		require.NoError(t, err)
+		partial, ok = resp.(oapi.DownloadRecording206Videomp4Response)
+		require.True(t, ok, "expected 206, got %T", resp)
+		body, err = io.ReadAll(partial.Body)
+		require.NoError(t, err)
+		require.Equal(t, "789", string(body))
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		box := func(boxType string, size int) []byte {
+			b := make([]byte, size)
+			binary.BigEndian.PutUint32(b, uint32(size))
+			copy(b[4:], boxType)
+			return b
+		}
+		mgr := recorder.NewFFmpegManager()
+		rec := &mockRecorder{id: "default", isRecordingFlag: true}
+		rec.recordingData = slices.Concat(box("ftyp", 16), box("moov", 64), box("moof", 32))
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec), "failed to register recorder")
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		snapshot := oapi.DownloadRecordingRequestObject{Params: oapi.DownloadRecordingParams{Snapshot: ptrOf(true)}}
+
+		// no complete fragment yet
+		resp, err := svc.DownloadRecording(ctx, snapshot)
+		require.NoError(t, err)
+		require.IsType(t, oapi.DownloadRecording202Response{}, resp)
+
+		// the second fragment's mdat is still being written
+		complete := slices.Concat(rec.recordingData, box("mdat", 128))
+		rec.recordingData = slices.Concat(complete, box("moof", 32), box("mdat", 128)[:50])
+		resp, err = svc.DownloadRecording(ctx, snapshot)
+		require.NoError(t, err)
+		r, ok := resp.(oapi.DownloadRecording200Videomp4Response)
+		require.True(t, ok, "expected 200, got %T", resp)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, complete, body)
+		require.Equal(t, int64(len(complete)), r.ContentLength)
+		require.Equal(t, "true", r.Headers.XRecordingPartial)
+		require.True(t, strings.HasPrefix(r.Headers.ETag, "W/"))
+
+		// once finalized the whole file is sent
+		rec.isRecordingFlag = false
+		resp, err = svc.DownloadRecording(ctx, snapshot)
+		require.NoError(t, err)
+		r, ok = resp.(oapi.DownloadRecording200Videomp4Response)
+		require.True(t, ok, "expected 200, got %T", resp)
+		require.Equal(t, int64(len(rec.recordingData)), r.ContentLength)
+		require.Equal(t, "false", r.Headers.XRecordingPartial)
+	})
+}
+
+func TestApiService_DeleteRecording(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("finalizing", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		rec := &mockRecorder{id: "default", deleteErr: recorder.ErrRecordingFinalizing}
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec), "failed to register recorder")
+
+		cfg := newTestConfig()
+		cfg.RecordingFinalizingRetryAfterSeconds = 7
+		svc, err := New(cfg, mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.DeleteRecording(ctx, oapi.DeleteRecordingRequestObject{})
+		require.NoError(t, err)
+		conflict, ok := resp.(oapi.DeleteRecording409JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, 7, conflict.Headers.RetryAfter)
+		require.NotNil(t, conflict.Body.Code)
+		assert.Equal(t, oapi.RecordingFinalizing, *conflict.Body.Code)
+		assert.False(t, rec.deleted)
+	})
+}
+
+func TestApiService_StreamRecordingProgress(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		resp, err := svc.StreamRecordingProgress(ctx, oapi.StreamRecordingProgressRequestObject{Id: "missing"})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StreamRecordingProgress404JSONResponse{}, resp)
+	})
+
+	t.Run("non-ffmpeg recorder", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		require.NoError(t, mgr.RegisterRecorder(ctx, &mockRecorder{id: "default", isRecordingFlag: true}))
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		resp, err := svc.StreamRecordingProgress(ctx, oapi.StreamRecordingProgressRequestObject{Id: "default"})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StreamRecordingProgress500JSONResponse{}, resp)
+	})
+
+	t.Run("never started", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		rec, err := testFFmpegFactory(t, t.TempDir())("idle", recorder.FFmpegRecordingParams{})
+		require.NoError(t, err)
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		reqCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		resp, err := svc.StreamRecordingProgress(reqCtx, oapi.StreamRecordingProgressRequestObject{Id: "idle"})
+		require.NoError(t, err)
+		r, ok := resp.(oapi.StreamRecordingProgress200TexteventStreamResponse)
+		require.True(t, ok, "expected 200 SSE response, got %T", resp)
+
+		// a recorder that hasn't run reports so, and the stream stays open in case it starts
+		line, err := bufio.NewReader(r.Body).ReadString('\n')
+		require.NoError(t, err)
+		var ev oapi.RecordingProgressEvent
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(strings.TrimSpace(line), "data: ")), &ev))
+		assert.Equal(t, oapi.NotStarted, ev.Event)
+		assert.Equal(t, int64(0), ev.Bytes)
+
+		// once the request is gone the stream ends even though nobody reads it
+		cancel()
+		_, err = io.ReadAll(r.Body)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("closes after finished", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		disp, fr, size := 0, 5, 1
+		params := recorder.FFmpegRecordingParams{FrameRate: &fr, DisplayNum: &disp, MaxSizeInMB: &size, OutputDir: ptrOf(t.TempDir())}
+		factory := recorder.NewFFmpegRecorderFactory(mockFFmpegBin, params, nil, scaletozero.NewNoopController())
+		rec, err := factory("progress", recorder.FFmpegRecordingParams{})
+		require.NoError(t, err)
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+		require.NoError(t, rec.Start(ctx))
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.StreamRecordingProgress(ctx, oapi.StreamRecordingProgressRequestObject{Id: "progress"})
+		require.NoError(t, err)
+		r, ok := resp.(oapi.StreamRecordingProgress200TexteventStreamResponse)
+		require.True(t, ok, "expected 200 SSE response, got %T", resp)
+
+		// the mock never writes output, so finalization fails fast but still completes
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			_ = rec.ForceStop(ctx)
+		}()
+
+		events := readRecordingProgressEvents(t, r.Body)
+		require.GreaterOrEqual(t, len(events), 2)
+		assert.Equal(t, oapi.Progress, events[0].Event)
+		for _, ev := range events[:len(events)-1] {
+			assert.Equal(t, oapi.Progress, ev.Event)
+		}
+		assert.Equal(t, oapi.Finished, events[len(events)-1].Event)
+	})
+}
+
+func TestApiService_GetRecordingStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not found", func(t *testing.T) {
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		resp, err := svc.GetRecordingStatus(ctx, oapi.GetRecordingStatusRequestObject{Id: "missing"})
+		require.NoError(t, err)
+		require.IsType(t, oapi.GetRecordingStatus404JSONResponse{}, resp)
+	})
+
+	t.Run("unsafe id", func(t *testing.T) {
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		resp, err := svc.GetRecordingStatus(ctx, oapi.GetRecordingStatusRequestObject{Id: "../default"})
+		require.NoError(t, err)
+		require.IsType(t, oapi.GetRecordingStatus400JSONResponse{}, resp)
+	})
+
+	t.Run("samples running ffmpeg", func(t *testing.T) {
+		mgr := recorder.NewFFmpegManager()
+		disp, fr, size := 0, 5, 1
+		params := recorder.FFmpegRecordingParams{FrameRate: &fr, DisplayNum: &disp, MaxSizeInMB: &size, OutputDir: ptrOf(t.TempDir())}
+		factory := recorder.NewFFmpegRecorderFactory(mockFFmpegBin, params, nil, scaletozero.NewNoopController())
+		rec, err := factory("status", recorder.FFmpegRecordingParams{})
+		require.NoError(t, err)
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+		require.NoError(t, rec.Start(ctx))
+		svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		resp, err := svc.GetRecordingStatus(ctx, oapi.GetRecordingStatusRequestObject{Id: "status"})
+		require.NoError(t, err)
+		status, ok := resp.(oapi.GetRecordingStatus200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.True(t, status.IsRecording)
+		require.NotNil(t, status.StartedAt)
+		require.NotNil(t, status.Resources)
+		assert.Greater(t, status.Resources.RssBytes, int64(0))
+
+		// once ffmpeg has exited there is nothing left to sample
+		require.NoError(t, rec.ForceStop(ctx))
+		resp, err = svc.GetRecordingStatus(ctx, oapi.GetRecordingStatusRequestObject{Id: "status"})
+		require.NoError(t, err)
+		status, ok = resp.(oapi.GetRecordingStatus200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.False(t, status.IsRecording)
+		assert.NotNil(t, status.FinishedAt)
+		assert.Nil(t, status.Resources)
 	})
 }
 
+// mockFFmpegBin stands in for ffmpeg; it idles until signalled and never writes output.
+var mockFFmpegBin = filepath.Join("..", "..", "..", "lib", "recorder", "testdata", "mock_ffmpeg.sh")
+
+// readRecordingProgressEvents reads SSE data events until the stream is closed.
+func readRecordingProgressEvents(t *testing.T, body io.Reader) []oapi.RecordingProgressEvent {
+	t.Helper()
+	var events []oapi.RecordingProgressEvent
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		line, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var ev oapi.RecordingProgressEvent
+		require.NoError(t, json.Unmarshal([]byte(line), &ev))
+		events = append(events, ev)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
 func TestApiService_Shutdown(t *testing.T) {
 	ctx := context.Background()
 	mgr := recorder.NewFFmpegManager()
@@ -223,7 +856,9 @@ type mockRecorder struct {
 	stopErr       error
 	forceStopErr  error
 	recordingErr  error
+	deleteErr     error
 	recordingData []byte
+	modTime       time.Time
 	deleted       bool
 }
 
@@ -265,8 +900,11 @@ func (m *mockRecorder) Recording(ctx context.Context) (io.ReadCloser, *recorder.
 	if m.recordingErr != nil {
 		return nil, nil, m.recordingErr
 	}
-	reader := io.NopCloser(bytes.NewReader(m.recordingData))
-	meta := &recorder.RecordingMetadata{Size: int64(len(m.recordingData))}
+	reader := struct {
+		*bytes.Reader
+		io.Closer
+	}{bytes.NewReader(m.recordingData), io.NopCloser(nil)}
+	meta := &recorder.RecordingMetadata{Size: int64(len(m.recordingData)), ModTime: m.modTime}
 	return reader, meta, nil
 }
 
@@ -278,6 +916,9 @@ func (m *mockRecorder) Delete(ctx context.Context) error {
 	if m.isRecordingFlag {
 		return fmt.Errorf("still recording")
 	}
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
 	m.deleted = true
 	return nil
 }
@@ -309,6 +950,19 @@ func newTestConfig() *config.Config {
 		TEEKUrl:     "wss://tk.reclaimprotocol.org/ws",
 		TEETUrl:     "wss://tt.reclaimprotocol.org/ws",
 		AttestorUrl: "wss://attestor.reclaimprotocol.org:444/ws",
+
+		RecordingDefaultID:                   "default",
+		RecordingRetryAfterSeconds:           300,
+		RecordingFinalizingRetryAfterSeconds: 5,
+		RecordingQueueRetryAfterSeconds:      5,
+		RecordingConvertRetryAfterSeconds:    5,
+		RecordingStartQueueDepth:             16,
+		RecordingStartQueueTimeoutSeconds:    60,
+		ReclaimRetryAfterSeconds:             5,
+		ReclaimCircuitsRetryAfterSeconds:     10,
+
+		PTYAttachBufferBytes:  2 << 20,
+		PTYAttachBufferPolicy: "block",
 	}
 }
 
@@ -344,3 +998,328 @@ func TestApiService_PatchChromiumFlags(t *testing.T) {
 		t.Fatalf("unexpected response type: %T", resp)
 	}
 }
+
+func TestApiService_WarmupChromium(t *testing.T) {
+	ctx := context.Background()
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	// no DevTools URL has been discovered yet
+	resp, err := svc.WarmupChromium(ctx, oapi.WarmupChromiumRequestObject{})
+	require.NoError(t, err)
+	require.IsType(t, oapi.WarmupChromium503JSONResponse{}, resp)
+}
+
+func TestApiService_GetRecordingManifest(t *testing.T) {
+	ctx := context.Background()
+	tempDir := t.TempDir()
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, tempDir), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	resp, err := svc.GetRecordingManifest(ctx, oapi.GetRecordingManifestRequestObject{Id: "missing"})
+	require.NoError(t, err)
+	require.IsType(t, oapi.GetRecordingManifest404JSONResponse{}, resp)
+
+	// fragmented so stopping doesn't invoke the mock ffmpeg to remux
+	rec, err := testFFmpegFactory(t, tempDir)("manifest", recorder.FFmpegRecordingParams{Fragmented: true})
+	require.NoError(t, err)
+	require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+	require.NoError(t, rec.Start(ctx))
+
+	resp, err = svc.GetRecordingManifest(ctx, oapi.GetRecordingManifestRequestObject{Id: "manifest"})
+	require.NoError(t, err)
+	require.IsType(t, oapi.GetRecordingManifest409JSONResponse{}, resp)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "manifest.mp4"), []byte("fragmented mp4"), 0644))
+	require.NoError(t, rec.Stop(ctx))
+
+	resp, err = svc.GetRecordingManifest(ctx, oapi.GetRecordingManifestRequestObject{Id: "manifest"})
+	require.NoError(t, err)
+	m, ok := resp.(oapi.GetRecordingManifest200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	assert.Equal(t, "manifest", m.Id)
+	assert.Equal(t, "manifest.mp4", m.File)
+	assert.Equal(t, oapi.Stopped, m.ExitReason)
+	assert.Equal(t, int64(len("fragmented mp4")), m.Size)
+	assert.True(t, m.Params.Fragmented)
+	assert.Nil(t, m.FinalizeError)
+}
+
+func TestApiService_SetChromiumEmulation(t *testing.T) {
+	ctx := context.Background()
+	upstreamMgr, _ := newTestBrowser(t)
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	t.Run("invalid overrides", func(t *testing.T) {
+		negative := -1.0
+		for _, body := range []oapi.ChromiumEmulationRequest{
+			{},
+			{Geolocation: &oapi.ChromiumGeolocation{Latitude: 91, Longitude: 0}},
+			{Geolocation: &oapi.ChromiumGeolocation{Latitude: 0, Longitude: -181}},
+			{Geolocation: &oapi.ChromiumGeolocation{Latitude: 0, Longitude: 0, Accuracy: &negative}},
+			{Timezone: ptrOf("Mars/Olympus_Mons")},
+			{Timezone: ptrOf("Local")},
+			{Locale: ptrOf("not a locale")},
+		} {
+			resp, err := svc.SetChromiumEmulation(ctx, oapi.SetChromiumEmulationRequestObject{Body: &body})
+			require.NoError(t, err)
+			require.IsType(t, oapi.SetChromiumEmulation400JSONResponse{}, resp, "body %+v", body)
+		}
+	})
+
+	t.Run("applies overrides", func(t *testing.T) {
+		resp, err := svc.SetChromiumEmulation(ctx, oapi.SetChromiumEmulationRequestObject{Body: &oapi.ChromiumEmulationRequest{
+			Geolocation: &oapi.ChromiumGeolocation{Latitude: 52.52, Longitude: 13.405},
+			Timezone:    ptrOf("Europe/Berlin"),
+			Locale:      ptrOf("de-de"),
+		}})
+		require.NoError(t, err)
+		applied, ok := resp.(oapi.SetChromiumEmulation200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Equal(t, oapi.SetChromiumEmulation200JSONResponse{
+			Geolocation: &oapi.ChromiumGeolocation{Latitude: 52.52, Longitude: 13.405, Accuracy: ptrOf(0.0)},
+			Timezone:    ptrOf("Europe/Berlin"),
+			Locale:      ptrOf("de-DE"),
+		}, applied)
+	})
+
+	t.Run("upstream unavailable", func(t *testing.T) {
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		resp, err := svc.SetChromiumEmulation(ctx, oapi.SetChromiumEmulationRequestObject{Body: &oapi.ChromiumEmulationRequest{Locale: ptrOf("en-US")}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.SetChromiumEmulation503JSONResponse{}, resp)
+	})
+}
+
+func TestApiService_GetRecordingLocation(t *testing.T) {
+	ctx := context.Background()
+	tempDir := t.TempDir()
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, tempDir), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	resp, err := svc.GetRecordingLocation(ctx, oapi.GetRecordingLocationRequestObject{Id: "missing"})
+	require.NoError(t, err)
+	require.IsType(t, oapi.GetRecordingLocation404JSONResponse{}, resp)
+
+	for id, tenant := range map[string]string{"plain": "", "tenanted": "acme"} {
+		rec, err := testFFmpegFactory(t, tempDir)(id, recorder.FFmpegRecordingParams{Tenant: tenant})
+		require.NoError(t, err)
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+	}
+
+	resp, err = svc.GetRecordingLocation(ctx, oapi.GetRecordingLocationRequestObject{Id: "plain"})
+	require.NoError(t, err)
+	loc, ok := resp.(oapi.GetRecordingLocation200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	assert.Equal(t, oapi.Local, loc.Backend)
+	assert.Equal(t, "plain.mp4", loc.Path)
+	assert.False(t, loc.Uploaded)
+
+	// tenant recordings live in a subdirectory, still reported relative to OUTPUT_DIR
+	resp, err = svc.GetRecordingLocation(ctx, oapi.GetRecordingLocationRequestObject{Id: "tenanted"})
+	require.NoError(t, err)
+	loc, ok = resp.(oapi.GetRecordingLocation200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	assert.Equal(t, "acme/tenanted.mp4", loc.Path)
+}
+
+func TestApiService_StartRecordingOutputDirUnwritable(t *testing.T) {
+	ctx := context.Background()
+	missing := filepath.Join(t.TempDir(), "missing")
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, missing), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{}})
+	require.NoError(t, err)
+	failed, ok := resp.(oapi.StartRecording500JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	require.Equal(t, oapi.OutputDirUnwritable, *failed.Code)
+	require.Contains(t, failed.Message, "no such file or directory")
+	_, exists := mgr.GetRecorder("default")
+	require.False(t, exists, "the recorder is not registered")
+}
+
+func TestApiService_ListRecordersByTag(t *testing.T) {
+	ctx := context.Background()
+	tempDir := t.TempDir()
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(newTestConfig(), mgr, testFFmpegFactory(t, tempDir), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	for id, tags := range map[string]map[string]string{
+		"prod":     {"env": "prod", "team": "core"},
+		"staging":  {"env": "staging"},
+		"untagged": nil,
+	} {
+		rec, err := testFFmpegFactory(t, tempDir)(id, recorder.FFmpegRecordingParams{Label: id + " run", Tags: tags})
+		require.NoError(t, err)
+		require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+	}
+
+	list := func(tags ...string) []oapi.RecorderInfo {
+		var params oapi.ListRecordersParams
+		if tags != nil {
+			params.Tag = &tags
+		}
+		resp, err := svc.ListRecorders(ctx, oapi.ListRecordersRequestObject{Params: params})
+		require.NoError(t, err)
+		return resp.(oapi.ListRecorders200JSONResponse)
+	}
+
+	assert.Len(t, list(), 3)
+	infos := list("env=prod")
+	require.Len(t, infos, 1)
+	assert.Equal(t, "prod", infos[0].Id)
+	assert.Equal(t, "prod run", *infos[0].Label)
+	assert.Equal(t, map[string]string{"env": "prod", "team": "core"}, *infos[0].Tags)
+	assert.Len(t, list("env"), 2)
+	assert.Len(t, list("env", "team=core"), 1)
+	assert.Empty(t, list("env=dev"))
+}
+
+func TestApiService_GetChromiumTargets(t *testing.T) {
+	ctx := context.Background()
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	resp, err := svc.GetChromiumTargets(ctx, oapi.GetChromiumTargetsRequestObject{})
+	require.NoError(t, err)
+	require.IsType(t, oapi.GetChromiumTargets503JSONResponse{}, resp)
+
+	upstreamMgr, _ := newTestBrowser(t)
+	svc, err = New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	resp, err = svc.GetChromiumTargets(ctx, oapi.GetChromiumTargetsRequestObject{})
+	require.NoError(t, err)
+	targets, ok := resp.(oapi.GetChromiumTargets200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	assert.Equal(t, []oapi.ChromiumTarget{
+		{TargetId: "page-1", Type: "page", Title: "Example", Url: "https://example.com/", Attached: true},
+	}, targets.Targets)
+}
+
+func TestApiService_SendCDPCommand(t *testing.T) {
+	ctx := context.Background()
+	upstreamMgr, _ := newTestBrowser(t)
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	t.Run("returns the result", func(t *testing.T) {
+		resp, err := svc.SendCDPCommand(ctx, oapi.SendCDPCommandRequestObject{Body: &oapi.CDPCommandRequest{Method: "Browser.getVersion"}})
+		require.NoError(t, err)
+		result, ok := resp.(oapi.SendCDPCommand200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.NotNil(t, result.Result)
+		assert.Nil(t, result.Error)
+		assert.Equal(t, "Chrome/131.0.6778.85", (*result.Result)["product"])
+	})
+
+	t.Run("attaches to target_id", func(t *testing.T) {
+		target := "page-1"
+		params := map[string]interface{}{"width": 800, "height": 600, "deviceScaleFactor": 1, "mobile": false}
+		resp, err := svc.SendCDPCommand(ctx, oapi.SendCDPCommandRequestObject{Body: &oapi.CDPCommandRequest{
+			Method: "Emulation.setDeviceMetricsOverride", Params: &params, TargetId: &target,
+		}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.SendCDPCommand200JSONResponse{}, resp)
+	})
+
+	t.Run("rejects commands outside the allowlist", func(t *testing.T) {
+		resp, err := svc.SendCDPCommand(ctx, oapi.SendCDPCommandRequestObject{Body: &oapi.CDPCommandRequest{Method: "Runtime.evaluate"}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.SendCDPCommand400JSONResponse{}, resp)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		// the fake browser never answers DOM.enable
+		timeout := 1
+		resp, err := svc.SendCDPCommand(ctx, oapi.SendCDPCommandRequestObject{Body: &oapi.CDPCommandRequest{Method: "DOM.enable", TimeoutSec: &timeout}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.SendCDPCommand504JSONResponse{}, resp)
+	})
+}
+
+func TestApiService_ChromiumCookies(t *testing.T) {
+	ctx := context.Background()
+	upstreamMgr, _ := newTestBrowser(t)
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	t.Run("export", func(t *testing.T) {
+		resp, err := svc.GetChromiumCookies(ctx, oapi.GetChromiumCookiesRequestObject{})
+		require.NoError(t, err)
+		cookies, ok := resp.(oapi.GetChromiumCookies200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		lax := "Lax"
+		assert.Equal(t, []oapi.ChromiumCookie{
+			{Name: "sid", Value: "abc", Domain: "example.com", Path: "/", Expires: -1, HttpOnly: true, Secure: true, Session: true, SameSite: &lax},
+		}, cookies.Cookies)
+	})
+
+	t.Run("import reports failures", func(t *testing.T) {
+		domain := "example.com"
+		resp, err := svc.SetChromiumCookies(ctx, oapi.SetChromiumCookiesRequestObject{Body: &oapi.SetChromiumCookiesRequest{Cookies: []oapi.ChromiumCookieParam{
+			{Name: "sid", Value: "abc", Domain: &domain},
+			{Name: "nowhere", Value: "x"},
+			{Name: "rejected", Value: "x", Domain: &domain},
+		}}})
+		require.NoError(t, err)
+		result, ok := resp.(oapi.SetChromiumCookies200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Equal(t, 1, result.Set)
+		require.Len(t, result.Failed, 2)
+		assert.Equal(t, oapi.ChromiumCookieFailure{Index: 1, Name: "nowhere", Error: "url or domain is required"}, result.Failed[0])
+		assert.Equal(t, 2, result.Failed[1].Index)
+		assert.Contains(t, result.Failed[1].Error, "Invalid cookie fields")
+	})
+
+	t.Run("import requires cookies", func(t *testing.T) {
+		resp, err := svc.SetChromiumCookies(ctx, oapi.SetChromiumCookiesRequestObject{Body: &oapi.SetChromiumCookiesRequest{}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.SetChromiumCookies400JSONResponse{}, resp)
+	})
+}
+
+func TestApiService_SetChromiumViewport(t *testing.T) {
+	ctx := context.Background()
+	upstreamMgr, _ := newTestBrowser(t)
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	t.Run("invalid ranges", func(t *testing.T) {
+		tooSmall := float32(0.01)
+		for _, body := range []oapi.ChromiumViewportRequest{
+			{Width: 0, Height: 600},
+			{Width: 800, Height: 5000},
+			{Width: 800, Height: 600, DeviceScaleFactor: &tooSmall},
+		} {
+			resp, err := svc.SetChromiumViewport(ctx, oapi.SetChromiumViewportRequestObject{Body: &body})
+			require.NoError(t, err)
+			require.IsType(t, oapi.SetChromiumViewport400JSONResponse{}, resp, "body %+v", body)
+		}
+	})
+
+	t.Run("applies metrics", func(t *testing.T) {
+		scale := float32(2)
+		mobile, resize := true, true
+		resp, err := svc.SetChromiumViewport(ctx, oapi.SetChromiumViewportRequestObject{Body: &oapi.ChromiumViewportRequest{
+			Width: 390, Height: 844, DeviceScaleFactor: &scale, Mobile: &mobile, ResizeWindow: &resize,
+		}})
+		require.NoError(t, err)
+		applied, ok := resp.(oapi.SetChromiumViewport200JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		assert.Equal(t, oapi.SetChromiumViewport200JSONResponse{Width: 390, Height: 844, DeviceScaleFactor: 2, Mobile: true, WindowResized: true}, applied)
+	})
+
+	t.Run("upstream unavailable", func(t *testing.T) {
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		resp, err := svc.SetChromiumViewport(ctx, oapi.SetChromiumViewportRequestObject{Body: &oapi.ChromiumViewportRequest{Width: 800, Height: 600}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.SetChromiumViewport503JSONResponse{}, resp)
+	})
+}
@@ -2,21 +2,30 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/onkernel/kernel-images/server/lib/cdpclient"
 	"github.com/onkernel/kernel-images/server/lib/chromiumflags"
+	"github.com/onkernel/kernel-images/server/lib/devtoolsproxy"
+	"github.com/onkernel/kernel-images/server/lib/extensions"
 	"github.com/onkernel/kernel-images/server/lib/logger"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 	"github.com/onkernel/kernel-images/server/lib/policy"
 	"github.com/onkernel/kernel-images/server/lib/ziputil"
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
 )
 
 var nameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]{1,255}$`)
@@ -25,7 +34,7 @@ var nameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]{1,255}$`)
 const chromiumFlagsPath = "/chromium/flags"
 
 // UploadExtensionsAndRestart handles multipart upload of one or more extension zips, extracts
-// them under /home/kernel/extensions/<name>, writes /chromium/flags to enable them, restarts
+// them under EXTENSIONS_DIR/<name>, writes /chromium/flags to enable them, restarts
 // Chromium via supervisord, and waits (via UpstreamManager) until DevTools is ready.
 func (s *ApiService) UploadExtensionsAndRestart(ctx context.Context, request oapi.UploadExtensionsAndRestartRequestObject) (oapi.UploadExtensionsAndRestartResponseObject, error) {
 	log := logger.FromContext(ctx)
@@ -131,7 +140,7 @@ func (s *ApiService) UploadExtensionsAndRestart(ctx context.Context, request oap
 	}
 
 	// Materialize uploads
-	extBase := "/home/kernel/extensions"
+	extBase := s.config.ExtensionsDir
 
 	// Fail early if any destination already exists
 	for _, p := range items {
@@ -191,12 +200,18 @@ func (s *ApiService) UploadExtensionsAndRestart(ctx context.Context, request oap
 			// Continue with requiresEntPolicy = false
 		}
 
-		// Try to extract Chrome extension ID from update.xml
+		// Try to extract Chrome extension ID from update.xml. Without one, the extensions
+		// server generates it from the .crx, whose header declares the ID.
 		chromeExtensionID := extensionName
 		var extractionErr error
+		generatedUpdateXML := false
 		if extractedID, err := policy.ExtractExtensionIDFromUpdateXML(updateXMLPath); err == nil {
 			chromeExtensionID = extractedID
 			log.Info("extracted Chrome extension ID from update.xml", "name", extensionName, "chromeExtensionID", chromeExtensionID)
+		} else if ext, descErr := extensions.Describe(extBase, extensionName); descErr == nil && !ext.HasUpdateXML && ext.ID != "" {
+			chromeExtensionID = ext.ID
+			generatedUpdateXML = true
+			log.Info("extracted Chrome extension ID from crx, update.xml will be generated", "name", extensionName, "chromeExtensionID", chromeExtensionID, "crx_file", ext.CRX)
 		} else {
 			extractionErr = err
 			log.Info("no Chrome extension ID in update.xml, using name as ID", "name", extensionName, "error", err)
@@ -210,7 +225,9 @@ func (s *ApiService) UploadExtensionsAndRestart(ctx context.Context, request oap
 			hasUpdateXML := false
 			hasCRX := false
 
-			if _, err := os.Stat(updateXMLPath); err == nil {
+			if generatedUpdateXML {
+				hasUpdateXML = true
+			} else if _, err := os.Stat(updateXMLPath); err == nil {
 				// For policy extensions, update.xml must exist AND be parseable
 				if extractionErr != nil {
 					return oapi.UploadExtensionsAndRestart400JSONResponse{
@@ -448,3 +465,437 @@ func (s *ApiService) PatchChromiumFlags(ctx context.Context, request oapi.PatchC
 	log.Info("devtools ready after flags update", "elapsed", time.Since(start).String())
 	return oapi.PatchChromiumFlags200Response{}, nil
 }
+
+// Bounds on SendCDPCommand's timeout_sec.
+const (
+	defaultCDPCommandTimeout = 10 * time.Second
+	maxCDPCommandTimeout     = 60 * time.Second
+)
+
+// SendCDPCommand forwards a single CDP command over a short-lived DevTools connection
+// and returns its result or the error the browser reported. Only commands allowed on
+// the restricted CDP endpoint are accepted.
+func (s *ApiService) SendCDPCommand(ctx context.Context, request oapi.SendCDPCommandRequestObject) (oapi.SendCDPCommandResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if request.Body == nil || request.Body.Method == "" {
+		return oapi.SendCDPCommand400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "method is required"}}, nil
+	}
+	body := *request.Body
+	if !devtoolsproxy.CommandAllowed(body.Method) {
+		return oapi.SendCDPCommand400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("CDP method %q is not allowed", body.Method)}}, nil
+	}
+	if body.TargetId != nil && body.SessionId != nil {
+		return oapi.SendCDPCommand400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "target_id and session_id are mutually exclusive"}}, nil
+	}
+	timeout := defaultCDPCommandTimeout
+	if body.TimeoutSec != nil {
+		timeout = time.Duration(*body.TimeoutSec) * time.Second
+		if timeout <= 0 || timeout > maxCDPCommandTimeout {
+			return oapi.SendCDPCommand400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("timeout_sec must be between 1 and %d", int(maxCDPCommandTimeout.Seconds()))}}, nil
+		}
+	}
+	var params json.RawMessage
+	if body.Params != nil {
+		b, err := json.Marshal(*body.Params)
+		if err != nil {
+			return oapi.SendCDPCommand400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "invalid params"}}, nil
+		}
+		params = b
+	}
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.SendCDPCommand503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	cdpCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("failed to connect to devtools", "err", err)
+		return oapi.SendCDPCommand503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	sessionID := lo.FromPtr(body.SessionId)
+	if body.TargetId != nil {
+		sessionID, err = client.Attach(cdpCtx, *body.TargetId)
+		if err != nil {
+			return cdpCommandFailure(cdpCtx, log, body.Method, err)
+		}
+	}
+
+	raw, err := client.Send(cdpCtx, body.Method, params, sessionID)
+	if err != nil {
+		return cdpCommandFailure(cdpCtx, log, body.Method, err)
+	}
+	var result map[string]interface{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &result); err != nil {
+			log.Error("failed to decode CDP result", "method", body.Method, "err", err)
+			return oapi.SendCDPCommand500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to decode CDP result"}}, nil
+		}
+	}
+	if result == nil {
+		result = map[string]interface{}{}
+	}
+	return oapi.SendCDPCommand200JSONResponse{Result: &result}, nil
+}
+
+// cdpCommandFailure maps an error from sending a CDP command to a response: the
+// browser's own errors are passed through, a missed deadline is a timeout.
+func cdpCommandFailure(ctx context.Context, log *slog.Logger, method string, err error) (oapi.SendCDPCommandResponseObject, error) {
+	var cdpErr *cdpclient.Error
+	if errors.As(err, &cdpErr) {
+		return oapi.SendCDPCommand200JSONResponse{Error: &oapi.CDPError{Code: cdpErr.Code, Message: cdpErr.Message}}, nil
+	}
+	if ctx.Err() != nil {
+		return oapi.SendCDPCommand504JSONResponse{Message: fmt.Sprintf("%s did not complete in time", method)}, nil
+	}
+	log.Error("failed to send CDP command", "method", method, "err", err)
+	return oapi.SendCDPCommand500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to send CDP command"}}, nil
+}
+
+// GetChromiumCookies returns every browser cookie via CDP Network.getAllCookies.
+func (s *ApiService) GetChromiumCookies(ctx context.Context, request oapi.GetChromiumCookiesRequestObject) (oapi.GetChromiumCookiesResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.GetChromiumCookies503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	cdpCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("failed to connect to devtools", "err", err)
+		return oapi.GetChromiumCookies503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	cookies, err := client.Cookies(cdpCtx)
+	if err != nil {
+		log.Error("failed to get cookies", "err", err)
+		return oapi.GetChromiumCookies500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to get cookies"}}, nil
+	}
+	out := make([]oapi.ChromiumCookie, 0, len(cookies))
+	for _, c := range cookies {
+		cookie := oapi.ChromiumCookie{
+			Name:     c.Name,
+			Value:    c.Value,
+			Domain:   c.Domain,
+			Path:     c.Path,
+			Expires:  c.Expires,
+			HttpOnly: c.HTTPOnly,
+			Secure:   c.Secure,
+			Session:  c.Session,
+		}
+		if c.SameSite != "" {
+			cookie.SameSite = &c.SameSite
+		}
+		out = append(out, cookie)
+	}
+	return oapi.GetChromiumCookies200JSONResponse{Cookies: out}, nil
+}
+
+// SetChromiumCookies sets each requested cookie via CDP Network.setCookie and
+// reports the ones that failed, so one bad cookie does not block the rest.
+func (s *ApiService) SetChromiumCookies(ctx context.Context, request oapi.SetChromiumCookiesRequestObject) (oapi.SetChromiumCookiesResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if request.Body == nil || len(request.Body.Cookies) == 0 {
+		return oapi.SetChromiumCookies400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "at least one cookie is required"}}, nil
+	}
+
+	failed := []oapi.ChromiumCookieFailure{}
+	var params []cdpclient.CookieParam
+	var indexes []int
+	for i, c := range request.Body.Cookies {
+		param := cdpclient.CookieParam{
+			Name:     c.Name,
+			Value:    c.Value,
+			URL:      lo.FromPtr(c.Url),
+			Domain:   lo.FromPtr(c.Domain),
+			Path:     lo.FromPtr(c.Path),
+			Secure:   lo.FromPtr(c.Secure),
+			HTTPOnly: lo.FromPtr(c.HttpOnly),
+			SameSite: lo.FromPtr(c.SameSite),
+			Expires:  lo.FromPtr(c.Expires),
+		}
+		switch {
+		case param.Name == "":
+			failed = append(failed, oapi.ChromiumCookieFailure{Index: i, Name: c.Name, Error: "name is required"})
+		case param.URL == "" && param.Domain == "":
+			failed = append(failed, oapi.ChromiumCookieFailure{Index: i, Name: c.Name, Error: "url or domain is required"})
+		default:
+			params = append(params, param)
+			indexes = append(indexes, i)
+		}
+	}
+	if len(params) == 0 {
+		return oapi.SetChromiumCookies200JSONResponse{Set: 0, Failed: failed}, nil
+	}
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.SetChromiumCookies503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	cdpCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("failed to connect to devtools", "err", err)
+		return oapi.SetChromiumCookies503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	errs, err := client.SetCookies(cdpCtx, params)
+	if err != nil {
+		log.Error("failed to set cookies", "err", err)
+		return oapi.SetChromiumCookies500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to set cookies"}}, nil
+	}
+	set := 0
+	for j, err := range errs {
+		if err == nil {
+			set++
+			continue
+		}
+		failed = append(failed, oapi.ChromiumCookieFailure{Index: indexes[j], Name: params[j].Name, Error: err.Error()})
+	}
+	slices.SortFunc(failed, func(a, b oapi.ChromiumCookieFailure) int { return a.Index - b.Index })
+
+	log.Info("cookies set", "set", set, "failed", len(failed))
+	return oapi.SetChromiumCookies200JSONResponse{Set: set, Failed: failed}, nil
+}
+
+// GetChromiumTargets lists the browser's CDP targets and whether a client is attached to
+// each, queried over a fresh connection that doesn't attach to anything itself.
+func (s *ApiService) GetChromiumTargets(ctx context.Context, request oapi.GetChromiumTargetsRequestObject) (oapi.GetChromiumTargetsResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.GetChromiumTargets503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	cdpCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("failed to connect to devtools", "err", err)
+		return oapi.GetChromiumTargets503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	targets, err := client.Targets(cdpCtx)
+	if err != nil {
+		log.Error("failed to list targets", "err", err)
+		return oapi.GetChromiumTargets500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to list targets"}}, nil
+	}
+	out := make([]oapi.ChromiumTarget, 0, len(targets))
+	for _, t := range targets {
+		target := oapi.ChromiumTarget{
+			TargetId: t.TargetID,
+			Type:     t.Type,
+			Title:    t.Title,
+			Url:      t.URL,
+			Attached: t.Attached,
+		}
+		if t.OpenerID != "" {
+			target.OpenerId = &t.OpenerID
+		}
+		out = append(out, target)
+	}
+	return oapi.GetChromiumTargets200JSONResponse{Targets: out}, nil
+}
+
+// Viewport limits accepted by SetChromiumViewport.
+const (
+	maxViewportWidth     = 7680
+	maxViewportHeight    = 4320
+	minDeviceScaleFactor = 0.1
+	maxDeviceScaleFactor = 10.0
+)
+
+// SetChromiumViewport overrides the first page's viewport size and scale over CDP,
+// optionally resizing the browser window to match, without restarting Chromium.
+func (s *ApiService) SetChromiumViewport(ctx context.Context, request oapi.SetChromiumViewportRequestObject) (oapi.SetChromiumViewportResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if request.Body == nil {
+		return oapi.SetChromiumViewport400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "request body required"}}, nil
+	}
+	body := *request.Body
+	metrics := cdpclient.DeviceMetrics{Width: body.Width, Height: body.Height, DeviceScaleFactor: 1}
+	if body.DeviceScaleFactor != nil {
+		metrics.DeviceScaleFactor = float64(*body.DeviceScaleFactor)
+	}
+	if body.Mobile != nil {
+		metrics.Mobile = *body.Mobile
+	}
+	resizeWindow := body.ResizeWindow != nil && *body.ResizeWindow
+
+	if metrics.Width < 1 || metrics.Width > maxViewportWidth {
+		return oapi.SetChromiumViewport400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("width must be between 1 and %d", maxViewportWidth)}}, nil
+	}
+	if metrics.Height < 1 || metrics.Height > maxViewportHeight {
+		return oapi.SetChromiumViewport400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("height must be between 1 and %d", maxViewportHeight)}}, nil
+	}
+	if metrics.DeviceScaleFactor < minDeviceScaleFactor || metrics.DeviceScaleFactor > maxDeviceScaleFactor {
+		return oapi.SetChromiumViewport400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("device_scale_factor must be between %g and %g", minDeviceScaleFactor, maxDeviceScaleFactor)}}, nil
+	}
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.SetChromiumViewport503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	cdpCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("failed to connect to devtools", "err", err)
+		return oapi.SetChromiumViewport503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	// resize the window first so the page's viewport settles on the override below
+	if resizeWindow {
+		if err := client.SetWindowSize(cdpCtx, metrics.Width, metrics.Height); err != nil {
+			log.Error("failed to resize browser window", "err", err)
+			return oapi.SetChromiumViewport500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to resize browser window"}}, nil
+		}
+	}
+	if err := client.SetDeviceMetrics(cdpCtx, metrics); err != nil {
+		log.Error("failed to set device metrics", "err", err)
+		return oapi.SetChromiumViewport500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to set device metrics"}}, nil
+	}
+
+	log.Info("chromium viewport set", "width", metrics.Width, "height", metrics.Height,
+		"device_scale_factor", metrics.DeviceScaleFactor, "mobile", metrics.Mobile, "window_resized", resizeWindow)
+	return oapi.SetChromiumViewport200JSONResponse{
+		Width:             metrics.Width,
+		Height:            metrics.Height,
+		DeviceScaleFactor: float32(metrics.DeviceScaleFactor),
+		Mobile:            metrics.Mobile,
+		WindowResized:     resizeWindow,
+	}, nil
+}
+
+// SetChromiumEmulation overrides the first page's geolocation, timezone and locale over
+// CDP in one call, without restarting Chromium.
+func (s *ApiService) SetChromiumEmulation(ctx context.Context, request oapi.SetChromiumEmulationRequestObject) (oapi.SetChromiumEmulationResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if request.Body == nil {
+		return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "request body required"}}, nil
+	}
+	body := *request.Body
+	var emulation cdpclient.Emulation
+	if g := body.Geolocation; g != nil {
+		if g.Latitude < -90 || g.Latitude > 90 {
+			return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "latitude must be between -90 and 90"}}, nil
+		}
+		if g.Longitude < -180 || g.Longitude > 180 {
+			return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "longitude must be between -180 and 180"}}, nil
+		}
+		emulation.Geolocation = &cdpclient.Geolocation{Latitude: g.Latitude, Longitude: g.Longitude}
+		if g.Accuracy != nil {
+			if *g.Accuracy < 0 {
+				return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "accuracy must not be negative"}}, nil
+			}
+			emulation.Geolocation.Accuracy = *g.Accuracy
+		}
+	}
+	if body.Timezone != nil {
+		// "Local" names the server's own zone rather than an IANA ID
+		if _, err := time.LoadLocation(*body.Timezone); err != nil || *body.Timezone == "" || *body.Timezone == "Local" {
+			return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("timezone %q is not an IANA timezone ID", *body.Timezone)}}, nil
+		}
+		emulation.Timezone = *body.Timezone
+	}
+	if body.Locale != nil {
+		tag, err := language.Parse(*body.Locale)
+		if err != nil {
+			return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: fmt.Sprintf("locale %q is not a BCP 47 language tag", *body.Locale)}}, nil
+		}
+		emulation.Locale = tag.String()
+	}
+	if emulation.Geolocation == nil && emulation.Timezone == "" && emulation.Locale == "" {
+		return oapi.SetChromiumEmulation400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "at least one of geolocation, timezone or locale is required"}}, nil
+	}
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.SetChromiumEmulation503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	cdpCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("failed to connect to devtools", "err", err)
+		return oapi.SetChromiumEmulation503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	if err := client.SetEmulation(cdpCtx, emulation); err != nil {
+		log.Error("failed to set emulation overrides", "err", err)
+		return oapi.SetChromiumEmulation500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to set emulation overrides"}}, nil
+	}
+
+	var applied oapi.SetChromiumEmulation200JSONResponse
+	if g := emulation.Geolocation; g != nil {
+		applied.Geolocation = &oapi.ChromiumGeolocation{Latitude: g.Latitude, Longitude: g.Longitude, Accuracy: &g.Accuracy}
+	}
+	if emulation.Timezone != "" {
+		applied.Timezone = &emulation.Timezone
+	}
+	if emulation.Locale != "" {
+		applied.Locale = &emulation.Locale
+	}
+	log.Info("chromium emulation set", "geolocation", emulation.Geolocation != nil, "timezone", emulation.Timezone, "locale", emulation.Locale)
+	return applied, nil
+}
+
+// WarmupChromium connects to Chromium over CDP and makes sure a page target exists,
+// opening about:blank when there is none, so the first automation client does not
+// wait on the browser creating its initial target.
+func (s *ApiService) WarmupChromium(ctx context.Context, request oapi.WarmupChromiumRequestObject) (oapi.WarmupChromiumResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return oapi.WarmupChromium503JSONResponse{Message: "devtools upstream not available"}, nil
+	}
+
+	s.warmupMu.Lock()
+	defer s.warmupMu.Unlock()
+
+	cdpCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	client, err := cdpclient.Dial(cdpCtx, upstreamURL)
+	if err != nil {
+		log.Error("warmup: failed to connect to devtools", "err", err)
+		return oapi.WarmupChromium503JSONResponse{Message: "failed to connect to devtools"}, nil
+	}
+	defer client.Close()
+
+	targetID, created, err := client.EnsurePageTarget(cdpCtx)
+	if err != nil {
+		log.Error("warmup: failed to ensure page target", "err", err)
+		return oapi.WarmupChromium500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to ensure page target"}}, nil
+	}
+	log.Info("chromium warmed up", "target_id", targetID, "created", created)
+	return oapi.WarmupChromium200JSONResponse{Ready: true, TargetId: targetID, Created: created}, nil
+}
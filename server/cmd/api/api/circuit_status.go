@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+
+	"github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// GetCircuitStatus reports whether each ZK circuit is initialized and roughly how much
+// memory it holds.
+// (GET /reclaim/circuits)
+func (s *ApiService) GetCircuitStatus(ctx context.Context, _ oapi.GetCircuitStatusRequestObject) (oapi.GetCircuitStatusResponseObject, error) {
+	status := oapi.CircuitStatus{Circuits: []oapi.CircuitInfo{}}
+	for _, st := range s.circuitStatuses() {
+		info := oapi.CircuitInfo{Name: st.Name, Initialized: st.Initialized}
+		if fp := st.Footprint; fp != nil {
+			info.ProvingKeyBytes = ptrOf(fp.ProvingKeyBytes)
+			info.R1csBytes = ptrOf(fp.R1CSBytes)
+			if fp.DeltaMeasured {
+				info.InitDeltaBytes = ptrOf(fp.InitDeltaBytes)
+			}
+			info.EstimatedBytes = ptrOf(fp.Bytes())
+			status.EstimatedBytes += fp.Bytes()
+		}
+		status.Circuits = append(status.Circuits, info)
+	}
+	return oapi.GetCircuitStatus200JSONResponse(status), nil
+}
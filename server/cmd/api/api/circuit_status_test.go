@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onkernel/kernel-images/server/cmd/api/circuits"
+	"github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCircuitStatus(t *testing.T) {
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	svc.circuitStatuses = func() []circuits.Status {
+		return []circuits.Status{
+			{Name: "chacha20", Initialized: true, Footprint: &circuits.Footprint{ProvingKeyBytes: 100, R1CSBytes: 50, InitDeltaBytes: 1000, DeltaMeasured: true}},
+			{Name: "aes128", Initialized: true, Footprint: &circuits.Footprint{ProvingKeyBytes: 200, R1CSBytes: 70}},
+			{Name: "aes256"},
+		}
+	}
+
+	resp, err := svc.GetCircuitStatus(context.Background(), oapi.GetCircuitStatusRequestObject{})
+	require.NoError(t, err)
+	status, ok := resp.(oapi.GetCircuitStatus200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	assert.Equal(t, int64(1150+270), status.EstimatedBytes)
+	require.Len(t, status.Circuits, 3)
+	assert.Equal(t, oapi.CircuitInfo{Name: "chacha20", Initialized: true, ProvingKeyBytes: ptrOf(int64(100)), R1csBytes: ptrOf(int64(50)), InitDeltaBytes: ptrOf(int64(1000)), EstimatedBytes: ptrOf(int64(1150))}, status.Circuits[0])
+	assert.Nil(t, status.Circuits[1].InitDeltaBytes, "no delta where resident memory couldn't be read")
+	assert.Equal(t, oapi.CircuitInfo{Name: "aes256"}, status.Circuits[2])
+}
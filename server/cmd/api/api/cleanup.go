@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// Cleanup removes the recording and temp files no registered recorder owns that are older
+// than the configured threshold, returning what it removed.
+// (POST /recording/cleanup)
+func (s *ApiService) Cleanup(ctx context.Context, req oapi.CleanupRequestObject) (oapi.CleanupResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	minAge := s.config.RecordingCleanupMinAgeSeconds
+	if req.Params.OlderThanSeconds != nil {
+		if *req.Params.OlderThanSeconds < 1 {
+			return oapi.Cleanup400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "olderThanSeconds must be at least 1"}}, nil
+		}
+		minAge = *req.Params.OlderThanSeconds
+	}
+	dryRun := req.Params.DryRun != nil && *req.Params.DryRun
+
+	// Recorders registered after this listing create their files after it too, so the
+	// age threshold keeps them out of the scan.
+	var activeIDs []string
+	for _, rec := range s.recordManager.ListActiveRecorders(ctx) {
+		activeIDs = append(activeIDs, rec.ID())
+	}
+	orphans, err := recorder.FindOrphanedFiles(s.config.OutputDir, s.config.TempDir, activeIDs, time.Now().Add(-time.Duration(minAge)*time.Second))
+	if err != nil {
+		log.Error("failed to scan for orphaned files", "err", err)
+		return oapi.Cleanup500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to scan for orphaned files"}}, nil
+	}
+
+	result := oapi.CleanupResult{DryRun: dryRun, Files: []oapi.OrphanedFile{}}
+	for _, f := range orphans {
+		if !dryRun {
+			if err := os.Remove(f.Path); err != nil {
+				log.Error("failed to remove orphaned file", "err", err, "path", f.Path)
+				continue
+			}
+			log.Info("removed orphaned file", "path", f.Path, "size", f.Size, "modified_at", f.ModTime)
+		}
+		result.Files = append(result.Files, oapi.OrphanedFile{Path: f.Path, Size: f.Size, ModifiedAt: f.ModTime})
+		result.FreedBytes += f.Size
+	}
+	return oapi.Cleanup200JSONResponse(result), nil
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanup(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.OutputDir, cfg.TempDir = t.TempDir(), t.TempDir()
+	cfg.RecordingCleanupMinAgeSeconds = 3600
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, name := range []string{"crashed.mp4", "active.mp4", "recent.mp4"} {
+		path := filepath.Join(cfg.OutputDir, name)
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+		modTime := old
+		if name == "recent.mp4" {
+			modTime = time.Now().Add(-time.Minute)
+		}
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	mgr := recorder.NewFFmpegManager()
+	require.NoError(t, mgr.RegisterRecorder(ctx, &mockRecorder{id: "active", isRecordingFlag: true}))
+	svc, err := New(cfg, mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	dryRun := true
+	resp, err := svc.Cleanup(ctx, oapi.CleanupRequestObject{Params: oapi.CleanupParams{DryRun: &dryRun}})
+	require.NoError(t, err)
+	result, ok := resp.(oapi.Cleanup200JSONResponse)
+	require.True(t, ok, "unexpected response %T", resp)
+	assert.True(t, result.DryRun)
+	require.Len(t, result.Files, 1)
+	assert.Equal(t, filepath.Join(cfg.OutputDir, "crashed.mp4"), result.Files[0].Path)
+	assert.FileExists(t, filepath.Join(cfg.OutputDir, "crashed.mp4"))
+
+	resp, err = svc.Cleanup(ctx, oapi.CleanupRequestObject{})
+	require.NoError(t, err)
+	result = resp.(oapi.Cleanup200JSONResponse)
+	assert.False(t, result.DryRun)
+	assert.Equal(t, int64(4), result.FreedBytes)
+	assert.NoFileExists(t, filepath.Join(cfg.OutputDir, "crashed.mp4"))
+	assert.FileExists(t, filepath.Join(cfg.OutputDir, "active.mp4"))
+	assert.FileExists(t, filepath.Join(cfg.OutputDir, "recent.mp4"))
+
+	// a shorter threshold reaches the recent file, but never an active recorder's
+	olderThan := 30
+	resp, err = svc.Cleanup(ctx, oapi.CleanupRequestObject{Params: oapi.CleanupParams{OlderThanSeconds: &olderThan}})
+	require.NoError(t, err)
+	result = resp.(oapi.Cleanup200JSONResponse)
+	require.Len(t, result.Files, 1)
+	assert.NoFileExists(t, filepath.Join(cfg.OutputDir, "recent.mp4"))
+	assert.FileExists(t, filepath.Join(cfg.OutputDir, "active.mp4"))
+
+	olderThan = 0
+	resp, err = svc.Cleanup(ctx, oapi.CleanupRequestObject{Params: oapi.CleanupParams{OlderThanSeconds: &olderThan}})
+	require.NoError(t, err)
+	assert.IsType(t, oapi.Cleanup400JSONResponse{}, resp)
+}
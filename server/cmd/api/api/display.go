@@ -78,6 +78,7 @@ func (s *ApiService) PatchDisplay(ctx context.Context, req oapi.PatchDisplayRequ
 		if !resizableNow {
 			return oapi.PatchDisplay409JSONResponse{
 				ConflictErrorJSONResponse: oapi.ConflictErrorJSONResponse{
+					Code:    ptrOf(oapi.DisplayBusy),
 					Message: "resize refused: live view or recording/replay active",
 				},
 			}, nil
@@ -169,6 +170,8 @@ func (s *ApiService) PatchDisplay(ctx context.Context, req oapi.PatchDisplayRequ
 		}, nil
 	}
 
+	s.displayGeometry.Invalidate()
+
 	// Return success with the new dimensions
 	return oapi.PatchDisplay200JSONResponse{
 		Width:       &width,
@@ -177,6 +180,23 @@ func (s *ApiService) PatchDisplay(ctx context.Context, req oapi.PatchDisplayRequ
 	}, nil
 }
 
+// GetDisplayInfo returns the X display's resolution and color depth.
+func (s *ApiService) GetDisplayInfo(ctx context.Context, _ oapi.GetDisplayInfoRequestObject) (oapi.GetDisplayInfoResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	g, err := s.displayGeometry.Get(ctx)
+	if err != nil {
+		log.Error("failed to query display geometry", "error", err)
+		return oapi.GetDisplayInfo500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to query display geometry"}}, nil
+	}
+	return oapi.GetDisplayInfo200JSONResponse{
+		Width:  g.Width,
+		Height: g.Height,
+		Depth:  g.Depth,
+		Mode:   s.detectDisplayMode(ctx),
+	}, nil
+}
+
 // detectDisplayMode detects whether we're running Xorg (headful) or Xvfb
 // (headless). The result is cached because the display server type does not
 // change during the container's lifetime, and querying supervisorctl during
@@ -336,6 +356,7 @@ func (s *ApiService) backgroundResizeXvfb(ctx context.Context, width, height int
 		log.Warn("background Xvfb resize failed (non-fatal), keeping viewport override", "error", xvfbErr)
 		return
 	}
+	s.displayGeometry.Invalidate()
 
 	s.viewportMu.Lock()
 	if s.viewportOverride != nil && s.viewportOverride[0] == width && s.viewportOverride[1] == height {
@@ -450,7 +471,17 @@ func (s *ApiService) getCurrentResolution(ctx context.Context) (int, int, int, e
 		return override[0], override[1], override[2], nil
 	}
 
-	return s.getCurrentResolutionFromXrandr(ctx)
+	width, height, refreshRate, err := s.getCurrentResolutionFromXrandr(ctx)
+	if err != nil {
+		// xrandr is unavailable on some headless displays; fall back to the cached
+		// geometry, which also covers the framebuffer size Xvfb was started with and the
+		// configured display size.
+		if g, gErr := s.displayGeometry.Get(ctx); gErr == nil {
+			return g.Width, g.Height, 60, nil
+		}
+		return 0, 0, 0, err
+	}
+	return width, height, refreshRate, nil
 }
 
 // getCurrentResolutionFromXrandr queries xrandr for the current display resolution.
@@ -574,7 +605,7 @@ func adjustParamsForRemainingBudget(log *slog.Logger, info stoppedRecordingInfo)
 	}
 
 	if params.MaxSizeInMB != nil && params.OutputDir != nil {
-		segmentPath := filepath.Join(*params.OutputDir, info.id+".mp4")
+		segmentPath := filepath.Join(params.RecordingDir(), info.id+".mp4")
 		if fi, err := os.Stat(segmentPath); err == nil {
 			consumedMB := int((fi.Size() + 1024*1024 - 1) / (1024 * 1024))
 			remaining := *params.MaxSizeInMB - consumedMB
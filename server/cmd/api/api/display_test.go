@@ -29,7 +29,7 @@ func testFFmpegFactory(t *testing.T, tempDir string) recorder.FFmpegRecorderFact
 		MaxSizeInMB: &size,
 		OutputDir:   &tempDir,
 	}
-	return recorder.NewFFmpegRecorderFactory(testMockFFmpegBin, config, scaletozero.NewNoopController())
+	return recorder.NewFFmpegRecorderFactory(testMockFFmpegBin, config, nil, scaletozero.NewNoopController())
 }
 
 func newTestServiceWithFactory(t *testing.T, mgr recorder.RecordManager, factory recorder.FFmpegRecorderFactory) *ApiService {
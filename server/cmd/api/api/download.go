@@ -0,0 +1,130 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// errRangeNotSatisfiable reports a Range that starts beyond the end of the file.
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// recordingValidators returns the ETag and Last-Modified of a recording file. The ETag is
+// derived from the file's modification time and size, and is weak while the recording is
+// still being written, since the bytes behind it keep changing.
+func recordingValidators(meta *recorder.RecordingMetadata, finalized bool) (etag, lastModified string) {
+	etag = fmt.Sprintf(`"%x-%x"`, meta.ModTime.UnixNano(), meta.Size)
+	if !finalized {
+		etag = "W/" + etag
+	}
+	return etag, meta.ModTime.UTC().Format(http.TimeFormat)
+}
+
+// notModified evaluates If-None-Match, or without it If-Modified-Since, against a finalized
+// recording, reporting whether the client's copy is current (RFC 9110 section 13.2.2).
+func notModified(ifNoneMatch, ifModifiedSince *string, etag string, modTime time.Time) bool {
+	if ifNoneMatch != nil {
+		for _, tag := range strings.Split(*ifNoneMatch, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+	if ifModifiedSince != nil {
+		since, err := http.ParseTime(*ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		return !modTime.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// ifRangeMatches reports whether an If-Range header names the current, strongly validated
+// file: its ETag, or exactly its modification time.
+func ifRangeMatches(ifRange, etag string, modTime time.Time) bool {
+	if strings.HasPrefix(ifRange, `"`) {
+		return !strings.HasPrefix(etag, "W/") && ifRange == etag
+	}
+	if strings.HasPrefix(ifRange, "W/") {
+		return false
+	}
+	t, err := http.ParseTime(ifRange)
+	return err == nil && modTime.Truncate(time.Second).Equal(t)
+}
+
+// parseByteRange parses a Range header for a file of size bytes, returning the start and
+// length of the single range it asks for. ok is false when the header should be ignored and
+// the whole file sent: it is malformed, not in bytes, or asks for several ranges.
+func parseByteRange(header string, size int64) (start, length int64, ok bool, err error) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false, nil
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false, nil
+	}
+
+	if first == "" {
+		// suffix range: the final n bytes
+		n, perr := strconv.ParseInt(last, 10, 64)
+		if perr != nil || n < 0 {
+			return 0, 0, false, nil
+		}
+		if n == 0 || size == 0 {
+			return 0, 0, false, errRangeNotSatisfiable
+		}
+		n = min(n, size)
+		return size - n, n, true, nil
+	}
+
+	start, perr := strconv.ParseInt(first, 10, 64)
+	if perr != nil || start < 0 {
+		return 0, 0, false, nil
+	}
+	end := size - 1
+	if last != "" {
+		if end, perr = strconv.ParseInt(last, 10, 64); perr != nil || end < start {
+			return 0, 0, false, nil
+		}
+		end = min(end, size-1)
+	}
+	if start >= size {
+		return 0, 0, false, errRangeNotSatisfiable
+	}
+	return start, end - start + 1, true, nil
+}
+
+// sectionOf returns a reader of length bytes of r from start on, closing r when closed.
+func sectionOf(r io.ReadCloser, start, length int64) (io.ReadCloser, error) {
+	if s, ok := r.(io.Seeker); ok {
+		if _, err := s.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+	} else if _, err := io.CopyN(io.Discard, r, start); err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(r, length), r}, nil
+}
+
+// snapshotSize returns how much of a recording still being written can be sent as a
+// snapshot: its first size bytes up to the last complete fragment, 0 before there is one.
+func snapshotSize(r io.Reader, size int64) (int64, error) {
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		return 0, errors.New("recording file does not support random access")
+	}
+	return recorder.CompleteFragmentsSize(ra, size)
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseByteRange(t *testing.T) {
+	tests := []struct {
+		header        string
+		start, length int64
+		ok            bool
+		unsatisfiable bool
+	}{
+		{header: "bytes=0-99", start: 0, length: 100, ok: true},
+		{header: "bytes=900-", start: 900, length: 100, ok: true},
+		{header: "bytes=900-5000", start: 900, length: 100, ok: true},
+		{header: "bytes=-10", start: 990, length: 10, ok: true},
+		{header: "bytes=-5000", start: 0, length: 1000, ok: true},
+		{header: "bytes=1000-", unsatisfiable: true},
+		{header: "bytes=-0", unsatisfiable: true},
+		{header: "bytes=0-1,5-9"},
+		{header: "bytes=5-1"},
+		{header: "bytes=a-b"},
+		{header: "items=0-1"},
+		{header: "bytes=7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			start, length, ok, err := parseByteRange(tt.header, 1000)
+			if tt.unsatisfiable {
+				assert.ErrorIs(t, err, errRangeNotSatisfiable)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.length, length)
+		})
+	}
+}
+
+func TestConditionalValidators(t *testing.T) {
+	modTime := time.Date(2025, 1, 2, 3, 4, 5, 600, time.UTC)
+	const date = "Thu, 02 Jan 2025 03:04:05 GMT"
+	etag := `"abc-10"`
+
+	assert.True(t, notModified(ptrOf(`W/"abc-10"`), nil, etag, modTime), "If-None-Match uses weak comparison")
+	assert.False(t, notModified(ptrOf(`"xyz"`), ptrOf(date), etag, modTime), "If-None-Match takes precedence")
+	assert.True(t, notModified(nil, ptrOf(date), etag, modTime))
+	assert.False(t, notModified(nil, ptrOf("garbage"), etag, modTime))
+	assert.False(t, notModified(nil, nil, etag, modTime))
+
+	assert.True(t, ifRangeMatches(etag, etag, modTime))
+	assert.False(t, ifRangeMatches(`W/"abc-10"`, etag, modTime))
+	assert.False(t, ifRangeMatches(`W/"abc-10"`, `W/"abc-10"`, modTime), "weak ETags never match If-Range")
+	assert.True(t, ifRangeMatches(date, etag, modTime))
+	assert.False(t, ifRangeMatches("Wed, 01 Jan 2025 00:00:00 GMT", etag, modTime))
+}
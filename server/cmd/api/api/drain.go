@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/cdpclient"
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// drainPollInterval is how often Drain checks whether in-flight work has finished.
+const drainPollInterval = 250 * time.Millisecond
+
+// Drain stops the service from starting new recordings and proofs, then waits until the
+// ones already running finish or ctx is done. Call it when shutdown begins, before the
+// servers are shut down.
+func (s *ApiService) Drain(ctx context.Context) {
+	log := logger.FromContext(ctx)
+	s.draining.Store(true)
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		recordings, proofs := s.inFlight(ctx)
+		if recordings == 0 && proofs == 0 {
+			log.Info("drained in-flight work")
+			return
+		}
+		select {
+		case <-ctx.Done():
+			log.Warn("drain deadline reached with work in flight", "recordings", recordings, "proofs", proofs)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// inFlight counts the recordings running and proofs holding a concurrency slot.
+func (s *ApiService) inFlight(ctx context.Context) (recordings, proofs int) {
+	for _, rec := range s.recordManager.ListActiveRecorders(ctx) {
+		if rec.IsRecording(ctx) {
+			recordings++
+		}
+	}
+	return recordings, len(s.proveSem)
+}
+
+const (
+	// browserCheckTTL is how long /readyz reuses a browser liveness result, so frequent
+	// probes don't open a CDP connection each time.
+	browserCheckTTL = 5 * time.Second
+	// browserCheckTimeout bounds a single browser liveness check.
+	browserCheckTimeout = 2 * time.Second
+)
+
+// browserCheck is the outcome of pinging Chromium over CDP.
+type browserCheck struct {
+	at      time.Time
+	version string
+	err     error
+}
+
+// checkBrowser reports whether Chromium answers Browser.getVersion on the current
+// upstream, returning its version. A result younger than browserCheckTTL is reused,
+// and concurrent callers share one check.
+func (s *ApiService) checkBrowser(ctx context.Context) (string, error) {
+	s.browserCheckMu.Lock()
+	defer s.browserCheckMu.Unlock()
+	if !s.browserCheck.at.IsZero() && time.Since(s.browserCheck.at) < browserCheckTTL {
+		return s.browserCheck.version, s.browserCheck.err
+	}
+	version, err := s.pingBrowser(ctx)
+	s.browserCheck = browserCheck{at: time.Now(), version: version, err: err}
+	return version, err
+}
+
+func (s *ApiService) pingBrowser(ctx context.Context) (string, error) {
+	upstreamURL := s.upstreamMgr.Current()
+	if upstreamURL == "" {
+		return "", errors.New("devtools upstream not available")
+	}
+	// the result is shared, so a probe that hangs up mustn't fail it for everyone
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), browserCheckTimeout)
+	defer cancel()
+	client, err := cdpclient.Dial(ctx, upstreamURL)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+	return client.BrowserVersion(ctx)
+}
+
+// checkOutputDir checks OUTPUT_DIR is writable when RECORDING_OUTPUT_DIR_CHECK makes that
+// part of readiness.
+func (s *ApiService) checkOutputDir() error {
+	if s.config.RecordingOutputDirCheck != "ready" {
+		return nil
+	}
+	return recorder.CheckWritable(s.config.OutputDir)
+}
+
+// circuitsInitialized reports whether the circuits preloaded at startup have finished
+// initializing.
+func (s *ApiService) circuitsInitialized() bool {
+	select {
+	case <-s.circuitsReady:
+		return true
+	default:
+		return false
+	}
+}
+
+type readinessResponse struct {
+	Status          string `json:"status"`
+	ChromiumVersion string `json:"chromium_version,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// HandleReadyz reports whether the server accepts new work: 200 {"status":"ready"} with
+// the Chromium version once the browser answers over CDP, 503 {"status":"browser_unavailable"}
+// when it doesn't, or 503 {"status":"draining"} once shutdown has begun so load balancers
+// stop routing to it. With RECORDING_OUTPUT_DIR_CHECK=ready it is also
+// 503 {"status":"output_dir_unwritable"} while recordings can't be written to OUTPUT_DIR,
+// and with RECLAIM_WAIT_FOR_CIRCUITS 503 {"status":"circuits_initializing"} until the
+// circuits preloaded at startup have finished initializing.
+func (s *ApiService) HandleReadyz(w http.ResponseWriter, r *http.Request) {
+	resp, code := readinessResponse{Status: "ready"}, http.StatusOK
+	if s.draining.Load() {
+		resp, code = readinessResponse{Status: "draining"}, http.StatusServiceUnavailable
+	} else if s.config.ReclaimWaitForCircuits && !s.circuitsInitialized() {
+		resp, code = readinessResponse{Status: "circuits_initializing"}, http.StatusServiceUnavailable
+	} else if err := s.checkOutputDir(); err != nil {
+		logger.FromContext(r.Context()).Warn("readiness: output directory not writable", "err", err)
+		resp, code = readinessResponse{Status: "output_dir_unwritable", Error: err.Error()}, http.StatusServiceUnavailable
+	} else if version, err := s.checkBrowser(r.Context()); err != nil {
+		logger.FromContext(r.Context()).Warn("readiness: browser not responding", "err", err)
+		resp, code = readinessResponse{Status: "browser_unavailable", Error: err.Error()}, http.StatusServiceUnavailable
+	} else {
+		resp.ChromiumVersion = version
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,224 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/devtoolsproxy"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiService_Drain(t *testing.T) {
+	ctx := context.Background()
+	mgr := recorder.NewFFmpegManager()
+	rec := &mockRecorder{id: "running", isRecordingFlag: true}
+	require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+	upstreamMgr, _ := newTestBrowser(t)
+	svc, err := New(newTestConfig(), mgr, newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	readyz := func() (int, string) {
+		w := httptest.NewRecorder()
+		svc.HandleReadyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return w.Code, w.Body.String()
+	}
+	code, body := readyz()
+	assert.Equal(t, http.StatusOK, code)
+	assert.JSONEq(t, `{"status":"ready","chromium_version":"Chrome/131.0.6778.85"}`, body)
+
+	// the running recording keeps Drain waiting until its deadline
+	drainCtx, cancel := context.WithTimeout(ctx, 2*drainPollInterval)
+	defer cancel()
+	start := time.Now()
+	svc.Drain(drainCtx)
+	assert.GreaterOrEqual(t, time.Since(start), 2*drainPollInterval)
+
+	code, body = readyz()
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.JSONEq(t, `{"status":"draining"}`, body)
+
+	resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{})
+	require.NoError(t, err)
+	unavailable, ok := resp.(oapi.StartRecording503JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	require.NotNil(t, unavailable.Code)
+	assert.Equal(t, oapi.Draining, *unavailable.Code)
+
+	proveResp, err := svc.ReclaimProve(ctx, oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "{}"}})
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve503JSONResponse{}, proveResp)
+
+	// with nothing in flight Drain returns without waiting for ctx
+	require.NoError(t, rec.Stop(ctx))
+	start = time.Now()
+	svc.Drain(ctx)
+	assert.Less(t, time.Since(start), drainPollInterval)
+}
+
+func TestApiService_Readyz(t *testing.T) {
+	readyz := func(svc *ApiService) (int, string) {
+		w := httptest.NewRecorder()
+		svc.HandleReadyz(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		return w.Code, w.Body.String()
+	}
+
+	t.Run("browser not discovered", func(t *testing.T) {
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		code, body := readyz(svc)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.JSONEq(t, `{"status":"browser_unavailable","error":"devtools upstream not available"}`, body)
+	})
+
+	t.Run("browser responds", func(t *testing.T) {
+		upstreamMgr, pings := newTestBrowser(t)
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		for range 3 {
+			code, body := readyz(svc)
+			assert.Equal(t, http.StatusOK, code)
+			assert.JSONEq(t, `{"status":"ready","chromium_version":"Chrome/131.0.6778.85"}`, body)
+		}
+		// results are cached, so repeated probes ping the browser once
+		assert.Equal(t, int32(1), pings.Load())
+	})
+
+	t.Run("output dir unwritable", func(t *testing.T) {
+		upstreamMgr, _ := newTestBrowser(t)
+		cfg := newTestConfig()
+		cfg.OutputDir = filepath.Join(t.TempDir(), "missing")
+		svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+
+		// only part of readiness when configured
+		cfg.RecordingOutputDirCheck = "warn"
+		code, _ := readyz(svc)
+		assert.Equal(t, http.StatusOK, code)
+
+		cfg.RecordingOutputDirCheck = "ready"
+		code, body := readyz(svc)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Contains(t, body, `"status":"output_dir_unwritable"`)
+
+		require.NoError(t, os.Mkdir(cfg.OutputDir, 0o755))
+		code, _ = readyz(svc)
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("circuits initializing", func(t *testing.T) {
+		upstreamMgr, _ := newTestBrowser(t)
+		cfg := newTestConfig()
+		svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), upstreamMgr, scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		ready := make(chan struct{})
+		svc.circuitsReady = ready
+
+		// only part of readiness when proofs wait for circuits
+		code, _ := readyz(svc)
+		assert.Equal(t, http.StatusOK, code)
+
+		cfg.ReclaimWaitForCircuits = true
+		code, body := readyz(svc)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.JSONEq(t, `{"status":"circuits_initializing"}`, body)
+
+		close(ready)
+		code, _ = readyz(svc)
+		assert.Equal(t, http.StatusOK, code)
+	})
+}
+
+// newTestBrowser serves a fake Chromium that answers Browser.getVersion and lists one
+// attached page, returning an upstream manager pointed at it and a count of the
+// Browser.getVersion pings it received.
+func newTestBrowser(t *testing.T) (*devtoolsproxy.UpstreamManager, *atomic.Int32) {
+	t.Helper()
+	pings := &atomic.Int32{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"webSocketDebuggerUrl":"ws://%s/devtools/browser/test"}`, r.Host)
+	})
+	mux.HandleFunc("/devtools/browser/test", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+		for {
+			_, msg, err := conn.Read(r.Context())
+			if err != nil {
+				return
+			}
+			var req struct {
+				ID     int64           `json:"id"`
+				Method string          `json:"method"`
+				Params json.RawMessage `json:"params"`
+			}
+			if json.Unmarshal(msg, &req) != nil {
+				continue
+			}
+			var result any
+			switch req.Method {
+			case "Browser.getVersion":
+				pings.Add(1)
+				result = map[string]string{"product": "Chrome/131.0.6778.85"}
+			case "Target.getTargets":
+				result = map[string]any{"targetInfos": []map[string]any{
+					{"targetId": "page-1", "type": "page", "title": "Example", "url": "https://example.com/", "attached": true},
+				}}
+			case "Target.attachToTarget":
+				result = map[string]string{"sessionId": "session-1"}
+			case "Browser.getWindowForTarget":
+				result = map[string]any{"windowId": 1, "bounds": map[string]any{"windowState": "normal"}}
+			case "Network.getAllCookies":
+				result = map[string]any{"cookies": []map[string]any{
+					{"name": "sid", "value": "abc", "domain": "example.com", "path": "/", "expires": -1, "httpOnly": true, "secure": true, "session": true, "sameSite": "Lax"},
+				}}
+			case "Network.setCookie":
+				var params struct {
+					Name string `json:"name"`
+				}
+				_ = json.Unmarshal(req.Params, &params)
+				if params.Name == "rejected" {
+					resp, _ := json.Marshal(map[string]any{"id": req.ID, "error": map[string]any{"code": -32602, "message": "Invalid cookie fields"}})
+					_ = conn.Write(r.Context(), websocket.MessageText, resp)
+					continue
+				}
+				result = map[string]any{}
+			case "Emulation.setDeviceMetricsOverride", "Emulation.setGeolocationOverride", "Emulation.setTimezoneOverride",
+				"Emulation.setLocaleOverride", "Browser.setWindowBounds", "Target.detachFromTarget":
+				result = map[string]any{}
+			default:
+				continue
+			}
+			resp, _ := json.Marshal(map[string]any{"id": req.ID, "result": result})
+			_ = conn.Write(r.Context(), websocket.MessageText, resp)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	mgr := devtoolsproxy.NewPollingUpstreamManager(strings.TrimPrefix(srv.URL, "http://"), slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	mgr.Start(ctx)
+	_, err := mgr.WaitForInitial(2 * time.Second)
+	require.NoError(t, err)
+	return mgr, pings
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/onkernel/kernel-images/server/lib/extensions"
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// GetExtensions lists the installed extensions with the digest of each packed .crx and,
+// when requested, whether its signatures verify.
+// (GET /chromium/extensions)
+func (s *ApiService) GetExtensions(ctx context.Context, req oapi.GetExtensionsRequestObject) (oapi.GetExtensionsResponseObject, error) {
+	log := logger.FromContext(ctx)
+	dir := s.config.ExtensionsDir
+	verify := req.Params.Verify != nil && *req.Params.Verify
+
+	exts, err := extensions.Scan(dir)
+	if err != nil {
+		log.Error("failed to scan extensions", "err", err, "dir", dir)
+		return oapi.GetExtensions500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to scan extensions"}}, nil
+	}
+
+	infos := make([]oapi.ExtensionInfo, 0, len(exts))
+	for _, ext := range exts {
+		info := oapi.ExtensionInfo{Name: ext.Name, HasUpdateXml: ext.HasUpdateXML}
+		if ext.ID != "" {
+			info.Id = ptrOf(ext.ID)
+		}
+		if ext.Version != "" {
+			info.Version = ptrOf(ext.Version)
+		}
+		if ext.Error != "" {
+			info.Error = ptrOf(ext.Error)
+		}
+		if ext.CRX != "" {
+			info.Crx = ptrOf(ext.CRX)
+			crxPath := filepath.Join(dir, ext.Name, ext.CRX)
+			digest, size, err := extensions.FileSHA256(crxPath)
+			if err != nil {
+				log.Error("failed to hash extension", "err", err, "file", crxPath)
+				return oapi.GetExtensions500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to hash extension"}}, nil
+			}
+			info.Sha256, info.Size = ptrOf(digest), ptrOf(size)
+			if verify {
+				verr := extensions.VerifyCRX(crxPath)
+				info.SignatureValid = ptrOf(verr == nil)
+				if verr != nil {
+					log.Warn("extension fails signature verification", "err", verr, "name", ext.Name, "sha256", digest, "size", size)
+					if info.Error == nil {
+						info.Error = ptrOf(verr.Error())
+					}
+				}
+			}
+		}
+		infos = append(infos, info)
+	}
+	return oapi.GetExtensions200JSONResponse(infos), nil
+}
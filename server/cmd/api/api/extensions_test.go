@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExtensions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "unpacked"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unpacked", "manifest.json"), []byte(`{"version":"1.2.3"}`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "packed"), 0o755))
+	crx := []byte("not a crx")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "packed", "ext.crx"), crx, 0o644))
+	sum := sha256.Sum256(crx)
+
+	cfg := newTestConfig()
+	cfg.ExtensionsDir = dir
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	resp, err := svc.GetExtensions(context.Background(), oapi.GetExtensionsRequestObject{})
+	require.NoError(t, err)
+	infos, ok := resp.(oapi.GetExtensions200JSONResponse)
+	require.True(t, ok, "unexpected response %T", resp)
+	require.Len(t, infos, 2)
+
+	packed := infos[0]
+	assert.Equal(t, "packed", packed.Name)
+	assert.Equal(t, "ext.crx", *packed.Crx)
+	assert.Equal(t, hex.EncodeToString(sum[:]), *packed.Sha256)
+	assert.Equal(t, int64(len(crx)), *packed.Size)
+	assert.Nil(t, packed.SignatureValid, "signatures are only checked on request")
+	assert.NotNil(t, packed.Error)
+
+	unpacked := infos[1]
+	assert.Equal(t, "unpacked", unpacked.Name)
+	assert.Equal(t, "1.2.3", *unpacked.Version)
+	assert.Nil(t, unpacked.Crx)
+	assert.Nil(t, unpacked.Sha256)
+
+	verify := true
+	resp, err = svc.GetExtensions(context.Background(), oapi.GetExtensionsRequestObject{Params: oapi.GetExtensionsParams{Verify: &verify}})
+	require.NoError(t, err)
+	infos = resp.(oapi.GetExtensions200JSONResponse)
+	require.NotNil(t, infos[0].SignatureValid)
+	assert.False(t, *infos[0].SignatureValid)
+	assert.Nil(t, infos[1].SignatureValid)
+}
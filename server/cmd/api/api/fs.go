@@ -17,6 +17,7 @@ import (
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/nrednav/cuid2"
+	"github.com/onkernel/kernel-images/server/lib/fsutil"
 	"github.com/onkernel/kernel-images/server/lib/logger"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 	"github.com/onkernel/kernel-images/server/lib/ziputil"
@@ -52,6 +53,26 @@ func (fw *fsWatch) Close() {
 	})
 }
 
+// errNoFileRoot is returned by resolvePath when no FILE_ROOT is configured. Config
+// validation rejects that at startup, so filesystem access fails closed if it happens.
+var errNoFileRoot = errors.New("file root not configured")
+
+// resolvePath confines a caller-supplied path to the configured FILE_ROOT.
+func (s *ApiService) resolvePath(p string) (string, error) {
+	if s.config == nil || s.config.FileRoot == "" {
+		return "", errNoFileRoot
+	}
+	return fsutil.ResolveSafePath(s.config.FileRoot, p)
+}
+
+// pathErrorMessage maps a resolvePath error to a client-facing message.
+func pathErrorMessage(err error) string {
+	if errors.Is(err, fsutil.ErrOutsideRoot) || errors.Is(err, errNoFileRoot) {
+		return err.Error()
+	}
+	return "invalid path"
+}
+
 // ReadFile returns the contents of a file specified by the path param.
 func (s *ApiService) ReadFile(ctx context.Context, req oapi.ReadFileRequestObject) (oapi.ReadFileResponseObject, error) {
 	log := logger.FromContext(ctx)
@@ -59,6 +80,10 @@ func (s *ApiService) ReadFile(ctx context.Context, req oapi.ReadFileRequestObjec
 	if path == "" {
 		return oapi.ReadFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.ReadFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 
 	f, err := os.Open(path)
 	if err != nil {
@@ -89,6 +114,10 @@ func (s *ApiService) WriteFile(ctx context.Context, req oapi.WriteFileRequestObj
 	if path == "" {
 		return oapi.WriteFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.WriteFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	if req.Body == nil {
 		return oapi.WriteFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "empty request body"}}, nil
 	}
@@ -136,6 +165,10 @@ func (s *ApiService) CreateDirectory(ctx context.Context, req oapi.CreateDirecto
 	if path == "" {
 		return oapi.CreateDirectory400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.CreateDirectory400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	// default to 0o755
 	perm := os.FileMode(0o755)
 	if req.Body.Mode != nil {
@@ -163,6 +196,10 @@ func (s *ApiService) DeleteFile(ctx context.Context, req oapi.DeleteFileRequestO
 	if path == "" {
 		return oapi.DeleteFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.DeleteFile400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	if err := os.Remove(path); err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return oapi.DeleteFile404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "file not found"}}, nil
@@ -183,6 +220,10 @@ func (s *ApiService) DeleteDirectory(ctx context.Context, req oapi.DeleteDirecto
 	if path == "" {
 		return oapi.DeleteDirectory400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.DeleteDirectory400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	if err := os.RemoveAll(path); err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return oapi.DeleteDirectory404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "directory not found"}}, nil
@@ -200,6 +241,10 @@ func (s *ApiService) ListFiles(ctx context.Context, req oapi.ListFilesRequestObj
 	if path == "" {
 		return oapi.ListFiles400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.ListFiles400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	entries, err := os.ReadDir(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -246,6 +291,10 @@ func (s *ApiService) FileInfo(ctx context.Context, req oapi.FileInfoRequestObjec
 	if path == "" {
 		return oapi.FileInfo400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.FileInfo400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	stat, err := os.Stat(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -282,6 +331,14 @@ func (s *ApiService) MovePath(ctx context.Context, req oapi.MovePathRequestObjec
 	if src == "" || dst == "" {
 		return oapi.MovePath400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "src_path and dest_path required"}}, nil
 	}
+	src, err := s.resolvePath(src)
+	if err != nil {
+		return oapi.MovePath400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
+	dst, err = s.resolvePath(dst)
+	if err != nil {
+		return oapi.MovePath400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	if err := os.Rename(src, dst); err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return oapi.MovePath404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "source not found"}}, nil
@@ -302,6 +359,10 @@ func (s *ApiService) SetFilePermissions(ctx context.Context, req oapi.SetFilePer
 	if path == "" {
 		return oapi.SetFilePermissions400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.SetFilePermissions400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	// parse mode
 	modeVal, err := strconv.ParseUint(req.Body.Mode, 8, 32)
 	if err != nil {
@@ -368,6 +429,10 @@ func (s *ApiService) StartFsWatch(ctx context.Context, req oapi.StartFsWatchRequ
 	if path == "" {
 		return oapi.StartFsWatch400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.StartFsWatch400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 	// Ensure path exists
 	if _, err := os.Stat(path); err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -587,6 +652,9 @@ func (s *ApiService) UploadZip(ctx context.Context, request oapi.UploadZipReques
 			if destPath == "" || !filepath.IsAbs(destPath) {
 				return oapi.UploadZip400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "dest_path must be an absolute path"}}, nil
 			}
+			if destPath, err = s.resolvePath(destPath); err != nil {
+				return oapi.UploadZip400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+			}
 		default:
 			return oapi.UploadZip400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "invalid form field: " + part.FormName()}}, nil
 		}
@@ -765,6 +833,9 @@ func (s *ApiService) UploadFiles(ctx context.Context, request oapi.UploadFilesRe
 			if dest == "" || !filepath.IsAbs(dest) {
 				return oapi.UploadFiles400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "dest_path must be an absolute path"}}, nil
 			}
+			if dest, err = s.resolvePath(dest); err != nil {
+				return oapi.UploadFiles400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+			}
 			pu.destPath = dest
 
 		default:
@@ -825,6 +896,10 @@ func (s *ApiService) DownloadDirZip(ctx context.Context, request oapi.DownloadDi
 	if path == "" {
 		return oapi.DownloadDirZip400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.DownloadDirZip400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 
 	info, err := os.Stat(path)
 	if err != nil {
@@ -866,6 +941,10 @@ func (s *ApiService) DownloadDirZstd(ctx context.Context, request oapi.DownloadD
 	if path == "" {
 		return oapi.DownloadDirZstd400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "path cannot be empty"}}, nil
 	}
+	path, err := s.resolvePath(path)
+	if err != nil {
+		return oapi.DownloadDirZstd400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+	}
 
 	info, err := os.Stat(path)
 	if err != nil {
@@ -947,6 +1026,9 @@ func (s *ApiService) UploadZstd(ctx context.Context, request oapi.UploadZstdRequ
 			if destPath == "" || !filepath.IsAbs(destPath) {
 				return oapi.UploadZstd400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "dest_path must be an absolute path"}}, nil
 			}
+			if destPath, err = s.resolvePath(destPath); err != nil {
+				return oapi.UploadZstd400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: pathErrorMessage(err)}}, nil
+			}
 		case "strip_components":
 			data, err := io.ReadAll(part)
 			if err != nil {
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/onkernel/kernel-images/server/cmd/config"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 	"github.com/onkernel/kernel-images/server/lib/ziputil"
 )
@@ -21,7 +22,7 @@ func TestWriteReadFile(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	svc := &ApiService{defaultRecorderID: "default"}
+	svc := &ApiService{defaultRecorderID: "default", config: &config.Config{FileRoot: "/"}}
 
 	tmpDir := t.TempDir()
 	filePath := filepath.Join(tmpDir, "test.txt")
@@ -79,7 +80,7 @@ func TestWriteFileAndWatch(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	svc := &ApiService{defaultRecorderID: "default", watches: make(map[string]*fsWatch)}
+	svc := &ApiService{defaultRecorderID: "default", watches: make(map[string]*fsWatch), config: &config.Config{FileRoot: "/"}}
 
 	// Prepare watch
 	dir := t.TempDir()
@@ -154,7 +155,7 @@ func TestFileDirOperations(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	tmp := t.TempDir()
 	dirPath := filepath.Join(tmp, "mydir")
@@ -287,7 +288,7 @@ func buildUploadZipMultipart(t *testing.T, destPath string, zipBytes []byte) *mu
 func TestUploadFilesSingle(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	tmp := t.TempDir()
 	dest := filepath.Join(tmp, "single.txt")
@@ -314,7 +315,7 @@ func TestUploadFilesSingle(t *testing.T) {
 func TestUploadFilesMultipleAndOutOfOrder(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	tmp := t.TempDir()
 	d1 := filepath.Join(tmp, "a.txt")
@@ -350,7 +351,7 @@ func TestUploadFilesMultipleAndOutOfOrder(t *testing.T) {
 func TestUploadFilesCommaFormat(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	tmp := t.TempDir()
 	dest := filepath.Join(tmp, "comma.txt")
@@ -377,7 +378,7 @@ func TestUploadFilesCommaFormat(t *testing.T) {
 func TestUploadZipSuccess(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	// Create a source directory with content
 	srcDir := t.TempDir()
@@ -422,7 +423,7 @@ func TestUploadZipSuccess(t *testing.T) {
 func TestUploadZipTraversalBlocked(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	// Build a malicious zip with a path traversal entry
 	var buf bytes.Buffer
@@ -448,7 +449,7 @@ func TestUploadZipTraversalBlocked(t *testing.T) {
 func TestUploadZipValidationErrors(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	// Missing dest_path
 	reader1 := func() *multipart.Reader {
@@ -494,7 +495,7 @@ func TestUploadZipValidationErrors(t *testing.T) {
 func TestDownloadDirZipSuccess(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	// Prepare a directory with nested content
 	root := t.TempDir()
@@ -562,7 +563,7 @@ func TestDownloadDirZipSuccess(t *testing.T) {
 func TestDownloadDirZipErrors(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	svc := &ApiService{}
+	svc := &ApiService{config: &config.Config{FileRoot: "/"}}
 
 	// Empty path
 	if resp, err := svc.DownloadDirZip(ctx, oapi.DownloadDirZipRequestObject{Params: oapi.DownloadDirZipParams{Path: ""}}); err != nil {
@@ -590,3 +591,104 @@ func TestDownloadDirZipErrors(t *testing.T) {
 		t.Fatalf("expected 400 for file path, got %T", resp)
 	}
 }
+
+// TestFileRootConfinement verifies that filesystem endpoints reject paths outside FILE_ROOT.
+func TestFileRootConfinement(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	root := t.TempDir()
+	outside := t.TempDir()
+	secret := filepath.Join(outside, "secret.txt")
+	if err := os.WriteFile(secret, []byte("s"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Fatalf("symlink: %v", err)
+	}
+	svc := &ApiService{config: &config.Config{FileRoot: root}}
+
+	for _, p := range []string{secret, filepath.Join(root, "..", filepath.Base(outside), "secret.txt"), filepath.Join(root, "escape", "secret.txt")} {
+		resp, err := svc.ReadFile(ctx, oapi.ReadFileRequestObject{Params: oapi.ReadFileParams{Path: p}})
+		if err != nil {
+			t.Fatalf("ReadFile error: %v", err)
+		}
+		if _, ok := resp.(oapi.ReadFile400JSONResponse); !ok {
+			t.Fatalf("expected 400 for %q, got %T", p, resp)
+		}
+	}
+
+	// Writes outside the root are rejected and leave nothing behind
+	planted := filepath.Join(outside, "planted.txt")
+	resp, err := svc.WriteFile(ctx, oapi.WriteFileRequestObject{Params: oapi.WriteFileParams{Path: planted}, Body: strings.NewReader("x")})
+	if err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	if _, ok := resp.(oapi.WriteFile400JSONResponse); !ok {
+		t.Fatalf("expected 400 for write outside root, got %T", resp)
+	}
+	if _, err := os.Stat(planted); !os.IsNotExist(err) {
+		t.Fatalf("file was written outside root: %v", err)
+	}
+
+	// Moving a file out of the root is rejected
+	inside := filepath.Join(root, "in.txt")
+	if err := os.WriteFile(inside, []byte("x"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	mresp, err := svc.MovePath(ctx, oapi.MovePathRequestObject{Body: &oapi.MovePathJSONRequestBody{SrcPath: inside, DestPath: planted}})
+	if err != nil {
+		t.Fatalf("MovePath error: %v", err)
+	}
+	if _, ok := mresp.(oapi.MovePath400JSONResponse); !ok {
+		t.Fatalf("expected 400 for move outside root, got %T", mresp)
+	}
+
+	// Uploads must target the root too
+	reader := buildUploadMultipart(t,
+		map[string]string{"dest_path": planted},
+		map[string]string{"file": "hello"},
+	)
+	uresp, err := svc.UploadFiles(ctx, oapi.UploadFilesRequestObject{Body: reader})
+	if err != nil {
+		t.Fatalf("UploadFiles error: %v", err)
+	}
+	if _, ok := uresp.(oapi.UploadFiles400JSONResponse); !ok {
+		t.Fatalf("expected 400 for upload outside root, got %T", uresp)
+	}
+
+	// Paths inside the root keep working
+	rresp, err := svc.ReadFile(ctx, oapi.ReadFileRequestObject{Params: oapi.ReadFileParams{Path: inside}})
+	if err != nil {
+		t.Fatalf("ReadFile error: %v", err)
+	}
+	r200, ok := rresp.(oapi.ReadFile200ApplicationoctetStreamResponse)
+	if !ok {
+		t.Fatalf("expected 200 for path inside root, got %T", rresp)
+	}
+	r200.Body.(io.Closer).Close()
+}
+
+// TestFileRootRequired verifies that filesystem endpoints fail closed without a FILE_ROOT.
+func TestFileRootRequired(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	f := filepath.Join(t.TempDir(), "f.txt")
+	if err := os.WriteFile(f, []byte("x"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	for _, svc := range []*ApiService{{}, {config: &config.Config{}}} {
+		resp, err := svc.ReadFile(ctx, oapi.ReadFileRequestObject{Params: oapi.ReadFileParams{Path: f}})
+		if err != nil {
+			t.Fatalf("ReadFile error: %v", err)
+		}
+		r400, ok := resp.(oapi.ReadFile400JSONResponse)
+		if !ok {
+			t.Fatalf("expected 400 without file root, got %T", resp)
+		}
+		if r400.Message != errNoFileRoot.Error() {
+			t.Fatalf("unexpected message: %q", r400.Message)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// startIdempotencyTTL is how long a StartRecording Idempotency-Key is remembered after the
+// recording it started.
+const startIdempotencyTTL = 10 * time.Minute
+
+// startAttempt is the StartRecording request that first used an idempotency key.
+type startAttempt struct {
+	recorderID string
+	// done is closed once resp is set. resp stays nil if the request ended without a
+	// result, e.g. because it was cancelled while queued.
+	done    chan struct{}
+	resp    oapi.StartRecordingResponseObject
+	expires time.Time
+}
+
+// startIdempotency maps StartRecording idempotency keys to the request that first used them,
+// so retries get the original result instead of starting or conflicting with a recorder.
+type startIdempotency struct {
+	mu       sync.Mutex
+	attempts map[string]*startAttempt
+	ttl      time.Duration
+}
+
+func newStartIdempotency(ttl time.Duration) *startIdempotency {
+	return &startIdempotency{
+		attempts: make(map[string]*startAttempt),
+		ttl:      ttl,
+	}
+}
+
+// begin returns the attempt recorded for key. first reports whether the caller created it
+// and so must run the request and call finish; otherwise the caller waits on its done.
+func (si *startIdempotency) begin(key, recorderID string) (a *startAttempt, first bool) {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
+	now := time.Now()
+	for k, a := range si.attempts {
+		if !a.expires.IsZero() && now.After(a.expires) {
+			delete(si.attempts, k)
+		}
+	}
+
+	if a, ok := si.attempts[key]; ok {
+		return a, false
+	}
+	a = &startAttempt{recorderID: recorderID, done: make(chan struct{})}
+	si.attempts[key] = a
+	return a, true
+}
+
+// finish records resp as the result of a. Only successful starts are remembered; the key
+// of a failed attempt is released so a retry can try again.
+func (si *startIdempotency) finish(key string, a *startAttempt, resp oapi.StartRecordingResponseObject) {
+	si.mu.Lock()
+	defer si.mu.Unlock()
+
+	a.resp = resp
+	if _, ok := resp.(oapi.StartRecording201Response); ok {
+		a.expires = time.Now().Add(si.ttl)
+	} else if si.attempts[key] == a {
+		delete(si.attempts, key)
+	}
+	close(a.done)
+}
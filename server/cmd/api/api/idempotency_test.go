@@ -0,0 +1,38 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartIdempotency(t *testing.T) {
+	si := newStartIdempotency(20 * time.Millisecond)
+
+	a, first := si.begin("k", "default")
+	require.True(t, first)
+
+	// a concurrent retry shares the in-flight attempt
+	dup, first := si.begin("k", "default")
+	require.False(t, first)
+	require.Same(t, a, dup)
+
+	si.finish("k", a, oapi.StartRecording201Response{})
+	<-dup.done
+	require.IsType(t, oapi.StartRecording201Response{}, dup.resp)
+
+	// the key expires after the ttl
+	time.Sleep(30 * time.Millisecond)
+	_, first = si.begin("k", "default")
+	require.True(t, first)
+
+	// failed attempts release their key so a retry runs again
+	failed, first := si.begin("f", "default")
+	require.True(t, first)
+	si.finish("f", failed, oapi.StartRecording500JSONResponse{})
+	<-failed.done
+	_, first = si.begin("f", "default")
+	require.True(t, first)
+}
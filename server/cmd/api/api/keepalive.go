@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// recordingDisconnectGrace is how long a stopOnDisconnect recording runs without an
+// attached progress stream before it is stopped. It covers the gap between StartRecording
+// and the client opening the stream, as well as brief reconnects.
+const recordingDisconnectGrace = 10 * time.Second
+
+// recordingKeepalive tracks the progress streams holding a stopOnDisconnect recording alive.
+type recordingKeepalive struct {
+	rec     recorder.Recorder
+	log     *slog.Logger
+	streams int
+	// timer is nil until arm is called
+	timer *time.Timer
+}
+
+// keepaliveRegistry stops recordings once no client holds their progress stream.
+type keepaliveRegistry struct {
+	mu      sync.Mutex
+	entries map[string]*recordingKeepalive
+	grace   time.Duration
+}
+
+func newKeepaliveRegistry(grace time.Duration) *keepaliveRegistry {
+	return &keepaliveRegistry{
+		entries: make(map[string]*recordingKeepalive),
+		grace:   grace,
+	}
+}
+
+// watch ties rec to its progress streams. Streams may attach from now on, but the
+// recording is only stopped for lack of one once arm is called after it has started.
+func (k *keepaliveRegistry) watch(id string, rec recorder.Recorder, log *slog.Logger) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if prev, ok := k.entries[id]; ok && prev.timer != nil {
+		prev.timer.Stop()
+	}
+	k.entries[id] = &recordingKeepalive{rec: rec, log: log}
+}
+
+// arm starts the grace period of a watched recording once it is running. The recording is
+// stopped if no stream is attached when the grace period ends.
+func (k *keepaliveRegistry) arm(id string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	ka, ok := k.entries[id]
+	if !ok || ka.timer != nil {
+		return
+	}
+	ka.timer = time.AfterFunc(k.grace, func() { k.expire(id, ka) })
+	if ka.streams > 0 {
+		ka.timer.Stop()
+	}
+}
+
+// drop forgets a watched recording, e.g. because it failed to start.
+func (k *keepaliveRegistry) drop(id string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if ka, ok := k.entries[id]; ok {
+		if ka.timer != nil {
+			ka.timer.Stop()
+		}
+		delete(k.entries, id)
+	}
+}
+
+// attach registers a progress stream for id and returns a func to call when it closes.
+// It is a no-op for recordings that were not started with stopOnDisconnect.
+func (k *keepaliveRegistry) attach(id string) (detach func()) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	ka, ok := k.entries[id]
+	if !ok {
+		return func() {}
+	}
+	ka.streams++
+	if ka.timer != nil {
+		ka.timer.Stop()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			k.mu.Lock()
+			defer k.mu.Unlock()
+			ka.streams--
+			if ka.streams == 0 && k.entries[id] == ka && ka.timer != nil {
+				ka.timer.Reset(k.grace)
+			}
+		})
+	}
+}
+
+// expire stops the recording once its grace period lapses without an attached stream.
+func (k *keepaliveRegistry) expire(id string, ka *recordingKeepalive) {
+	k.mu.Lock()
+	if k.entries[id] != ka || ka.streams > 0 {
+		k.mu.Unlock()
+		return
+	}
+	delete(k.entries, id)
+	k.mu.Unlock()
+
+	ctx := context.Background()
+	if !ka.rec.IsRecording(ctx) {
+		return
+	}
+	ka.log.Info("client disconnected, stopping recording", "recorder_id", id)
+	if err := ka.rec.Stop(ctx); err != nil {
+		ka.log.Error("error occurred while stopping recording after disconnect", "err", err, "recorder_id", id)
+	}
+}
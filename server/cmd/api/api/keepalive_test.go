@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/require"
+)
+
+// stopNotifyRecorder signals on stopped when Stop is called from the keepalive timer.
+type stopNotifyRecorder struct {
+	*mockRecorder
+	stopped chan struct{}
+}
+
+func (r *stopNotifyRecorder) Stop(ctx context.Context) error {
+	close(r.stopped)
+	return nil
+}
+
+func newStopNotifyRecorder(id string) *stopNotifyRecorder {
+	return &stopNotifyRecorder{mockRecorder: &mockRecorder{id: id, isRecordingFlag: true}, stopped: make(chan struct{})}
+}
+
+// blockingStartRecorder blocks in Start until release is closed.
+type blockingStartRecorder struct {
+	*stopNotifyRecorder
+	starting chan struct{}
+	release  chan struct{}
+}
+
+func (r *blockingStartRecorder) Start(ctx context.Context) error {
+	close(r.starting)
+	<-r.release
+	return nil
+}
+
+func requireStopped(t *testing.T, rec *stopNotifyRecorder, want bool, within time.Duration) {
+	t.Helper()
+	select {
+	case <-rec.stopped:
+		require.True(t, want, "recording was stopped unexpectedly")
+	case <-time.After(within):
+		require.False(t, want, "recording was not stopped")
+	}
+}
+
+func TestKeepaliveRegistry(t *testing.T) {
+	const grace = 20 * time.Millisecond
+
+	t.Run("stops when no stream attaches", func(t *testing.T) {
+		k := newKeepaliveRegistry(grace)
+		rec := newStopNotifyRecorder("never-attached")
+		k.watch(rec.ID(), rec, slog.Default())
+		k.arm(rec.ID())
+		requireStopped(t, rec, true, time.Second)
+	})
+
+	t.Run("stays alive while a stream is attached", func(t *testing.T) {
+		k := newKeepaliveRegistry(grace)
+		rec := newStopNotifyRecorder("attached")
+		k.watch(rec.ID(), rec, slog.Default())
+		k.arm(rec.ID())
+		detach := k.attach(rec.ID())
+		requireStopped(t, rec, false, 5*grace)
+
+		detach()
+		requireStopped(t, rec, true, time.Second)
+	})
+
+	t.Run("reconnect within grace keeps recording", func(t *testing.T) {
+		k := newKeepaliveRegistry(grace)
+		rec := newStopNotifyRecorder("reconnect")
+		k.watch(rec.ID(), rec, slog.Default())
+		k.arm(rec.ID())
+		first := k.attach(rec.ID())
+		second := k.attach(rec.ID())
+		first()
+		requireStopped(t, rec, false, 5*grace)
+		second()
+		requireStopped(t, rec, true, time.Second)
+	})
+
+	t.Run("stream attached before start keeps recording", func(t *testing.T) {
+		k := newKeepaliveRegistry(grace)
+		rec := newStopNotifyRecorder("early-stream")
+		// StartRecording watches before Start, so a stream may attach while it runs
+		k.watch(rec.ID(), rec, slog.Default())
+		detach := k.attach(rec.ID())
+		requireStopped(t, rec, false, 5*grace)
+
+		k.arm(rec.ID())
+		requireStopped(t, rec, false, 5*grace)
+
+		detach()
+		requireStopped(t, rec, true, time.Second)
+	})
+
+	t.Run("dropped when start fails", func(t *testing.T) {
+		k := newKeepaliveRegistry(grace)
+		rec := newStopNotifyRecorder("failed-start")
+		k.watch(rec.ID(), rec, slog.Default())
+		detach := k.attach(rec.ID())
+		k.drop(rec.ID())
+		detach()
+		require.Empty(t, k.entries)
+		requireStopped(t, rec, false, 5*grace)
+	})
+
+	t.Run("attach is a no-op for unwatched recordings", func(t *testing.T) {
+		k := newKeepaliveRegistry(grace)
+		k.attach("unwatched")()
+		require.Empty(t, k.entries)
+	})
+}
+
+func TestStartRecording_StreamOpenedDuringStart(t *testing.T) {
+	ctx := context.Background()
+	rec := &blockingStartRecorder{stopNotifyRecorder: newStopNotifyRecorder("slow-start"), starting: make(chan struct{}), release: make(chan struct{})}
+	factory := func(string, recorder.FFmpegRecordingParams) (recorder.Recorder, error) { return rec, nil }
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), factory, newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	const grace = 20 * time.Millisecond
+	svc.keepalives = newKeepaliveRegistry(grace)
+
+	id, stopOnDisconnect := rec.ID(), true
+	done := make(chan oapi.StartRecordingResponseObject, 1)
+	go func() {
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Body: &oapi.StartRecordingJSONRequestBody{Id: &id, StopOnDisconnect: &stopOnDisconnect},
+		})
+		require.NoError(t, err)
+		done <- resp
+	}()
+
+	// the progress stream attaches while the recorder is still starting
+	<-rec.starting
+	detach := svc.keepalives.attach(id)
+	close(rec.release)
+	require.IsType(t, oapi.StartRecording201Response{}, <-done)
+	requireStopped(t, rec.stopNotifyRecorder, false, 5*grace)
+
+	detach()
+	requireStopped(t, rec.stopNotifyRecorder, true, time.Second)
+}
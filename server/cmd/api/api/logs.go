@@ -5,13 +5,59 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/onkernel/kernel-images/server/lib/logger"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 )
 
+// defaultRecentLogLines is how many entries GetLogs returns when lines is not given.
+const defaultRecentLogLines = 100
+
+// SetLogRing makes the server's recent log entries in ring available through GetLogs.
+func (s *ApiService) SetLogRing(ring *logger.Ring) {
+	s.logRing = ring
+}
+
+// GetLogs returns the server's own recent log entries from the in-memory buffer.
+// (GET /logs)
+func (s *ApiService) GetLogs(ctx context.Context, request oapi.GetLogsRequestObject) (oapi.GetLogsResponseObject, error) {
+	if s.logRing == nil {
+		return oapi.GetLogs404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "in-memory log buffer is disabled (LOG_BUFFER_LINES)"}}, nil
+	}
+
+	level := slog.LevelInfo
+	if request.Params.Level != nil {
+		if !request.Params.Level.Valid() {
+			return oapi.GetLogs400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "level must be one of debug, info, warn, error"}}, nil
+		}
+		// the enum values are the names slog.Level parses
+		_ = level.UnmarshalText([]byte(*request.Params.Level))
+	}
+	lines := defaultRecentLogLines
+	if request.Params.Lines != nil {
+		lines = *request.Params.Lines
+		if lines < 1 || lines > 10000 {
+			return oapi.GetLogs400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "lines must be between 1 and 10000"}}, nil
+		}
+	}
+
+	entries := s.logRing.Recent(lines, level)
+	resp := make(oapi.GetLogs200JSONResponse, 0, len(entries))
+	for _, e := range entries {
+		resp = append(resp, oapi.ServerLogEntry{
+			Time:    e.Time,
+			Level:   e.Level.String(),
+			Message: e.Message,
+			Attrs:   e.Attrs,
+		})
+	}
+	return resp, nil
+}
+
 // LogsStream implements Server-Sent Events log streaming.
 // (GET /logs/stream)
 func (s *ApiService) LogsStream(ctx context.Context, request oapi.LogsStreamRequestObject) (oapi.LogsStreamResponseObject, error) {
@@ -3,12 +3,15 @@ package api
 import (
 	"bufio"
 	"context"
+	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/onkernel/kernel-images/server/lib/logger"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 )
 
@@ -63,3 +66,50 @@ func TestLogsStream_PathFollow(t *testing.T) {
 		}
 	}
 }
+
+func TestGetLogs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc := &ApiService{}
+
+	resp, err := svc.GetLogs(ctx, oapi.GetLogsRequestObject{})
+	if err != nil {
+		t.Fatalf("GetLogs error: %v", err)
+	}
+	if _, ok := resp.(oapi.GetLogs404JSONResponse); !ok {
+		t.Fatalf("expected 404 when the buffer is disabled, got %T", resp)
+	}
+
+	ring := logger.NewRing(10)
+	svc.SetLogRing(ring)
+	log := slog.New(logger.NewRingHandler(slog.NewTextHandler(io.Discard, nil), ring))
+	log.Info("started", "port", 10001)
+	log.Error("failed")
+
+	level := oapi.GetLogsParamsLevelError
+	resp, err = svc.GetLogs(ctx, oapi.GetLogsRequestObject{Params: oapi.GetLogsParams{Level: &level}})
+	if err != nil {
+		t.Fatalf("GetLogs error: %v", err)
+	}
+	r200, ok := resp.(oapi.GetLogs200JSONResponse)
+	if !ok || len(r200) != 1 || r200[0].Message != "failed" || r200[0].Level != "ERROR" {
+		t.Fatalf("expected only the error entry, got %#v", resp)
+	}
+
+	resp, err = svc.GetLogs(ctx, oapi.GetLogsRequestObject{})
+	if err != nil {
+		t.Fatalf("GetLogs error: %v", err)
+	}
+	if r200 := resp.(oapi.GetLogs200JSONResponse); len(r200) != 2 || r200[0].Attrs["port"] != "10001" {
+		t.Fatalf("expected both entries with attrs, got %#v", r200)
+	}
+
+	lines := 0
+	resp, err = svc.GetLogs(ctx, oapi.GetLogsRequestObject{Params: oapi.GetLogsParams{Lines: &lines}})
+	if err != nil {
+		t.Fatalf("GetLogs error: %v", err)
+	}
+	if _, ok := resp.(oapi.GetLogs400JSONResponse); !ok {
+		t.Fatalf("expected 400 for lines=0, got %T", resp)
+	}
+}
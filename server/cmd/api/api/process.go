@@ -27,6 +27,8 @@ import (
 	"github.com/onkernel/kernel-images/server/lib/logger"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 	"github.com/onkernel/kernel-images/server/lib/ptyio"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"golang.org/x/sys/unix"
 )
 
 type processHandle struct {
@@ -64,6 +66,47 @@ func (h *processHandle) setExited(code int) {
 	h.mu.Unlock()
 }
 
+// processSignals are the signals ProcessSignal and the attach signal message accept.
+var processSignals = map[string]syscall.Signal{
+	"HUP":   syscall.SIGHUP,
+	"INT":   syscall.SIGINT,
+	"QUIT":  syscall.SIGQUIT,
+	"KILL":  syscall.SIGKILL,
+	"USR1":  syscall.SIGUSR1,
+	"USR2":  syscall.SIGUSR2,
+	"TERM":  syscall.SIGTERM,
+	"CONT":  syscall.SIGCONT,
+	"STOP":  syscall.SIGSTOP,
+	"TSTP":  syscall.SIGTSTP,
+	"TTIN":  syscall.SIGTTIN,
+	"TTOU":  syscall.SIGTTOU,
+	"WINCH": syscall.SIGWINCH,
+}
+
+// parseSignal resolves a signal name, with or without the SIG prefix.
+func parseSignal(name string) (syscall.Signal, bool) {
+	sig, ok := processSignals[strings.TrimPrefix(name, "SIG")]
+	return sig, ok
+}
+
+// signalJob sends sig the way a terminal would: to the foreground process group of a
+// PTY-backed process's terminal when that group is in the process's session, otherwise to
+// the process's group if it leads one, else to the process alone. Processes spawned
+// without a PTY share the server's group, so it is never signaled as a whole.
+func (h *processHandle) signalJob(sig syscall.Signal) error {
+	if h.isTTY && h.ptyFile != nil {
+		if fg, err := unix.IoctlGetInt(int(h.ptyFile.Fd()), unix.TIOCGPGRP); err == nil && fg > 0 {
+			if sid, err := unix.Getsid(fg); err == nil && sid == h.pid {
+				return syscall.Kill(-fg, sig)
+			}
+		}
+	}
+	if pgid, err := syscall.Getpgid(h.pid); err == nil && pgid == h.pid {
+		return syscall.Kill(-h.pid, sig)
+	}
+	return syscall.Kill(h.pid, sig)
+}
+
 func buildCmd(body *oapi.ProcessExecRequest) (*exec.Cmd, error) {
 	if body == nil || body.Command == "" {
 		return nil, errors.New("command required")
@@ -302,7 +345,8 @@ func (s *ApiService) ProcessSpawn(ctx context.Context, request oapi.ProcessSpawn
 	// Disable scale-to-zero while the process is running.
 	// Track success so we only re-enable if disable succeeded.
 	stzDisabled := false
-	if err := s.stz.Disable(ctx); err != nil {
+	processStz := scaletozero.WithReason(s.stz, scaletozero.ReasonProcess)
+	if err := processStz.Disable(ctx); err != nil {
 		log.Error("failed to disable scale-to-zero", "err", err)
 	} else {
 		stzDisabled = true
@@ -406,7 +450,7 @@ func (s *ApiService) ProcessSpawn(ctx context.Context, request oapi.ProcessSpawn
 		// Re-enable scale-to-zero now that the process has exited,
 		// but only if we successfully disabled it earlier
 		if stzWasDisabled {
-			if err := s.stz.Enable(stzCtx); err != nil {
+			if err := processStz.Enable(stzCtx); err != nil {
 				log.Error("failed to enable scale-to-zero", "err", err)
 			}
 		}
@@ -475,6 +519,34 @@ func (s *ApiService) ProcessKill(ctx context.Context, request oapi.ProcessKillRe
 	return oapi.ProcessKill200JSONResponse(oapi.OkResponse{Ok: true}), nil
 }
 
+// Send a signal to a process's job
+// (POST /process/{process_id}/signal)
+func (s *ApiService) ProcessSignal(ctx context.Context, request oapi.ProcessSignalRequestObject) (oapi.ProcessSignalResponseObject, error) {
+	log := logger.FromContext(ctx)
+	id := request.ProcessId.String()
+	s.procMu.RLock()
+	h, ok := s.procs[id]
+	s.procMu.RUnlock()
+	if !ok {
+		return oapi.ProcessSignal404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "process not found"}}, nil
+	}
+	if request.Body == nil {
+		return oapi.ProcessSignal400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "request body required"}}, nil
+	}
+	sig, ok := parseSignal(request.Body.Signal)
+	if !ok {
+		return oapi.ProcessSignal400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Message: "invalid signal"}}, nil
+	}
+	if h.state() != "running" {
+		return oapi.ProcessSignal404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "process not running"}}, nil
+	}
+	if err := h.signalJob(sig); err != nil {
+		log.Error("failed to signal process", "err", err, "signal", request.Body.Signal)
+		return oapi.ProcessSignal500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to signal process"}}, nil
+	}
+	return oapi.ProcessSignal200JSONResponse(oapi.OkResponse{Ok: true}), nil
+}
+
 // Get process status
 // (GET /process/{process_id}/status)
 func (s *ApiService) ProcessStatus(ctx context.Context, request oapi.ProcessStatusRequestObject) (oapi.ProcessStatusResponseObject, error) {
@@ -636,9 +708,12 @@ func writeJSON(w http.ResponseWriter, status int, body string) {
 // Protocol:
 //   - Client sends BinaryMessage for stdin data
 //   - Server sends BinaryMessage for stdout data
-//   - Client sends TextMessage with JSON for control (e.g., resize)
+//   - Client sends TextMessage with JSON for control (resize, or signal for job control)
 //   - Server sends TextMessage with JSON for events (e.g., exit code)
 //
+// Output the client doesn't keep up with is buffered up to PTY_ATTACH_BUFFER_BYTES; beyond
+// that PTY_ATTACH_BUFFER_POLICY either pauses reading from the PTY or drops the oldest output.
+//
 // This endpoint is intentionally not defined in OpenAPI.
 func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Request, id string) {
 	ctx := r.Context()
@@ -665,6 +740,18 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 	h.attachActive = true
 	h.mu.Unlock()
 
+	// PTY output waits here for a client that reads slower than the process writes.
+	policy := ptyio.OverflowPolicy(s.config.PTYAttachBufferPolicy)
+	out, err := ptyio.NewOutputBuffer(s.config.PTYAttachBufferBytes, policy)
+	if err != nil {
+		log.Error("failed to create attach output buffer", "err", err)
+		writeJSON(w, http.StatusInternalServerError, `{"type":"error","message":"internal error"}`)
+		h.mu.Lock()
+		h.attachActive = false
+		h.mu.Unlock()
+		return
+	}
+
 	// Accept WebSocket connection.
 	// OriginPatterns allows all origins because this endpoint uses token-based auth
 	// (not cookies), so CSWSH attacks are not a concern.
@@ -686,7 +773,7 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 	// Set a generous read limit for PTY data
 	wsConn.SetReadLimit(1024 * 1024) // 1MB
 
-	log.Info("websocket attach started", "process_id", id)
+	log.Info("websocket attach started", "process_id", id, "buffer_bytes", s.config.PTYAttachBufferBytes, "overflow_policy", policy)
 
 	// WaitGroup to track all goroutines for clean shutdown
 	var wg sync.WaitGroup
@@ -697,6 +784,8 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 	shutdown := func() {
 		doneOnce.Do(func() {
 			close(done)
+			// release a PTY read blocked on a full buffer
+			out.Close()
 		})
 	}
 
@@ -707,13 +796,26 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 	writerDone := make(chan struct{})
 
 	// Writer goroutine - serializes all writes to the WebSocket.
-	// After done is closed, drains any remaining messages before exiting.
+	// PTY output is written before control messages, so the exit message follows the
+	// process's last output. After done is closed, drains any remaining messages before exiting.
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		defer close(writerDone)
 		for {
+			if data, ok := out.Pop(); ok {
+				writeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				err := wsConn.Write(writeCtx, websocket.MessageBinary, data)
+				cancel()
+				if err != nil {
+					log.Error("websocket write failed", "err", err)
+					shutdown()
+					return
+				}
+				continue
+			}
 			select {
+			case <-out.Ready():
 			case op := <-writeCh:
 				// Use context.Background() instead of request context because we want to
 				// complete pending writes even if the HTTP request context is cancelled.
@@ -726,7 +828,16 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 					return
 				}
 			case <-done:
-				// Drain any remaining messages in the channel before exiting
+				// Drain any remaining output and messages before exiting
+				for data, ok := out.Pop(); ok; data, ok = out.Pop() {
+					writeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+					err := wsConn.Write(writeCtx, websocket.MessageBinary, data)
+					cancel()
+					if err != nil {
+						log.Error("websocket write failed during drain", "err", err)
+						return
+					}
+				}
 				for {
 					select {
 					case op := <-writeCh:
@@ -800,6 +911,17 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 					} else {
 						log.Warn("resize rejected: dimensions out of range", "rows", ctrl.Rows, "cols", ctrl.Cols)
 					}
+				case ptyio.AttachMessageSignal:
+					sig, ok := parseSignal(ctrl.Signal)
+					if !ok {
+						log.Warn("signal rejected: unknown signal", "signal", ctrl.Signal)
+						sendAttachError(writeCh, done, "invalid signal")
+						continue
+					}
+					if err := h.signalJob(sig); err != nil {
+						log.Error("failed to signal process", "err", err, "signal", ctrl.Signal)
+						sendAttachError(writeCh, done, "failed to signal process")
+					}
 				default:
 					log.Warn("unknown control message type", "type", ctrl.Type)
 				}
@@ -811,15 +933,8 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err := ptyio.ReadPTYToWriter(h.ptyFile, func(data []byte) error {
-			select {
-			case writeCh <- wsWriteOp{msgType: websocket.MessageBinary, data: data}:
-				return nil
-			case <-done:
-				return io.EOF // Signal to stop reading
-			}
-		}, done)
-		if err != nil {
+		err := ptyio.ReadPTYToWriter(h.ptyFile, out.Push, done)
+		if err != nil && !errors.Is(err, ptyio.ErrBufferClosed) {
 			log.Error("pty read error", "err", err)
 		}
 		shutdown()
@@ -869,7 +984,17 @@ func (s *ApiService) HandleProcessAttachWS(w http.ResponseWriter, r *http.Reques
 	h.attachActive = false
 	h.mu.Unlock()
 
-	log.Info("websocket attach ended", "process_id", id)
+	log.Info("websocket attach ended", "process_id", id, "dropped_bytes", out.Dropped())
+}
+
+// sendAttachError queues an error control message for the attach client, unless the
+// session is shutting down.
+func sendAttachError(writeCh chan<- wsWriteOp, done <-chan struct{}, message string) {
+	data, _ := json.Marshal(ptyio.AttachControlMessage{Type: ptyio.AttachMessageError, Message: message})
+	select {
+	case writeCh <- wsWriteOp{msgType: websocket.MessageText, data: data}:
+	case <-done:
+	}
 }
 
 // wsWriteOp represents a write operation to be performed on the WebSocket.
@@ -9,6 +9,7 @@ import (
 	"os/user"
 	"strconv"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -184,6 +185,72 @@ func TestProcessKill(t *testing.T) {
 	require.True(t, false, "process not killed in time")
 }
 
+func TestProcessSignal(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc := &ApiService{procs: make(map[string]*processHandle), stz: scaletozero.NewNoopController()}
+
+	waitExited := func(id openapi_types.UUID) {
+		t.Helper()
+		deadline := time.Now().Add(3 * time.Second)
+		for time.Now().Before(deadline) {
+			resp, err := svc.ProcessStatus(ctx, oapi.ProcessStatusRequestObject{ProcessId: id})
+			require.NoError(t, err, "ProcessStatus error")
+			if sr, ok := resp.(oapi.ProcessStatus200JSONResponse); ok && sr.State != nil && *sr.State == "exited" {
+				return
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+		t.Fatal("process not signaled in time")
+	}
+
+	for _, tty := range []bool{false, true} {
+		// sleep runs directly rather than under sh -c: a shell that forks it can take the
+		// signal before sleep has joined the foreground job, then wait the full 5s for it
+		args := []string{"5"}
+		body := &oapi.ProcessSpawnRequest{Command: "sleep", Args: &args, AllocateTty: &tty}
+		spawnResp, err := svc.ProcessSpawn(ctx, oapi.ProcessSpawnRequestObject{Body: body})
+		require.NoError(t, err, "ProcessSpawn error")
+		s200, ok := spawnResp.(oapi.ProcessSpawn200JSONResponse)
+		require.True(t, ok, "unexpected spawn resp: %T", spawnResp)
+		id := *s200.ProcessId
+
+		resp, err := svc.ProcessSignal(ctx, oapi.ProcessSignalRequestObject{ProcessId: id, Body: &oapi.ProcessSignalRequest{Signal: "SIGSEGV"}})
+		require.NoError(t, err)
+		_, ok = resp.(oapi.ProcessSignal400JSONResponse)
+		require.True(t, ok, "expected 400 for a signal outside the allowlist, got %T", resp)
+
+		// without a PTY the process shares this test's process group, which must survive
+		sig := "TERM"
+		if tty {
+			sig = "SIGINT"
+		}
+		resp, err = svc.ProcessSignal(ctx, oapi.ProcessSignalRequestObject{ProcessId: id, Body: &oapi.ProcessSignalRequest{Signal: sig}})
+		require.NoError(t, err)
+		_, ok = resp.(oapi.ProcessSignal200JSONResponse)
+		require.True(t, ok, "unexpected signal resp: %T", resp)
+		waitExited(id)
+
+		resp, err = svc.ProcessSignal(ctx, oapi.ProcessSignalRequestObject{ProcessId: id, Body: &oapi.ProcessSignalRequest{Signal: "INT"}})
+		require.NoError(t, err)
+		_, ok = resp.(oapi.ProcessSignal404JSONResponse)
+		require.True(t, ok, "expected 404 once exited, got %T", resp)
+	}
+}
+
+func TestParseSignal(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"INT", "SIGINT"} {
+		sig, ok := parseSignal(name)
+		require.True(t, ok, name)
+		require.Equal(t, syscall.SIGINT, sig)
+	}
+	for _, name := range []string{"", "int", "SIG", "SIGSEGV", "9"} {
+		_, ok := parseSignal(name)
+		require.False(t, ok, name)
+	}
+}
+
 func TestProcessNotFoundRoutes(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"sync/atomic"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// proofPool bounds how many admitted proofs run the protocol at once, which is what
+// holds the prover's memory. RECLAIM_MAX_CONCURRENT still decides which proofs are
+// admitted; those beyond the pool's workers wait in acquire for one to be released.
+type proofPool struct {
+	workers chan struct{}
+	busy    atomic.Int64
+	queued  atomic.Int64
+}
+
+func newProofPool(workers int) *proofPool {
+	return &proofPool{workers: make(chan struct{}, max(workers, 1))}
+}
+
+// acquire waits for a free worker, counting the caller as queued meanwhile. It returns
+// ctx's error if ctx is done first. A nil error must be followed by release.
+func (p *proofPool) acquire(ctx context.Context) error {
+	p.queued.Add(1)
+	defer p.queued.Add(-1)
+	select {
+	case p.workers <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	p.busy.Add(1)
+	return nil
+}
+
+// release frees the worker taken by acquire.
+func (p *proofPool) release() {
+	p.busy.Add(-1)
+	<-p.workers
+}
+
+func (p *proofPool) stats() oapi.ProofPoolStats {
+	return oapi.ProofPoolStats{
+		Workers: cap(p.workers),
+		Busy:    int(p.busy.Load()),
+		Queued:  int(p.queued.Load()),
+	}
+}
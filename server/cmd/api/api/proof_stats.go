@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+const (
+	// proofLatencySamples is how many recent proof durations each group keeps to compute
+	// its latency percentiles.
+	proofLatencySamples = 1000
+	// maxProofStatsProviders bounds the provider names tracked separately, since they
+	// come from requests; later names are counted under otherProofProvider.
+	maxProofStatsProviders = 100
+	otherProofProvider     = "other"
+	unknownProofProvider   = "unknown"
+)
+
+// proofGroup accumulates the outcomes of a group of proofs.
+type proofGroup struct {
+	total, succeeded, failed int64
+	// latencies is a ring of the most recent proof durations; next is where the
+	// following one goes once it is full.
+	latencies []time.Duration
+	next      int
+}
+
+func (g *proofGroup) record(d time.Duration, succeeded bool) {
+	g.total++
+	if succeeded {
+		g.succeeded++
+	} else {
+		g.failed++
+	}
+	if len(g.latencies) < proofLatencySamples {
+		g.latencies = append(g.latencies, d)
+		return
+	}
+	g.latencies[g.next] = d
+	g.next = (g.next + 1) % proofLatencySamples
+}
+
+func (g *proofGroup) entry() oapi.ProofStatsEntry {
+	e := oapi.ProofStatsEntry{
+		Total:     g.total,
+		Succeeded: g.succeeded,
+		Failed:    g.failed,
+	}
+	if g.total > 0 {
+		e.SuccessRate = float32(g.succeeded) / float32(g.total)
+	}
+	if len(g.latencies) > 0 {
+		sorted := slices.Clone(g.latencies)
+		slices.Sort(sorted)
+		e.LatencyP50Ms = ptrOf(percentile(sorted, 50).Milliseconds())
+		e.LatencyP90Ms = ptrOf(percentile(sorted, 90).Milliseconds())
+		e.LatencyP99Ms = ptrOf(percentile(sorted, 99).Milliseconds())
+	}
+	return e
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted, which must not be empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// proofStats tracks the outcomes of the proofs run by ReclaimProve, overall and per provider.
+type proofStats struct {
+	mu        sync.Mutex
+	since     time.Time
+	overall   proofGroup
+	providers map[string]*proofGroup
+}
+
+func newProofStats() *proofStats {
+	return &proofStats{
+		since:     time.Now(),
+		providers: make(map[string]*proofGroup),
+	}
+}
+
+// record adds a proof of provider that ran for d.
+func (ps *proofStats) record(provider string, d time.Duration, succeeded bool) {
+	if provider == "" {
+		provider = unknownProofProvider
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.overall.record(d, succeeded)
+	g, ok := ps.providers[provider]
+	if !ok {
+		if len(ps.providers) >= maxProofStatsProviders {
+			provider = otherProofProvider
+		}
+		if g, ok = ps.providers[provider]; !ok {
+			g = &proofGroup{}
+			ps.providers[provider] = g
+		}
+	}
+	g.record(d, succeeded)
+}
+
+func (ps *proofStats) snapshot() oapi.ProofStats {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	stats := oapi.ProofStats{
+		Since:     ps.since,
+		Overall:   ps.overall.entry(),
+		Providers: make([]oapi.ProofStatsEntry, 0, len(ps.providers)),
+	}
+	for name, g := range ps.providers {
+		e := g.entry()
+		e.Provider = ptrOf(name)
+		stats.Providers = append(stats.Providers, e)
+	}
+	slices.SortFunc(stats.Providers, func(a, b oapi.ProofStatsEntry) int { return strings.Compare(*a.Provider, *b.Provider) })
+	return stats
+}
+
+// GetProofStats returns counts, success rate and latency of the proofs run since startup,
+// and the current state of the proof worker pool.
+// (GET /reclaim/stats)
+func (s *ApiService) GetProofStats(ctx context.Context, _ oapi.GetProofStatsRequestObject) (oapi.GetProofStatsResponseObject, error) {
+	stats := s.proofStats.snapshot()
+	stats.Pool = s.proofPool.stats()
+	return oapi.GetProofStats200JSONResponse(stats), nil
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/reclaimprotocol/reclaim-tee/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofStats(t *testing.T) {
+	ps := newProofStats()
+
+	empty := ps.snapshot()
+	assert.Zero(t, empty.Overall.Total)
+	assert.Nil(t, empty.Overall.LatencyP50Ms)
+	assert.Empty(t, empty.Providers)
+
+	for i := 1; i <= 100; i++ {
+		ps.record("http", time.Duration(i)*time.Millisecond, i%4 != 0)
+	}
+	ps.record("", 500*time.Millisecond, false)
+
+	stats := ps.snapshot()
+	assert.Equal(t, int64(101), stats.Overall.Total)
+	assert.Equal(t, int64(75), stats.Overall.Succeeded)
+	assert.Equal(t, int64(26), stats.Overall.Failed)
+	require.Len(t, stats.Providers, 2)
+
+	httpStats := stats.Providers[0]
+	assert.Equal(t, "http", *httpStats.Provider)
+	assert.InDelta(t, 0.75, httpStats.SuccessRate, 0.001)
+	assert.Equal(t, int64(50), *httpStats.LatencyP50Ms)
+	assert.Equal(t, int64(90), *httpStats.LatencyP90Ms)
+	assert.Equal(t, int64(99), *httpStats.LatencyP99Ms)
+	assert.Equal(t, unknownProofProvider, *stats.Providers[1].Provider)
+	assert.Equal(t, int64(500), *stats.Providers[1].LatencyP50Ms)
+}
+
+func TestProofStats_LatencyWindow(t *testing.T) {
+	ps := newProofStats()
+	for range proofLatencySamples {
+		ps.record("http", time.Second, true)
+	}
+	for range proofLatencySamples {
+		ps.record("http", time.Millisecond, true)
+	}
+
+	stats := ps.snapshot()
+	assert.Equal(t, int64(2*proofLatencySamples), stats.Overall.Total)
+	assert.Equal(t, int64(1), *stats.Overall.LatencyP99Ms, "older samples should have rolled out")
+}
+
+func TestProofStats_ProviderLimit(t *testing.T) {
+	ps := newProofStats()
+	for i := range maxProofStatsProviders + 5 {
+		ps.record(fmt.Sprintf("p%03d", i), time.Millisecond, true)
+	}
+
+	stats := ps.snapshot()
+	require.Len(t, stats.Providers, maxProofStatsProviders+1)
+	other := stats.Providers[0] // sorts before the p### names
+	assert.Equal(t, otherProofProvider, *other.Provider)
+	assert.Equal(t, int64(5), other.Total)
+}
+
+// failingReclaimClient is a reclaimProtocolClient whose protocol fails immediately.
+type failingReclaimClient struct{}
+
+func (failingReclaimClient) ExecuteCompleteProtocol(*client.ProviderRequestData) (*client.ClaimWithSignatures, error) {
+	return nil, errors.New("attestor unreachable")
+}
+
+func (failingReclaimClient) Close() error { return nil }
+
+func TestReclaimProve_RecordsProofStats(t *testing.T) {
+	ctx := context.Background()
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return failingReclaimClient{}, nil }
+
+	// rejected before the protocol starts: not counted
+	resp, err := svc.ReclaimProve(ctx, oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "not json"}})
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve400JSONResponse{}, resp)
+
+	resp, err = svc.ReclaimProve(ctx, oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: `{"name":"http"}`}})
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve500JSONResponse{}, resp)
+
+	statsResp, err := svc.GetProofStats(ctx, oapi.GetProofStatsRequestObject{})
+	require.NoError(t, err)
+	stats, ok := statsResp.(oapi.GetProofStats200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", statsResp)
+	assert.Equal(t, int64(1), stats.Overall.Total)
+	assert.Equal(t, int64(1), stats.Overall.Failed)
+	require.Len(t, stats.Providers, 1)
+	assert.Equal(t, "http", *stats.Providers[0].Provider)
+	assert.NotNil(t, stats.Providers[0].LatencyP50Ms)
+}
@@ -3,17 +3,39 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/onkernel/kernel-images/server/cmd/api/circuits"
+	"github.com/onkernel/kernel-images/server/cmd/config"
 	"github.com/onkernel/kernel-images/server/lib/logger"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
 	"github.com/reclaimprotocol/reclaim-tee/client"
 )
 
+const (
+	// reclaimProveTimeout bounds how long ReclaimProve waits for the protocol to finish.
+	reclaimProveTimeout = 5 * time.Minute
+	// reclaimProveGracePeriod is how long a timed-out request waits for the protocol
+	// goroutine before closing the client underneath it.
+	reclaimProveGracePeriod = 10 * time.Second
+)
+
+// reclaimProtocolClient is the subset of *client.ReclaimClient used by ReclaimProve.
+type reclaimProtocolClient interface {
+	ExecuteCompleteProtocol(providerData *client.ProviderRequestData) (*client.ClaimWithSignatures, error)
+	Close() error
+}
+
+// newReclaimProtocolClient creates the library client used for a single proof.
+func newReclaimProtocolClient(providerParamsJSON, configJSON string) (reclaimProtocolClient, error) {
+	return client.NewReclaimClientFromJSON(providerParamsJSON, configJSON)
+}
+
 // reclaimConfigJSON is the structure for optional config overrides
 type reclaimConfigJSON struct {
 	TEEKUrl     string `json:"teekUrl,omitempty"`
@@ -26,6 +48,17 @@ type reclaimConfigJSON struct {
 func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequestObject) (oapi.ReclaimProveResponseObject, error) {
 	log := logger.FromContext(ctx)
 
+	// Refuse oversized provider params before anything parses or copies them
+	if limit := s.config.ReclaimMaxProviderParamsKB; limit > 0 && len(req.Body.ProviderParamsJson) > limit*1024 {
+		log.Warn("rejecting reclaim prove, provider params too large", "bytes", len(req.Body.ProviderParamsJson), "limit_kb", limit)
+		return oapi.ReclaimProve400JSONResponse{
+			BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{
+				Code:    ptrOf(oapi.ProviderParamsTooLarge),
+				Message: fmt.Sprintf("provider_params_json exceeds the maximum size of %d KB", limit),
+			},
+		}, nil
+	}
+
 	// Setup ZK callback (idempotent, only runs once)
 	circuits.SetupZKCallback()
 
@@ -65,12 +98,78 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 		}, nil
 	}
 
+	verifyOnChain := req.Body.VerifyOnChain != nil && *req.Body.VerifyOnChain
+	if verifyOnChain && s.chainVerifier == nil {
+		return oapi.ReclaimProve400JSONResponse{
+			BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{
+				Message: "verify_on_chain requires RECLAIM_CHAIN_RPC_URL and RECLAIM_VERIFIER_CONTRACT to be configured",
+			},
+		}, nil
+	}
+
+	if s.draining.Load() {
+		log.Warn("rejecting reclaim prove, server is draining", "request_id", requestID)
+		return oapi.ReclaimProve503JSONResponse{
+			Body: oapi.Error{
+				Code:    ptrOf(oapi.Draining),
+				Message: "server is shutting down",
+			},
+			Headers: oapi.ReclaimProve503ResponseHeaders{
+				RetryAfter: s.config.ReclaimRetryAfterSeconds,
+			},
+		}, nil
+	}
+
+	// Optionally refuse proofs until the circuits are loaded. The cipher (and so the circuit)
+	// a proof needs is only known once the TLS handshake with the target has happened, so
+	// every preloaded circuit has to be ready before a proof can be admitted.
+	if s.config.ReclaimWaitForCircuits {
+		if pending := s.pendingCircuits(); len(pending) > 0 {
+			log.Warn("rejecting reclaim prove, circuits still initializing", "request_id", requestID, "pending", pending)
+			return oapi.ReclaimProve503JSONResponse{
+				Body: oapi.Error{
+					Code:    ptrOf(oapi.CircuitsInitializing),
+					Message: "ZK circuits are still initializing, please retry later",
+				},
+				Headers: oapi.ReclaimProve503ResponseHeaders{
+					RetryAfter: s.config.ReclaimCircuitsRetryAfterSeconds,
+				},
+			}, nil
+		}
+	}
+
+	// Bound the number of proofs running at once; each holds TEE connections and
+	// significant memory for the duration of the protocol. The slot is released here on
+	// early returns and handed off to the protocol goroutine once it starts, since that
+	// goroutine can outlive this handler when the proof times out.
+	select {
+	case s.proveSem <- struct{}{}:
+	default:
+		log.Warn("rejecting reclaim prove, concurrency limit reached", "request_id", requestID, "limit", cap(s.proveSem))
+		return oapi.ReclaimProve429JSONResponse{
+			Body: oapi.Error{
+				Code:    ptrOf(oapi.TooManyProofs),
+				Message: "too many proofs in progress, please retry later",
+			},
+			Headers: oapi.ReclaimProve429ResponseHeaders{
+				RetryAfter: s.config.ReclaimRetryAfterSeconds,
+			},
+		}, nil
+	}
+
+	slotHandedOff := false
+	defer func() {
+		if !slotHandedOff {
+			<-s.proveSem
+		}
+	}()
+
 	log.Info("starting reclaim prove", "request_id", requestID)
 
 	log.Info("using TEE configuration",
-		"teek_url", teekUrl,
-		"teet_url", teetUrl,
-		"attestor_url", attestorUrl,
+		"teek_url", config.RedactURL(teekUrl),
+		"teet_url", config.RedactURL(teetUrl),
+		"attestor_url", config.RedactURL(attestorUrl),
 	)
 
 	// Parse provider data for ExecuteCompleteProtocol
@@ -79,6 +178,7 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 		log.Error("failed to parse provider params", "err", err)
 		return oapi.ReclaimProve400JSONResponse{
 			BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{
+				Code:    ptrOf(oapi.InvalidProviderParams),
 				Message: fmt.Sprintf("invalid provider parameters JSON: %v", err),
 			},
 		}, nil
@@ -101,7 +201,7 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 	}
 
 	// Create reclaim client from JSON
-	reclaimClient, err := client.NewReclaimClientFromJSON(
+	reclaimClient, err := s.newReclaimClient(
 		req.Body.ProviderParamsJson,
 		string(clientConfigJSON),
 	)
@@ -109,13 +209,16 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 		log.Error("failed to create reclaim client", "err", err)
 		return oapi.ReclaimProve400JSONResponse{
 			BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{
+				Code:    ptrOf(oapi.InvalidProviderParams),
 				Message: fmt.Sprintf("invalid provider parameters: %v", err),
 			},
 		}, nil
 	}
 
-	// Create a context with timeout (5 minutes for proof generation)
-	proofCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	// Create a context with timeout for proof generation
+	proveTimeout, timeoutSource := s.proofTimeout(providerData.Name)
+	log.Info("applying proof timeout", "request_id", requestID, "provider", providerData.Name, "timeout", proveTimeout, "source", timeoutSource)
+	proofCtx, cancel := context.WithTimeout(ctx, proveTimeout)
 	defer cancel()
 
 	// Execute protocol in a goroutine so we can handle timeout
@@ -125,7 +228,64 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 	}
 	resultCh := make(chan result, 1)
 
+	// Wait for a proof worker. The wait counts against the proof's timeout, and a proof
+	// that times out in the queue never starts.
+	queuedAt := time.Now()
+	if err := s.proofPool.acquire(proofCtx); err != nil {
+		reclaimClient.Close()
+		log.Error("proof timed out waiting for a proof worker", "request_id", requestID, "waited", time.Since(queuedAt), "err", err)
+		return oapi.ReclaimProve500JSONResponse{
+			InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{
+				Code:    ptrOf(oapi.ProofTimeout),
+				Message: "proof timed out waiting for a proof worker",
+			},
+		}, nil
+	}
+	if waited := time.Since(queuedAt); waited >= time.Millisecond {
+		log.Info("proof waited for a proof worker", "request_id", requestID, "waited_ms", waited.Milliseconds())
+	}
+
+	// Record the proof once it has a worker, so the recording covers the protocol rather
+	// than the queue. It is capped at the time the proof may still take.
+	var recordingID *string
+	stopRecording := func() {}
+	if req.Body.Record != nil && *req.Body.Record {
+		deadline, _ := proofCtx.Deadline()
+		id, stop, err := s.startProofRecording(ctx, requestID, time.Until(deadline)+s.proveGracePeriod)
+		if err != nil {
+			s.proofPool.release()
+			reclaimClient.Close()
+			log.Error("failed to start proof recording", "request_id", requestID, "err", err)
+			return oapi.ReclaimProve500JSONResponse{
+				InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{
+					Code:    ptrOf(oapi.ProofRecordingFailed),
+					Message: fmt.Sprintf("failed to start proof recording: %v", err),
+				},
+			}, nil
+		}
+		log.Info("recording proof", "request_id", requestID, "recorder_id", id)
+		recordingID, stopRecording = &id, stop
+	}
+
+	// Keep scale-to-zero off while the protocol runs, which can outlast this handler.
+	proofStz := scaletozero.WithReason(s.stz, scaletozero.ReasonProof)
+	stzHeld := true
+	if err := proofStz.Disable(ctx); err != nil {
+		log.Error("failed to disable scale-to-zero", "err", err)
+		stzHeld = false
+	}
+
+	slotHandedOff = true
+	proofStart := time.Now()
 	go func() {
+		// Hold the concurrency slot and worker until the protocol has actually stopped running
+		defer func() { <-s.proveSem }()
+		defer s.proofPool.release()
+		defer func() {
+			if stzHeld {
+				_ = proofStz.Enable(context.WithoutCancel(ctx))
+			}
+		}()
 		// Recover from panics in the external library to prevent server crash
 		defer func() {
 			if r := recover(); r != nil {
@@ -142,28 +302,59 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 	select {
 	case <-proofCtx.Done():
 		timedOut = true
+		s.proofStats.record(providerData.Name, time.Since(proofStart), false)
 		log.Error("proof execution timed out, waiting for goroutine cleanup", "request_id", requestID)
 	case res := <-resultCh:
 		// Close client after goroutine completes
 		reclaimClient.Close()
+		stopRecording()
+		elapsed := time.Since(proofStart)
 
 		if res.err != nil {
+			s.proofStats.record(providerData.Name, elapsed, false)
 			log.Error("proof execution failed", "request_id", requestID, "err", res.err)
 			return oapi.ReclaimProve500JSONResponse{
 				InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{
+					Code:    ptrOf(oapi.ProofFailed),
 					Message: fmt.Sprintf("proof execution failed: %v", res.err),
 				},
 			}, nil
 		}
 
-		log.Info("proof execution completed", "request_id", requestID, "identifier", res.claim.Claim.Identifier)
+		if s.config.ReclaimVerifySignatures {
+			if err := verifyClaimSignature(res.claim.Claim, res.claim.Signature); err != nil {
+				s.proofStats.record(providerData.Name, elapsed, false)
+				log.Error("claim signature verification failed", "request_id", requestID, "err", err)
+				return oapi.ReclaimProve502JSONResponse{
+					Code:    ptrOf(oapi.ClaimSignatureInvalid),
+					Message: fmt.Sprintf("claim signature verification failed: %v", err),
+				}, nil
+			}
+		}
+
+		s.proofStats.record(providerData.Name, elapsed, true)
+		log.Info("proof execution completed", "request_id", requestID, "identifier", res.claim.Claim.Identifier, "duration_ms", elapsed.Milliseconds())
 
 		// Map result to response
-		return oapi.ReclaimProve200JSONResponse{
-			SessionId: requestID,
-			Claim:     mapClaimToOapi(res.claim.Claim),
-			Signature: mapSignatureToOapi(res.claim.Signature),
-		}, nil
+		rawClaim := rawClaimJSON(res.claim.Claim)
+		resp := oapi.ReclaimProve200JSONResponse{
+			SessionId:    requestID,
+			Claim:        mapClaimToOapi(res.claim.Claim),
+			Signature:    mapSignatureToOapi(res.claim.Signature),
+			RawClaimJson: &rawClaim,
+			RecordingId:  recordingID,
+		}
+		// the proof already succeeded, so the outcome is reported rather than failing it
+		if verifyOnChain {
+			v := s.chainVerifier.verify(ctx, res.claim.Claim, res.claim.Signature)
+			if v.Message != nil {
+				log.Warn("on-chain verification did not succeed", "request_id", requestID, "status", v.Status, "message", *v.Message)
+			} else {
+				log.Info("claim verified on chain", "request_id", requestID, "contract", v.Contract)
+			}
+			resp.OnChainVerification = &v
+		}
+		return resp, nil
 	}
 
 	// If we timed out, wait for the goroutine to complete before closing
@@ -173,13 +364,15 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 		select {
 		case <-resultCh:
 			log.Info("goroutine completed after timeout", "request_id", requestID)
-		case <-time.After(10 * time.Second):
+		case <-time.After(s.proveGracePeriod):
 			log.Warn("goroutine did not complete within grace period, closing anyway", "request_id", requestID)
 		}
 		reclaimClient.Close()
+		stopRecording()
 
 		return oapi.ReclaimProve500JSONResponse{
 			InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{
+				Code:    ptrOf(oapi.ProofTimeout),
 				Message: "proof execution timed out",
 			},
 		}, nil
@@ -193,6 +386,15 @@ func (s *ApiService) ReclaimProve(ctx context.Context, req oapi.ReclaimProveRequ
 	}, nil
 }
 
+// proofTimeout returns how long a proof of provider may run: its RECLAIM_PROVIDER_TIMEOUTS
+// entry if it has one, else the default. source names which was applied.
+func (s *ApiService) proofTimeout(provider string) (timeout time.Duration, source string) {
+	if seconds, ok := s.config.ReclaimProviderTimeouts[provider]; ok {
+		return time.Duration(seconds) * time.Second, "provider"
+	}
+	return s.proveTimeout, "default"
+}
+
 func mapClaimToOapi(claim interface{}) oapi.ReclaimClaim {
 	// The claim is a protobuf message, we need to extract fields
 	// Using type assertion with the actual proto type
@@ -241,11 +443,15 @@ func mapSignatureToOapi(sig interface{}) oapi.ReclaimSignature {
 		attestorAddr := s.GetAttestorAddress()
 		claimSig := base64.StdEncoding.EncodeToString(s.GetClaimSignature())
 		resultSig := base64.StdEncoding.EncodeToString(s.GetResultSignature())
+		claimSigHex := "0x" + hex.EncodeToString(s.GetClaimSignature())
+		resultSigHex := "0x" + hex.EncodeToString(s.GetResultSignature())
 
 		return oapi.ReclaimSignature{
-			AttestorAddress: &attestorAddr,
-			ClaimSignature:  &claimSig,
-			ResultSignature: &resultSig,
+			AttestorAddress:    &attestorAddr,
+			ClaimSignature:     &claimSig,
+			ResultSignature:    &resultSig,
+			ClaimSignatureHex:  &claimSigHex,
+			ResultSignatureHex: &resultSigHex,
 		}
 	}
 
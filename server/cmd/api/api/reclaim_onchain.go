@@ -0,0 +1,234 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	teeproto "github.com/reclaimprotocol/reclaim-tee/proto"
+	"github.com/reclaimprotocol/reclaim-tee/shared"
+)
+
+// chainVerifyTimeout bounds the eth_call checking a claim against the verifier contract.
+const chainVerifyTimeout = 15 * time.Second
+
+// verifyProofSignature is the signature of the Reclaim verifier contract's verifyProof,
+// which reverts unless the proof's claim is signed by the epoch's witnesses.
+const verifyProofSignature = "verifyProof(((string,string,string),((bytes32,address,uint32,uint32),bytes[])))"
+
+// chainVerifier checks claims against a Reclaim verifier contract with read-only eth_calls.
+type chainVerifier struct {
+	rpcURL   string
+	contract string
+	client   *http.Client
+}
+
+// newChainVerifier returns a verifier calling contract through the JSON-RPC endpoint at
+// rpcURL, or nil if either is empty.
+func newChainVerifier(rpcURL, contract string) *chainVerifier {
+	if rpcURL == "" || contract == "" {
+		return nil
+	}
+	return &chainVerifier{rpcURL: rpcURL, contract: contract, client: &http.Client{Timeout: chainVerifyTimeout}}
+}
+
+// verify reports whether the contract accepts the claim. Problems reaching the chain are
+// reported as unavailable rather than rejected, so callers can tell them apart.
+func (v *chainVerifier) verify(ctx context.Context, claim *teeproto.ProviderClaimData, sig *teeproto.ClaimTeeBundleResponse_Signature) oapi.OnChainVerification {
+	result := oapi.OnChainVerification{Contract: v.contract}
+	data, err := verifyProofCalldata(claim, sig)
+	if err != nil {
+		result.Status, result.Message = oapi.Rejected, ptrOf(err.Error())
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, chainVerifyTimeout)
+	defer cancel()
+	rpcErr, err := v.call(ctx, data)
+	switch {
+	case err != nil:
+		result.Status, result.Message = oapi.Unavailable, ptrOf(err.Error())
+	case rpcErr != nil:
+		// the node reports a revert of verifyProof as a JSON-RPC error
+		result.Status, result.Message = oapi.Rejected, ptrOf(rpcErr.Message)
+	default:
+		result.Status = oapi.Verified
+	}
+	return result
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// call runs an eth_call of data against the contract at the latest block. It returns the
+// node's JSON-RPC error, if any, separately from failures to get an answer at all.
+func (v *chainVerifier) call(ctx context.Context, data []byte) (*jsonRPCError, error) {
+	body, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_call",
+		"params": []any{
+			map[string]string{"to": v.contract, "data": "0x" + hex.EncodeToString(data)},
+			"latest",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := v.client.Do(req)
+	if err != nil {
+		// the URL may carry an API key, so only the cause is reported
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("chain RPC request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain RPC response: %w", err)
+	}
+	var out struct {
+		Result *string       `json:"result"`
+		Error  *jsonRPCError `json:"error"`
+	}
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf("chain RPC returned status %d with an invalid body", resp.StatusCode)
+	}
+	// a revert is a valid answer; anything else that isn't a result means the call didn't run
+	if out.Error != nil && (out.Error.Code == 3 || strings.Contains(out.Error.Message, "revert")) {
+		return out.Error, nil
+	}
+	if out.Error != nil {
+		return nil, fmt.Errorf("chain RPC error %d: %s", out.Error.Code, out.Error.Message)
+	}
+	if out.Result == nil {
+		return nil, fmt.Errorf("chain RPC returned status %d without a result", resp.StatusCode)
+	}
+	return nil, nil
+}
+
+// verifyProofCalldata ABI-encodes a verifyProof call for the claim with its claim signature.
+func verifyProofCalldata(claim *teeproto.ProviderClaimData, sig *teeproto.ClaimTeeBundleResponse_Signature) ([]byte, error) {
+	if claim == nil || sig == nil {
+		return nil, fmt.Errorf("result is missing its claim or signatures")
+	}
+	identifier, err := hex.DecodeString(strings.TrimPrefix(claim.GetIdentifier(), "0x"))
+	if err != nil || len(identifier) != 32 {
+		return nil, fmt.Errorf("claim identifier %q is not 32 hex-encoded bytes", claim.GetIdentifier())
+	}
+	if !shared.IsHexAddress(claim.GetOwner()) {
+		return nil, fmt.Errorf("claim owner %q is not an address", claim.GetOwner())
+	}
+	proof := abiTuple{
+		abiTuple{
+			abiBytes(claim.GetProvider()),
+			abiBytes(claim.GetParameters()),
+			abiBytes(claim.GetContext()),
+		},
+		abiTuple{
+			abiTuple{
+				abiWord(identifier),
+				abiWord(shared.HexToAddress(claim.GetOwner()).Bytes()),
+				abiUint(uint64(claim.GetTimestampS())),
+				abiUint(uint64(claim.GetEpoch())),
+			},
+			abiArray{abiBytes(sig.GetClaimSignature())},
+		},
+	}
+	selector := shared.Keccak256([]byte(verifyProofSignature))[:4:4]
+	return append(selector, abiTuple{proof}.encode()...), nil
+}
+
+// abiValue is a value in the Solidity ABI encoding, enough of it for verifyProof.
+type abiValue interface {
+	dynamic() bool
+	encode() []byte
+}
+
+// abiWord is a static value, left-padded to 32 bytes as addresses and integers are.
+type abiWord []byte
+
+func (w abiWord) dynamic() bool { return false }
+func (w abiWord) encode() []byte {
+	out := make([]byte, 32)
+	copy(out[32-len(w):], w)
+	return out
+}
+
+func abiUint(n uint64) abiWord {
+	return binary.BigEndian.AppendUint64(nil, n)
+}
+
+// abiBytes is a bytes or string value.
+type abiBytes []byte
+
+func (b abiBytes) dynamic() bool { return true }
+func (b abiBytes) encode() []byte {
+	out := abiUint(uint64(len(b))).encode()
+	out = append(out, b...)
+	if pad := len(b) % 32; pad != 0 {
+		out = append(out, make([]byte, 32-pad)...)
+	}
+	return out
+}
+
+// abiTuple is a struct or argument list: static members in place, dynamic ones as offsets
+// into the tail that follows.
+type abiTuple []abiValue
+
+func (t abiTuple) dynamic() bool {
+	for _, v := range t {
+		if v.dynamic() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t abiTuple) encode() []byte {
+	var head, tail []byte
+	headSize := 0
+	for _, v := range t {
+		if v.dynamic() {
+			headSize += 32
+		} else {
+			headSize += len(v.encode())
+		}
+	}
+	for _, v := range t {
+		if v.dynamic() {
+			head = append(head, abiUint(uint64(headSize+len(tail))).encode()...)
+			tail = append(tail, v.encode()...)
+		} else {
+			head = append(head, v.encode()...)
+		}
+	}
+	return append(head, tail...)
+}
+
+// abiArray is a variable-length array: its length followed by its elements as a tuple.
+type abiArray []abiValue
+
+func (a abiArray) dynamic() bool { return true }
+func (a abiArray) encode() []byte {
+	return append(abiUint(uint64(len(a))).encode(), abiTuple(a).encode()...)
+}
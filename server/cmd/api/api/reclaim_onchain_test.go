@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/reclaimprotocol/reclaim-tee/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testVerifierContract = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+
+// onChainClaim returns a signed claim whose identifier is a full 32-byte hash, as the
+// verifier contract expects.
+func onChainClaim(t *testing.T) *client.ClaimWithSignatures {
+	t.Helper()
+	c := signedClaim(t)
+	c.Claim.Identifier = "0x" + strings.Repeat("ab", 32)
+	return c
+}
+
+func TestVerifyProofCalldata(t *testing.T) {
+	c := onChainClaim(t)
+	data, err := verifyProofCalldata(c.Claim, c.Signature)
+	require.NoError(t, err)
+	require.Zero(t, len(data[4:])%32, "arguments must be whole words")
+	// the single proof argument is dynamic, so the head is its offset
+	assert.Equal(t, uint64(32), binary.BigEndian.Uint64(data[4+24:4+32]))
+
+	c.Claim.Identifier = "0x1234"
+	_, err = verifyProofCalldata(c.Claim, c.Signature)
+	assert.ErrorContains(t, err, "identifier")
+
+	c = onChainClaim(t)
+	c.Claim.Owner = "nobody"
+	_, err = verifyProofCalldata(c.Claim, c.Signature)
+	assert.ErrorContains(t, err, "owner")
+}
+
+// jsonRPCServer answers every eth_call with reply, recording the call's target.
+func jsonRPCServer(t *testing.T, reply string) (*httptest.Server, *string) {
+	t.Helper()
+	var to string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "eth_call", req.Method)
+		var call struct {
+			To string `json:"to"`
+		}
+		require.NoError(t, json.Unmarshal(req.Params[0], &call))
+		to = call.To
+		_, _ = w.Write([]byte(reply))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &to
+}
+
+func TestChainVerifier(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, newChainVerifier("", testVerifierContract))
+
+	tests := []struct {
+		name  string
+		reply string
+		want  oapi.OnChainVerificationStatus
+	}{
+		{"verified", `{"jsonrpc":"2.0","id":1,"result":"0x"}`, oapi.Verified},
+		{"reverted", `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted: Invalid signature"}}`, oapi.Rejected},
+		{"node error", `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`, oapi.Unavailable},
+		{"not json-rpc", `<html>bad gateway</html>`, oapi.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, to := jsonRPCServer(t, tt.reply)
+			c := onChainClaim(t)
+			got := newChainVerifier(srv.URL, testVerifierContract).verify(ctx, c.Claim, c.Signature)
+			assert.Equal(t, tt.want, got.Status)
+			assert.Equal(t, testVerifierContract, got.Contract)
+			assert.Equal(t, testVerifierContract, *to)
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+		c := onChainClaim(t)
+		got := newChainVerifier(srv.URL+"/?key=secret", testVerifierContract).verify(ctx, c.Claim, c.Signature)
+		assert.Equal(t, oapi.Unavailable, got.Status)
+		require.NotNil(t, got.Message)
+		assert.NotContains(t, *got.Message, "secret")
+	})
+}
+
+func TestReclaimProve_VerifyOnChain(t *testing.T) {
+	ctx := context.Background()
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	fake := &fixedReclaimClient{claim: onChainClaim(t)}
+	svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return fake, nil }
+	verifyOnChain := true
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "{}", VerifyOnChain: &verifyOnChain}}
+
+	resp, err := svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve400JSONResponse{}, resp)
+
+	srv, _ := jsonRPCServer(t, `{"jsonrpc":"2.0","id":1,"result":"0x"}`)
+	svc.chainVerifier = newChainVerifier(srv.URL, testVerifierContract)
+	resp, err = svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	ok, isOK := resp.(oapi.ReclaimProve200JSONResponse)
+	require.True(t, isOK, "unexpected response type: %T", resp)
+	require.NotNil(t, ok.OnChainVerification)
+	assert.Equal(t, oapi.Verified, ok.OnChainVerification.Status)
+
+	// without the flag the result carries no verification
+	req.Body.VerifyOnChain = nil
+	resp, err = svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	require.Nil(t, resp.(oapi.ReclaimProve200JSONResponse).OnChainVerification)
+}
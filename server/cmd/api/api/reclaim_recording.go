@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// proofRecordingTag is the tag recordings of proofs carry, with the proof's request ID.
+const proofRecordingTag = "reclaim_request_id"
+
+// proofRecorderID names a recorder of the proof with requestID, after it where it makes a
+// valid recorder ID. A random suffix keeps a retried proof from colliding with the
+// recorder of an earlier attempt.
+func proofRecorderID(requestID string) string {
+	suffix := uuid.NewString()[:8]
+	if id := "proof-" + requestID + "-" + suffix; recorder.ValidID(id) {
+		return id
+	}
+	return "proof-" + uuid.NewString()
+}
+
+// startProofRecording starts recording the screen for the proof with requestID, for at
+// most maxDuration. The returned stop is safe to call more than once.
+func (s *ApiService) startProofRecording(ctx context.Context, requestID string, maxDuration time.Duration) (recorderID string, stop func(), err error) {
+	recorderID = proofRecorderID(requestID)
+	label := "reclaim proof " + requestID
+	tags := map[string]string{proofRecordingTag: requestID}
+	seconds := int(math.Ceil(maxDuration.Seconds()))
+	resp, err := s.startRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{
+		Id:                   &recorderID,
+		Label:                &label,
+		Tags:                 &tags,
+		MaxDurationInSeconds: &seconds,
+	}}, recorderID)
+	if err != nil {
+		return "", nil, err
+	}
+	switch r := resp.(type) {
+	case oapi.StartRecording201Response:
+	case oapi.StartRecording400JSONResponse:
+		return "", nil, errors.New(r.Message)
+	case oapi.StartRecording409JSONResponse:
+		return "", nil, errors.New(r.Message)
+	case oapi.StartRecording429JSONResponse:
+		return "", nil, errors.New(r.Body.Message)
+	case oapi.StartRecording500JSONResponse:
+		return "", nil, errors.New(r.Message)
+	case oapi.StartRecording503JSONResponse:
+		return "", nil, errors.New(r.Message)
+	case oapi.StartRecording507JSONResponse:
+		return "", nil, errors.New(r.Message)
+	default:
+		return "", nil, fmt.Errorf("unexpected response %T", resp)
+	}
+
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			rec, ok := s.recordManager.GetRecorder(recorderID)
+			if !ok {
+				return
+			}
+			// the proof's context may be done by now, which mustn't cut the stop short
+			if err := rec.Stop(context.WithoutCancel(ctx)); err != nil {
+				logger.FromContext(ctx).Error("failed to stop proof recording", "request_id", requestID, "recorder_id", recorderID, "err", err)
+			}
+		})
+	}
+	return recorderID, stop, nil
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/reclaimprotocol/reclaim-tee/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReclaimProve_ConcurrencyLimit(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.ReclaimMaxConcurrent = 1
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "not json"}}
+
+	t.Run("rejects when limit reached", func(t *testing.T) {
+		svc.proveSem <- struct{}{}
+		defer func() { <-svc.proveSem }()
+
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		tooMany, ok := resp.(oapi.ReclaimProve429JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, cfg.ReclaimRetryAfterSeconds, tooMany.Headers.RetryAfter)
+		require.NotNil(t, tooMany.Body.Code)
+		require.Equal(t, oapi.TooManyProofs, *tooMany.Body.Code)
+	})
+
+	t.Run("releases slot on early return", func(t *testing.T) {
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		require.IsType(t, oapi.ReclaimProve400JSONResponse{}, resp)
+		require.Equal(t, 0, len(svc.proveSem))
+	})
+}
+
+// blockingReclaimClient is a reclaimProtocolClient whose protocol runs until release is closed.
+type blockingReclaimClient struct {
+	release chan struct{}
+}
+
+func (c *blockingReclaimClient) ExecuteCompleteProtocol(*client.ProviderRequestData) (*client.ClaimWithSignatures, error) {
+	<-c.release
+	return nil, errors.New("released")
+}
+
+func (c *blockingReclaimClient) Close() error { return nil }
+
+func TestReclaimProve_TimeoutHoldsSlotUntilProtocolExits(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.ReclaimMaxConcurrent = 1
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	fake := &blockingReclaimClient{release: make(chan struct{})}
+	svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return fake, nil }
+	svc.proveTimeout = 10 * time.Millisecond
+	svc.proveGracePeriod = 10 * time.Millisecond
+
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "{}"}}
+	resp, err := svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	timedOut, ok := resp.(oapi.ReclaimProve500JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	require.Equal(t, "proof execution timed out", timedOut.Message)
+
+	// the protocol is still running, so its slot must still be held
+	require.Equal(t, 1, len(svc.proveSem))
+	resp, err = svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve429JSONResponse{}, resp)
+
+	close(fake.release)
+	require.Eventually(t, func() bool { return len(svc.proveSem) == 0 }, time.Second, 5*time.Millisecond)
+}
+
+func TestReclaimProve_ProofWorkers(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.ReclaimMaxConcurrent = 2
+	cfg.ReclaimProofWorkers = 1
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	fake := &blockingReclaimClient{release: make(chan struct{})}
+	svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return fake, nil }
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "{}"}}
+
+	poolStats := func() oapi.ProofPoolStats {
+		resp, err := svc.GetProofStats(ctx, oapi.GetProofStatsRequestObject{})
+		require.NoError(t, err)
+		return resp.(oapi.GetProofStats200JSONResponse).Pool
+	}
+
+	t.Run("queues proofs beyond the workers", func(t *testing.T) {
+		done := make(chan oapi.ReclaimProveResponseObject, 2)
+		for range 2 {
+			go func() {
+				resp, _ := svc.ReclaimProve(ctx, req)
+				done <- resp
+			}()
+		}
+		require.Eventually(t, func() bool { return poolStats() == oapi.ProofPoolStats{Workers: 1, Busy: 1, Queued: 1} }, time.Second, 5*time.Millisecond)
+
+		close(fake.release)
+		for range 2 {
+			resp := <-done
+			failed, ok := resp.(oapi.ReclaimProve500JSONResponse)
+			require.True(t, ok, "unexpected response type: %T", resp)
+			require.Equal(t, oapi.ProofFailed, *failed.Code)
+		}
+		require.Eventually(t, func() bool { return poolStats() == oapi.ProofPoolStats{Workers: 1} }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("times out in the queue", func(t *testing.T) {
+		svc.proveTimeout = 10 * time.Millisecond
+		svc.proofPool.workers <- struct{}{}
+		defer func() { <-svc.proofPool.workers }()
+
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		timedOut, ok := resp.(oapi.ReclaimProve500JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, oapi.ProofTimeout, *timedOut.Code)
+		require.Equal(t, 0, len(svc.proveSem))
+		require.Equal(t, oapi.ProofPoolStats{Workers: 1}, poolStats())
+	})
+}
+
+func TestReclaimProve_WaitForCircuits(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	pending := []string{"aes128"}
+	svc.pendingCircuits = func() []string { return pending }
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "not json"}}
+
+	t.Run("attempts early when disabled", func(t *testing.T) {
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		require.IsType(t, oapi.ReclaimProve400JSONResponse{}, resp)
+	})
+
+	cfg.ReclaimWaitForCircuits = true
+
+	t.Run("rejects while circuits are pending", func(t *testing.T) {
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		unavailable, ok := resp.(oapi.ReclaimProve503JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, cfg.ReclaimCircuitsRetryAfterSeconds, unavailable.Headers.RetryAfter)
+		require.NotNil(t, unavailable.Body.Code)
+		require.Equal(t, oapi.CircuitsInitializing, *unavailable.Body.Code)
+		require.Equal(t, 0, len(svc.proveSem))
+	})
+
+	t.Run("admits once circuits are ready", func(t *testing.T) {
+		pending = nil
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		require.IsType(t, oapi.ReclaimProve400JSONResponse{}, resp)
+	})
+}
+
+func TestReclaimProve_ProviderTimeouts(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.ReclaimProviderTimeouts = map[string]int{"http": 30, "slow-bank": 900}
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	timeout, source := svc.proofTimeout("http")
+	require.Equal(t, 30*time.Second, timeout)
+	require.Equal(t, "provider", source)
+
+	timeout, _ = svc.proofTimeout("slow-bank")
+	require.Equal(t, 15*time.Minute, timeout)
+
+	timeout, source = svc.proofTimeout("other")
+	require.Equal(t, reclaimProveTimeout, timeout)
+	require.Equal(t, "default", source)
+}
+
+func TestReclaimProve_ProviderParamsLimit(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.ReclaimMaxProviderParamsKB = 1
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	params := `{"name":"http","params":{"body":"` + strings.Repeat("a", 1024) + `"}}`
+	resp, err := svc.ReclaimProve(ctx, oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: params}})
+	require.NoError(t, err)
+	tooLarge, ok := resp.(oapi.ReclaimProve400JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	require.NotNil(t, tooLarge.Code)
+	require.Equal(t, oapi.ProviderParamsTooLarge, *tooLarge.Code)
+
+	// raising the limit lets the same params through to the protocol
+	cfg.ReclaimMaxProviderParamsKB = 2
+	fake := &fixedReclaimClient{claim: signedClaim(t)}
+	svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return fake, nil }
+	resp, err = svc.ReclaimProve(ctx, oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: params}})
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve200JSONResponse{}, resp)
+}
+
+func TestReclaimProve_Record(t *testing.T) {
+	ctx := context.Background()
+	record := true
+	configJSON := `{"requestId":"req-1"}`
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "{}", ConfigJson: &configJSON, Record: &record}}
+
+	newSvc := func(t *testing.T, factory recorder.FFmpegRecorderFactory) *ApiService {
+		svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), factory, newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+		require.NoError(t, err)
+		return svc
+	}
+	stopped := func(t *testing.T, svc *ApiService) bool {
+		recs := svc.recordManager.ListActiveRecorders(ctx)
+		require.Len(t, recs, 1)
+		require.True(t, strings.HasPrefix(recs[0].ID(), "proof-req-1-"), recs[0].ID())
+		return recs[0].(*mockRecorder).stopCalled
+	}
+
+	t.Run("returns the recording with the claim", func(t *testing.T) {
+		svc := newSvc(t, newMockFactory())
+		svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) {
+			return &fixedReclaimClient{claim: signedClaim(t)}, nil
+		}
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		ok, isOK := resp.(oapi.ReclaimProve200JSONResponse)
+		require.True(t, isOK, "unexpected response type: %T", resp)
+		require.NotNil(t, ok.RecordingId)
+		require.True(t, strings.HasPrefix(*ok.RecordingId, "proof-req-1-"), *ok.RecordingId)
+		require.True(t, stopped(t, svc))
+	})
+
+	t.Run("a retry with the same request ID gets its own recording", func(t *testing.T) {
+		svc := newSvc(t, newMockFactory())
+		svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) {
+			return &fixedReclaimClient{claim: signedClaim(t)}, nil
+		}
+		var ids []string
+		for range 2 {
+			resp, err := svc.ReclaimProve(ctx, req)
+			require.NoError(t, err)
+			ok, isOK := resp.(oapi.ReclaimProve200JSONResponse)
+			require.True(t, isOK, "unexpected response type: %T", resp)
+			require.NotNil(t, ok.RecordingId)
+			ids = append(ids, *ok.RecordingId)
+		}
+		require.NotEqual(t, ids[0], ids[1])
+		for _, id := range ids {
+			require.True(t, strings.HasPrefix(id, "proof-req-1-"), id)
+			_, exists := svc.recordManager.GetRecorder(id)
+			require.True(t, exists, "recorder %s not registered", id)
+		}
+	})
+
+	t.Run("stops the recording when the proof times out", func(t *testing.T) {
+		svc := newSvc(t, newMockFactory())
+		fake := &blockingReclaimClient{release: make(chan struct{})}
+		defer close(fake.release)
+		svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return fake, nil }
+		svc.proveTimeout = 10 * time.Millisecond
+		svc.proveGracePeriod = 10 * time.Millisecond
+
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		timedOut, ok := resp.(oapi.ReclaimProve500JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, oapi.ProofTimeout, *timedOut.Code)
+		require.True(t, stopped(t, svc))
+	})
+
+	t.Run("refuses the proof if recording fails", func(t *testing.T) {
+		svc := newSvc(t, func(id string, _ recorder.FFmpegRecordingParams) (recorder.Recorder, error) {
+			return &mockRecorder{id: id, startErr: errors.New("no display")}, nil
+		})
+		svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) {
+			return &fixedReclaimClient{claim: signedClaim(t)}, nil
+		}
+		resp, err := svc.ReclaimProve(ctx, req)
+		require.NoError(t, err)
+		failed, ok := resp.(oapi.ReclaimProve500JSONResponse)
+		require.True(t, ok, "unexpected response type: %T", resp)
+		require.Equal(t, oapi.ProofRecordingFailed, *failed.Code)
+		require.Equal(t, 0, len(svc.proveSem))
+		require.Equal(t, 0, len(svc.proofPool.workers))
+	})
+
+	t.Run("recorder IDs", func(t *testing.T) {
+		id := proofRecorderID("abc_1")
+		require.True(t, strings.HasPrefix(id, "proof-abc_1-") && recorder.ValidID(id), id)
+		require.NotEqual(t, id, proofRecorderID("abc_1"))
+		id = proofRecorderID("has spaces")
+		require.True(t, strings.HasPrefix(id, "proof-") && recorder.ValidID(id), id)
+	})
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	teeproto "github.com/reclaimprotocol/reclaim-tee/proto"
+	"github.com/reclaimprotocol/reclaim-tee/shared"
+)
+
+// claimSignData serializes a claim the way attestors do before signing it: the identifier,
+// lowercased owner, timestamp and epoch joined by newlines.
+func claimSignData(claim *teeproto.ProviderClaimData) []byte {
+	return []byte(strings.Join([]string{
+		claim.GetIdentifier(),
+		strings.ToLower(claim.GetOwner()),
+		strconv.FormatUint(uint64(claim.GetTimestampS()), 10),
+		strconv.FormatUint(uint64(claim.GetEpoch()), 10),
+	}, "\n"))
+}
+
+// rawClaimJSON serializes a claim as the claimData object Reclaim verifiers consume. It
+// reproduces JSON.stringify on the SDK's object byte for byte: keys in protocol field order
+// and only the escapes JSON.stringify emits, which encoding/json does not (it also escapes
+// HTML characters and U+2028/U+2029).
+func rawClaimJSON(claim *teeproto.ProviderClaimData) string {
+	var b strings.Builder
+	b.WriteString(`{"provider":`)
+	writeJSString(&b, claim.GetProvider())
+	b.WriteString(`,"parameters":`)
+	writeJSString(&b, claim.GetParameters())
+	b.WriteString(`,"owner":`)
+	writeJSString(&b, claim.GetOwner())
+	b.WriteString(`,"timestampS":`)
+	b.WriteString(strconv.FormatUint(uint64(claim.GetTimestampS()), 10))
+	b.WriteString(`,"context":`)
+	writeJSString(&b, claim.GetContext())
+	b.WriteString(`,"identifier":`)
+	writeJSString(&b, claim.GetIdentifier())
+	b.WriteString(`,"epoch":`)
+	b.WriteString(strconv.FormatUint(uint64(claim.GetEpoch()), 10))
+	b.WriteString(`}`)
+	return b.String()
+}
+
+// writeJSString writes s as a JSON string literal escaped the way JSON.stringify does.
+func writeJSString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+}
+
+// verifyClaimSignature checks that the claim signature in sig was produced by the attestor
+// address it reports. The result signature covers the attestor's full response, which the
+// client library does not return, so only its presence is checked.
+func verifyClaimSignature(claim *teeproto.ProviderClaimData, sig *teeproto.ClaimTeeBundleResponse_Signature) error {
+	if claim == nil || sig == nil {
+		return fmt.Errorf("result is missing its claim or signatures")
+	}
+	if !shared.IsHexAddress(sig.GetAttestorAddress()) {
+		return fmt.Errorf("invalid attestor address %q", sig.GetAttestorAddress())
+	}
+	if len(sig.GetResultSignature()) == 0 {
+		return fmt.Errorf("result signature is missing")
+	}
+	if err := shared.VerifyEthSignature(claimSignData(claim), sig.GetClaimSignature(), shared.HexToAddress(sig.GetAttestorAddress())); err != nil {
+		return fmt.Errorf("claim signature: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/reclaimprotocol/reclaim-tee/client"
+	teeproto "github.com/reclaimprotocol/reclaim-tee/proto"
+	"github.com/reclaimprotocol/reclaim-tee/shared"
+	"github.com/stretchr/testify/require"
+)
+
+// signedClaim returns a claim signed the way an attestor signs it, by a freshly generated key.
+func signedClaim(t *testing.T) *client.ClaimWithSignatures {
+	t.Helper()
+	key, err := shared.GenerateKey()
+	require.NoError(t, err)
+	claim := &teeproto.ProviderClaimData{
+		Provider:   "http",
+		Owner:      "0xAbC0000000000000000000000000000000000001",
+		TimestampS: 1700000000,
+		Identifier: "0x1234",
+		Epoch:      1,
+	}
+	claimSig, err := shared.Sign(shared.TextHash(claimSignData(claim)), key)
+	require.NoError(t, err)
+	claimSig[64] += 27
+	return &client.ClaimWithSignatures{
+		Claim: claim,
+		Signature: &teeproto.ClaimTeeBundleResponse_Signature{
+			AttestorAddress: shared.PubkeyToAddress(&key.PublicKey).Hex(),
+			ClaimSignature:  claimSig,
+			ResultSignature: []byte{1},
+		},
+	}
+}
+
+func TestVerifyClaimSignature(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		c := signedClaim(t)
+		require.NoError(t, verifyClaimSignature(c.Claim, c.Signature))
+	})
+
+	t.Run("tampered claim", func(t *testing.T) {
+		c := signedClaim(t)
+		c.Claim.Epoch = 2
+		require.ErrorContains(t, verifyClaimSignature(c.Claim, c.Signature), "claim signature")
+	})
+
+	t.Run("different attestor", func(t *testing.T) {
+		c := signedClaim(t)
+		c.Signature.AttestorAddress = signedClaim(t).Signature.AttestorAddress
+		require.ErrorContains(t, verifyClaimSignature(c.Claim, c.Signature), "claim signature")
+	})
+
+	t.Run("missing result signature", func(t *testing.T) {
+		c := signedClaim(t)
+		c.Signature.ResultSignature = nil
+		require.ErrorContains(t, verifyClaimSignature(c.Claim, c.Signature), "result signature")
+	})
+}
+
+// fixedReclaimClient is a reclaimProtocolClient that returns claim immediately.
+type fixedReclaimClient struct {
+	claim *client.ClaimWithSignatures
+}
+
+func (c *fixedReclaimClient) ExecuteCompleteProtocol(*client.ProviderRequestData) (*client.ClaimWithSignatures, error) {
+	return c.claim, nil
+}
+
+func (c *fixedReclaimClient) Close() error { return nil }
+
+func TestReclaimProve_VerifySignatures(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.ReclaimVerifySignatures = true
+	svc, err := New(cfg, recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	fake := &fixedReclaimClient{claim: signedClaim(t)}
+	svc.newReclaimClient = func(string, string) (reclaimProtocolClient, error) { return fake, nil }
+	req := oapi.ReclaimProveRequestObject{Body: &oapi.ReclaimProveJSONRequestBody{ProviderParamsJson: "{}"}}
+
+	resp, err := svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve200JSONResponse{}, resp)
+
+	fake.claim.Claim.Identifier = "0x5678"
+	resp, err = svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	invalid, ok := resp.(oapi.ReclaimProve502JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	require.NotNil(t, invalid.Code)
+	require.Equal(t, oapi.ClaimSignatureInvalid, *invalid.Code)
+
+	cfg.ReclaimVerifySignatures = false
+	resp, err = svc.ReclaimProve(ctx, req)
+	require.NoError(t, err)
+	require.IsType(t, oapi.ReclaimProve200JSONResponse{}, resp)
+}
+
+func TestRawClaimJSON(t *testing.T) {
+	claim := &teeproto.ProviderClaimData{
+		Provider:   "http",
+		Parameters: `{"url":"https://example.com/?a=1&b=<2>"}`,
+		Owner:      "0xabc",
+		TimestampS: 1700000000,
+		Context:    "line\u2028sep\x01",
+		Identifier: "0x1234",
+		Epoch:      1,
+	}
+	want := `{"provider":"http","parameters":"{\"url\":\"https://example.com/?a=1&b=<2>\"}","owner":"0xabc",` +
+		`"timestampS":1700000000,"context":"line` + "\u2028" + `sep\u0001","identifier":"0x1234","epoch":1}`
+	require.Equal(t, want, rawClaimJSON(claim))
+}
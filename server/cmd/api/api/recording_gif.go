@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// gifConvertWait is how long a request waits for a GIF conversion before answering 202
+// and leaving it to finish in the background.
+var gifConvertWait = 5 * time.Second
+
+// ConvertRecording returns a finalized recording as an animated GIF, converting it with
+// ffmpeg unless a GIF with the same fps and width is cached.
+// (GET /recordings/{id}/gif)
+func (s *ApiService) ConvertRecording(ctx context.Context, req oapi.ConvertRecordingRequestObject) (oapi.ConvertRecordingResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	if !recorder.ValidID(req.Id) {
+		return oapi.ConvertRecording400JSONResponse{BadRequestErrorJSONResponse: invalidRecorderIDError()}, nil
+	}
+	opts := recorder.GIFOptions{FPS: recorder.DefaultGIFFPS, Width: recorder.DefaultGIFWidth}
+	if req.Params.Fps != nil {
+		opts.FPS = *req.Params.Fps
+	}
+	if req.Params.Width != nil {
+		opts.Width = *req.Params.Width
+	}
+	if err := opts.Validate(); err != nil {
+		return oapi.ConvertRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.InvalidRecordingParams), Message: err.Error()}}, nil
+	}
+	rec, exists := s.recordManager.GetRecorder(req.Id)
+	if !exists {
+		return oapi.ConvertRecording404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Code: ptrOf(oapi.RecorderNotFound), Message: "no recording found"}}, nil
+	}
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		log.Error("failed to cast recorder to FFmpegRecorder", "recorder_id", req.Id)
+		return oapi.ConvertRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "internal error"}}, nil
+	}
+	if rec.IsDeleted(ctx) {
+		return oapi.ConvertRecording400JSONResponse{BadRequestErrorJSONResponse: oapi.BadRequestErrorJSONResponse{Code: ptrOf(oapi.RecordingDeleted), Message: "requested recording has been deleted"}}, nil
+	}
+	if rec.IsRecording(ctx) {
+		return oapi.ConvertRecording409JSONResponse{ConflictErrorJSONResponse: oapi.ConflictErrorJSONResponse{Code: ptrOf(oapi.RecordingInProgress), Message: "recording is still in progress; it can be converted once it is finalized"}}, nil
+	}
+
+	type result struct {
+		path string
+		err  error
+	}
+	done := make(chan result, 1)
+	// The conversion outlives the request when it takes longer than gifConvertWait; the
+	// recorder shares it with the retries, which pick up the GIF once it is cached.
+	go func() {
+		convertCtx := context.WithoutCancel(ctx)
+		path, err := ffmpegRec.GIF(convertCtx, opts)
+		if errors.Is(err, recorder.ErrRecordingFinalizing) {
+			_ = ffmpegRec.WaitForFinalization(convertCtx)
+			path, err = ffmpegRec.GIF(convertCtx, opts)
+		}
+		done <- result{path, err}
+	}()
+
+	var res result
+	select {
+	case res = <-done:
+	case <-time.After(gifConvertWait):
+		return oapi.ConvertRecording202Response{Headers: oapi.ConvertRecording202ResponseHeaders{RetryAfter: s.config.RecordingConvertRetryAfterSeconds}}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if errors.Is(res.err, os.ErrNotExist) {
+		return oapi.ConvertRecording404JSONResponse{NotFoundErrorJSONResponse: oapi.NotFoundErrorJSONResponse{Message: "recording has no file to convert"}}, nil
+	}
+	if res.err != nil {
+		log.Error("failed to convert recording to GIF", "err", res.err, "recorder_id", req.Id)
+		return oapi.ConvertRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to convert recording to GIF"}}, nil
+	}
+
+	f, err := os.Open(res.path)
+	if err != nil {
+		log.Error("failed to open recording GIF", "err", err, "recorder_id", req.Id)
+		return oapi.ConvertRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to open recording GIF"}}, nil
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		log.Error("failed to stat recording GIF", "err", err, "recorder_id", req.Id)
+		return oapi.ConvertRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to open recording GIF"}}, nil
+	}
+	return oapi.ConvertRecording200ImagegifResponse{Body: f, ContentLength: info.Size()}, nil
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiService_ConvertRecording(t *testing.T) {
+	ctx := context.Background()
+	tempDir := t.TempDir()
+	mock, err := filepath.Abs(testMockFFmpegBin)
+	require.NoError(t, err)
+	// converts to GIF89a, slowly while the slow file exists, and records like the mock otherwise
+	slow := filepath.Join(t.TempDir(), "slow")
+	bin := filepath.Join(t.TempDir(), "ffmpeg")
+	script := `#!/bin/sh
+case "$*" in
+*palette*)
+	[ -e "` + slow + `" ] && [ -z "${*##*palettegen*}" ] && sleep 1
+	for a; do out=$a; done
+	printf GIF89a > "$out"
+	exit 0;;
+esac
+exec "` + mock + `" "$@"
+`
+	require.NoError(t, os.WriteFile(bin, []byte(script), 0o755))
+	fr, disp, size := 5, 0, 1
+	factory := recorder.NewFFmpegRecorderFactory(bin, recorder.FFmpegRecordingParams{
+		FrameRate:   &fr,
+		DisplayNum:  &disp,
+		MaxSizeInMB: &size,
+		OutputDir:   &tempDir,
+		TempDir:     t.TempDir(),
+	}, nil, scaletozero.NewNoopController())
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(newTestConfig(), mgr, factory, newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	convert := func(fps, width *int) oapi.ConvertRecordingResponseObject {
+		resp, err := svc.ConvertRecording(ctx, oapi.ConvertRecordingRequestObject{Id: "gif", Params: oapi.ConvertRecordingParams{Fps: fps, Width: width}})
+		require.NoError(t, err)
+		return resp
+	}
+
+	require.IsType(t, oapi.ConvertRecording404JSONResponse{}, convert(nil, nil))
+	invalid, ok := convert(ptrOf(0), nil).(oapi.ConvertRecording400JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, oapi.InvalidRecordingParams, *invalid.Code)
+
+	// fragmented so stopping doesn't invoke the mock ffmpeg to remux
+	rec, err := factory("gif", recorder.FFmpegRecordingParams{Fragmented: true})
+	require.NoError(t, err)
+	require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+	require.NoError(t, rec.Start(ctx))
+	require.IsType(t, oapi.ConvertRecording409JSONResponse{}, convert(nil, nil))
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "gif.mp4"), []byte("fragmented mp4"), 0o644))
+	require.NoError(t, rec.Stop(ctx))
+
+	gif, ok := convert(nil, nil).(oapi.ConvertRecording200ImagegifResponse)
+	require.True(t, ok)
+	b, err := io.ReadAll(gif.Body)
+	require.NoError(t, err)
+	require.NoError(t, gif.Body.(io.Closer).Close())
+	assert.Equal(t, "GIF89a", string(b))
+	assert.Equal(t, int64(len(b)), gif.ContentLength)
+	assert.FileExists(t, filepath.Join(tempDir, "gif.640w-10fps.gif"))
+
+	t.Run("slow conversion", func(t *testing.T) {
+		defer func(d time.Duration) { gifConvertWait = d }(gifConvertWait)
+		gifConvertWait = 50 * time.Millisecond
+		require.NoError(t, os.WriteFile(slow, nil, 0o644))
+
+		accepted, ok := convert(ptrOf(5), ptrOf(320)).(oapi.ConvertRecording202Response)
+		require.True(t, ok, "a slow conversion is answered with 202")
+		assert.Equal(t, 5, accepted.Headers.RetryAfter)
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(filepath.Join(tempDir, "gif.320w-5fps.gif"))
+			return err == nil
+		}, 5*time.Second, 20*time.Millisecond, "the conversion finishes in the background")
+		require.IsType(t, oapi.ConvertRecording200ImagegifResponse{}, convert(ptrOf(5), ptrOf(320)))
+	})
+
+	require.NoError(t, rec.Delete(ctx))
+	deleted, ok := convert(nil, nil).(oapi.ConvertRecording400JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, oapi.RecordingDeleted, *deleted.Code)
+}
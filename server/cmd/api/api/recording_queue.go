@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// recordingQueuePollInterval is how often queued starts check for a free slot. Recordings
+// also end on their own, e.g. at their maximum duration, so there is no single place to
+// be told that one did.
+const recordingQueuePollInterval = 250 * time.Millisecond
+
+var (
+	errTooManyRecordings  = errors.New("too many recordings")
+	errRecordingQueueFull = errors.New("recording start queue is full")
+)
+
+// queuedStart is a start waiting in a recordingQueue.
+type queuedStart struct {
+	id string
+}
+
+// recordingQueue bounds how many recordings run at once (RECORDING_MAX_CONCURRENT).
+// Starts beyond the limit fail, or, if they ask to wait, queue in arrival order until a
+// running recording ends.
+type recordingQueue struct {
+	limit int
+	depth int
+	mgr   recorder.RecordManager
+
+	mu       sync.Mutex
+	starting map[string]int // starts holding a slot, by recorder ID
+	waiters  []*queuedStart
+}
+
+func newRecordingQueue(limit, depth int, mgr recorder.RecordManager) *recordingQueue {
+	return &recordingQueue{limit: limit, depth: depth, mgr: mgr, starting: make(map[string]int)}
+}
+
+// runningLocked counts the slots in use: starts holding one, and registered recorders
+// that haven't finished and aren't counted as starting.
+func (q *recordingQueue) runningLocked(ctx context.Context) int {
+	n := 0
+	for _, c := range q.starting {
+		n += c
+	}
+	for _, rec := range q.mgr.ListActiveRecorders(ctx) {
+		if q.starting[rec.ID()] > 0 {
+			continue
+		}
+		if rec.IsRecording(ctx) || rec.Metadata().EndTime.IsZero() {
+			n++
+		}
+	}
+	return n
+}
+
+// takeLocked gives a slot to the start of id until the returned release is called.
+func (q *recordingQueue) takeLocked(id string) (release func()) {
+	q.starting[id]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.mu.Lock()
+			defer q.mu.Unlock()
+			if q.starting[id]--; q.starting[id] == 0 {
+				delete(q.starting, id)
+			}
+		})
+	}
+}
+
+// acquire takes a slot for the recording id. Without a free slot it returns
+// errTooManyRecordings, or with wait queues until one frees up, returning
+// errRecordingQueueFull if the queue is full and ctx's error if ctx is done first. The
+// start must call release once it has started the recording or failed to; a running
+// recorder holds its slot from then on.
+func (q *recordingQueue) acquire(ctx context.Context, id string, wait bool) (release func(), err error) {
+	if q.limit == 0 {
+		return func() {}, nil
+	}
+	q.mu.Lock()
+	if len(q.waiters) == 0 && q.runningLocked(ctx) < q.limit {
+		defer q.mu.Unlock()
+		return q.takeLocked(id), nil
+	}
+	if !wait {
+		q.mu.Unlock()
+		return nil, errTooManyRecordings
+	}
+	if len(q.waiters) >= q.depth {
+		q.mu.Unlock()
+		return nil, errRecordingQueueFull
+	}
+	w := &queuedStart{id: id}
+	q.waiters = append(q.waiters, w)
+	q.mu.Unlock()
+
+	ticker := time.NewTicker(recordingQueuePollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			q.mu.Lock()
+			q.waiters = slices.DeleteFunc(q.waiters, func(o *queuedStart) bool { return o == w })
+			q.mu.Unlock()
+			return nil, ctx.Err()
+		}
+		q.mu.Lock()
+		if q.waiters[0] == w && q.runningLocked(ctx) < q.limit {
+			q.waiters = q.waiters[1:]
+			defer q.mu.Unlock()
+			return q.takeLocked(id), nil
+		}
+		q.mu.Unlock()
+	}
+}
+
+func (q *recordingQueue) stats(ctx context.Context) oapi.RecordingQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return oapi.RecordingQueueStats{
+		MaxConcurrent: q.limit,
+		Running:       q.runningLocked(ctx),
+		Queued:        len(q.waiters),
+		MaxQueued:     q.depth,
+	}
+}
+
+// GetRecordingQueue reports how many recordings are running against
+// RECORDING_MAX_CONCURRENT and how many starts are queued for a slot.
+// (GET /recording/queue)
+func (s *ApiService) GetRecordingQueue(ctx context.Context, _ oapi.GetRecordingQueueRequestObject) (oapi.GetRecordingQueueResponseObject, error) {
+	return oapi.GetRecordingQueue200JSONResponse(s.recordingQueue.stats(ctx)), nil
+}
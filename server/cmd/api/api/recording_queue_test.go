@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartRecording_Queue(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.RecordingMaxConcurrent = 1
+	cfg.RecordingStartQueueDepth = 1
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(cfg, mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	start := func(ctx context.Context, id string, wait bool) oapi.StartRecordingResponseObject {
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{Wait: &wait},
+			Body:   &oapi.StartRecordingJSONRequestBody{Id: &id},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+	queued := func() int {
+		resp, err := svc.GetRecordingQueue(ctx, oapi.GetRecordingQueueRequestObject{})
+		require.NoError(t, err)
+		return resp.(oapi.GetRecordingQueue200JSONResponse).Queued
+	}
+
+	require.IsType(t, oapi.StartRecording201Response{}, start(ctx, "a", false))
+
+	tooMany, ok := start(ctx, "b", false).(oapi.StartRecording429JSONResponse)
+	require.True(t, ok, "a start beyond the limit is refused without wait")
+	assert.Equal(t, oapi.TooManyRecordings, *tooMany.Body.Code)
+	assert.Equal(t, 5, tooMany.Headers.RetryAfter)
+
+	waited := make(chan oapi.StartRecordingResponseObject, 1)
+	go func() { waited <- start(ctx, "b", true) }()
+	require.Eventually(t, func() bool { return queued() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	full, ok := start(ctx, "c", true).(oapi.StartRecording429JSONResponse)
+	require.True(t, ok, "a start is refused once the queue is full")
+	assert.Equal(t, oapi.RecordingQueueFull, *full.Body.Code)
+
+	resp, err := svc.GetRecordingQueue(ctx, oapi.GetRecordingQueueRequestObject{})
+	require.NoError(t, err)
+	assert.Equal(t, oapi.GetRecordingQueue200JSONResponse{MaxConcurrent: 1, Running: 1, Queued: 1, MaxQueued: 1}, resp)
+
+	// the queued start proceeds once the running recording is gone
+	a, ok := mgr.GetRecorder("a")
+	require.True(t, ok)
+	require.NoError(t, mgr.DeregisterRecorder(ctx, a))
+	select {
+	case resp := <-waited:
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+	case <-time.After(2 * time.Second):
+		t.Fatal("queued start did not proceed")
+	}
+	assert.Equal(t, 0, queued())
+
+	cfg.RecordingStartQueueTimeoutSeconds = 1
+	timedOut, ok := start(ctx, "c", true).(oapi.StartRecording503JSONResponse)
+	require.True(t, ok, "a queued start gives up after the timeout")
+	assert.Equal(t, oapi.RecordingQueueTimeout, *timedOut.Code)
+	assert.Equal(t, 0, queued())
+}
+
+func TestStartRecording_QueuedIdempotentRetry(t *testing.T) {
+	ctx := context.Background()
+	cfg := newTestConfig()
+	cfg.RecordingMaxConcurrent = 1
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(cfg, mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	running := "a"
+	resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{Id: &running}})
+	require.NoError(t, err)
+	require.IsType(t, oapi.StartRecording201Response{}, resp)
+
+	type result struct {
+		resp oapi.StartRecordingResponseObject
+		err  error
+	}
+	key, id, wait := "retry-1", "b", true
+	start := func(ctx context.Context, out chan<- result) {
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{
+			Params: oapi.StartRecordingParams{Wait: &wait, IdempotencyKey: &key},
+			Body:   &oapi.StartRecordingJSONRequestBody{Id: &id},
+		})
+		out <- result{resp, err}
+	}
+	queued := func() int {
+		resp, err := svc.GetRecordingQueue(ctx, oapi.GetRecordingQueueRequestObject{})
+		require.NoError(t, err)
+		return resp.(oapi.GetRecordingQueue200JSONResponse).Queued
+	}
+
+	firstCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	first, retry := make(chan result, 1), make(chan result, 1)
+	go start(firstCtx, first)
+	require.Eventually(t, func() bool { return queued() == 1 }, 2*time.Second, 10*time.Millisecond)
+	go start(ctx, retry)
+	// give the retry time to find the queued attempt and wait on it
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	r := <-first
+	require.ErrorIs(t, r.err, context.Canceled)
+	assert.Nil(t, r.resp)
+
+	// the retry takes over the abandoned start instead of returning its missing result
+	require.Eventually(t, func() bool { return queued() == 1 }, 2*time.Second, 10*time.Millisecond)
+	a, ok := mgr.GetRecorder(running)
+	require.True(t, ok)
+	require.NoError(t, mgr.DeregisterRecorder(ctx, a))
+	select {
+	case r := <-retry:
+		require.NoError(t, r.err)
+		require.IsType(t, oapi.StartRecording201Response{}, r.resp)
+	case <-time.After(2 * time.Second):
+		t.Fatal("retried start did not proceed")
+	}
+}
+
+func TestStartRecording_NoConcurrencyLimit(t *testing.T) {
+	ctx := context.Background()
+	mgr := recorder.NewFFmpegManager()
+	svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	for _, id := range []string{"a", "b", "c"} {
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: &oapi.StartRecordingJSONRequestBody{Id: &id}})
+		require.NoError(t, err)
+		require.IsType(t, oapi.StartRecording201Response{}, resp)
+	}
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+// recordingStderrMessage is a text message of the recording stderr stream.
+type recordingStderrMessage struct {
+	// Type is "line" for a line of ffmpeg's stderr, or "exit" once ffmpeg has exited.
+	Type     string `json:"type"`
+	Line     string `json:"line,omitempty"`
+	ExitCode *int   `json:"exit_code,omitempty"`
+}
+
+// HandleRecordingStderrWS streams the stderr of a recorder's ffmpeg process over WebSocket.
+// Protocol:
+//   - Server sends TextMessage {"type":"line","line":"..."} per stderr line, starting with
+//     the most recent lines written before the client connected
+//   - Server sends TextMessage {"type":"exit","exit_code":N} once ffmpeg exits, then closes
+//
+// Lines are dropped rather than stalling ffmpeg when the client can't keep up.
+func (s *ApiService) HandleRecordingStderrWS(w http.ResponseWriter, r *http.Request, id string) {
+	log := logger.FromContext(r.Context())
+
+	if !recorder.ValidID(id) {
+		writeJSON(w, http.StatusBadRequest, `{"type":"error","message":"invalid recorder id"}`)
+		return
+	}
+	rec, ok := s.recordManager.GetRecorder(id)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, `{"type":"error","message":"no recording found"}`)
+		return
+	}
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		writeJSON(w, http.StatusBadRequest, `{"type":"error","message":"recording is not ffmpeg-backed"}`)
+		return
+	}
+	stderrLog := ffmpegRec.StderrLog()
+	if stderrLog == nil {
+		writeJSON(w, http.StatusConflict, `{"type":"error","message":"recording has not been started"}`)
+		return
+	}
+
+	// OriginPatterns allows all origins because this endpoint uses token-based auth
+	// (not cookies), so CSWSH attacks are not a concern.
+	wsConn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		OriginPatterns: []string{"*"},
+	})
+	if err != nil {
+		log.Error("websocket accept failed", "err", err)
+		return
+	}
+	defer wsConn.CloseNow()
+	// the client only ever closes; CloseRead cancels ctx when it does
+	ctx := wsConn.CloseRead(r.Context())
+
+	backlog, lines, unsubscribe := stderrLog.Subscribe()
+	defer unsubscribe()
+
+	send := func(msg recordingStderrMessage) error {
+		data, _ := json.Marshal(msg)
+		writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		return wsConn.Write(writeCtx, websocket.MessageText, data)
+	}
+
+	log.Info("recording stderr stream started", "recorder_id", id)
+	for _, line := range backlog {
+		if err := send(recordingStderrMessage{Type: "line", Line: line}); err != nil {
+			log.Debug("recording stderr stream ended", "recorder_id", id, "err", err)
+			return
+		}
+	}
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				// closed by the process exiting; the exit code is set
+				exitCode := stderrLog.ExitCode()
+				if err := send(recordingStderrMessage{Type: "exit", ExitCode: &exitCode}); err != nil {
+					log.Debug("recording stderr stream ended", "recorder_id", id, "err", err)
+					return
+				}
+				wsConn.Close(websocket.StatusNormalClosure, "")
+				log.Info("recording stderr stream ended", "recorder_id", id, "exit_code", exitCode)
+				return
+			}
+			if err := send(recordingStderrMessage{Type: "line", Line: line}); err != nil {
+				log.Debug("recording stderr stream ended", "recorder_id", id, "err", err)
+				return
+			}
+		case <-ctx.Done():
+			log.Info("recording stderr stream closed by client", "recorder_id", id)
+			return
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleRecordingStderrWS(t *testing.T) {
+	ctx := context.Background()
+	mgr := recorder.NewFFmpegManager()
+	disp, fr, size := 0, 5, 1
+	params := recorder.FFmpegRecordingParams{FrameRate: &fr, DisplayNum: &disp, MaxSizeInMB: &size, OutputDir: ptrOf(t.TempDir())}
+	factory := recorder.NewFFmpegRecorderFactory(mockFFmpegBin, params, nil, scaletozero.NewNoopController())
+	rec, err := factory("stderr", recorder.FFmpegRecordingParams{})
+	require.NoError(t, err)
+	require.NoError(t, mgr.RegisterRecorder(ctx, rec))
+	svc, err := New(newTestConfig(), mgr, newMockFactory(), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		svc.HandleRecordingStderrWS(w, r, strings.TrimPrefix(r.URL.Path, "/"))
+	}))
+	defer srv.Close()
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	resp, err := http.Get(srv.URL + "/missing")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL + "/rec.mp4")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL + "/stderr")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode, "a recording that never started has no stderr")
+
+	require.NoError(t, rec.Start(ctx))
+	conn, _, err := websocket.Dial(ctx, wsURL+"/stderr", nil)
+	require.NoError(t, err)
+	defer conn.CloseNow()
+
+	// the line was written before the client connected and comes from the backlog
+	var msg recordingStderrMessage
+	_, data, err := conn.Read(ctx)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &msg))
+	assert.Equal(t, recordingStderrMessage{Type: "line", Line: "mock ffmpeg started"}, msg)
+
+	_ = rec.ForceStop(ctx)
+	_, data, err = conn.Read(ctx)
+	require.NoError(t, err)
+	msg = recordingStderrMessage{}
+	require.NoError(t, json.Unmarshal(data, &msg))
+	assert.Equal(t, "exit", msg.Type)
+	require.NotNil(t, msg.ExitCode)
+
+	_, _, err = conn.Read(ctx)
+	assert.Equal(t, websocket.StatusNormalClosure, websocket.CloseStatus(err))
+}
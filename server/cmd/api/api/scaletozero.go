@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+)
+
+// GetScaleToZeroStatus reports whether scale-to-zero is held off and why.
+// (GET /scale-to-zero)
+func (s *ApiService) GetScaleToZeroStatus(ctx context.Context, _ oapi.GetScaleToZeroStatusRequestObject) (oapi.GetScaleToZeroStatusResponseObject, error) {
+	s.stzPauseMu.Lock()
+	defer s.stzPauseMu.Unlock()
+	return oapi.GetScaleToZeroStatus200JSONResponse(s.scaleToZeroStatus()), nil
+}
+
+// PauseScaleToZero holds scale-to-zero off until ResumeScaleToZero.
+// (POST /scale-to-zero/pause)
+func (s *ApiService) PauseScaleToZero(ctx context.Context, _ oapi.PauseScaleToZeroRequestObject) (oapi.PauseScaleToZeroResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	s.stzPauseMu.Lock()
+	defer s.stzPauseMu.Unlock()
+	if !s.stzPaused {
+		// the hold outlives this request
+		if err := scaletozero.WithReason(s.stz, scaletozero.ReasonManual).Disable(context.WithoutCancel(ctx)); err != nil {
+			log.Error("failed to pause scale-to-zero", "err", err)
+			return oapi.PauseScaleToZero500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to pause scale-to-zero"}}, nil
+		}
+		s.stzPaused = true
+		log.Info("scale-to-zero paused")
+	}
+	return oapi.PauseScaleToZero200JSONResponse(s.scaleToZeroStatus()), nil
+}
+
+// ResumeScaleToZero releases the hold taken by PauseScaleToZero.
+// (DELETE /scale-to-zero/pause)
+func (s *ApiService) ResumeScaleToZero(ctx context.Context, _ oapi.ResumeScaleToZeroRequestObject) (oapi.ResumeScaleToZeroResponseObject, error) {
+	log := logger.FromContext(ctx)
+
+	s.stzPauseMu.Lock()
+	defer s.stzPauseMu.Unlock()
+	if s.stzPaused {
+		if err := scaletozero.WithReason(s.stz, scaletozero.ReasonManual).Enable(context.WithoutCancel(ctx)); err != nil {
+			log.Error("failed to resume scale-to-zero", "err", err)
+			return oapi.ResumeScaleToZero500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to resume scale-to-zero"}}, nil
+		}
+		s.stzPaused = false
+		log.Info("scale-to-zero resumed")
+	}
+	return oapi.ResumeScaleToZero200JSONResponse(s.scaleToZeroStatus()), nil
+}
+
+// scaleToZeroStatus converts the controller's status for the API. The caller holds
+// s.stzPauseMu. Controllers that don't track holds report none.
+func (s *ApiService) scaleToZeroStatus() oapi.ScaleToZeroStatus {
+	out := oapi.ScaleToZeroStatus{Paused: s.stzPaused, Holds: []oapi.ScaleToZeroHold{}}
+	reporter, ok := s.stz.(scaletozero.StatusReporter)
+	if !ok {
+		return out
+	}
+	st := reporter.Status()
+	out.Suppressed = st.Suppressed
+	for reason, n := range st.Holds {
+		out.Holds = append(out.Holds, oapi.ScaleToZeroHold{Reason: string(reason), Count: n})
+	}
+	sort.Slice(out.Holds, func(i, j int) bool { return out.Holds[i].Reason < out.Holds[j].Reason })
+	if st.LastDisable != nil {
+		out.LastSuppressedBy = &oapi.ScaleToZeroEvent{Reason: string(st.LastDisable.Reason), At: st.LastDisable.At}
+	}
+	return out
+}
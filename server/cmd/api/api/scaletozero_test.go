@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaleToZeroPauseResume(t *testing.T) {
+	ctx := context.Background()
+	stz := scaletozero.NewDebouncedController(scaletozero.NewNoopController())
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), stz, newMockNekoClient(t))
+	require.NoError(t, err)
+
+	resp, err := svc.GetScaleToZeroStatus(ctx, oapi.GetScaleToZeroStatusRequestObject{})
+	require.NoError(t, err)
+	status := oapi.ScaleToZeroStatus(resp.(oapi.GetScaleToZeroStatus200JSONResponse))
+	assert.False(t, status.Suppressed)
+	assert.False(t, status.Paused)
+	assert.Empty(t, status.Holds)
+	assert.Nil(t, status.LastSuppressedBy)
+
+	require.NoError(t, scaletozero.WithReason(stz, scaletozero.ReasonRecording).Disable(ctx))
+	for range 2 {
+		// pausing is idempotent
+		pauseResp, err := svc.PauseScaleToZero(ctx, oapi.PauseScaleToZeroRequestObject{})
+		require.NoError(t, err)
+		status = oapi.ScaleToZeroStatus(pauseResp.(oapi.PauseScaleToZero200JSONResponse))
+	}
+	assert.True(t, status.Suppressed)
+	assert.True(t, status.Paused)
+	assert.Equal(t, []oapi.ScaleToZeroHold{{Reason: "manual", Count: 1}, {Reason: "recording", Count: 1}}, status.Holds)
+	require.NotNil(t, status.LastSuppressedBy)
+	assert.Equal(t, "manual", status.LastSuppressedBy.Reason)
+
+	for range 2 {
+		resumeResp, err := svc.ResumeScaleToZero(ctx, oapi.ResumeScaleToZeroRequestObject{})
+		require.NoError(t, err)
+		status = oapi.ScaleToZeroStatus(resumeResp.(oapi.ResumeScaleToZero200JSONResponse))
+	}
+	assert.True(t, status.Suppressed, "the recording still holds scale-to-zero off")
+	assert.False(t, status.Paused)
+	assert.Equal(t, []oapi.ScaleToZeroHold{{Reason: "recording", Count: 1}}, status.Holds)
+}
+
+func TestScaleToZeroStatusWithoutReporter(t *testing.T) {
+	svc, err := New(newTestConfig(), recorder.NewFFmpegManager(), newMockFactory(), newTestUpstreamManager(), &mockScaleToZero{}, newMockNekoClient(t))
+	require.NoError(t, err)
+
+	resp, err := svc.GetScaleToZeroStatus(context.Background(), oapi.GetScaleToZeroStatusRequestObject{})
+	require.NoError(t, err)
+	status := oapi.ScaleToZeroStatus(resp.(oapi.GetScaleToZeroStatus200JSONResponse))
+	assert.False(t, status.Suppressed)
+	assert.Empty(t, status.Holds)
+}
+
+// mockScaleToZero is a Controller that doesn't report its status.
+type mockScaleToZero struct{}
+
+func (mockScaleToZero) Disable(context.Context) error { return nil }
+func (mockScaleToZero) Enable(context.Context) error  { return nil }
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"context"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// GetConfig reports the settings the server runs with, leaving out its secrets.
+// (GET /config)
+func (s *ApiService) GetConfig(ctx context.Context, _ oapi.GetConfigRequestObject) (oapi.GetConfigResponseObject, error) {
+	return oapi.GetConfig200JSONResponse(s.config.Settings()), nil
+}
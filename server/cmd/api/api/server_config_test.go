@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfig(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.NekoAdminPassword = "hunter2"
+	cfg.TEEKUrl = "wss://tk.example.com/ws?token=abc"
+	svc := &ApiService{config: cfg}
+
+	resp, err := svc.GetConfig(context.Background(), oapi.GetConfigRequestObject{})
+	require.NoError(t, err)
+	settings, ok := resp.(oapi.GetConfig200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+
+	assert.Equal(t, cfg.FrameRate, settings["FRAME_RATE"])
+	assert.Equal(t, cfg.OutputDir, settings["OUTPUT_DIR"])
+	assert.Equal(t, "wss://tk.example.com/ws?token=REDACTED", settings["TEE_K_URL"])
+	assert.NotContains(t, settings, "NEKO_ADMIN_PASSWORD")
+	assert.NotContains(t, settings, "RECLAIM_CHAIN_RPC_URL")
+}
+
+func TestGetConfig_WebhookURLWithPathToken(t *testing.T) {
+	const secret = "xoxb0secret0token"
+	cfg := newTestConfig()
+	cfg.CircuitsReadyWebhookURL = "https://hooks.slack.com/services/T000/B000/" + secret
+	svc := &ApiService{config: cfg}
+
+	resp, err := svc.GetConfig(context.Background(), oapi.GetConfigRequestObject{})
+	require.NoError(t, err)
+	settings, ok := resp.(oapi.GetConfig200JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+
+	assert.NotContains(t, settings, "CIRCUITS_READY_WEBHOOK_URL")
+	body, err := json.Marshal(settings)
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), secret)
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	"github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+)
+
+const bytesPerMB = 1024 * 1024
+
+// fitTenantQuota checks a new recorder of a tenant against RECORDING_TENANT_QUOTA_MB. When
+// the tenant has room left the recorder is returned, recreated with a lower maximum size
+// if its own would not fit; otherwise the returned response rejects the start. Callers
+// hold tenantMu until the recorder has started, so its size counts against later starts.
+func (s *ApiService) fitTenantQuota(ctx context.Context, rec recorder.Recorder, recorderID string, overrides recorder.FFmpegRecordingParams) (recorder.Recorder, oapi.StartRecordingResponseObject) {
+	log := logger.FromContext(ctx)
+
+	ffmpegRec, ok := rec.(*recorder.FFmpegRecorder)
+	if !ok {
+		return rec, nil
+	}
+	params := ffmpegRec.Params()
+
+	free, err := s.tenantFreeBytes(ctx, params)
+	if err != nil {
+		log.Error("failed to compute tenant disk usage", "err", err, "tenant", params.Tenant)
+		return nil, oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to check tenant quota"}}
+	}
+	freeMB := int(free / bytesPerMB)
+	if freeMB < 1 {
+		log.Warn("tenant disk quota exhausted", "tenant", params.Tenant, "quota_mb", s.config.RecordingTenantQuotaMB, "free_bytes", free)
+		return nil, oapi.StartRecording507JSONResponse{Code: ptrOf(oapi.TenantQuotaExceeded), Message: fmt.Sprintf("tenant %s has no space left under its %d MB quota", params.Tenant, s.config.RecordingTenantQuotaMB)}
+	}
+	if *params.MaxSizeInMB <= freeMB {
+		return rec, nil
+	}
+
+	overrides.MaxSizeInMB = &freeMB
+	capped, err := s.factory(recorderID, overrides)
+	if err != nil {
+		log.Error("failed to create recorder", "err", err, "recorder_id", recorderID)
+		return nil, oapi.StartRecording500JSONResponse{InternalErrorJSONResponse: oapi.InternalErrorJSONResponse{Message: "failed to create recording"}}
+	}
+	log.Info("capped recording size to tenant quota", "recorder_id", recorderID, "tenant", params.Tenant, "max_size_mb", freeMB)
+	return capped, nil
+}
+
+// tenantFreeBytes returns how much of the tenant's quota is left: the quota less the files
+// in the tenant's directory and the room its running recordings may still grow into.
+func (s *ApiService) tenantFreeBytes(ctx context.Context, params recorder.FFmpegRecordingParams) (int64, error) {
+	used, err := dirSize(params.RecordingDir())
+	if err != nil {
+		return 0, err
+	}
+	for _, r := range s.recordManager.ListActiveRecorders(ctx) {
+		other, ok := r.(*recorder.FFmpegRecorder)
+		if !ok || !other.IsRecording(ctx) {
+			continue
+		}
+		p := other.Params()
+		if p.Tenant != params.Tenant || p.MaxSizeInMB == nil {
+			continue
+		}
+		progress, err := other.Progress()
+		if err != nil {
+			return 0, err
+		}
+		if room := int64(*p.MaxSizeInMB)*bytesPerMB - progress.Bytes; room > 0 {
+			used += room
+		}
+	}
+	return int64(s.config.RecordingTenantQuotaMB)*bytesPerMB - used, nil
+}
+
+// dirSize sums the sizes of the files in dir. A missing dir is empty.
+func dirSize(dir string) (int64, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		info, err := e.Info()
+		if errors.Is(err, os.ErrNotExist) {
+			// removed since it was listed
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		size += info.Size()
+	}
+	return size, nil
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiService_TenantQuota(t *testing.T) {
+	ctx := context.Background()
+	tempDir := t.TempDir()
+	mgr := recorder.NewFFmpegManager()
+	cfg := newTestConfig()
+	cfg.RecordingTenantQuotaMB = 3
+	svc, err := New(cfg, mgr, testFFmpegFactory(t, tempDir), newTestUpstreamManager(), scaletozero.NewNoopController(), newMockNekoClient(t))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		for _, rec := range mgr.ListActiveRecorders(ctx) {
+			_ = rec.ForceStop(ctx)
+		}
+	})
+
+	start := func(id, tenant string) oapi.StartRecordingResponseObject {
+		t.Helper()
+		size := 10
+		body := &oapi.StartRecordingJSONRequestBody{Id: &id, MaxFileSizeInMB: &size}
+		if tenant != "" {
+			body.Tenant = &tenant
+		}
+		resp, err := svc.StartRecording(ctx, oapi.StartRecordingRequestObject{Body: body})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// the first recording fits once capped to the whole quota
+	require.IsType(t, oapi.StartRecording201Response{}, start("a1", "acme"))
+	rec, ok := mgr.GetRecorder("a1")
+	require.True(t, ok)
+	params := rec.(*recorder.FFmpegRecorder).Params()
+	assert.Equal(t, 3, *params.MaxSizeInMB)
+	assert.DirExists(t, filepath.Join(tempDir, "acme"), "the tenant's directory is created on start")
+
+	// it reserves the tenant's whole quota while running
+	resp := start("a2", "acme")
+	full, ok := resp.(oapi.StartRecording507JSONResponse)
+	require.True(t, ok, "unexpected response type: %T", resp)
+	require.NotNil(t, full.Code)
+	assert.Equal(t, oapi.TenantQuotaExceeded, *full.Code)
+	_, exists := mgr.GetRecorder("a2")
+	assert.False(t, exists)
+
+	// other tenants and recordings without a tenant are unaffected
+	require.IsType(t, oapi.StartRecording201Response{}, start("b1", "beta"))
+	require.IsType(t, oapi.StartRecording201Response{}, start("untenanted", ""))
+
+	tenant := "acme"
+	listResp, err := svc.ListRecorders(ctx, oapi.ListRecordersRequestObject{Params: oapi.ListRecordersParams{Tenant: &tenant}})
+	require.NoError(t, err)
+	list := listResp.(oapi.ListRecorders200JSONResponse)
+	require.Len(t, list, 1)
+	assert.Equal(t, "a1", list[0].Id)
+	require.NotNil(t, list[0].Tenant)
+	assert.Equal(t, "acme", *list[0].Tenant)
+
+	listResp, err = svc.ListRecorders(ctx, oapi.ListRecordersRequestObject{})
+	require.NoError(t, err)
+	assert.Len(t, listResp.(oapi.ListRecorders200JSONResponse), 3)
+}
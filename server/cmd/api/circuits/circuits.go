@@ -1,31 +1,174 @@
 package circuits
 
 import (
-	_ "embed"
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/reclaimprotocol/reclaim-tee/client"
 )
 
-//go:embed pk.chacha20_oprf
-var pkChacha20OPRF []byte
+// ManifestFile lists the SHA-256 of every circuit file in a circuit directory, in the
+// format written by sha256sum.
+const ManifestFile = "SHA256SUMS"
 
-//go:embed r1cs.chacha20_oprf
-var r1csChacha20OPRF []byte
+// maxCircuitFileSize bounds circuit files read from disk; the largest shipped file is ~27MB.
+const maxCircuitFileSize = 256 << 20
 
-//go:embed pk.aes128_oprf
-var pkAES128OPRF []byte
+var setupOnce sync.Once
 
-//go:embed r1cs.aes128_oprf
-var r1csAES128OPRF []byte
+// initProver initializes a circuit in the prover; tests replace it.
+var initProver = client.InitAlgorithmWithTracking
 
-//go:embed pk.aes256_oprf
-var pkAES256OPRF []byte
+// footprints holds the Footprint of each initialized circuit by algorithm ID.
+var (
+	footprintMu sync.Mutex
+	footprints  = make(map[uint8]Footprint)
+)
 
-//go:embed r1cs.aes256_oprf
-var r1csAES256OPRF []byte
+// dir and dirHashes are set by UseDir before circuits are initialized.
+var (
+	dir       string
+	dirHashes map[string]string
+)
 
-var setupOnce sync.Once
+type algorithm struct {
+	id   uint8
+	name string
+	// file is the suffix of the pk.<file> and r1cs.<file> circuit files.
+	file string
+	pk   []byte
+	r1cs []byte
+}
+
+// algorithms lists the circuits preloaded at startup.
+var algorithms = []algorithm{
+	{client.CHACHA20_OPRF, "chacha20", "chacha20_oprf", pkChacha20OPRF, r1csChacha20OPRF},
+	{client.AES_128_OPRF, "aes128", "aes128_oprf", pkAES128OPRF, r1csAES128OPRF},
+	{client.AES_256_OPRF, "aes256", "aes256_oprf", pkAES256OPRF, r1csAES256OPRF},
+}
+
+// UseDir loads circuit files from d instead of the embedded copies. Circuits whose files
+// are absent from d fall back to the embedded ones. Files that are present must be listed
+// in d's ManifestFile and match the hash recorded there; they are verified now and again
+// when loaded. It must be called before InitAllCircuits or SetupZKCallback.
+func UseDir(d string) error {
+	hashes, err := readManifest(filepath.Join(d, ManifestFile))
+	if err != nil {
+		return err
+	}
+	for _, alg := range algorithms {
+		for _, name := range []string{"pk." + alg.file, "r1cs." + alg.file} {
+			if _, err := readVerified(d, name, hashes); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+	dir, dirHashes = d, hashes
+	return nil
+}
+
+// readManifest parses a sha256sum-style manifest into a file name to hex digest map.
+func readManifest(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open circuit manifest: %w", err)
+	}
+	defer f.Close()
+
+	hashes := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		sum, name, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("malformed circuit manifest line %q", line)
+		}
+		// sha256sum marks binary mode with a leading '*' on the name
+		name = strings.TrimPrefix(strings.TrimSpace(name), "*")
+		hashes[name] = strings.ToLower(sum)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read circuit manifest: %w", err)
+	}
+	return hashes, nil
+}
+
+// readVerified reads name from d, checking its size and its hash against hashes.
+func readVerified(d, name string, hashes map[string]string) ([]byte, error) {
+	path := filepath.Join(d, name)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() == 0 || info.Size() > maxCircuitFileSize {
+		return nil, fmt.Errorf("circuit file %s has unexpected size %d", path, info.Size())
+	}
+	want, ok := hashes[name]
+	if !ok {
+		return nil, fmt.Errorf("circuit file %s is not listed in %s", path, ManifestFile)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return nil, fmt.Errorf("circuit file %s has sha256 %s, manifest expects %s", path, got, want)
+	}
+	return data, nil
+}
+
+// load returns the proving key and r1cs for alg, preferring the directory set by UseDir.
+func load(alg algorithm) (pk, r1cs []byte, err error) {
+	pk, r1cs = alg.pk, alg.r1cs
+	if dir != "" {
+		if data, err := readVerified(dir, "pk."+alg.file, dirHashes); err == nil {
+			pk = data
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, err
+		}
+		if data, err := readVerified(dir, "r1cs."+alg.file, dirHashes); err == nil {
+			r1cs = data
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, err
+		}
+	}
+	if len(pk) == 0 || len(r1cs) == 0 {
+		return nil, nil, fmt.Errorf("no circuit files available for %s", alg.name)
+	}
+	return pk, r1cs, nil
+}
+
+// initAlgorithm loads alg's circuit and initializes it in the prover, recording its footprint.
+func initAlgorithm(alg algorithm) error {
+	pk, r1cs, err := load(alg)
+	if err != nil {
+		return err
+	}
+	fp := Footprint{ProvingKeyBytes: int64(len(pk)), R1CSBytes: int64(len(r1cs))}
+	before, beforeErr := residentMemory()
+	if !initProver(alg.id, pk, r1cs) {
+		return fmt.Errorf("prover rejected %s circuit", alg.name)
+	}
+	if after, err := residentMemory(); err == nil && beforeErr == nil {
+		fp.InitDeltaBytes, fp.DeltaMeasured = int64(after)-int64(before), true
+	}
+	footprintMu.Lock()
+	footprints[alg.id] = fp
+	footprintMu.Unlock()
+	return nil
+}
 
 // SetupZKCallback configures the lazy loading callback for ZK circuits.
 // This function is idempotent and safe to call multiple times.
@@ -34,51 +177,97 @@ func SetupZKCallback() {
 		client.SetZKInitCallback(func(algorithmID uint8) <-chan bool {
 			ch := make(chan bool, 1)
 			go func() {
-				var pk, r1cs []byte
-				switch algorithmID {
-				case client.CHACHA20_OPRF:
-					pk, r1cs = pkChacha20OPRF, r1csChacha20OPRF
-				case client.AES_128_OPRF:
-					pk, r1cs = pkAES128OPRF, r1csAES128OPRF
-				case client.AES_256_OPRF:
-					pk, r1cs = pkAES256OPRF, r1csAES256OPRF
-				default:
-					ch <- false
-					return
+				for _, alg := range algorithms {
+					if alg.id == algorithmID {
+						ch <- initAlgorithm(alg) == nil
+						return
+					}
 				}
-				ch <- client.InitAlgorithmWithTracking(algorithmID, pk, r1cs)
+				ch <- false
 			}()
 			return ch
 		})
 	})
 }
 
-// InitAllCircuits preloads all ZK circuits at startup.
+// InitAllCircuits preloads all ZK circuits at startup, at most parallelism at a time;
+// 1 initializes them one after another. It returns immediately; Ready is closed once
+// every circuit has finished, after its onComplete call.
 // This should be called during server initialization to avoid
 // delays on the first client request.
-func InitAllCircuits(onComplete func(algorithm string, success bool)) {
+func InitAllCircuits(parallelism int, onComplete func(algorithm string, err error)) {
 	// First setup the callback
 	SetupZKCallback()
 
-	// Initialize all algorithms
-	algorithms := []struct {
-		id   uint8
-		name string
-		pk   []byte
-		r1cs []byte
-	}{
-		{client.CHACHA20_OPRF, "chacha20", pkChacha20OPRF, r1csChacha20OPRF},
-		{client.AES_128_OPRF, "aes128", pkAES128OPRF, r1csAES128OPRF},
-		{client.AES_256_OPRF, "aes256", pkAES256OPRF, r1csAES256OPRF},
-	}
-
+	sem := make(chan struct{}, max(parallelism, 1))
+	var wg sync.WaitGroup
 	for _, alg := range algorithms {
 		alg := alg // capture for goroutine
+		wg.Add(1)
 		go func() {
-			success := client.InitAlgorithmWithTracking(alg.id, alg.pk, alg.r1cs)
+			defer wg.Done()
+			sem <- struct{}{}
+			err := initAlgorithm(alg)
+			<-sem
 			if onComplete != nil {
-				onComplete(alg.name, success)
+				onComplete(alg.name, err)
 			}
 		}()
 	}
+	go func() {
+		wg.Wait()
+		markReady()
+	}()
+}
+
+// Pending returns the names of the preloaded circuits that are not initialized yet.
+func Pending() []string {
+	var pending []string
+	for _, alg := range algorithms {
+		if !client.IsAlgorithmInitialized(alg.id) {
+			pending = append(pending, alg.name)
+		}
+	}
+	return pending
+}
+
+// Footprint approximates the memory an initialized circuit holds.
+type Footprint struct {
+	// ProvingKeyBytes and R1CSBytes are the sizes of the files it was initialized from.
+	ProvingKeyBytes int64
+	R1CSBytes       int64
+	// InitDeltaBytes is how much the process's resident memory grew while the prover
+	// initialized the circuit. Circuits initializing in parallel are counted in each
+	// other's deltas, and memory the Go runtime released meanwhile offsets them, so it
+	// may even be negative. It is only set if DeltaMeasured.
+	InitDeltaBytes int64
+	DeltaMeasured  bool
+}
+
+// Bytes estimates the memory the circuit holds: its file sizes plus any growth during init.
+func (f Footprint) Bytes() int64 {
+	return f.ProvingKeyBytes + f.R1CSBytes + max(f.InitDeltaBytes, 0)
+}
+
+// Status is the state of one preloaded circuit.
+type Status struct {
+	Name        string
+	Initialized bool
+	// Footprint is nil until the circuit has been initialized by this package.
+	Footprint *Footprint
+}
+
+// Statuses reports every preloaded circuit, in the order they are initialized.
+func Statuses() []Status {
+	footprintMu.Lock()
+	defer footprintMu.Unlock()
+	statuses := make([]Status, 0, len(algorithms))
+	for _, alg := range algorithms {
+		st := Status{Name: alg.name, Initialized: client.IsAlgorithmInitialized(alg.id)}
+		if fp, ok := footprints[alg.id]; ok {
+			st.Footprint = &fp
+		}
+		statuses = append(statuses, st)
+	}
+	return statuses
 }
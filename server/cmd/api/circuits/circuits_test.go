@@ -0,0 +1,179 @@
+package circuits
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCircuitDir writes files into a temp directory along with a manifest listing the
+// names in listed.
+func writeCircuitDir(t *testing.T, files map[string]string, listed ...string) string {
+	t.Helper()
+	d := t.TempDir()
+	var manifest strings.Builder
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(d, name), []byte(content), 0o644))
+	}
+	for _, name := range listed {
+		sum := sha256.Sum256([]byte(files[name]))
+		manifest.WriteString(hex.EncodeToString(sum[:]) + " *" + name + "\n")
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(d, ManifestFile), []byte(manifest.String()), 0o644))
+	return d
+}
+
+func TestReadVerified(t *testing.T) {
+	d := writeCircuitDir(t, map[string]string{
+		"pk.chacha20_oprf":   "proving key",
+		"r1cs.chacha20_oprf": "constraints",
+	}, "pk.chacha20_oprf")
+	hashes, err := readManifest(filepath.Join(d, ManifestFile))
+	require.NoError(t, err)
+
+	data, err := readVerified(d, "pk.chacha20_oprf", hashes)
+	require.NoError(t, err)
+	assert.Equal(t, "proving key", string(data))
+
+	_, err = readVerified(d, "r1cs.chacha20_oprf", hashes)
+	assert.ErrorContains(t, err, "not listed")
+
+	_, err = readVerified(d, "pk.aes128_oprf", hashes)
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	require.NoError(t, os.WriteFile(filepath.Join(d, "pk.chacha20_oprf"), []byte("tampered"), 0o644))
+	_, err = readVerified(d, "pk.chacha20_oprf", hashes)
+	assert.ErrorContains(t, err, "manifest expects")
+}
+
+func TestUseDir(t *testing.T) {
+	t.Cleanup(func() { dir, dirHashes = "", nil })
+
+	t.Run("missing manifest", func(t *testing.T) {
+		assert.Error(t, UseDir(t.TempDir()))
+	})
+
+	t.Run("hash mismatch", func(t *testing.T) {
+		d := writeCircuitDir(t, map[string]string{"pk.aes128_oprf": "key"}, "pk.aes128_oprf")
+		require.NoError(t, os.WriteFile(filepath.Join(d, "pk.aes128_oprf"), []byte("other"), 0o644))
+		assert.ErrorContains(t, UseDir(d), "manifest expects")
+	})
+
+	t.Run("absent files fall back to embedded", func(t *testing.T) {
+		d := writeCircuitDir(t, map[string]string{"pk.aes256_oprf": "key"}, "pk.aes256_oprf")
+		require.NoError(t, UseDir(d))
+
+		var aes256, chacha algorithm
+		for _, alg := range algorithms {
+			switch alg.name {
+			case "aes256":
+				aes256 = alg
+			case "chacha20":
+				chacha = alg
+			}
+		}
+		pk, r1cs, err := load(aes256)
+		require.NoError(t, err)
+		assert.Equal(t, "key", string(pk))
+		assert.Equal(t, aes256.r1cs, r1cs)
+
+		pk, _, err = load(chacha)
+		require.NoError(t, err)
+		assert.Equal(t, chacha.pk, pk)
+	})
+}
+
+func TestInitAlgorithm_RecordsFootprint(t *testing.T) {
+	status := filepath.Join(t.TempDir(), "status")
+	oldStatus, oldInit := procSelfStatus, initProver
+	t.Cleanup(func() {
+		procSelfStatus, initProver = oldStatus, oldInit
+		footprintMu.Lock()
+		delete(footprints, 200)
+		footprintMu.Unlock()
+	})
+	procSelfStatus = status
+	require.NoError(t, os.WriteFile(status, []byte("Name:\tapi\nVmRSS:\t  100000 kB\n"), 0o644))
+	// the prover's allocations show up as resident memory growth
+	initProver = func(uint8, []byte, []byte) bool {
+		return os.WriteFile(status, []byte("Name:\tapi\nVmRSS:\t  150000 kB\n"), 0o644) == nil
+	}
+
+	alg := algorithm{id: 200, name: "test", file: "test_oprf", pk: []byte("proving key"), r1cs: []byte("r1cs")}
+	require.NoError(t, initAlgorithm(alg))
+	footprintMu.Lock()
+	fp, ok := footprints[200]
+	footprintMu.Unlock()
+	require.True(t, ok)
+	assert.Equal(t, Footprint{ProvingKeyBytes: 11, R1CSBytes: 4, InitDeltaBytes: 50000 * 1024, DeltaMeasured: true}, fp)
+	assert.Equal(t, int64(11+4+50000*1024), fp.Bytes())
+
+	// without resident memory only the file sizes count
+	procSelfStatus = filepath.Join(t.TempDir(), "missing")
+	require.NoError(t, initAlgorithm(alg))
+	footprintMu.Lock()
+	fp = footprints[200]
+	footprintMu.Unlock()
+	assert.False(t, fp.DeltaMeasured)
+	assert.Equal(t, int64(15), fp.Bytes())
+
+	initProver = func(uint8, []byte, []byte) bool { return false }
+	assert.ErrorContains(t, initAlgorithm(alg), "prover rejected")
+}
+
+func TestInitAllCircuits_ReadyAndNotify(t *testing.T) {
+	oldInit := initProver
+	t.Cleanup(func() {
+		initProver = oldInit
+		footprintMu.Lock()
+		clear(footprints)
+		footprintMu.Unlock()
+	})
+	initProver = func(uint8, []byte, []byte) bool { return true }
+
+	var mu sync.Mutex
+	var completed []string
+	InitAllCircuits(2, func(algorithm string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		completed = append(completed, algorithm)
+	})
+	select {
+	case <-Ready():
+	case <-time.After(10 * time.Second):
+		t.Fatal("circuits never became ready")
+	}
+	mu.Lock()
+	assert.Len(t, completed, len(algorithms), "ready only after every circuit completed")
+	mu.Unlock()
+
+	var got ReadyEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer srv.Close()
+	require.NoError(t, NotifyReady(context.Background(), srv.URL))
+	assert.Equal(t, "circuits_ready", got.Event)
+	assert.Len(t, got.Circuits, len(algorithms))
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	assert.ErrorContains(t, NotifyReady(context.Background(), failing.URL), "502")
+}
@@ -0,0 +1,23 @@
+//go:build !circuits_external
+
+package circuits
+
+import _ "embed"
+
+//go:embed pk.chacha20_oprf
+var pkChacha20OPRF []byte
+
+//go:embed r1cs.chacha20_oprf
+var r1csChacha20OPRF []byte
+
+//go:embed pk.aes128_oprf
+var pkAES128OPRF []byte
+
+//go:embed r1cs.aes128_oprf
+var r1csAES128OPRF []byte
+
+//go:embed pk.aes256_oprf
+var pkAES256OPRF []byte
+
+//go:embed r1cs.aes256_oprf
+var r1csAES256OPRF []byte
@@ -0,0 +1,13 @@
+//go:build circuits_external
+
+package circuits
+
+// Built without embedded circuits: every circuit must be supplied through UseDir.
+var (
+	pkChacha20OPRF   []byte
+	r1csChacha20OPRF []byte
+	pkAES128OPRF     []byte
+	r1csAES128OPRF   []byte
+	pkAES256OPRF     []byte
+	r1csAES256OPRF   []byte
+)
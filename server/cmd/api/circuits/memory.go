@@ -0,0 +1,109 @@
+package circuits
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// initMemoryPerCircuit is a rough estimate of the peak memory initializing one circuit
+// takes, as its proving key and constraint system are deserialized and expanded.
+const initMemoryPerCircuit = 256 << 20
+
+// Memory sources, overridden in tests.
+var (
+	procMeminfo    = "/proc/meminfo"
+	procSelfStatus = "/proc/self/status"
+	cgroupRoot     = "/sys/fs/cgroup"
+)
+
+// AvailableMemory returns the memory the process can still use: the lowest of the kernel's
+// MemAvailable and the headroom under a cgroup v2 or v1 memory limit. source names where
+// the figure came from. It fails only if no source could be read.
+func AvailableMemory() (available uint64, source string, err error) {
+	if v, err := memAvailable(procMeminfo); err == nil {
+		available, source = v, "meminfo"
+	}
+	for _, cg := range []struct{ name, limit, usage string }{
+		{"cgroup v2", "memory.max", "memory.current"},
+		{"cgroup v1", "memory/memory.limit_in_bytes", "memory/memory.usage_in_bytes"},
+	} {
+		limit, err := readUint(filepath.Join(cgroupRoot, cg.limit))
+		if err != nil {
+			// unlimited ("max") or no such cgroup version
+			continue
+		}
+		usage, err := readUint(filepath.Join(cgroupRoot, cg.usage))
+		if err != nil {
+			continue
+		}
+		var headroom uint64
+		if limit > usage {
+			headroom = limit - usage
+		}
+		if source == "" || headroom < available {
+			available, source = headroom, cg.name
+		}
+		break
+	}
+	if source == "" {
+		return 0, "", fmt.Errorf("no memory information in %s or %s", procMeminfo, cgroupRoot)
+	}
+	return available, source, nil
+}
+
+// InitParallelism returns how many circuits to initialize at once so that their estimated
+// peak memory fits in available: between 1 (sequential) and the number of circuits.
+func InitParallelism(available uint64) int {
+	n := int(available / initMemoryPerCircuit)
+	return max(1, min(n, len(algorithms)))
+}
+
+// memAvailable reads MemAvailable from a /proc/meminfo-style file.
+func memAvailable(path string) (uint64, error) {
+	return readKBField(path, "MemAvailable")
+}
+
+// residentMemory returns the process's resident set size.
+func residentMemory() (uint64, error) {
+	return readKBField(procSelfStatus, "VmRSS")
+}
+
+// readKBField reads a "key:   N kB" line from a /proc/meminfo or /proc/<pid>/status-style
+// file, in bytes.
+func readKBField(path, key string) (uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		rest, ok := strings.CutPrefix(scanner.Text(), key+":")
+		if !ok {
+			continue
+		}
+		kb, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimSpace(rest), " kB"), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse %s: %w", key, err)
+		}
+		return kb * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("no %s in %s", key, path)
+}
+
+// readUint reads a file holding a single unsigned integer, as cgroup memory files do.
+func readUint(path string) (uint64, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+}
@@ -0,0 +1,87 @@
+package circuits
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMemory points the memory sources at temp files; empty contents leave a file out.
+func fakeMemory(t *testing.T, meminfo string, cgroupFiles map[string]string) {
+	t.Helper()
+	d := t.TempDir()
+	oldMeminfo, oldRoot := procMeminfo, cgroupRoot
+	t.Cleanup(func() { procMeminfo, cgroupRoot = oldMeminfo, oldRoot })
+
+	procMeminfo = filepath.Join(d, "meminfo")
+	cgroupRoot = filepath.Join(d, "cgroup")
+	if meminfo != "" {
+		require.NoError(t, os.WriteFile(procMeminfo, []byte(meminfo), 0o644))
+	}
+	for name, content := range cgroupFiles {
+		path := filepath.Join(cgroupRoot, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+func TestAvailableMemory(t *testing.T) {
+	const meminfo = "MemTotal:       16384000 kB\nMemAvailable:    2097152 kB\n"
+
+	t.Run("meminfo only", func(t *testing.T) {
+		fakeMemory(t, meminfo, nil)
+		avail, source, err := AvailableMemory()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2<<30), avail)
+		assert.Equal(t, "meminfo", source)
+	})
+
+	t.Run("cgroup v2 limit below meminfo", func(t *testing.T) {
+		fakeMemory(t, meminfo, map[string]string{
+			"memory.max":     "1073741824\n",
+			"memory.current": "536870912\n",
+		})
+		avail, source, err := AvailableMemory()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(512<<20), avail)
+		assert.Equal(t, "cgroup v2", source)
+	})
+
+	t.Run("unlimited cgroup v2", func(t *testing.T) {
+		fakeMemory(t, meminfo, map[string]string{
+			"memory.max":     "max\n",
+			"memory.current": "536870912\n",
+		})
+		avail, source, err := AvailableMemory()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2<<30), avail)
+		assert.Equal(t, "meminfo", source)
+	})
+
+	t.Run("cgroup v1 over its limit", func(t *testing.T) {
+		fakeMemory(t, meminfo, map[string]string{
+			"memory/memory.limit_in_bytes": "1073741824\n",
+			"memory/memory.usage_in_bytes": "2147483648\n",
+		})
+		avail, source, err := AvailableMemory()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), avail)
+		assert.Equal(t, "cgroup v1", source)
+	})
+
+	t.Run("no sources", func(t *testing.T) {
+		fakeMemory(t, "", nil)
+		_, _, err := AvailableMemory()
+		assert.Error(t, err)
+	})
+}
+
+func TestInitParallelism(t *testing.T) {
+	assert.Equal(t, 1, InitParallelism(0))
+	assert.Equal(t, 1, InitParallelism(initMemoryPerCircuit+1))
+	assert.Equal(t, 2, InitParallelism(2*initMemoryPerCircuit))
+	assert.Equal(t, len(algorithms), InitParallelism(64<<30))
+}
@@ -0,0 +1,79 @@
+package circuits
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// webhookTimeout bounds the call NotifyReady makes.
+const webhookTimeout = 10 * time.Second
+
+var (
+	readyOnce sync.Once
+	ready     = make(chan struct{})
+)
+
+// Ready returns a channel that is closed once InitAllCircuits has finished initializing
+// every preloaded circuit, whether or not all of them succeeded; Pending tells which
+// failed. It is closed at most once per process.
+func Ready() <-chan struct{} {
+	return ready
+}
+
+func markReady() {
+	readyOnce.Do(func() { close(ready) })
+}
+
+// ReadyEvent is the body NotifyReady posts.
+type ReadyEvent struct {
+	Event string `json:"event"`
+	// Circuits lists every preloaded circuit and whether it initialized.
+	Circuits []ReadyCircuit `json:"circuits"`
+	// Failed is true if any circuit failed to initialize; those load lazily on first use.
+	Failed bool `json:"failed"`
+}
+
+type ReadyCircuit struct {
+	Name        string `json:"name"`
+	Initialized bool   `json:"initialized"`
+}
+
+// NotifyReady waits for Ready, then posts a ReadyEvent to url once. It returns early with
+// ctx's error if ctx is done first, and does not retry a failed call.
+func NotifyReady(ctx context.Context, url string) error {
+	select {
+	case <-ready:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	event := ReadyEvent{Event: "circuits_ready", Circuits: []ReadyCircuit{}}
+	for _, st := range Statuses() {
+		event.Circuits = append(event.Circuits, ReadyCircuit{Name: st.Name, Initialized: st.Initialized})
+		event.Failed = event.Failed || !st.Initialized
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("circuits ready webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -13,6 +14,8 @@ import (
 	"strings"
 	"syscall"
 	"time"
+	// embedded so timezone overrides validate on images without zoneinfo
+	_ "time/tzdata"
 
 	"github.com/ghodss/yaml"
 	"github.com/go-chi/chi/v5"
@@ -23,13 +26,18 @@ import (
 	"github.com/onkernel/kernel-images/server/cmd/api/api"
 	"github.com/onkernel/kernel-images/server/cmd/api/circuits"
 	"github.com/onkernel/kernel-images/server/cmd/config"
+	"github.com/onkernel/kernel-images/server/lib/audit"
+	"github.com/onkernel/kernel-images/server/lib/bodylimit"
 	"github.com/onkernel/kernel-images/server/lib/chromedriverproxy"
 	"github.com/onkernel/kernel-images/server/lib/devtoolsproxy"
+	"github.com/onkernel/kernel-images/server/lib/extensions"
 	"github.com/onkernel/kernel-images/server/lib/logger"
 	"github.com/onkernel/kernel-images/server/lib/nekoclient"
 	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
 	"github.com/onkernel/kernel-images/server/lib/recorder"
+	"github.com/onkernel/kernel-images/server/lib/recoverer"
 	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/onkernel/kernel-images/server/lib/tlsutil"
 )
 
 func main() {
@@ -41,6 +49,12 @@ func main() {
 		slogger.Error("failed to load configuration", "err", err)
 		os.Exit(1)
 	}
+	// keep recent entries in memory for GET /logs, only when enabled
+	var logRing *logger.Ring
+	if config.LogBufferLines > 0 {
+		logRing = logger.NewRing(config.LogBufferLines)
+		slogger = slog.New(logger.NewRingHandler(slogger.Handler(), logRing))
+	}
 	slogger.Info("server configuration", "config", config)
 
 	// context cancellation on SIGINT/SIGTERM
@@ -51,60 +65,201 @@ func main() {
 	mustFFmpeg()
 
 	// Initialize ZK circuits in background at startup
+	if config.CircuitsDir != "" {
+		if err := circuits.UseDir(config.CircuitsDir); err != nil {
+			slogger.Error("invalid circuits directory", "dir", config.CircuitsDir, "err", err)
+			os.Exit(1)
+		}
+		slogger.Info("loading ZK circuits from directory, falling back to embedded", "dir", config.CircuitsDir)
+	}
+	// parallel init of every circuit can OOM small instances; size it to the memory left
+	parallelism := config.CircuitsInitParallelism
+	if parallelism > 0 {
+		slogger.Info("ZK circuit init parallelism set by config", "parallelism", parallelism)
+	} else if available, source, err := circuits.AvailableMemory(); err != nil {
+		parallelism = 1
+		slogger.Warn("could not read available memory, initializing ZK circuits sequentially", "err", err)
+	} else {
+		parallelism = circuits.InitParallelism(available)
+		slogger.Info("ZK circuit init parallelism chosen from available memory",
+			"parallelism", parallelism, "available_mb", available>>20, "source", source)
+	}
 	slogger.Info("initializing ZK circuits in background...")
-	circuits.InitAllCircuits(func(algorithm string, success bool) {
-		if success {
+	circuits.InitAllCircuits(parallelism, func(algorithm string, err error) {
+		if err == nil {
 			slogger.Info("ZK circuit initialized", "algorithm", algorithm)
 		} else {
-			slogger.Error("ZK circuit initialization failed", "algorithm", algorithm)
+			slogger.Error("ZK circuit initialization failed", "algorithm", algorithm, "err", err)
 		}
 	})
+	go func() {
+		select {
+		case <-circuits.Ready():
+			slogger.Info("ZK circuits finished initializing", "pending", circuits.Pending())
+		case <-ctx.Done():
+		}
+	}()
+	if config.CircuitsReadyWebhookURL != "" {
+		go func() {
+			if err := circuits.NotifyReady(ctx, config.CircuitsReadyWebhookURL); err != nil && ctx.Err() == nil {
+				slogger.Error("circuits ready webhook failed", "err", err)
+			}
+		}()
+	}
+
+	// per-request log level overrides via X-Log-Level, only when explicitly allowed
+	var levelLogger func(slog.Level) *slog.Logger
+	if config.AllowLogLevelHeader {
+		levelLogger = func(level slog.Level) *slog.Logger {
+			var h slog.Handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: level})
+			if logRing != nil {
+				h = logger.NewRingHandler(h, logRing)
+			}
+			return slog.New(h)
+		}
+	}
 
 	stz := scaletozero.NewDebouncedController(scaletozero.NewUnikraftCloudController())
+	// uploads are exempt from the general body limit, validate-extraction takes response
+	// bodies of up to 50 MB, and proofs have a limit of their own
+	uploadLimit := int64(config.MaxUploadBodyMB) << 20
+	bodyLimitOverrides := map[string]int64{
+		"/fs/write_file":  uploadLimit,
+		"/fs/upload":      uploadLimit,
+		"/fs/upload_zip":  uploadLimit,
+		"/fs/upload_zstd": uploadLimit,
+		"/chromium/upload-extensions-and-restart": uploadLimit,
+		"/reclaim/validate-extraction":            50 << 20,
+	}
+	if config.ReclaimMaxBodyKB > 0 {
+		bodyLimitOverrides["/reclaim/prove"] = int64(config.ReclaimMaxBodyKB) << 10
+	}
 	r := chi.NewRouter()
 	r.Use(
 		chiMiddleware.Logger,
-		chiMiddleware.Recoverer,
-		func(next http.Handler) http.Handler {
-			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				ctxWithLogger := logger.AddToContext(r.Context(), slogger)
-				next.ServeHTTP(w, r.WithContext(ctxWithLogger))
-			})
-		},
+		logger.Middleware(slogger, levelLogger),
+		recoverer.Middleware,
 		scaletozero.Middleware(stz),
+		bodylimit.Middleware(int64(config.MaxRequestBodyMB)<<20, bodyLimitOverrides),
 	)
+	if config.AuditLog != "" {
+		auditLog, auditCloser, err := audit.Open(config.AuditLog)
+		if err != nil {
+			slogger.Error("failed to open audit log", "err", err, "dest", config.AuditLog)
+			os.Exit(1)
+		}
+		defer auditCloser.Close()
+		r.Use(audit.Middleware(auditLog, audit.Actions))
+		slogger.Info("audit logging enabled", "dest", config.AuditLog)
+	}
 
 	defaultParams := recorder.FFmpegRecordingParams{
-		DisplayNum:  &config.DisplayNum,
-		FrameRate:   &config.FrameRate,
-		MaxSizeInMB: &config.MaxSizeInMB,
-		OutputDir:   &config.OutputDir,
+		DisplayNum:          &config.DisplayNum,
+		FrameRate:           &config.FrameRate,
+		MaxSizeInMB:         &config.MaxSizeInMB,
+		OutputDir:           &config.OutputDir,
+		TempDir:             config.TempDir,
+		StartTimeout:        time.Duration(config.FFmpegStartTimeoutSeconds) * time.Second,
+		StallTimeout:        time.Duration(config.RecordingStallTimeoutSeconds) * time.Second,
+		StallForceStop:      config.RecordingStallForceStop,
+		Fragmented:          config.RecordingFragmented,
+		Mode:                recorder.CaptureMode(config.RecordingMode),
+		Backend:             recorder.CaptureBackend(config.CaptureBackend),
+		LogLevel:            config.FFmpegLogLevel,
+		Progress:            config.FFmpegProgress,
+		DropDuplicateFrames: config.RecordingDropDuplicateFrames,
+		DuplicateFrameThresholds: recorder.DuplicateFrameThresholds{
+			Hi:   config.RecordingDuplicateFrameHi,
+			Lo:   config.RecordingDuplicateFrameLo,
+			Frac: config.RecordingDuplicateFrameFrac,
+		},
+	}
+	if config.RecordingKeyframeIntervalSeconds > 0 {
+		defaultParams.KeyframeIntervalSeconds = &config.RecordingKeyframeIntervalSeconds
+	}
+	// cache ffmpeg's filters so recordings needing a missing one fail validation; without
+	// the list they are left to fail in ffmpeg
+	if filters, err := recorder.ProbeFilters(ctx, config.PathToFFmpeg); err != nil {
+		slogger.Warn("failed to probe ffmpeg filters, recording filters won't be validated", "err", err)
+	} else {
+		slogger.Info("probed ffmpeg filters", "count", len(filters))
 	}
 	if err := defaultParams.Validate(); err != nil {
 		slogger.Error("invalid default recording parameters", "err", err)
 		os.Exit(1)
 	}
+	if err := recorder.CheckCaptureBackend(ctx, config.PathToFFmpeg, defaultParams.Backend); err != nil {
+		slogger.Error("capture backend not available", "err", err, "backend", config.CaptureBackend)
+		os.Exit(1)
+	}
 
-	// DevTools WebSocket upstream manager: tail Chromium supervisord log
-	const chromiumLogPath = "/var/log/supervisord/chromium"
-	upstreamMgr := devtoolsproxy.NewUpstreamManager(chromiumLogPath, slogger)
+	// DevTools WebSocket upstream manager: tail Chromium supervisord log, or poll
+	// Chromium's /json/version in images without one
+	var upstreamMgr *devtoolsproxy.UpstreamManager
+	if config.DevToolsUpstreamDiscovery == "poll" {
+		upstreamMgr = devtoolsproxy.NewPollingUpstreamManager(config.ChromiumDevToolsAddr, slogger)
+	} else {
+		if _, err := os.Stat(config.ChromiumLogPath); err != nil {
+			slogger.Error("chromium log not found; set CHROMIUM_LOG_PATH or use DEVTOOLS_UPSTREAM_DISCOVERY=poll",
+				"path", config.ChromiumLogPath, "err", err)
+			os.Exit(1)
+		}
+		upstreamMgr = devtoolsproxy.NewUpstreamManager(config.ChromiumLogPath, slogger)
+	}
+	if config.DevToolsProxyReconnect {
+		upstreamMgr.EnableReconnect()
+	}
+	upstreamMgr.SetMaxMessageSize(int64(config.DevToolsProxyMaxMessageMB) << 20)
 	upstreamMgr.Start(ctx)
 
 	// Initialize Neko authenticated client
-	adminPassword := os.Getenv("NEKO_ADMIN_PASSWORD")
-	if adminPassword == "" {
-		adminPassword = "admin" // Default from neko.yaml
-	}
-	nekoAuthClient, err := nekoclient.NewAuthClient("http://127.0.0.1:8080", "admin", adminPassword)
+	nekoAuthClient, err := nekoclient.NewAuthClient(config.NekoURL, config.NekoAdminUsername, config.NekoAdminPassword)
 	if err != nil {
 		slogger.Error("failed to create neko auth client", "err", err)
 		os.Exit(1)
 	}
+	defer nekoAuthClient.Close()
+	if config.NekoVerifyAuth {
+		loginCtx, loginCancel := context.WithTimeout(ctx, 10*time.Second)
+		err := nekoAuthClient.Login(loginCtx)
+		loginCancel()
+		if err != nil {
+			slogger.Error("neko authentication failed", "err", err, "url", config.NekoURL, "username", config.NekoAdminUsername)
+			os.Exit(1)
+		}
+		slogger.Info("neko authentication verified", "url", config.NekoURL)
+	}
+
+	// Surface disk problems now rather than as the first recording failing
+	if err := recorder.CheckWritable(config.OutputDir); err != nil {
+		if config.RecordingOutputDirCheck == "fail" {
+			slogger.Error("output directory is not writable", "err", err)
+			os.Exit(1)
+		}
+		slogger.Warn("output directory is not writable, recordings will be refused until it is", "err", err)
+	}
+
+	// Recordings finalized before a restart stay listable and downloadable
+	recordManager := recorder.NewFFmpegManager()
+	restored, err := recorder.RestoreFFmpegRecorders(logger.AddToContext(ctx, slogger), config.OutputDir, config.PathToFFmpeg, stz)
+	if err != nil {
+		slogger.Error("failed to restore recordings", "err", err, "dir", config.OutputDir)
+		os.Exit(1)
+	}
+	for _, rec := range restored {
+		// recorder IDs are shared across tenants, so two tenant directories may hold the same one
+		if err := recordManager.RegisterRecorder(ctx, rec); err != nil {
+			slogger.Warn("skipping restored recording", "err", err, "id", rec.ID(), "tenant", rec.Params().Tenant)
+		}
+	}
+	if len(restored) > 0 {
+		slogger.Info("restored finalized recordings", "count", len(restored), "dir", config.OutputDir)
+	}
 
 	apiService, err := api.New(
 		config,
-		recorder.NewFFmpegManager(),
-		recorder.NewFFmpegRecorderFactory(config.PathToFFmpeg, defaultParams, stz),
+		recordManager,
+		recorder.NewFFmpegRecorderFactory(config.PathToFFmpeg, defaultParams, upstreamMgr.Current, stz),
 		upstreamMgr,
 		stz,
 		nekoAuthClient,
@@ -113,8 +268,16 @@ func main() {
 		slogger.Error("failed to create api service", "err", err)
 		os.Exit(1)
 	}
+	if logRing != nil {
+		apiService.SetLogRing(logRing)
+	}
 
-	strictHandler := oapi.NewStrictHandler(apiService, nil)
+	strictHandler := oapi.NewStrictHandlerWithOptions(apiService, nil, oapi.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: bodylimit.RequestErrorHandler,
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	})
 	oapi.HandlerFromMux(strictHandler, r)
 
 	// endpoints to expose the spec
@@ -138,16 +301,18 @@ func main() {
 		id := chi.URLParam(r, "process_id")
 		apiService.HandleProcessAttachWS(w, r, id)
 	})
+	// Recording ffmpeg stderr stream (WebSocket) - not part of OpenAPI spec
+	r.Get("/recordings/{id}/stderr", func(w http.ResponseWriter, r *http.Request) {
+		apiService.HandleRecordingStderrWS(w, r, chi.URLParam(r, "id"))
+	})
 	r.Post("/reclaim/validate-extraction", apiService.HandleReclaimValidateExtraction)
+	r.Get("/readyz", apiService.HandleReadyz)
+	// ffmpeg filters probed at startup, for debugging recording validation - not part of OpenAPI spec
+	r.Get("/debug/ffmpeg/filters", recorder.FiltersHandler().ServeHTTP)
 
 	// Serve extension files for Chrome policy-installed extensions
 	// This allows Chrome to download .crx and update.xml files via HTTP
-	extensionsDir := "/home/kernel/extensions"
-	r.Get("/extensions/*", func(w http.ResponseWriter, r *http.Request) {
-		// Serve files from /home/kernel/extensions/
-		fs := http.StripPrefix("/extensions/", http.FileServer(http.Dir(extensionsDir)))
-		fs.ServeHTTP(w, r)
-	})
+	r.Get("/extensions/*", http.StripPrefix("/extensions", extensions.Handler(config.ExtensionsDir, "/extensions", config.ExtensionsVerifyCRX)).ServeHTTP)
 
 	// Start the persistent CDP FocusTracker — it polls document.activeElement
 	// every 100ms and caches the result.
@@ -170,10 +335,7 @@ func main() {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%d", config.Port),
-		Handler: r,
-	}
+	srv := newHTTPServer(config, "", r, false)
 
 	// wait up to 10 seconds for initial upstream; exit nonzero if not found
 	if _, err := upstreamMgr.WaitForInitial(10 * time.Second); err != nil {
@@ -181,16 +343,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	// validated by config.Load, so parsing cannot fail here
+	devtoolsCompression, _ := devtoolsproxy.ParseCompressionMode(config.DevToolsProxyCompression)
+
 	rDevtools := chi.NewRouter()
 	rDevtools.Use(
 		chiMiddleware.Logger,
-		chiMiddleware.Recoverer,
-		func(next http.Handler) http.Handler {
-			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				ctxWithLogger := logger.AddToContext(r.Context(), slogger)
-				next.ServeHTTP(w, r.WithContext(ctxWithLogger))
-			})
-		},
+		logger.Middleware(slogger, levelLogger),
+		recoverer.Middleware,
 		scaletozero.Middleware(stz),
 	)
 	// Proxy /json/version and /json/list to upstream Chrome with URL rewriting.
@@ -222,28 +382,25 @@ func main() {
 			})
 		})
 		r.Get("/active-element", devtoolsproxy.ActiveElementHandler(focusTracker).ServeHTTP)
+		r.Get("/stats", devtoolsproxy.StatsHandler(upstreamMgr).ServeHTTP)
 	})
 
-	rDevtools.Get("/*", func(w http.ResponseWriter, r *http.Request) {
-		devtoolsproxy.WebSocketProxyHandlerFiltered(upstreamMgr, slogger, stz).ServeHTTP(w, r)
-	})
-
-	srvDevtools := &http.Server{
-		Addr:    fmt.Sprintf("0.0.0.0:%d", config.DevToolsProxyPort),
-		Handler: rDevtools,
+	devtoolsHandler := devtoolsproxy.WebSocketProxyHandlerFiltered(upstreamMgr, slogger, devtoolsCompression, stz)
+	if config.DevToolsProxyMultiplex {
+		mux := devtoolsproxy.NewFilteredMultiplexer(upstreamMgr, slogger, devtoolsCompression)
+		defer mux.Close()
+		devtoolsHandler = mux
 	}
+	rDevtools.Get("/*", devtoolsHandler.ServeHTTP)
+
+	srvDevtools := newHTTPServer(config, fmt.Sprintf("0.0.0.0:%d", config.DevToolsProxyPort), rDevtools, true)
 
 	// Internal CDP server with full access (no filtering) on port 9226
 	rDevtoolsInternal := chi.NewRouter()
 	rDevtoolsInternal.Use(
 		chiMiddleware.Logger,
-		chiMiddleware.Recoverer,
-		func(next http.Handler) http.Handler {
-			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				ctxWithLogger := logger.AddToContext(r.Context(), slogger)
-				next.ServeHTTP(w, r.WithContext(ctxWithLogger))
-			})
-		},
+		logger.Middleware(slogger, levelLogger),
+		recoverer.Middleware,
 		func(next http.Handler) http.Handler {
 			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.Header().Set("Access-Control-Allow-Origin", "*")
@@ -266,14 +423,17 @@ func main() {
 	rDevtoolsInternal.Get("/json/", jsonTargetHandlerInternal)
 	rDevtoolsInternal.Get("/json/list", jsonTargetHandlerInternal)
 	rDevtoolsInternal.Get("/json/list/", jsonTargetHandlerInternal)
-	rDevtoolsInternal.Get("/*", func(w http.ResponseWriter, r *http.Request) {
-		devtoolsproxy.WebSocketProxyHandler(upstreamMgr, slogger, config.LogCDPMessages, stz).ServeHTTP(w, r)
-	})
-
-	srvDevtoolsInternal := &http.Server{
-		Addr:    "0.0.0.0:9226",
-		Handler: rDevtoolsInternal,
+	rDevtoolsInternal.Get("/cdp/stats", devtoolsproxy.StatsHandler(upstreamMgr).ServeHTTP)
+	cdpCapture := devtoolsproxy.CaptureOptions{Dir: config.CDPCaptureDir, Gzip: config.CDPCaptureGzip, GzipLevel: config.CDPCaptureGzipLevel}
+	devtoolsInternalHandler := devtoolsproxy.WebSocketProxyHandler(upstreamMgr, slogger, config.LogCDPMessages, cdpCapture, devtoolsCompression, stz)
+	if config.DevToolsProxyMultiplex {
+		mux := devtoolsproxy.NewMultiplexer(upstreamMgr, slogger, config.LogCDPMessages, cdpCapture, devtoolsCompression)
+		defer mux.Close()
+		devtoolsInternalHandler = mux
 	}
+	rDevtoolsInternal.Get("/*", devtoolsInternalHandler.ServeHTTP)
+
+	srvDevtoolsInternal := newHTTPServer(config, "0.0.0.0:9226", rDevtoolsInternal, true)
 
 	// ChromeDriver proxy: intercepts POST /session to inject the DevTools proxy
 	// address as goog:chromeOptions.debuggerAddress,
@@ -281,13 +441,8 @@ func main() {
 	rChromeDriver := chi.NewRouter()
 	rChromeDriver.Use(
 		chiMiddleware.Logger,
-		chiMiddleware.Recoverer,
-		func(next http.Handler) http.Handler {
-			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				ctxWithLogger := logger.AddToContext(r.Context(), slogger)
-				next.ServeHTTP(w, r.WithContext(ctxWithLogger))
-			})
-		},
+		logger.Middleware(slogger, levelLogger),
+		recoverer.Middleware,
 		scaletozero.Middleware(stz),
 	)
 	rChromeDriver.Handle("/*", chromedriverproxy.Handler(slogger, &chromedriverproxy.Options{
@@ -295,14 +450,31 @@ func main() {
 		DevToolsProxyAddr:    config.DevToolsProxyAddr,
 	}))
 
-	srvChromeDriver := &http.Server{
-		Addr:    fmt.Sprintf("0.0.0.0:%d", config.ChromeDriverProxyPort),
-		Handler: rChromeDriver,
+	srvChromeDriver := newHTTPServer(config, fmt.Sprintf("0.0.0.0:%d", config.ChromeDriverProxyPort), rChromeDriver, true)
+
+	apiListener, err := listenAPI(config)
+	if err != nil {
+		slogger.Error("failed to listen for api server", "err", err)
+		os.Exit(1)
+	}
+
+	if config.TLSCertFile != "" {
+		certs, err := tlsutil.NewCertReloader(config.TLSCertFile, config.TLSKeyFile, slogger)
+		if err != nil {
+			slogger.Error("failed to load tls certificate", "err", err)
+			os.Exit(1)
+		}
+		srv.TLSConfig = certs.ServerConfig()
 	}
 
 	go func() {
-		slogger.Info("http server starting", "addr", srv.Addr)
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		slogger.Info("http server starting", "addr", apiListener.Addr().String(), "tls", srv.TLSConfig != nil)
+		serve := srv.Serve
+		if srv.TLSConfig != nil {
+			// the certificate comes from TLSConfig.GetCertificate
+			serve = func(l net.Listener) error { return srv.ServeTLS(l, "", "") }
+		}
+		if err := serve(apiListener); err != nil && err != http.ErrServerClosed {
 			slogger.Error("http server failed", "err", err)
 			stop()
 		}
@@ -334,7 +506,10 @@ func main() {
 
 	// graceful shutdown
 	<-ctx.Done()
-	slogger.Info("shutdown signal received")
+	slogger.Info("shutdown signal received, draining", "timeout_seconds", config.DrainTimeoutSeconds)
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), time.Duration(config.DrainTimeoutSeconds)*time.Second)
+	apiService.Drain(logger.AddToContext(drainCtx, slogger))
+	drainCancel()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
@@ -362,6 +537,45 @@ func main() {
 	}
 }
 
+// newHTTPServer returns a server for handler with the configured HTTP timeouts. Servers
+// proxying websockets only get the header and idle timeouts: read and write deadlines stay
+// on hijacked connections and would cut long-lived sessions off.
+func newHTTPServer(cfg *config.Config, addr string, handler http.Handler, websockets bool) *http.Server {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: time.Duration(cfg.HTTPReadHeaderTimeoutSeconds) * time.Second,
+		IdleTimeout:       time.Duration(cfg.HTTPIdleTimeoutSeconds) * time.Second,
+	}
+	if !websockets {
+		srv.ReadTimeout = time.Duration(cfg.HTTPReadTimeoutSeconds) * time.Second
+		srv.WriteTimeout = time.Duration(cfg.HTTPWriteTimeoutSeconds) * time.Second
+	}
+	return srv
+}
+
+// listenAPI opens the API server's listener: the Unix socket at LISTEN_SOCKET if set,
+// otherwise TCP on PORT. A socket file left behind by a previous run is removed first;
+// one that still accepts connections belongs to a live server and is an error.
+func listenAPI(cfg *config.Config) (net.Listener, error) {
+	if cfg.ListenSocket == "" {
+		return net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	}
+	if info, err := os.Lstat(cfg.ListenSocket); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", cfg.ListenSocket)
+		}
+		if conn, err := net.DialTimeout("unix", cfg.ListenSocket, time.Second); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s is in use by another server", cfg.ListenSocket)
+		}
+		if err := os.Remove(cfg.ListenSocket); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	}
+	return net.Listen("unix", cfg.ListenSocket)
+}
+
 func mustFFmpeg() {
 	cmd := exec.Command("ffmpeg", "-version")
 	if err := cmd.Run(); err != nil {
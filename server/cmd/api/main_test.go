@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/onkernel/kernel-images/server/cmd/config"
 	"github.com/onkernel/kernel-images/server/lib/devtoolsproxy"
 	"github.com/stretchr/testify/require"
 )
@@ -126,3 +128,51 @@ func TestChromeJSONProxyHandler_CancelsUpstreamRequestWithCallerContext(t *testi
 
 	require.Equal(t, http.StatusBadGateway, rec.Code)
 }
+
+func TestListenAPI_UnixSocket(t *testing.T) {
+	sock := filepath.Join(t.TempDir(), "api.sock")
+	cfg := &config.Config{ListenSocket: sock}
+
+	ln, err := listenAPI(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "unix", ln.Addr().Network())
+
+	// a live server keeps its socket
+	_, err = listenAPI(cfg)
+	require.Error(t, err)
+
+	// a socket left behind by a dead server is replaced
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, ln.Close())
+	ln, err = listenAPI(cfg)
+	require.NoError(t, err)
+	require.NoError(t, ln.Close())
+
+	// anything else at the path is left alone
+	require.NoError(t, os.WriteFile(sock, nil, 0o600))
+	_, err = listenAPI(cfg)
+	require.Error(t, err)
+}
+
+func TestNewHTTPServer_Timeouts(t *testing.T) {
+	cfg := &config.Config{
+		HTTPReadHeaderTimeoutSeconds: 10,
+		HTTPReadTimeoutSeconds:       30,
+		HTTPWriteTimeoutSeconds:      60,
+		HTTPIdleTimeoutSeconds:       120,
+	}
+
+	api := newHTTPServer(cfg, "", http.NotFoundHandler(), false)
+	require.Equal(t, 10*time.Second, api.ReadHeaderTimeout)
+	require.Equal(t, 30*time.Second, api.ReadTimeout)
+	require.Equal(t, time.Minute, api.WriteTimeout)
+	require.Equal(t, 2*time.Minute, api.IdleTimeout)
+
+	// read/write deadlines would cut off proxied websockets
+	proxy := newHTTPServer(cfg, "0.0.0.0:9226", http.NotFoundHandler(), true)
+	require.Equal(t, "0.0.0.0:9226", proxy.Addr)
+	require.Equal(t, 10*time.Second, proxy.ReadHeaderTimeout)
+	require.Zero(t, proxy.ReadTimeout)
+	require.Zero(t, proxy.WriteTimeout)
+	require.Equal(t, 2*time.Minute, proxy.IdleTimeout)
+}
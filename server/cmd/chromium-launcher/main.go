@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/chromiumflags"
+)
+
+func main() {
+	headless := flag.Bool("headless", false, "Run Chromium with headless flags")
+	chromiumPath := flag.String("chromium", "chromium", "Chromium binary path (default: chromium)")
+	runtimeFlagsPath := flag.String("runtime-flags", "/chromium/flags", "Path to runtime flags overlay file")
+	runtimeFlagsDir := flag.String("runtime-flags-dir", "/chromium/flags.d", "Directory of *.flags overlay files, applied in lexical order after CHROMIUM_FLAGS and before -runtime-flags. "+
+		"An exact duplicate token keeps its first occurrence; when the same flag appears with different values, the last one wins")
+	expandEnv := flag.Bool("expand-env", false, "Expand ${VAR} references in -runtime-flags-dir overlay files from the environment (never applied to -runtime-flags)")
+	keepUndefinedEnv := flag.Bool("keep-undefined-env", false, "With -expand-env, leave undefined ${VAR} references as-is instead of expanding to empty")
+	flag.Parse()
+
+	// Clean up stale lock file from previous SIGKILL termination
+	// Chromium creates this lock and doesn't clean it up when killed
+	_ = os.Remove("/home/kernel/user-data/SingletonLock")
+	_ = os.Remove("/home/kernel/user-data/SingletonSocket")
+	_ = os.Remove("/home/kernel/user-data/SingletonCookie")
+
+	// Kill any existing chromium processes to ensure clean restart.
+	// This is necessary because supervisord's stopwaitsecs=0 doesn't wait for
+	// the old process to fully die before starting the new one, which can cause
+	// the new process to fall back to IPv6 while the old one holds IPv4.
+	killExistingChromium()
+
+	// Inputs
+	internalPort := strings.TrimSpace(os.Getenv("INTERNAL_PORT"))
+	if internalPort == "" {
+		internalPort = "9223"
+	}
+
+	// Wait for devtools port to be available (handles SIGKILL socket cleanup delay)
+	waitForPort(internalPort, 5*time.Second)
+
+	baseFlags := os.Getenv("CHROMIUM_FLAGS")
+	startupURL := strings.TrimSpace(os.Getenv("POPCORN_BROWSER_STARTUP_URL"))
+	if startupURL == "" {
+		startupURL = strings.TrimSpace(os.Getenv("CHROMIUM_STARTUP_URL"))
+	}
+	startupURL = normalizeStartupURL(startupURL)
+	// Tokens are ordered CHROMIUM_FLAGS, then each *.flags file in -runtime-flags-dir in
+	// lexical order, then -runtime-flags. MergeFlags keeps the first occurrence of an exact
+	// duplicate token and passes differing values of the same flag through, so Chromium
+	// applies the last one.
+	runtimeTokens, err := readRuntimeTokens(*runtimeFlagsDir, *runtimeFlagsPath, *expandEnv, *keepUndefinedEnv)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	final, mergeReport := chromiumflags.MergeFlagsWithRuntimeTokens(baseFlags, runtimeTokens)
+
+	// Diagnostics for parity with previous scripts
+	fmt.Printf("BASE_FLAGS: %s\n", baseFlags)
+	fmt.Printf("RUNTIME_FLAGS: %s\n", strings.Join(runtimeTokens, " "))
+	fmt.Printf("FINAL_FLAGS: %s\n", strings.Join(final, " "))
+	for _, note := range mergeReport.Notes {
+		fmt.Printf("FLAG_MERGE: %s\n", note)
+	}
+	fmt.Printf("STARTUP_URL: %s\n", startupURL)
+
+	// flags we send no matter what
+	chromiumArgs := []string{
+		fmt.Sprintf("--remote-debugging-port=%s", internalPort),
+		"--remote-allow-origins=*",
+		"--user-data-dir=/home/kernel/user-data",
+		"--password-store=basic",
+		"--no-first-run",
+		"--kiosk",
+	}
+	if *headless {
+		chromiumArgs = append([]string{"--headless=new"}, chromiumArgs...)
+	}
+	chromiumArgs = append(chromiumArgs, final...)
+	if startupURL != "" {
+		chromiumArgs = append(chromiumArgs, startupURL)
+	}
+
+	runAsRoot := strings.EqualFold(strings.TrimSpace(os.Getenv("RUN_AS_ROOT")), "true")
+
+	// Prepare environment
+	env := os.Environ()
+	env = append(env,
+		"DISPLAY=:1",
+		"DBUS_SESSION_BUS_ADDRESS=unix:path=/run/dbus/system_bus_socket",
+	)
+
+	// CHROMIUM_LAUNCHER_SUPERVISE=true runs Chromium as a child instead of replacing this process,
+	// so crashes shortly after startup can be reported with their stderr and exit code.
+	supervised := strings.EqualFold(strings.TrimSpace(os.Getenv("CHROMIUM_LAUNCHER_SUPERVISE")), "true")
+
+	if runAsRoot {
+		// Replace current process with Chromium
+		if p, err := execLookPath(*chromiumPath); err == nil {
+			launch(supervised, p, append([]string{filepath.Base(p)}, chromiumArgs...), env, "chromium")
+		} else {
+			fmt.Fprintf(os.Stderr, "chromium binary not found: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Not running as root: call runuser to exec as kernel user, providing env vars inside
+	runuserPath, err := execLookPath("runuser")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "runuser not found: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Build: runuser -u kernel -- env DISPLAY=... DBUS_... XDG_... HOME=... chromium <args>
+	inner := []string{
+		"env",
+		"DISPLAY=:1",
+		"DBUS_SESSION_BUS_ADDRESS=unix:path=/run/dbus/system_bus_socket",
+		"XDG_CONFIG_HOME=/home/kernel/.config",
+		"XDG_CACHE_HOME=/home/kernel/.cache",
+		"HOME=/home/kernel",
+		*chromiumPath,
+	}
+	inner = append(inner, chromiumArgs...)
+	argv := append([]string{filepath.Base(runuserPath), "-u", "kernel", "--"}, inner...)
+	launch(supervised, runuserPath, argv, env, "runuser")
+}
+
+// launch replaces the current process with path, or when supervised runs it as a child
+// and exits with its exit code. It only returns if the exec-replace path fails to start.
+func launch(supervised bool, path string, argv, env []string, name string) {
+	if supervised {
+		code, err := supervise(path, argv, env, envDuration("CHROMIUM_CRASH_WINDOW", 5*time.Second), os.Stdout, os.Stderr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "start %s failed: %v\n", name, err)
+			os.Exit(1)
+		}
+		os.Exit(code)
+	}
+	if err := syscall.Exec(path, argv, env); err != nil {
+		fmt.Fprintf(os.Stderr, "exec %s failed: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// readRuntimeTokens returns the overlay tokens from the *.flags files in dir followed by those in
+// path. With expandEnv, ${VAR} references are expanded in the dir tokens only: that directory is
+// operator-owned, whereas path is written by the API from request input and must never be able
+// to pull the launcher's environment into Chromium flags where page scripts could read it.
+func readRuntimeTokens(dir, path string, expandEnv, keepUndefinedEnv bool) ([]string, error) {
+	dirTokens, err := chromiumflags.ReadFlagDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading runtime flags dir: %w", err)
+	}
+	if expandEnv {
+		dirTokens = chromiumflags.ExpandEnv(dirTokens, keepUndefinedEnv)
+	}
+	fileTokens, err := chromiumflags.ReadOptionalFlagFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading runtime flags: %w", err)
+	}
+	return append(dirTokens, fileTokens...), nil
+}
+
+// execLookPath helps satisfy syscall.Exec's requirement to pass an absolute path.
+func execLookPath(file string) (string, error) {
+	if strings.ContainsRune(file, os.PathSeparator) {
+		return file, nil
+	}
+	return exec.LookPath(file)
+}
+
+func normalizeStartupURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		fmt.Fprintf(os.Stderr, "ignoring invalid startup URL: %q\n", raw)
+		return ""
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		fmt.Fprintf(os.Stderr, "ignoring startup URL with unsupported scheme: %q\n", raw)
+		return ""
+	}
+	return parsed.String()
+}
+
+// waitForPort waits until the given port is available for binding on both IPv4 and IPv6.
+// This handles the delay after SIGKILL before the kernel releases the socket.
+// We disable SO_REUSEADDR to get an accurate check matching chromium's bind behavior.
+func waitForPort(port string, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	addrs := []string{"127.0.0.1:" + port, "[::1]:" + port}
+
+	// ListenConfig with Control to disable SO_REUSEADDR for accurate port availability check
+	lc := &net.ListenConfig{
+		Control: func(network, address string, c syscall.RawConn) error {
+			var sockErr error
+			err := c.Control(func(fd uintptr) {
+				// Disable SO_REUSEADDR to match chromium's behavior
+				sockErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_REUSEADDR, 0)
+			})
+			if err != nil {
+				return err
+			}
+			return sockErr
+		},
+	}
+
+	ctx := context.Background()
+	for time.Now().Before(deadline) {
+		allFree := true
+		for _, addr := range addrs {
+			ln, err := lc.Listen(ctx, "tcp", addr)
+			if err != nil {
+				allFree = false
+				break
+			}
+			ln.Close()
+		}
+		if allFree {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	// Timeout reached, proceed anyway and let chromium report the error
+}
+
+// killExistingChromium asks any existing chromium browser processes to exit and waits for them to die.
+// This ensures a clean restart where the new process can bind to both IPv4 and IPv6.
+// Processes are first sent CHROMIUM_KILL_SIGNAL (default TERM) so Chromium can flush profile
+// state; survivors of CHROMIUM_KILL_GRACE_PERIOD (default 2s) are escalated to SIGKILL and
+// waited on for up to CHROMIUM_KILL_TIMEOUT (default 2s).
+// Note: We use -x for exact match to avoid killing chromium-launcher itself.
+func killExistingChromium() {
+	signal := envKillSignal("CHROMIUM_KILL_SIGNAL", "TERM")
+	grace := envDuration("CHROMIUM_KILL_GRACE_PERIOD", 2*time.Second)
+	timeout := envDuration("CHROMIUM_KILL_TIMEOUT", 2*time.Second)
+
+	// Kill chromium processes by exact name match.
+	// Using -x prevents matching "chromium-launcher" which would kill this process.
+	_ = exec.Command("pkill", "-"+signal, "-x", "chromium").Run()
+	if signal != "KILL" {
+		if waitForChromiumExit(grace) {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "chromium did not exit within %s of SIG%s, escalating to SIGKILL\n", grace, signal)
+		_ = exec.Command("pkill", "-KILL", "-x", "chromium").Run()
+	}
+	if !waitForChromiumExit(timeout) {
+		// Timeout - processes may still exist but we continue anyway
+		fmt.Fprintf(os.Stderr, "warning: chromium processes may still be running after kill attempt\n")
+	}
+}
+
+// waitForChromiumExit polls until no chromium browser processes remain or timeout elapses.
+// It reports whether all processes exited.
+func waitForChromiumExit(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		// Check if any chromium browser processes are still running (exact match)
+		output, err := exec.Command("pgrep", "-x", "chromium").Output()
+		if err != nil || len(strings.TrimSpace(string(output))) == 0 {
+			// No processes found, we're done
+			return true
+		}
+		if !time.Now().Before(deadline) {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return false
+}
+
+// envDuration reads a time.Duration (e.g. "500ms", "2s") from the named env var.
+// Empty, invalid or negative values fall back to def.
+func envDuration(name string, def time.Duration) time.Duration {
+	raw := strings.TrimSpace(os.Getenv(name))
+	if raw == "" {
+		return def
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d < 0 {
+		fmt.Fprintf(os.Stderr, "ignoring invalid %s: %q\n", name, raw)
+		return def
+	}
+	return d
+}
+
+// envKillSignal reads a signal name (e.g. "TERM", "SIGINT", "kill") from the named env var and
+// returns it in the upper-case, SIG-less form accepted by pkill. Unknown names fall back to def.
+func envKillSignal(name, def string) string {
+	raw := strings.TrimSpace(os.Getenv(name))
+	if raw == "" {
+		return def
+	}
+	sig := strings.TrimPrefix(strings.ToUpper(raw), "SIG")
+	switch sig {
+	case "TERM", "INT", "HUP", "QUIT", "KILL":
+		return sig
+	}
+	fmt.Fprintf(os.Stderr, "ignoring unsupported %s: %q\n", name, raw)
+	return def
+}
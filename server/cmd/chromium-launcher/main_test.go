@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeStartupURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "empty", raw: "", want: ""},
+		{name: "https", raw: "https://www.google.com", want: "https://www.google.com"},
+		{name: "http", raw: "http://example.com/path", want: "http://example.com/path"},
+		{name: "trimmed", raw: "  https://example.com  ", want: "https://example.com"},
+		{name: "flag", raw: "--disable-web-security", want: ""},
+		{name: "unsupported scheme", raw: "file:///tmp/index.html", want: ""},
+		{name: "missing host", raw: "https:///missing-host", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := normalizeStartupURL(tt.raw); got != tt.want {
+				t.Fatalf("normalizeStartupURL(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want time.Duration
+	}{
+		{name: "unset", raw: "", want: 2 * time.Second},
+		{name: "valid", raw: "500ms", want: 500 * time.Millisecond},
+		{name: "zero", raw: "0s", want: 0},
+		{name: "invalid", raw: "soon", want: 2 * time.Second},
+		{name: "negative", raw: "-1s", want: 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_KILL_DURATION", tt.raw)
+			if got := envDuration("TEST_KILL_DURATION", 2*time.Second); got != tt.want {
+				t.Fatalf("envDuration(%q) = %s, want %s", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvKillSignal(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{name: "unset", raw: "", want: "TERM"},
+		{name: "plain", raw: "INT", want: "INT"},
+		{name: "prefixed", raw: "SIGKILL", want: "KILL"},
+		{name: "lowercase", raw: "sigquit", want: "QUIT"},
+		{name: "unsupported", raw: "USR1", want: "TERM"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_KILL_SIGNAL", tt.raw)
+			if got := envKillSignal("TEST_KILL_SIGNAL", "TERM"); got != tt.want {
+				t.Fatalf("envKillSignal(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadRuntimeTokensExpandsOnlyFlagDir(t *testing.T) {
+	t.Setenv("TEST_LAUNCHER_SECRET", "s3cret")
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "10-operator.flags"), []byte(`{"flags":["--proxy-server=${TEST_LAUNCHER_SECRET}"]}`), 0o644); err != nil {
+		t.Fatalf("write overlay: %v", err)
+	}
+	// The runtime flags file is written by the API from request input.
+	path := filepath.Join(t.TempDir(), "flags")
+	if err := os.WriteFile(path, []byte(`{"flags":["--user-agent=${TEST_LAUNCHER_SECRET}"]}`), 0o644); err != nil {
+		t.Fatalf("write runtime flags: %v", err)
+	}
+
+	got, err := readRuntimeTokens(dir, path, true, false)
+	if err != nil {
+		t.Fatalf("readRuntimeTokens error: %v", err)
+	}
+	want := []string{"--proxy-server=s3cret", "--user-agent=${TEST_LAUNCHER_SECRET}"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("readRuntimeTokens mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+
+	got, err = readRuntimeTokens(dir, path, false, false)
+	if err != nil {
+		t.Fatalf("readRuntimeTokens error: %v", err)
+	}
+	want = []string{"--proxy-server=${TEST_LAUNCHER_SECRET}", "--user-agent=${TEST_LAUNCHER_SECRET}"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("readRuntimeTokens without expansion mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestTailBuffer(t *testing.T) {
+	tb := &tailBuffer{max: 5}
+	_, _ = tb.Write([]byte("abc"))
+	_, _ = tb.Write([]byte("defg"))
+	if got := tb.String(); got != "cdefg" {
+		t.Fatalf("tailBuffer = %q, want %q", got, "cdefg")
+	}
+}
+
+func TestSuperviseEarlyExit(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+	var stdout, stderr bytes.Buffer
+	code, err := supervise(sh, []string{"sh", "-c", "echo bad flag >&2; exit 3"}, os.Environ(), 5*time.Second, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("supervise error: %v", err)
+	}
+	if code != 3 {
+		t.Fatalf("exit code = %d, want 3", code)
+	}
+	out := stderr.String()
+	if !strings.Contains(out, "exit code 3") || strings.Count(out, "bad flag") != 2 {
+		t.Fatalf("expected crash report with re-logged stderr, got:\n%s", out)
+	}
+}
+
+func TestSuperviseCleanEarlyExitFails(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+	var stdout, stderr bytes.Buffer
+	code, err := supervise(sh, []string{"sh", "-c", "exit 0"}, os.Environ(), 5*time.Second, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("supervise error: %v", err)
+	}
+	if code != 1 {
+		t.Fatalf("exit code = %d, want 1 for immediate clean exit", code)
+	}
+}
+
+func TestSuperviseLongRunningNoReport(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+	var stdout, stderr bytes.Buffer
+	code, err := supervise(sh, []string{"sh", "-c", "echo hi; exit 0"}, os.Environ(), 0, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("supervise error: %v", err)
+	}
+	if code != 0 {
+		t.Fatalf("exit code = %d, want 0", code)
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("expected no crash report outside the crash window, got:\n%s", stderr.String())
+	}
+	if stdout.String() != "hi\n" {
+		t.Fatalf("stdout = %q, want %q", stdout.String(), "hi\n")
+	}
+}
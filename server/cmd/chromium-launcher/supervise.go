@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// crashTailBytes is how much of the child's stderr is retained for crash reports.
+const crashTailBytes = 16 * 1024
+
+// tailBuffer is an io.Writer that keeps only the last max bytes written to it.
+type tailBuffer struct {
+	mu  sync.Mutex
+	max int
+	buf []byte
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.buf = append(t.buf, p...)
+	if over := len(t.buf) - t.max; over > 0 {
+		t.buf = append(t.buf[:0], t.buf[over:]...)
+	}
+	return len(p), nil
+}
+
+func (t *tailBuffer) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return string(t.buf)
+}
+
+// supervise starts path as a child process, forwards termination signals to it and waits
+// for it to exit. Output is streamed to stdout/stderr as usual. If the child exits within
+// crashWindow of starting, the tail of its stderr and its exit status are re-logged to
+// stderr so boot-time failures are easy to spot. It returns the exit code to propagate.
+func supervise(path string, argv, env []string, crashWindow time.Duration, stdout, stderr io.Writer) (int, error) {
+	tail := &tailBuffer{max: crashTailBytes}
+	cmd := &exec.Cmd{
+		Path:   path,
+		Args:   argv,
+		Env:    env,
+		Stdin:  os.Stdin,
+		Stdout: stdout,
+		Stderr: io.MultiWriter(stderr, tail),
+	}
+
+	started := time.Now()
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP, syscall.SIGQUIT)
+	defer signal.Stop(sigs)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case sig := <-sigs:
+				_ = cmd.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := cmd.Wait()
+	elapsed := time.Since(started)
+
+	code := 0
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return 0, err
+		}
+		code = exitErr.ExitCode()
+		if code < 0 {
+			// Killed by a signal; mirror the shell convention of 128+signal.
+			if ws, ok := exitErr.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+				code = 128 + int(ws.Signal())
+			} else {
+				code = 1
+			}
+		}
+	}
+
+	if elapsed < crashWindow {
+		fmt.Fprintf(stderr, "chromium exited %s after start (%v, exit code %d)\n", elapsed.Round(time.Millisecond), cmd.ProcessState, code)
+		if out := tail.String(); out != "" {
+			fmt.Fprintf(stderr, "---- chromium stderr (last %d bytes) ----\n%s\n---- end chromium stderr ----\n", len(out), out)
+		}
+		if code == 0 {
+			// An immediate clean exit is still a failed launch from supervisord's point of view.
+			code = 1
+		}
+	}
+	return code, nil
+}
@@ -1,28 +1,179 @@
 package config
 
 import (
+	"compress/gzip"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/onkernel/kernel-images/server/lib/ptyio"
+	"github.com/onkernel/kernel-images/server/lib/recorder"
 )
 
-// Config holds all configuration for the server
+// Config holds all configuration for the server.
+//
+// Fields holding secrets must carry a `redact` tag so they are masked when the
+// config is logged: `redact:"true"` hides the whole value and `redact:"url"`
+// hides only the credentials and token-like query parameters of a URL.
+//
+// String fields tagged `file:"true"` can instead be read from the file named by the
+// variable with a _FILE suffix, e.g. TEE_K_URL_FILE, for config mounted as files such
+// as Docker secrets. The variable itself takes precedence when set.
 type Config struct {
 	// Server configuration
 	Port int `envconfig:"PORT" default:"10001"`
+	// Unix socket path for the API server to listen on instead of PORT, for sidecar
+	// deployments that shouldn't expose a TCP port. The proxies still listen on TCP.
+	ListenSocket string `envconfig:"LISTEN_SOCKET" default:""`
+	// PEM certificate and key for serving the API over TLS. Plaintext HTTP when unset, for
+	// deployments behind a TLS-terminating proxy. The files are reloaded when they change.
+	TLSCertFile string `envconfig:"TLS_CERT_FILE" default:""`
+	TLSKeyFile  string `envconfig:"TLS_KEY_FILE" default:""`
+	// Seconds to keep serving after SIGTERM while running recordings and proofs finish.
+	// New ones are refused with 503 and /readyz reports draining meanwhile.
+	DrainTimeoutSeconds int `envconfig:"DRAIN_TIMEOUT_SECONDS" default:"0"`
+	// Maximum size of API request bodies in MB; larger ones get a 413. 0 disables the limit.
+	MaxRequestBodyMB int `envconfig:"MAX_REQUEST_BODY_MB" default:"10"`
+	// Maximum size in MB of file and extension uploads, which are exempt from
+	// MAX_REQUEST_BODY_MB. 0 leaves them unlimited.
+	MaxUploadBodyMB int `envconfig:"MAX_UPLOAD_BODY_MB" default:"0"`
+	// HTTP server timeouts in seconds; 0 disables one. Header and idle timeouts apply to every
+	// server. Read and write timeouts apply to the API server only, as they would cut off the
+	// proxies' websockets; they are off by default because uploads, downloads and streams
+	// (logs, process output, fs watches) can legitimately run long.
+	HTTPReadHeaderTimeoutSeconds int `envconfig:"HTTP_READ_HEADER_TIMEOUT_SECONDS" default:"10"`
+	HTTPReadTimeoutSeconds       int `envconfig:"HTTP_READ_TIMEOUT_SECONDS" default:"0"`
+	HTTPWriteTimeoutSeconds      int `envconfig:"HTTP_WRITE_TIMEOUT_SECONDS" default:"0"`
+	HTTPIdleTimeoutSeconds       int `envconfig:"HTTP_IDLE_TIMEOUT_SECONDS" default:"120"`
 
 	// Recording configuration
 	FrameRate   int    `envconfig:"FRAME_RATE" default:"10"`
 	DisplayNum  int    `envconfig:"DISPLAY_NUM" default:"1"`
 	MaxSizeInMB int    `envconfig:"MAX_SIZE_MB" default:"500"`
 	OutputDir   string `envconfig:"OUTPUT_DIR" default:"."`
+	// What to do when OUTPUT_DIR can't be written to, which is checked at startup and
+	// before each recording starts: "warn" logs it at startup, "ready" also fails /readyz
+	// while it lasts, and "fail" exits at startup. Recordings are refused either way.
+	RecordingOutputDirCheck string `envconfig:"RECORDING_OUTPUT_DIR_CHECK" default:"warn"`
+	// Accept extraArgs in StartRecording, raw arguments passed through to ffmpeg. Off by
+	// default since they can change what ffmpeg reads and writes.
+	AllowFFmpegExtraArgs bool `envconfig:"ALLOW_FFMPEG_EXTRA_ARGS" default:"false"`
+	// Directory for intermediate files such as remuxed recordings before they replace the
+	// originals, so OUTPUT_DIR only holds finished recordings. Empty uses the system temp dir.
+	TempDir string `envconfig:"TMP_DIR"`
+	// Keep recordings as fragmented MP4 instead of remuxing them to a standard MP4 once
+	// ffmpeg exits. See recorder.FFmpegRecordingParams.Fragmented for the tradeoff.
+	RecordingFragmented bool `envconfig:"RECORDING_FRAGMENTED" default:"false"`
+	// How recordings capture frames unless a request says otherwise: "screen" grabs the
+	// X display, "screencast" records Chromium's CDP screencast (for headless environments).
+	RecordingMode string `envconfig:"RECORDING_MODE" default:"screen"`
+	// How screen recordings grab the display: "x11" (x11grab), "kmsgrab" (KMS/DRM),
+	// "pipewire" (Wayland) or "cdp", which records Chromium's CDP screencast instead.
+	CaptureBackend string `envconfig:"CAPTURE_BACKEND" default:"x11"`
+	// Drop near-duplicate frames from every recording with ffmpeg's mpdecimate filter, to
+	// shrink recordings of idle screens. Requests can also enable it with dropDuplicateFrames.
+	RecordingDropDuplicateFrames bool `envconfig:"RECORDING_DROP_DUPLICATE_FRAMES" default:"false"`
+	// mpdecimate thresholds deciding which frames count as duplicates; 0 keeps ffmpeg's
+	// defaults (hi=768, lo=320, frac=0.33). See recorder.DuplicateFrameThresholds.
+	RecordingDuplicateFrameHi   int     `envconfig:"RECORDING_DUPLICATE_FRAME_HI" default:"0"`
+	RecordingDuplicateFrameLo   int     `envconfig:"RECORDING_DUPLICATE_FRAME_LO" default:"0"`
+	RecordingDuplicateFrameFrac float64 `envconfig:"RECORDING_DUPLICATE_FRAME_FRAC" default:"0"`
+	// Maximum seconds between keyframes in recordings, for seeking; 0 leaves keyframe
+	// placement to the encoder. Requests can override it with keyframeIntervalSeconds.
+	RecordingKeyframeIntervalSeconds int `envconfig:"RECORDING_KEYFRAME_INTERVAL_SECONDS" default:"0"`
+	// Mark a recording unhealthy in GET /recordings when its file hasn't grown for this many
+	// seconds while ffmpeg is still running. 0 disables the check.
+	RecordingStallTimeoutSeconds int `envconfig:"RECORDING_STALL_TIMEOUT_SECONDS" default:"0"`
+	// Force-stop a recording once RECORDING_STALL_TIMEOUT_SECONDS marks it unhealthy.
+	RecordingStallForceStop bool `envconfig:"RECORDING_STALL_FORCE_STOP" default:"false"`
+	// Disk space in MB each tenant's recordings may use, counting the maximum size of its
+	// running recordings. Recordings without a tenant are not limited. 0 disables quotas.
+	RecordingTenantQuotaMB int `envconfig:"RECORDING_TENANT_QUOTA_MB" default:"0"`
+	// Maximum number of recordings running at once; further starts get a 429, or wait in
+	// the start queue when made with ?wait=true. 0 means no limit.
+	RecordingMaxConcurrent int `envconfig:"RECORDING_MAX_CONCURRENT" default:"0"`
+	// How many ?wait=true starts may wait for RECORDING_MAX_CONCURRENT at once, and for how
+	// many seconds each waits before giving up with a 503. A full queue gets a 429.
+	RecordingStartQueueDepth          int `envconfig:"RECORDING_START_QUEUE_DEPTH" default:"16"`
+	RecordingStartQueueTimeoutSeconds int `envconfig:"RECORDING_START_QUEUE_TIMEOUT_SECONDS" default:"60"`
+	// Minimum age, in seconds, of the orphaned recording and temp files POST /recording/cleanup
+	// removes, so files a recorder is about to claim are left alone.
+	RecordingCleanupMinAgeSeconds int `envconfig:"RECORDING_CLEANUP_MIN_AGE_SECONDS" default:"3600"`
+	// ID of the recording the recording endpoints use when a request names none. Instances
+	// sharing an OUTPUT_DIR need distinct ones. Alphanumeric or hyphen.
+	RecordingDefaultID string `envconfig:"RECORDING_DEFAULT_ID" default:"default"`
+	// Retry-After hint, in seconds, for downloads of a recording too new to have any content.
+	RecordingRetryAfterSeconds int `envconfig:"RECORDING_RETRY_AFTER_SECONDS" default:"300"`
+	// Retry-After hint, in seconds, for deletes refused while a recording is being finalized.
+	RecordingFinalizingRetryAfterSeconds int `envconfig:"RECORDING_FINALIZING_RETRY_AFTER_SECONDS" default:"5"`
+	// Retry-After hint, in seconds, for starts refused by RECORDING_MAX_CONCURRENT.
+	RecordingQueueRetryAfterSeconds int `envconfig:"RECORDING_QUEUE_RETRY_AFTER_SECONDS" default:"5"`
+	// Retry-After hint, in seconds, for GIF conversions still running.
+	RecordingConvertRetryAfterSeconds int `envconfig:"RECORDING_CONVERT_RETRY_AFTER_SECONDS" default:"5"`
+	// Expected display size, used when the X server cannot be queried for it (e.g. a headless
+	// Xvfb without xdpyinfo or xrandr). 0 leaves the size to detection.
+	DisplayWidth  int `envconfig:"DISPLAY_WIDTH" default:"0"`
+	DisplayHeight int `envconfig:"DISPLAY_HEIGHT" default:"0"`
+	DisplayDepth  int `envconfig:"DISPLAY_DEPTH" default:"0"`
+	// Comma-separated X displays, besides DISPLAY_NUM, that StartRecording may target with
+	// displayNum, for sessions that run a secondary display.
+	RecordingAllowedDisplays []int `envconfig:"RECORDING_ALLOWED_DISPLAYS" default:""`
+
+	// Root directory that all filesystem API paths are confined to.
+	FileRoot string `envconfig:"FILE_ROOT" default:"/home/kernel"`
+	// Directory uploaded extensions are installed in and served from under /extensions/.
+	ExtensionsDir string `envconfig:"EXTENSIONS_DIR" default:"/home/kernel/extensions"`
+	// Verify the signatures of .crx files before serving them to Chromium, refusing ones
+	// that fail instead of letting the browser reject them silently.
+	ExtensionsVerifyCRX bool `envconfig:"EXTENSIONS_VERIFY_CRX" default:"false"`
 
 	// Absolute or relative path to the ffmpeg binary. If empty the code falls back to "ffmpeg" on $PATH.
 	PathToFFmpeg string `envconfig:"FFMPEG_PATH" default:"ffmpeg"`
+	// ffmpeg -loglevel for recordings (quiet, panic, fatal, error, warning, info, verbose,
+	// debug or trace). Empty keeps ffmpeg's default.
+	FFmpegLogLevel string `envconfig:"FFMPEG_LOGLEVEL" default:""`
+	// When true, ffmpeg reports encoder stats (frames, fps, bitrate, speed) with -progress,
+	// included in recording progress events and status.
+	FFmpegProgress bool `envconfig:"FFMPEG_PROGRESS" default:"false"`
+	// Seconds StartRecording waits for ffmpeg to open its input and create the recording
+	// before killing it and failing, e.g. when the display can't be opened. 0 disables it.
+	FFmpegStartTimeoutSeconds int `envconfig:"FFMPEG_START_TIMEOUT_SECONDS" default:"10"`
 
 	// DevTools proxy configuration
 	DevToolsProxyPort int  `envconfig:"DEVTOOLS_PROXY_PORT" default:"9222"`
 	LogCDPMessages    bool `envconfig:"LOG_CDP_MESSAGES" default:"false"`
+	// Directory the internal CDP proxy writes each client session to, one JSON line per
+	// message. Empty disables capture.
+	CDPCaptureDir string `envconfig:"CDP_CAPTURE_DIR" default:""`
+	// Gzip capture files (*.cdp.gz) as they are written, at CDP_CAPTURE_GZIP_LEVEL (1-9).
+	CDPCaptureGzip      bool `envconfig:"CDP_CAPTURE_GZIP" default:"false"`
+	CDPCaptureGzipLevel int  `envconfig:"CDP_CAPTURE_GZIP_LEVEL" default:"6"`
+	// permessage-deflate mode offered to CDP clients and to Chromium: "disabled",
+	// "no_context_takeover" or "context_takeover". Compression trades CPU for bandwidth;
+	// context takeover compresses best but keeps a deflate window per connection.
+	DevToolsProxyCompression string `envconfig:"DEVTOOLS_PROXY_COMPRESSION" default:"context_takeover"`
+	// When true, CDP clients of each DevTools proxy share one upstream connection to
+	// Chromium, with responses and session events routed back to the client they belong to.
+	DevToolsProxyMultiplex bool `envconfig:"DEVTOOLS_PROXY_MULTIPLEX" default:"false"`
+	// When true, non-multiplexed CDP sessions survive a Chromium restart: their upstream leg
+	// is moved to the new browser instead of the client being closed.
+	DevToolsProxyReconnect bool `envconfig:"DEVTOOLS_PROXY_RECONNECT" default:"false"`
+	// Largest CDP message, in MB, the DevTools proxies accept from a client or Chromium.
+	// Bigger ones (e.g. screencast frames or DOM snapshots) close the connection.
+	DevToolsProxyMaxMessageMB int `envconfig:"DEVTOOLS_PROXY_MAX_MESSAGE_MB" default:"100"`
+	// How the proxies find Chromium's DevTools websocket URL: "log" tails CHROMIUM_LOG_PATH
+	// for the "DevTools listening on" line, "poll" polls /json/version on CHROMIUM_DEVTOOLS_ADDR.
+	DevToolsUpstreamDiscovery string `envconfig:"DEVTOOLS_UPSTREAM_DISCOVERY" default:"log"`
+	// Chromium's supervisord log, which must exist at startup when discovering from the log.
+	ChromiumLogPath string `envconfig:"CHROMIUM_LOG_PATH" default:"/var/log/supervisord/chromium"`
+	// Chromium's internal remote debugging address, polled when discovering by polling.
+	ChromiumDevToolsAddr string `envconfig:"CHROMIUM_DEVTOOLS_ADDR" default:"127.0.0.1:9223"`
 
 	// ChromeDriver proxy: external port where the proxy listens.
 	ChromeDriverProxyPort int `envconfig:"CHROMEDRIVER_PROXY_PORT" default:"9224"`
@@ -36,17 +187,92 @@ type Config struct {
 	// Note: Port 9222 is restricted CDP (filtered), port 9224 is WebDriver/BiDi, port 9226 is internal/full CDP
 
 	// Reclaim TEE configuration
-	TEEKUrl     string `envconfig:"TEE_K_URL" default:"wss://tk.reclaimprotocol.org/ws"`
-	TEETUrl     string `envconfig:"TEE_T_URL" default:"wss://tt.reclaimprotocol.org/ws"`
-	AttestorUrl string `envconfig:"ATTESTOR_URL" default:"wss://attestor.reclaimprotocol.org:444/ws"`
+	TEEKUrl     string `envconfig:"TEE_K_URL" default:"wss://tk.reclaimprotocol.org/ws" redact:"url" file:"true"`
+	TEETUrl     string `envconfig:"TEE_T_URL" default:"wss://tt.reclaimprotocol.org/ws" redact:"url" file:"true"`
+	AttestorUrl string `envconfig:"ATTESTOR_URL" default:"wss://attestor.reclaimprotocol.org:444/ws" redact:"url" file:"true"`
+
+	// When true, requests may raise or lower their own log level with an X-Log-Level header.
+	// Leave disabled in production so clients can't flood the logs.
+	AllowLogLevelHeader bool `envconfig:"ALLOW_LOG_LEVEL_HEADER" default:"false"`
+	// Number of recent server log entries kept in memory for GET /logs; 0 disables it.
+	// Each entry keeps at most ~4KB of attributes, bounding the buffer's memory use.
+	LogBufferLines int `envconfig:"LOG_BUFFER_LINES" default:"0"`
+	// Where to write the audit log of sensitive operations (Chromium flag, policy and
+	// extension changes, display changes, proofs): "stdout" or an absolute file path. Empty
+	// disables it.
+	AuditLog string `envconfig:"AUDIT_LOG" default:""`
+
+	// Bytes of PTY output buffered for a WebSocket attach client that reads slower than the
+	// process writes, at least 32768.
+	PTYAttachBufferBytes int `envconfig:"PTY_ATTACH_BUFFER_BYTES" default:"2097152"`
+	// What happens when that buffer is full: "block" stops reading from the PTY until the
+	// client catches up, stalling a process that keeps writing; "drop-oldest" discards the
+	// oldest buffered output instead.
+	PTYAttachBufferPolicy string `envconfig:"PTY_ATTACH_BUFFER_POLICY" default:"block"`
+
+	// Neko (WebRTC server) API used for session and screen management.
+	NekoURL           string `envconfig:"NEKO_URL" default:"http://127.0.0.1:8080"`
+	NekoAdminUsername string `envconfig:"NEKO_ADMIN_USERNAME" default:"admin"`
+	NekoAdminPassword string `envconfig:"NEKO_ADMIN_PASSWORD" default:"admin" redact:"true" file:"true"`
+	// The server logs in to Neko at startup and exits if authentication fails. Only images
+	// that run without Neko should turn this off.
+	NekoVerifyAuth bool `envconfig:"NEKO_VERIFY_AUTH" default:"true"`
+
+	// Maximum number of reclaim proofs executed concurrently. Further requests get a 429.
+	ReclaimMaxConcurrent int `envconfig:"RECLAIM_MAX_CONCURRENT" default:"4"`
+	// Number of admitted proofs that run the protocol, and so the memory-heavy ZK proving,
+	// at once. The others wait in a queue for a free worker, within their proof timeout.
+	// 0 runs every proof admitted by RECLAIM_MAX_CONCURRENT at once.
+	ReclaimProofWorkers int `envconfig:"RECLAIM_PROOF_WORKERS" default:"0"`
+	// Retry-After hint, in seconds, for proofs rejected by RECLAIM_MAX_CONCURRENT.
+	ReclaimRetryAfterSeconds int `envconfig:"RECLAIM_RETRY_AFTER_SECONDS" default:"5"`
+	// Proof timeouts in seconds for specific providers, as name:seconds pairs matched against
+	// the provider name in the request, e.g. "http:60,slow-bank:600". Others use 5 minutes.
+	ReclaimProviderTimeouts map[string]int `envconfig:"RECLAIM_PROVIDER_TIMEOUTS" default:""`
+	// Maximum size in KB of a proof request's provider_params_json, which is parsed and copied
+	// into the protocol client, independently of MAX_REQUEST_BODY_MB. 0 disables the limit.
+	ReclaimMaxProviderParamsKB int `envconfig:"RECLAIM_MAX_PROVIDER_PARAMS_KB" default:"1024"`
+	// Maximum size in KB of a whole proof request body, in place of MAX_REQUEST_BODY_MB for
+	// POST /reclaim/prove. Larger bodies get a 413 before they are decoded. 0 keeps
+	// MAX_REQUEST_BODY_MB.
+	ReclaimMaxBodyKB int `envconfig:"RECLAIM_MAX_BODY_KB" default:"2048"`
+	// Directory to load ZK circuit files (pk.*, r1cs.*) from instead of the embedded copies.
+	// Files must be listed in a SHA256SUMS manifest there; absent circuits use the embedded ones.
+	CircuitsDir string `envconfig:"CIRCUITS_DIR" default:""`
+	// Number of ZK circuits initialized at once at startup. 0 picks it from available memory
+	// (cgroup limit or /proc/meminfo), down to 1 (sequential) on small instances.
+	CircuitsInitParallelism int `envconfig:"CIRCUITS_INIT_PARALLELISM" default:"0"`
+	// When true, ReclaimProve returns 503 until the ZK circuits preloaded at startup are
+	// initialized, instead of attempting the proof and waiting on them mid-protocol.
+	ReclaimWaitForCircuits bool `envconfig:"RECLAIM_WAIT_FOR_CIRCUITS" default:"false"`
+	// Retry-After hint, in seconds, for proofs rejected while circuits are initializing.
+	ReclaimCircuitsRetryAfterSeconds int `envconfig:"RECLAIM_CIRCUITS_RETRY_AFTER_SECONDS" default:"10"`
+	// URL posted a JSON event once, when the circuits preloaded at startup have finished
+	// initializing, so orchestration can route proofs without polling. Empty disables it.
+	// Webhook URLs usually carry their secret in the path, so the whole URL is redacted.
+	CircuitsReadyWebhookURL string `envconfig:"CIRCUITS_READY_WEBHOOK_URL" default:"" redact:"true"`
+	// When true, ReclaimProve checks that the claim signature recovers to the attestor
+	// address in the result and returns 502 if not. Off for clients that verify downstream.
+	ReclaimVerifySignatures bool `envconfig:"RECLAIM_VERIFY_SIGNATURES" default:"false"`
+	// JSON-RPC endpoint and Reclaim verifier contract address that ReclaimProve checks claims
+	// against when a request sets verify_on_chain. Both or neither must be set. Providers
+	// often put their API key in the URL path, so the whole URL is redacted.
+	ReclaimChainRPCURL      string `envconfig:"RECLAIM_CHAIN_RPC_URL" redact:"true" file:"true"`
+	ReclaimVerifierContract string `envconfig:"RECLAIM_VERIFIER_CONTRACT"`
 }
 
+// contractAddressRegex matches a hex-encoded Ethereum address.
+var contractAddressRegex = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	var config Config
 	if err := envconfig.Process("", &config); err != nil {
 		return nil, err
 	}
+	if err := loadFileVars(&config); err != nil {
+		return nil, err
+	}
 	if config.DevToolsProxyAddr == "" {
 		config.DevToolsProxyAddr = fmt.Sprintf("127.0.0.1:%d", config.DevToolsProxyPort)
 	}
@@ -57,28 +283,307 @@ func Load() (*Config, error) {
 	return &config, nil
 }
 
+// loadFileVars sets each field tagged `file:"true"` whose variable is unset from the file
+// named by the variable's _FILE variant, if that is set. The file must exist and hold more
+// than whitespace; surrounding whitespace, such as a trailing newline, is trimmed.
+func loadFileVars(config *Config) error {
+	rv := reflect.ValueOf(config).Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		name := f.Tag.Get("envconfig")
+		if f.Tag.Get("file") != "true" || name == "" || f.Type.Kind() != reflect.String {
+			continue
+		}
+		if _, ok := os.LookupEnv(name); ok {
+			continue
+		}
+		path, ok := os.LookupEnv(name + "_FILE")
+		if !ok {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%s_FILE: %w", name, err)
+		}
+		value := strings.TrimSpace(string(data))
+		if value == "" {
+			return fmt.Errorf("%s_FILE: %s is empty", name, path)
+		}
+		rv.Field(i).SetString(value)
+	}
+	return nil
+}
+
 func validate(config *Config) error {
+	// sun_path is 108 bytes on Linux, including the terminating NUL
+	if len(config.ListenSocket) > 107 {
+		return fmt.Errorf("LISTEN_SOCKET must be at most 107 bytes")
+	}
+	if (config.TLSCertFile == "") != (config.TLSKeyFile == "") {
+		return fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if config.LogBufferLines < 0 || config.LogBufferLines > 10000 {
+		return fmt.Errorf("LOG_BUFFER_LINES must be between 0 and 10000")
+	}
+	if config.AuditLog != "" && config.AuditLog != "stdout" && !filepath.IsAbs(config.AuditLog) {
+		return fmt.Errorf("AUDIT_LOG must be \"stdout\" or an absolute path")
+	}
+	if config.DrainTimeoutSeconds < 0 {
+		return fmt.Errorf("DRAIN_TIMEOUT_SECONDS must not be negative")
+	}
+	if config.HTTPReadHeaderTimeoutSeconds < 0 || config.HTTPReadTimeoutSeconds < 0 ||
+		config.HTTPWriteTimeoutSeconds < 0 || config.HTTPIdleTimeoutSeconds < 0 {
+		return fmt.Errorf("HTTP_*_TIMEOUT_SECONDS must not be negative")
+	}
+	if config.MaxRequestBodyMB < 0 || config.MaxUploadBodyMB < 0 {
+		return fmt.Errorf("MAX_REQUEST_BODY_MB and MAX_UPLOAD_BODY_MB must not be negative")
+	}
 	if config.OutputDir == "" {
 		return fmt.Errorf("OUTPUT_DIR is required")
 	}
 	if config.DisplayNum < 0 {
 		return fmt.Errorf("DISPLAY_NUM must be greater than 0")
 	}
+	for _, d := range config.RecordingAllowedDisplays {
+		if d < 0 {
+			return fmt.Errorf("RECORDING_ALLOWED_DISPLAYS must not contain negative displays")
+		}
+	}
+	if config.DisplayWidth < 0 || config.DisplayHeight < 0 || config.DisplayDepth < 0 {
+		return fmt.Errorf("DISPLAY_WIDTH, DISPLAY_HEIGHT and DISPLAY_DEPTH must not be negative")
+	}
+	if (config.DisplayWidth == 0) != (config.DisplayHeight == 0) {
+		return fmt.Errorf("DISPLAY_WIDTH and DISPLAY_HEIGHT must be set together")
+	}
 	if config.FrameRate < 0 || config.FrameRate > 20 {
 		return fmt.Errorf("FRAME_RATE must be greater than 0 and less than or equal to 20")
 	}
+	if config.RecordingMode != "screen" && config.RecordingMode != "screencast" {
+		return fmt.Errorf("RECORDING_MODE must be screen or screencast")
+	}
+	switch recorder.CaptureBackend(config.CaptureBackend) {
+	case recorder.CaptureBackendX11, recorder.CaptureBackendKMS, recorder.CaptureBackendPipeWire, recorder.CaptureBackendCDP:
+	default:
+		return fmt.Errorf("CAPTURE_BACKEND must be x11, kmsgrab, pipewire or cdp")
+	}
+	if config.RecordingKeyframeIntervalSeconds < 0 {
+		return fmt.Errorf("RECORDING_KEYFRAME_INTERVAL_SECONDS must not be negative")
+	}
+	if config.RecordingStallTimeoutSeconds < 0 {
+		return fmt.Errorf("RECORDING_STALL_TIMEOUT_SECONDS must not be negative")
+	}
+	if config.CircuitsInitParallelism < 0 {
+		return fmt.Errorf("CIRCUITS_INIT_PARALLELISM must not be negative")
+	}
+	if config.RecordingTenantQuotaMB < 0 {
+		return fmt.Errorf("RECORDING_TENANT_QUOTA_MB must not be negative")
+	}
+	if config.RecordingCleanupMinAgeSeconds < 1 {
+		return fmt.Errorf("RECORDING_CLEANUP_MIN_AGE_SECONDS must be at least 1")
+	}
+	if !recorder.ValidID(config.RecordingDefaultID) {
+		return fmt.Errorf("RECORDING_DEFAULT_ID must be 1-64 letters, digits, hyphens or underscores")
+	}
 	if config.MaxSizeInMB < 0 || config.MaxSizeInMB > 1000 {
 		return fmt.Errorf("MAX_SIZE_MB must be greater than 0 and less than or equal to 1000")
 	}
+	if config.FileRoot == "" || !filepath.IsAbs(config.FileRoot) {
+		return fmt.Errorf("FILE_ROOT must be an absolute path")
+	}
+	if config.CDPCaptureDir != "" && !filepath.IsAbs(config.CDPCaptureDir) {
+		return fmt.Errorf("CDP_CAPTURE_DIR must be an absolute path")
+	}
+	if config.CDPCaptureGzipLevel < gzip.BestSpeed || config.CDPCaptureGzipLevel > gzip.BestCompression {
+		return fmt.Errorf("CDP_CAPTURE_GZIP_LEVEL must be between 1 and 9")
+	}
+	if config.ExtensionsDir == "" || !filepath.IsAbs(config.ExtensionsDir) {
+		return fmt.Errorf("EXTENSIONS_DIR must be an absolute path")
+	}
 	if config.PathToFFmpeg == "" {
 		return fmt.Errorf("FFMPEG_PATH is required")
 	}
+	if config.FFmpegStartTimeoutSeconds < 0 {
+		return fmt.Errorf("FFMPEG_START_TIMEOUT_SECONDS must not be negative")
+	}
+	switch config.FFmpegLogLevel {
+	case "", "quiet", "panic", "fatal", "error", "warning", "info", "verbose", "debug", "trace":
+	default:
+		return fmt.Errorf("FFMPEG_LOGLEVEL must be one of quiet, panic, fatal, error, warning, info, verbose, debug, trace")
+	}
 	if config.ChromeDriverUpstreamAddr == "" {
 		return fmt.Errorf("CHROMEDRIVER_UPSTREAM_ADDR is required")
 	}
 	if config.DevToolsProxyAddr == "" {
 		return fmt.Errorf("DEVTOOLS_PROXY_ADDR is required")
 	}
+	switch config.DevToolsProxyCompression {
+	case "disabled", "no_context_takeover", "context_takeover":
+	default:
+		return fmt.Errorf("DEVTOOLS_PROXY_COMPRESSION must be one of disabled, no_context_takeover, context_takeover")
+	}
+	if config.DevToolsProxyMaxMessageMB < 1 || config.DevToolsProxyMaxMessageMB > 1024 {
+		return fmt.Errorf("DEVTOOLS_PROXY_MAX_MESSAGE_MB must be between 1 and 1024")
+	}
+	switch config.DevToolsUpstreamDiscovery {
+	case "log":
+		if config.ChromiumLogPath == "" {
+			return fmt.Errorf("CHROMIUM_LOG_PATH is required when DEVTOOLS_UPSTREAM_DISCOVERY is log")
+		}
+	case "poll":
+		if config.ChromiumDevToolsAddr == "" {
+			return fmt.Errorf("CHROMIUM_DEVTOOLS_ADDR is required when DEVTOOLS_UPSTREAM_DISCOVERY is poll")
+		}
+	default:
+		return fmt.Errorf("DEVTOOLS_UPSTREAM_DISCOVERY must be log or poll")
+	}
+	if config.PTYAttachBufferBytes < ptyio.ReadBufferSize {
+		return fmt.Errorf("PTY_ATTACH_BUFFER_BYTES must be at least %d", ptyio.ReadBufferSize)
+	}
+	switch config.RecordingOutputDirCheck {
+	case "warn", "ready", "fail":
+	default:
+		return fmt.Errorf("RECORDING_OUTPUT_DIR_CHECK must be warn, ready or fail")
+	}
+	switch ptyio.OverflowPolicy(config.PTYAttachBufferPolicy) {
+	case ptyio.OverflowBlock, ptyio.OverflowDropOldest:
+	default:
+		return fmt.Errorf("PTY_ATTACH_BUFFER_POLICY must be block or drop-oldest")
+	}
+	if u, err := url.Parse(config.NekoURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("NEKO_URL must be an absolute http(s) URL")
+	}
+	if (config.ReclaimChainRPCURL == "") != (config.ReclaimVerifierContract == "") {
+		return fmt.Errorf("RECLAIM_CHAIN_RPC_URL and RECLAIM_VERIFIER_CONTRACT must be set together")
+	}
+	if config.ReclaimChainRPCURL != "" {
+		if u, err := url.Parse(config.ReclaimChainRPCURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("RECLAIM_CHAIN_RPC_URL must be an absolute http(s) URL")
+		}
+		if !contractAddressRegex.MatchString(config.ReclaimVerifierContract) {
+			return fmt.Errorf("RECLAIM_VERIFIER_CONTRACT must be a 0x-prefixed hex address")
+		}
+	}
+	if config.NekoAdminUsername == "" {
+		return fmt.Errorf("NEKO_ADMIN_USERNAME is required")
+	}
+	if config.ReclaimMaxConcurrent < 1 {
+		return fmt.Errorf("RECLAIM_MAX_CONCURRENT must be greater than 0")
+	}
+	if config.ReclaimProofWorkers < 0 || config.ReclaimProofWorkers > config.ReclaimMaxConcurrent {
+		return fmt.Errorf("RECLAIM_PROOF_WORKERS must be between 0 and RECLAIM_MAX_CONCURRENT")
+	}
+	for provider, seconds := range config.ReclaimProviderTimeouts {
+		if provider == "" || seconds < 1 {
+			return fmt.Errorf("RECLAIM_PROVIDER_TIMEOUTS entries must be provider:seconds with seconds greater than 0")
+		}
+	}
+	if config.ReclaimMaxProviderParamsKB < 0 || config.ReclaimMaxBodyKB < 0 {
+		return fmt.Errorf("RECLAIM_MAX_PROVIDER_PARAMS_KB and RECLAIM_MAX_BODY_KB must not be negative")
+	}
+	if config.RecordingMaxConcurrent < 0 || config.RecordingStartQueueDepth < 0 {
+		return fmt.Errorf("RECORDING_MAX_CONCURRENT and RECORDING_START_QUEUE_DEPTH must not be negative")
+	}
+	if config.RecordingStartQueueTimeoutSeconds < 1 {
+		return fmt.Errorf("RECORDING_START_QUEUE_TIMEOUT_SECONDS must be greater than 0")
+	}
+	if config.RecordingRetryAfterSeconds < 1 || config.RecordingFinalizingRetryAfterSeconds < 1 ||
+		config.RecordingQueueRetryAfterSeconds < 1 || config.RecordingConvertRetryAfterSeconds < 1 ||
+		config.ReclaimRetryAfterSeconds < 1 || config.ReclaimCircuitsRetryAfterSeconds < 1 {
+		return fmt.Errorf("retry-after settings (*_RETRY_AFTER_SECONDS) must be greater than 0")
+	}
 
 	return nil
 }
+
+// LogValue implements slog.LogValuer so the config can be logged directly
+// without leaking fields tagged with `redact`.
+func (c Config) LogValue() slog.Value {
+	return redactedLogValue(c)
+}
+
+// Settings returns the configuration keyed by environment variable for GET /config.
+// Fields tagged `redact:"true"` are left out and URLs tagged `redact:"url"` are redacted.
+func (c Config) Settings() map[string]any {
+	settings := make(map[string]any)
+	redactedFields(c, func(f reflect.StructField, val any, secret bool) {
+		if name := f.Tag.Get("envconfig"); name != "" && !secret {
+			settings[name] = val
+		}
+	})
+	return settings
+}
+
+// redactedLogValue renders the exported fields of struct v as a slog group,
+// masking values according to each field's `redact` tag.
+func redactedLogValue(v any) slog.Value {
+	attrs := make([]slog.Attr, 0, reflect.TypeOf(v).NumField())
+	redactedFields(v, func(f reflect.StructField, val any, _ bool) {
+		attrs = append(attrs, slog.Any(f.Name, val))
+	})
+	return slog.GroupValue(attrs...)
+}
+
+// redactedFields calls fn with each exported field of struct v and its value, masked
+// according to the field's `redact` tag. secret is set for fields hidden entirely.
+func redactedFields(v any, fn func(f reflect.StructField, val any, secret bool)) {
+	rv := reflect.ValueOf(v)
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		val, secret := fv.Interface(), false
+		switch f.Tag.Get("redact") {
+		case "url":
+			if fv.Kind() == reflect.String {
+				val = RedactURL(fv.String())
+			} else if !fv.IsZero() {
+				val = redactedValue
+			}
+		case "true":
+			secret = true
+			if !fv.IsZero() {
+				val = redactedValue
+			}
+		}
+		fn(f, val, secret)
+	}
+}
+
+// redactedValue replaces secrets in log output.
+const redactedValue = "REDACTED"
+
+// sensitiveQueryKeys are query parameter name fragments whose values are masked by RedactURL.
+var sensitiveQueryKeys = []string{"token", "key", "secret", "password", "auth", "signature", "sig"}
+
+// RedactURL masks the userinfo and any token-like query parameter values of raw.
+// Values that do not parse as a URL are fully redacted unless empty.
+func RedactURL(raw string) string {
+	if raw == "" {
+		return ""
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redactedValue
+	}
+	if u.User != nil {
+		u.User = url.User(redactedValue)
+	}
+	if u.RawQuery != "" {
+		q := u.Query()
+		for k := range q {
+			lk := strings.ToLower(k)
+			for _, s := range sensitiveQueryKeys {
+				if strings.Contains(lk, s) {
+					q[k] = []string{redactedValue}
+					break
+				}
+			}
+		}
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
@@ -81,7 +81,7 @@ func TestZipTransferTiming(t *testing.T) {
 			float64(len(zipData))/float64(dirSize)*100)
 
 		// Upload to a different location
-		destPath := fmt.Sprintf("/tmp/upload-test-%d", i)
+		destPath := fmt.Sprintf("/home/kernel/upload-test-%d", i)
 		uploadStart := time.Now()
 		err = uploadZip(ctx, client, zipData, destPath)
 		uploadTime := time.Since(uploadStart)
@@ -317,7 +317,7 @@ func TestZstdTransferTiming(t *testing.T) {
 				float64(len(zstdData))/float64(dirSize)*100)
 
 			// Upload to a different location
-			destPath := fmt.Sprintf("/tmp/zstd-upload-%s-%d", level, i)
+			destPath := fmt.Sprintf("/home/kernel/zstd-upload-%s-%d", level, i)
 			uploadStart := time.Now()
 			err = uploadZstd(ctx, client, zstdData, destPath, 0)
 			uploadTime := time.Since(uploadStart)
@@ -461,7 +461,7 @@ func TestZipVsZstdComparison(t *testing.T) {
 			require.NoError(t, err)
 
 			start = time.Now()
-			err = uploadZip(ctx, client, zipData, fmt.Sprintf("/tmp/zip-test-%d", i))
+			err = uploadZip(ctx, client, zipData, fmt.Sprintf("/home/kernel/zip-test-%d", i))
 			uploadTime := time.Since(start).Milliseconds()
 			require.NoError(t, err)
 
@@ -487,7 +487,7 @@ func TestZipVsZstdComparison(t *testing.T) {
 			require.NoError(t, err)
 
 			start = time.Now()
-			err = uploadZstd(ctx, client, zstdData, fmt.Sprintf("/tmp/zstd-fastest-%d", i), 0)
+			err = uploadZstd(ctx, client, zstdData, fmt.Sprintf("/home/kernel/zstd-fastest-%d", i), 0)
 			uploadTime := time.Since(start).Milliseconds()
 			require.NoError(t, err)
 
@@ -513,7 +513,7 @@ func TestZipVsZstdComparison(t *testing.T) {
 			require.NoError(t, err)
 
 			start = time.Now()
-			err = uploadZstd(ctx, client, zstdData, fmt.Sprintf("/tmp/zstd-default-%d", i), 0)
+			err = uploadZstd(ctx, client, zstdData, fmt.Sprintf("/home/kernel/zstd-default-%d", i), 0)
 			uploadTime := time.Since(start).Milliseconds()
 			require.NoError(t, err)
 
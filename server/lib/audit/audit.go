@@ -0,0 +1,188 @@
+// Package audit records sensitive API operations to a JSON log kept apart from the
+// server's debug logs.
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+)
+
+// Actions maps the audited API operations, as "METHOD /path", to their action names.
+// Chromium flags and policies carry the browser's proxy settings.
+var Actions = map[string]string{
+	"PATCH /chromium/flags":                        "chromium.flags.update",
+	"PATCH /chromium/policies":                     "chromium.policies.update",
+	"POST /chromium/upload-extensions-and-restart": "extensions.install",
+	"PATCH /display":                               "display.update",
+	"POST /reclaim/prove":                          "reclaim.prove",
+}
+
+// maxCapturedBody is how much of a JSON request body is kept for its parameters. Larger
+// bodies are logged by size only.
+const maxCapturedBody = 64 << 10
+
+// redacted replaces the values of sensitive parameters.
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are substrings of parameter names whose values are never logged.
+var sensitiveKeys = []string{"password", "secret", "token", "key", "cookie", "auth", "credential", "signature"}
+
+// urlPasswordRegex matches the password in URLs with userinfo, such as proxy servers in
+// Chromium flags.
+var urlPasswordRegex = regexp.MustCompile(`(://[^/@\s:]*):[^/@\s]*@`)
+
+// Open returns a JSON logger writing to dest: "stdout", or a file appended to and created
+// with mode 0600 if missing. The returned closer closes the file.
+func Open(dest string) (*slog.Logger, io.Closer, error) {
+	if dest == "stdout" {
+		return slog.New(slog.NewJSONHandler(os.Stdout, nil)), io.NopCloser(nil), nil
+	}
+	f, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return slog.New(slog.NewJSONHandler(f, nil)), f, nil
+}
+
+// Middleware writes an audit record to log for each request to an operation in actions,
+// once the handler has responded. Records carry the action, the actor, the request's
+// parameters with sensitive values redacted, and the outcome. The server has no API keys,
+// so the actor is the client's address.
+func Middleware(log *slog.Logger, actions map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			action, ok := actions[r.Method+" "+r.URL.Path]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body := &capture{limit: maxCapturedBody}
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.TeeReader(r.Body, body), r.Body}
+			}
+			ww := chiMiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			outcome := "success"
+			if status >= 400 {
+				outcome = "failure"
+			}
+			attrs := []any{
+				"action", action,
+				"actor", clientAddr(r),
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", status,
+				"outcome", outcome,
+				"duration_ms", time.Since(start).Milliseconds(),
+			}
+			if len(r.URL.Query()) > 0 {
+				query := make(map[string]any, len(r.URL.Query()))
+				for k, v := range r.URL.Query() {
+					query[k] = redactValue(k, strings.Join(v, ","))
+				}
+				attrs = append(attrs, "query", query)
+			}
+			attrs = append(attrs, body.attrs(r.Header.Get("Content-Type"))...)
+			log.Info("audit", attrs...)
+		})
+	}
+}
+
+// clientAddr is the host of the request's remote address.
+func clientAddr(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// capture keeps the first limit bytes written to it and counts the rest.
+type capture struct {
+	buf   bytes.Buffer
+	limit int
+	n     int64
+}
+
+func (c *capture) Write(p []byte) (int, error) {
+	if room := c.limit - c.buf.Len(); room > 0 {
+		c.buf.Write(p[:min(room, len(p))])
+	}
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// attrs describes the body the handler read: its redacted parameters when it is a JSON
+// body that fit in the capture, and its size otherwise.
+func (c *capture) attrs(contentType string) []any {
+	if c.n == 0 {
+		return nil
+	}
+	if c.n <= int64(c.limit) && strings.HasPrefix(contentType, "application/json") {
+		var params any
+		if err := json.Unmarshal(c.buf.Bytes(), &params); err == nil {
+			return []any{"params", redact(params)}
+		}
+	}
+	return []any{"body_bytes", c.n}
+}
+
+// redact returns v with the values of sensitive keys and URL passwords replaced, at any
+// depth. Strings that hold JSON objects, such as reclaim provider params, are redacted as
+// objects.
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			out[k] = redactValue(k, val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = redact(val)
+		}
+		return out
+	case string:
+		if strings.HasPrefix(strings.TrimSpace(v), "{") {
+			var nested map[string]any
+			if err := json.Unmarshal([]byte(v), &nested); err == nil {
+				return redact(nested)
+			}
+		}
+		return urlPasswordRegex.ReplaceAllString(v, "${1}:"+redacted+"@")
+	default:
+		return v
+	}
+}
+
+// redactValue redacts val entirely if key names a sensitive parameter.
+func redactValue(key string, val any) any {
+	lower := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(lower, s) {
+			return redacted
+		}
+	}
+	return redact(val)
+}
@@ -0,0 +1,62 @@
+// Package bodylimit caps the size of HTTP request bodies.
+package bodylimit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// Middleware limits request bodies to limit bytes, or to overrides[path] for requests to
+// the paths in overrides. A limit of 0 or less leaves bodies unlimited. Requests declaring
+// a larger Content-Length are rejected with 413 before reaching the handler; bodies that
+// turn out larger while being read fail with an *http.MaxBytesError, which handlers
+// report with RequestErrorHandler or WriteTooLarge.
+func Middleware(limit int64, overrides map[string]int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := limit
+			if o, ok := overrides[r.URL.Path]; ok {
+				n = o
+			}
+			if n <= 0 || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.ContentLength > n {
+				logger.FromContext(r.Context()).Warn("rejecting request body over limit", "path", r.URL.Path, "content_length", r.ContentLength, "limit", n)
+				WriteTooLarge(w, n)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, n)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// WriteTooLarge writes a 413 JSON error for a body over limit bytes.
+func WriteTooLarge(w http.ResponseWriter, limit int64) {
+	code := oapi.RequestTooLarge
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	_ = json.NewEncoder(w).Encode(oapi.Error{
+		Code:    &code,
+		Message: fmt.Sprintf("request body exceeds the limit of %d bytes", limit),
+	})
+}
+
+// RequestErrorHandler is a RequestErrorHandlerFunc for the generated strict handler: it
+// reports bodies cut off by Middleware with 413 and other request errors as the default
+// handler does, with a plain 400.
+func RequestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		WriteTooLarge(w, tooLarge.Limit)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
@@ -0,0 +1,84 @@
+package bodylimit
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readAll is a handler that reads the whole body, reporting read errors as the generated
+// strict handler does.
+var readAll = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if _, err := io.ReadAll(r.Body); err != nil {
+		RequestErrorHandler(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+})
+
+func serve(t *testing.T, h http.Handler, path, body string, chunked bool) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if chunked {
+		req.ContentLength = -1
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func assertTooLarge(t *testing.T, rec *httptest.ResponseRecorder) {
+	t.Helper()
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var body oapi.Error
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.NotNil(t, body.Code)
+	assert.Equal(t, oapi.RequestTooLarge, *body.Code)
+	assert.Contains(t, body.Message, "limit of 8 bytes")
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+	h := Middleware(8, map[string]int64{"/upload": 0, "/small": 4})(readAll)
+
+	t.Run("within limit", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(t, h, "/", "12345678", false).Code)
+	})
+
+	t.Run("content length over limit", func(t *testing.T) {
+		assertTooLarge(t, serve(t, h, "/", "123456789", false))
+	})
+
+	t.Run("chunked body over limit", func(t *testing.T) {
+		assertTooLarge(t, serve(t, h, "/", "123456789", true))
+	})
+
+	t.Run("unlimited override", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(t, h, "/upload", strings.Repeat("x", 1024), false).Code)
+	})
+
+	t.Run("lower override", func(t *testing.T) {
+		assert.Equal(t, http.StatusRequestEntityTooLarge, serve(t, h, "/small", "12345", false).Code)
+	})
+}
+
+func TestMiddlewareDisabled(t *testing.T) {
+	t.Parallel()
+	h := Middleware(0, nil)(readAll)
+	assert.Equal(t, http.StatusOK, serve(t, h, "/", strings.Repeat("x", 1024), true).Code)
+}
+
+func TestRequestErrorHandlerOtherErrors(t *testing.T) {
+	t.Parallel()
+	rec := httptest.NewRecorder()
+	RequestErrorHandler(rec, httptest.NewRequest(http.MethodPost, "/", nil), io.ErrUnexpectedEOF)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
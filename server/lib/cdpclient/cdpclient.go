@@ -19,15 +19,16 @@ type cdpRequest struct {
 type cdpResponse struct {
 	ID     int64           `json:"id"`
 	Result json.RawMessage `json:"result,omitempty"`
-	Error  *cdpError       `json:"error,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
 }
 
-type cdpError struct {
+// Error is an error the browser returned in reply to a CDP command.
+type Error struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 }
 
-func (e *cdpError) Error() string {
+func (e *Error) Error() string {
 	return fmt.Sprintf("CDP error %d: %s", e.Code, e.Message)
 }
 
@@ -57,25 +58,9 @@ func (c *Client) Close() error {
 // any intermediate events. This is safe for short-lived connections where the
 // caller controls the full message sequence.
 func (c *Client) send(ctx context.Context, method string, params any, sessionID string) (json.RawMessage, error) {
-	id := c.nextID.Add(1)
-
-	var rawParams json.RawMessage
-	if params != nil {
-		b, err := json.Marshal(params)
-		if err != nil {
-			return nil, fmt.Errorf("marshal params: %w", err)
-		}
-		rawParams = b
-	}
-
-	req := cdpRequest{ID: id, Method: method, Params: rawParams, SessionID: sessionID}
-	reqBytes, err := json.Marshal(req)
+	id, err := c.write(ctx, method, params, sessionID)
 	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
-	}
-
-	if err := c.conn.Write(ctx, websocket.MessageText, reqBytes); err != nil {
-		return nil, fmt.Errorf("write: %w", err)
+		return nil, err
 	}
 
 	for {
@@ -98,64 +83,437 @@ func (c *Client) send(ctx context.Context, method string, params any, sessionID
 	}
 }
 
+// write sends a CDP command without waiting for its response and returns its ID.
+func (c *Client) write(ctx context.Context, method string, params any, sessionID string) (int64, error) {
+	id := c.nextID.Add(1)
+
+	var rawParams json.RawMessage
+	if params != nil {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return 0, fmt.Errorf("marshal params: %w", err)
+		}
+		rawParams = b
+	}
+
+	req := cdpRequest{ID: id, Method: method, Params: rawParams, SessionID: sessionID}
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+
+	if err := c.conn.Write(ctx, websocket.MessageText, reqBytes); err != nil {
+		return 0, fmt.Errorf("write: %w", err)
+	}
+	return id, nil
+}
+
+// DeviceMetrics are the viewport parameters of Emulation.setDeviceMetricsOverride.
+type DeviceMetrics struct {
+	Width             int     `json:"width"`
+	Height            int     `json:"height"`
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	Mobile            bool    `json:"mobile"`
+}
+
 // SetDeviceMetricsOverride sets the viewport dimensions on the first page
-// target found in the browser. It attaches to the target with a flattened
-// session, sends Emulation.setDeviceMetricsOverride, then detaches.
+// target found in the browser, at a device scale factor of 1.
 func (c *Client) SetDeviceMetricsOverride(ctx context.Context, width, height int) error {
+	return c.SetDeviceMetrics(ctx, DeviceMetrics{Width: width, Height: height, DeviceScaleFactor: 1})
+}
+
+// SetDeviceMetrics applies m to the first page target found in the browser.
+// It attaches to the target with a flattened session, sends
+// Emulation.setDeviceMetricsOverride, then detaches.
+func (c *Client) SetDeviceMetrics(ctx context.Context, m DeviceMetrics) error {
+	pageTargetID, err := c.firstPageTarget(ctx)
+	if err != nil {
+		return err
+	}
+	if pageTargetID == "" {
+		return fmt.Errorf("no page target found")
+	}
+
+	sessionID, err := c.Attach(ctx, pageTargetID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.send(ctx, "Emulation.setDeviceMetricsOverride", m, sessionID)
+	if err != nil {
+		return fmt.Errorf("Emulation.setDeviceMetricsOverride: %w", err)
+	}
+
+	_, _ = c.send(ctx, "Target.detachFromTarget", map[string]any{
+		"sessionId": sessionID,
+	}, "")
+
+	return nil
+}
+
+// Geolocation is the position set by Emulation.setGeolocationOverride.
+type Geolocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Accuracy  float64 `json:"accuracy"`
+}
+
+// Emulation holds the overrides applied by SetEmulation. A nil Geolocation or an
+// empty Timezone or Locale leaves that override alone.
+type Emulation struct {
+	Geolocation *Geolocation
+	Timezone    string
+	Locale      string
+}
+
+// SetEmulation applies e to the first page target found in the browser, sending
+// Emulation.setGeolocationOverride, Emulation.setTimezoneOverride and
+// Emulation.setLocaleOverride on one flattened session.
+func (c *Client) SetEmulation(ctx context.Context, e Emulation) error {
+	pageTargetID, err := c.firstPageTarget(ctx)
+	if err != nil {
+		return err
+	}
+	if pageTargetID == "" {
+		return fmt.Errorf("no page target found")
+	}
+
+	sessionID, err := c.Attach(ctx, pageTargetID)
+	if err != nil {
+		return err
+	}
+	defer c.detach(ctx, sessionID)
+
+	if e.Geolocation != nil {
+		if _, err := c.send(ctx, "Emulation.setGeolocationOverride", e.Geolocation, sessionID); err != nil {
+			return fmt.Errorf("Emulation.setGeolocationOverride: %w", err)
+		}
+	}
+	if e.Timezone != "" {
+		if _, err := c.send(ctx, "Emulation.setTimezoneOverride", map[string]any{
+			"timezoneId": e.Timezone,
+		}, sessionID); err != nil {
+			return fmt.Errorf("Emulation.setTimezoneOverride: %w", err)
+		}
+	}
+	if e.Locale != "" {
+		if _, err := c.send(ctx, "Emulation.setLocaleOverride", map[string]any{
+			"locale": e.Locale,
+		}, sessionID); err != nil {
+			return fmt.Errorf("Emulation.setLocaleOverride: %w", err)
+		}
+	}
+	return nil
+}
+
+// SetWindowSize resizes the browser window holding the first page target to
+// width x height. A maximized or fullscreen window is restored to its normal
+// state first, since Chromium refuses to resize it otherwise.
+func (c *Client) SetWindowSize(ctx context.Context, width, height int) error {
+	pageTargetID, err := c.firstPageTarget(ctx)
+	if err != nil {
+		return err
+	}
+	if pageTargetID == "" {
+		return fmt.Errorf("no page target found")
+	}
+
+	windowResult, err := c.send(ctx, "Browser.getWindowForTarget", map[string]any{
+		"targetId": pageTargetID,
+	}, "")
+	if err != nil {
+		return fmt.Errorf("Browser.getWindowForTarget: %w", err)
+	}
+	var window struct {
+		WindowID int `json:"windowId"`
+		Bounds   struct {
+			WindowState string `json:"windowState"`
+		} `json:"bounds"`
+	}
+	if err := json.Unmarshal(windowResult, &window); err != nil {
+		return fmt.Errorf("unmarshal window: %w", err)
+	}
+
+	if window.Bounds.WindowState != "" && window.Bounds.WindowState != "normal" {
+		_, err = c.send(ctx, "Browser.setWindowBounds", map[string]any{
+			"windowId": window.WindowID,
+			"bounds":   map[string]any{"windowState": "normal"},
+		}, "")
+		if err != nil {
+			return fmt.Errorf("Browser.setWindowBounds: %w", err)
+		}
+	}
+
+	_, err = c.send(ctx, "Browser.setWindowBounds", map[string]any{
+		"windowId": window.WindowID,
+		"bounds":   map[string]any{"width": width, "height": height},
+	}, "")
+	if err != nil {
+		return fmt.Errorf("Browser.setWindowBounds: %w", err)
+	}
+	return nil
+}
+
+// BrowserVersion returns the browser's product string, e.g. "Chrome/131.0.6778.85".
+// It doubles as a liveness check since it needs a reply from the browser process.
+func (c *Client) BrowserVersion(ctx context.Context) (string, error) {
+	result, err := c.send(ctx, "Browser.getVersion", nil, "")
+	if err != nil {
+		return "", fmt.Errorf("Browser.getVersion: %w", err)
+	}
+	var version struct {
+		Product string `json:"product"`
+	}
+	if err := json.Unmarshal(result, &version); err != nil {
+		return "", fmt.Errorf("unmarshal version: %w", err)
+	}
+	return version.Product, nil
+}
+
+// EnsurePageTarget returns the ID of the first page target in the browser,
+// opening about:blank when there is none. created reports whether a target
+// was opened, so repeated calls reuse the same page.
+func (c *Client) EnsurePageTarget(ctx context.Context) (targetID string, created bool, err error) {
+	targetID, err = c.firstPageTarget(ctx)
+	if err != nil || targetID != "" {
+		return targetID, false, err
+	}
+
+	createResult, err := c.send(ctx, "Target.createTarget", map[string]any{
+		"url": "about:blank",
+	}, "")
+	if err != nil {
+		return "", false, fmt.Errorf("Target.createTarget: %w", err)
+	}
+
+	var target struct {
+		TargetID string `json:"targetId"`
+	}
+	if err := json.Unmarshal(createResult, &target); err != nil {
+		return "", false, fmt.Errorf("unmarshal created target: %w", err)
+	}
+	return target.TargetID, true, nil
+}
+
+// TargetInfo describes a browser target as reported by Target.getTargets.
+type TargetInfo struct {
+	TargetID string `json:"targetId"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	// Attached reports whether a DevTools client is attached to the target.
+	Attached bool   `json:"attached"`
+	OpenerID string `json:"openerId,omitempty"`
+}
+
+// Targets lists the browser's targets.
+func (c *Client) Targets(ctx context.Context) ([]TargetInfo, error) {
 	targetsResult, err := c.send(ctx, "Target.getTargets", nil, "")
 	if err != nil {
-		return fmt.Errorf("Target.getTargets: %w", err)
+		return nil, fmt.Errorf("Target.getTargets: %w", err)
 	}
 
 	var targets struct {
-		TargetInfos []struct {
-			TargetID string `json:"targetId"`
-			Type     string `json:"type"`
-		} `json:"targetInfos"`
+		TargetInfos []TargetInfo `json:"targetInfos"`
 	}
 	if err := json.Unmarshal(targetsResult, &targets); err != nil {
-		return fmt.Errorf("unmarshal targets: %w", err)
+		return nil, fmt.Errorf("unmarshal targets: %w", err)
+	}
+	return targets.TargetInfos, nil
+}
+
+// firstPageTarget returns the ID of the first page target, or "" if there is none.
+func (c *Client) firstPageTarget(ctx context.Context) (string, error) {
+	targets, err := c.Targets(ctx)
+	if err != nil {
+		return "", err
 	}
 
-	var pageTargetID string
-	for _, t := range targets.TargetInfos {
+	for _, t := range targets {
 		if t.Type == "page" {
-			pageTargetID = t.TargetID
-			break
+			return t.TargetID, nil
 		}
 	}
-	if pageTargetID == "" {
-		return fmt.Errorf("no page target found")
+	return "", nil
+}
+
+// Send sends method with the given raw JSON params, on sessionID when it is not
+// empty, and returns the raw result. Errors reported by the browser are *Error.
+func (c *Client) Send(ctx context.Context, method string, params json.RawMessage, sessionID string) (json.RawMessage, error) {
+	if len(params) == 0 {
+		return c.send(ctx, method, nil, sessionID)
 	}
+	return c.send(ctx, method, params, sessionID)
+}
 
+// Attach attaches to targetID with a flattened session and returns the session ID.
+func (c *Client) Attach(ctx context.Context, targetID string) (string, error) {
 	attachResult, err := c.send(ctx, "Target.attachToTarget", map[string]any{
-		"targetId": pageTargetID,
+		"targetId": targetID,
 		"flatten":  true,
 	}, "")
 	if err != nil {
-		return fmt.Errorf("Target.attachToTarget: %w", err)
+		return "", fmt.Errorf("Target.attachToTarget: %w", err)
 	}
 
 	var attach struct {
 		SessionID string `json:"sessionId"`
 	}
 	if err := json.Unmarshal(attachResult, &attach); err != nil {
-		return fmt.Errorf("unmarshal attach: %w", err)
+		return "", fmt.Errorf("unmarshal attach: %w", err)
 	}
+	return attach.SessionID, nil
+}
 
-	_, err = c.send(ctx, "Emulation.setDeviceMetricsOverride", map[string]any{
-		"width":            width,
-		"height":           height,
-		"deviceScaleFactor": 1,
-		"mobile":           false,
-	}, attach.SessionID)
+// Cookie is a browser cookie as reported by Network.getAllCookies.
+type Cookie struct {
+	Name     string  `json:"name"`
+	Value    string  `json:"value"`
+	Domain   string  `json:"domain"`
+	Path     string  `json:"path"`
+	Expires  float64 `json:"expires"` // seconds since the epoch; -1 for session cookies
+	HTTPOnly bool    `json:"httpOnly"`
+	Secure   bool    `json:"secure"`
+	Session  bool    `json:"session"`
+	SameSite string  `json:"sameSite,omitempty"`
+}
+
+// CookieParam is a cookie to set with Network.setCookie. Either URL or Domain
+// must be given.
+type CookieParam struct {
+	Name     string  `json:"name"`
+	Value    string  `json:"value"`
+	URL      string  `json:"url,omitempty"`
+	Domain   string  `json:"domain,omitempty"`
+	Path     string  `json:"path,omitempty"`
+	Secure   bool    `json:"secure,omitempty"`
+	HTTPOnly bool    `json:"httpOnly,omitempty"`
+	SameSite string  `json:"sameSite,omitempty"`
+	Expires  float64 `json:"expires,omitempty"`
+}
+
+// Cookies returns all browser cookies. The Network domain is only available on
+// page sessions, so it attaches to the first page target, opening one if needed.
+func (c *Client) Cookies(ctx context.Context) ([]Cookie, error) {
+	sessionID, err := c.attachPage(ctx)
 	if err != nil {
-		return fmt.Errorf("Emulation.setDeviceMetricsOverride: %w", err)
+		return nil, err
+	}
+	defer c.detach(ctx, sessionID)
+
+	result, err := c.send(ctx, "Network.getAllCookies", nil, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("Network.getAllCookies: %w", err)
+	}
+	var cookies struct {
+		Cookies []Cookie `json:"cookies"`
+	}
+	if err := json.Unmarshal(result, &cookies); err != nil {
+		return nil, fmt.Errorf("unmarshal cookies: %w", err)
+	}
+	return cookies.Cookies, nil
+}
+
+// SetCookies sets each cookie with its own Network.setCookie call, since
+// Network.setCookies rejects the whole batch when one cookie is invalid. The
+// returned slice holds each cookie's error, nil for those that were set; the
+// error is non-nil only when no cookie could be attempted.
+func (c *Client) SetCookies(ctx context.Context, cookies []CookieParam) ([]error, error) {
+	sessionID, err := c.attachPage(ctx)
+	if err != nil {
+		return nil, err
 	}
+	defer c.detach(ctx, sessionID)
+
+	errs := make([]error, len(cookies))
+	for i, cookie := range cookies {
+		result, err := c.send(ctx, "Network.setCookie", cookie, sessionID)
+		if err != nil {
+			errs[i] = fmt.Errorf("Network.setCookie: %w", err)
+			continue
+		}
+		// older Chromium versions report rejected cookies with success=false
+		var set struct {
+			Success *bool `json:"success"`
+		}
+		if json.Unmarshal(result, &set) == nil && set.Success != nil && !*set.Success {
+			errs[i] = fmt.Errorf("cookie rejected by browser")
+		}
+	}
+	return errs, nil
+}
+
+// attachPage attaches to the first page target, opening one if there is none,
+// and returns the session ID.
+func (c *Client) attachPage(ctx context.Context) (string, error) {
+	targetID, _, err := c.EnsurePageTarget(ctx)
+	if err != nil {
+		return "", err
+	}
+	return c.Attach(ctx, targetID)
+}
 
+// detach ends a session opened by attach, ignoring errors since the
+// connection is about to be closed anyway.
+func (c *Client) detach(ctx context.Context, sessionID string) {
 	_, _ = c.send(ctx, "Target.detachFromTarget", map[string]any{
-		"sessionId": attach.SessionID,
+		"sessionId": sessionID,
 	}, "")
+}
 
-	return nil
+// Screencast streams the screencast of the first page target (opening one if
+// there is none), calling onFrame with each decoded JPEG frame until ctx is done
+// or the connection fails. Each frame is acknowledged once onFrame returns, since
+// Chromium sends the next frame only after the previous one is acknowledged.
+func (c *Client) Screencast(ctx context.Context, onFrame func(frame []byte)) error {
+	targetID, _, err := c.EnsurePageTarget(ctx)
+	if err != nil {
+		return err
+	}
+	sessionID, err := c.Attach(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	// Frames may arrive before the response to startScreencast, so read the
+	// response and the events in one loop rather than through send.
+	startID, err := c.write(ctx, "Page.startScreencast", map[string]any{
+		"format":  "jpeg",
+		"quality": 80,
+	}, sessionID)
+	if err != nil {
+		return fmt.Errorf("Page.startScreencast: %w", err)
+	}
+
+	for {
+		_, msg, err := c.conn.Read(ctx)
+		if err != nil {
+			return fmt.Errorf("read: %w", err)
+		}
+
+		var m struct {
+			ID     int64  `json:"id"`
+			Method string `json:"method"`
+			Error  *Error `json:"error"`
+			Params struct {
+				Data      []byte `json:"data"`
+				SessionID int64  `json:"sessionId"`
+			} `json:"params"`
+		}
+		if err := json.Unmarshal(msg, &m); err != nil {
+			continue // skip malformed messages
+		}
+		if m.ID == startID && m.Error != nil {
+			return fmt.Errorf("Page.startScreencast: %w", m.Error)
+		}
+		if m.Method != "Page.screencastFrame" {
+			continue
+		}
+		onFrame(m.Params.Data)
+		if _, err := c.write(ctx, "Page.screencastFrameAck", map[string]any{
+			"sessionId": m.Params.SessionID,
+		}, sessionID); err != nil {
+			return fmt.Errorf("Page.screencastFrameAck: %w", err)
+		}
+	}
 }
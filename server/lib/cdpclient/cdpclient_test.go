@@ -3,6 +3,7 @@ package cdpclient
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -22,12 +23,19 @@ type fakeCDP struct {
 	setMetricsCalled     bool
 	setMetricsWidth      int
 	setMetricsHeight     int
+	setMetricsScale      float64
+	setMetricsMobile     bool
+	windowState          string
+	windowBounds         []map[string]any
+	emulation            map[string]json.RawMessage
+	cookies              []map[string]any
 	detachCalled         bool
 	pageTargetID         string
 	sessionID            string
 	failGetTargets       bool
 	failSetMetrics       bool
 	returnNoPageTargets  bool
+	createCalls          int
 }
 
 func (f *fakeCDP) handler(w http.ResponseWriter, r *http.Request) {
@@ -50,13 +58,13 @@ func (f *fakeCDP) handler(w http.ResponseWriter, r *http.Request) {
 		}
 
 		var result any
-		var cdpErr *cdpError
+		var cdpErr *Error
 
 		switch req.Method {
 		case "Target.getTargets":
 			f.getTargetsCalled = true
 			if f.failGetTargets {
-				cdpErr = &cdpError{Code: -1, Message: "mock error"}
+				cdpErr = &Error{Code: -1, Message: "mock error"}
 			} else {
 				targets := []map[string]string{}
 				if !f.returnNoPageTargets {
@@ -73,14 +81,73 @@ func (f *fakeCDP) handler(w http.ResponseWriter, r *http.Request) {
 		case "Emulation.setDeviceMetricsOverride":
 			f.setMetricsCalled = true
 			if f.failSetMetrics {
-				cdpErr = &cdpError{Code: -2, Message: "metrics error"}
+				cdpErr = &Error{Code: -2, Message: "metrics error"}
 			} else {
 				var params map[string]any
 				_ = json.Unmarshal(req.Params, &params)
 				f.setMetricsWidth = int(params["width"].(float64))
 				f.setMetricsHeight = int(params["height"].(float64))
+				f.setMetricsScale = params["deviceScaleFactor"].(float64)
+				f.setMetricsMobile = params["mobile"].(bool)
 				result = map[string]any{}
 			}
+		case "Emulation.setGeolocationOverride", "Emulation.setTimezoneOverride", "Emulation.setLocaleOverride":
+			if f.emulation == nil {
+				f.emulation = map[string]json.RawMessage{}
+			}
+			f.emulation[req.Method] = req.Params
+			result = map[string]any{}
+		case "Page.startScreencast":
+			// the first frame may arrive before the command's response
+			frame, _ := json.Marshal(map[string]any{
+				"method":    "Page.screencastFrame",
+				"sessionId": req.SessionID,
+				"params":    map[string]any{"data": []byte("frame-1"), "sessionId": 1},
+			})
+			_ = conn.Write(ctx, websocket.MessageText, frame)
+			result = map[string]any{}
+		case "Page.screencastFrameAck":
+			var params struct {
+				SessionID int `json:"sessionId"`
+			}
+			_ = json.Unmarshal(req.Params, &params)
+			next, _ := json.Marshal(map[string]any{
+				"method":    "Page.screencastFrame",
+				"sessionId": req.SessionID,
+				"params":    map[string]any{"data": []byte(fmt.Sprintf("frame-%d", params.SessionID+1)), "sessionId": params.SessionID + 1},
+			})
+			_ = conn.Write(ctx, websocket.MessageText, next)
+			continue
+		case "Browser.getWindowForTarget":
+			result = map[string]any{"windowId": 7, "bounds": map[string]any{"windowState": f.windowState}}
+		case "Browser.setWindowBounds":
+			var params struct {
+				WindowID int            `json:"windowId"`
+				Bounds   map[string]any `json:"bounds"`
+			}
+			_ = json.Unmarshal(req.Params, &params)
+			if params.WindowID == 7 {
+				f.windowBounds = append(f.windowBounds, params.Bounds)
+			}
+			result = map[string]any{}
+		case "Network.getAllCookies":
+			result = map[string]any{"cookies": f.cookies}
+		case "Network.setCookie":
+			var cookie map[string]any
+			_ = json.Unmarshal(req.Params, &cookie)
+			if cookie["name"] == "bad" {
+				cdpErr = &Error{Code: -32602, Message: "Invalid cookie fields"}
+			} else {
+				f.cookies = append(f.cookies, cookie)
+				result = map[string]any{}
+			}
+		case "Browser.getVersion":
+			result = map[string]string{"product": "Chrome/131.0.6778.85", "protocolVersion": "1.3"}
+		case "Target.createTarget":
+			f.createCalls++
+			f.returnNoPageTargets = false
+			f.pageTargetID = "created-target"
+			result = map[string]string{"targetId": f.pageTargetID}
 		case "Target.detachFromTarget":
 			f.detachCalled = true
 			result = map[string]any{}
@@ -194,6 +261,223 @@ func TestSetDeviceMetricsOverride(t *testing.T) {
 	})
 }
 
+func TestSetDeviceMetrics(t *testing.T) {
+	f := &fakeCDP{pageTargetID: "target-123", sessionID: "session-abc"}
+	url := startFakeCDP(t, f)
+
+	ctx := context.Background()
+	client, err := Dial(ctx, url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.SetDeviceMetrics(ctx, DeviceMetrics{Width: 390, Height: 844, DeviceScaleFactor: 3, Mobile: true}))
+	assert.Equal(t, 390, f.setMetricsWidth)
+	assert.Equal(t, 844, f.setMetricsHeight)
+	assert.Equal(t, 3.0, f.setMetricsScale)
+	assert.True(t, f.setMetricsMobile)
+	assert.True(t, f.detachCalled)
+}
+
+func TestSetEmulation(t *testing.T) {
+	t.Run("all overrides", func(t *testing.T) {
+		f := &fakeCDP{pageTargetID: "target-123", sessionID: "session-abc"}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.SetEmulation(ctx, Emulation{
+			Geolocation: &Geolocation{Latitude: 52.52, Longitude: 13.405, Accuracy: 10},
+			Timezone:    "Europe/Berlin",
+			Locale:      "de-DE",
+		}))
+		assert.JSONEq(t, `{"latitude":52.52,"longitude":13.405,"accuracy":10}`, string(f.emulation["Emulation.setGeolocationOverride"]))
+		assert.JSONEq(t, `{"timezoneId":"Europe/Berlin"}`, string(f.emulation["Emulation.setTimezoneOverride"]))
+		assert.JSONEq(t, `{"locale":"de-DE"}`, string(f.emulation["Emulation.setLocaleOverride"]))
+		assert.True(t, f.detachCalled)
+	})
+
+	t.Run("only requested overrides are sent", func(t *testing.T) {
+		f := &fakeCDP{pageTargetID: "target-123", sessionID: "session-abc"}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.SetEmulation(ctx, Emulation{Timezone: "Asia/Tokyo"}))
+		assert.Len(t, f.emulation, 1)
+		assert.Contains(t, f.emulation, "Emulation.setTimezoneOverride")
+	})
+}
+
+func TestSetWindowSize(t *testing.T) {
+	t.Run("normal window", func(t *testing.T) {
+		f := &fakeCDP{pageTargetID: "target-123", windowState: "normal"}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.SetWindowSize(ctx, 1280, 720))
+		require.Len(t, f.windowBounds, 1)
+		assert.Equal(t, map[string]any{"width": 1280.0, "height": 720.0}, f.windowBounds[0])
+	})
+
+	t.Run("maximized window is restored first", func(t *testing.T) {
+		f := &fakeCDP{pageTargetID: "target-123", windowState: "maximized"}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		require.NoError(t, client.SetWindowSize(ctx, 1280, 720))
+		require.Len(t, f.windowBounds, 2)
+		assert.Equal(t, map[string]any{"windowState": "normal"}, f.windowBounds[0])
+		assert.Equal(t, map[string]any{"width": 1280.0, "height": 720.0}, f.windowBounds[1])
+	})
+}
+
+func TestCookies(t *testing.T) {
+	f := &fakeCDP{pageTargetID: "target-123", sessionID: "session-abc"}
+	url := startFakeCDP(t, f)
+
+	ctx := context.Background()
+	client, err := Dial(ctx, url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	errs, err := client.SetCookies(ctx, []CookieParam{
+		{Name: "sid", Value: "abc", Domain: "example.com", Path: "/", HTTPOnly: true, Expires: 1893456000},
+		{Name: "bad", Value: "x", Domain: "example.com"},
+	})
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "Invalid cookie fields")
+	assert.True(t, f.detachCalled)
+
+	cookies, err := client.Cookies(ctx)
+	require.NoError(t, err)
+	require.Len(t, cookies, 1)
+	assert.Equal(t, Cookie{Name: "sid", Value: "abc", Domain: "example.com", Path: "/", HTTPOnly: true, Expires: 1893456000}, cookies[0])
+}
+
+func TestSend(t *testing.T) {
+	f := &fakeCDP{failGetTargets: true}
+	url := startFakeCDP(t, f)
+
+	ctx := context.Background()
+	client, err := Dial(ctx, url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	result, err := client.Send(ctx, "Browser.getVersion", nil, "")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"product":"Chrome/131.0.6778.85","protocolVersion":"1.3"}`, string(result))
+
+	_, err = client.Send(ctx, "Target.getTargets", json.RawMessage(`{}`), "")
+	var cdpErr *Error
+	require.ErrorAs(t, err, &cdpErr)
+	assert.Equal(t, -1, cdpErr.Code)
+	assert.Equal(t, "mock error", cdpErr.Message)
+}
+
+func TestBrowserVersion(t *testing.T) {
+	url := startFakeCDP(t, &fakeCDP{})
+
+	ctx := context.Background()
+	client, err := Dial(ctx, url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	version, err := client.BrowserVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Chrome/131.0.6778.85", version)
+}
+
+func TestEnsurePageTarget(t *testing.T) {
+	t.Run("reuses existing page", func(t *testing.T) {
+		f := &fakeCDP{pageTargetID: "target-123"}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		id, created, err := client.EnsurePageTarget(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "target-123", id)
+		assert.False(t, created)
+		assert.Equal(t, 0, f.createCalls)
+	})
+
+	t.Run("creates blank page once", func(t *testing.T) {
+		f := &fakeCDP{returnNoPageTargets: true}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		id, created, err := client.EnsurePageTarget(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "created-target", id)
+		assert.True(t, created)
+
+		id, created, err = client.EnsurePageTarget(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "created-target", id)
+		assert.False(t, created)
+		assert.Equal(t, 1, f.createCalls)
+	})
+
+	t.Run("getTargets failure", func(t *testing.T) {
+		f := &fakeCDP{failGetTargets: true}
+		url := startFakeCDP(t, f)
+
+		ctx := context.Background()
+		client, err := Dial(ctx, url)
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, _, err = client.EnsurePageTarget(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Target.getTargets")
+	})
+}
+
+func TestScreencast(t *testing.T) {
+	f := &fakeCDP{pageTargetID: "target-123", sessionID: "session-abc"}
+	url := startFakeCDP(t, f)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client, err := Dial(ctx, url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var frames []string
+	err = client.Screencast(ctx, func(frame []byte) {
+		frames = append(frames, string(frame))
+		if len(frames) == 3 {
+			cancel()
+		}
+	})
+	require.Error(t, err)
+	assert.Equal(t, []string{"frame-1", "frame-2", "frame-3"}, frames)
+	assert.True(t, f.attachCalled)
+}
+
 func TestDial(t *testing.T) {
 	t.Run("invalid URL", func(t *testing.T) {
 		ctx := context.Background()
@@ -3,8 +3,10 @@ package chromiumflags
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -115,6 +117,157 @@ func ReadOptionalFlagFile(path string) ([]string, error) {
 	return normalized, nil
 }
 
+// ReadFlagDir reads every *.flags file in dir (conf.d style) and returns their tokens
+// concatenated in lexical filename order. Each file uses the same JSON format as
+// ReadOptionalFlagFile. If the directory does not exist, it returns nil and a nil error.
+func ReadFlagDir(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// os.ReadDir returns entries sorted by filename
+	var tokens []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".flags" {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		fileTokens, err := ReadOptionalFlagFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		tokens = append(tokens, fileTokens...)
+	}
+	return tokens, nil
+}
+
+// maxExpandDepth bounds recursive expansion of variable values so that
+// self-referencing variables cannot loop forever.
+const maxExpandDepth = 8
+
+// ExpandEnv replaces ${VAR} references in each token with the value from the
+// process environment. See ExpandVars for the expansion rules. Only apply it to
+// operator-supplied tokens; expanding API-written flags would let callers read the
+// process environment back out of Chromium.
+func ExpandEnv(tokens []string, keepUndefined bool) []string {
+	return ExpandVars(tokens, os.LookupEnv, keepUndefined)
+}
+
+// ExpandVars replaces ${VAR} references in each token using lookup. Only the braced
+// form is recognised so that a bare '$' in a flag value is passed through untouched.
+// Values that themselves contain ${VAR} references are expanded recursively (up to
+// a fixed depth). Undefined variables expand to "" unless keepUndefined is set, in
+// which case the literal ${VAR} is preserved. Tokens that expand to nothing are dropped.
+func ExpandVars(tokens []string, lookup func(string) (string, bool), keepUndefined bool) []string {
+	out := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		if t := strings.TrimSpace(expandVars(tok, lookup, keepUndefined, 0)); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func expandVars(s string, lookup func(string) (string, bool), keepUndefined bool, depth int) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(s, "${")
+		if start < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		end := strings.IndexByte(s[start+2:], '}')
+		if end < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		end += start + 2
+		b.WriteString(s[:start])
+		name := s[start+2 : end]
+		ref := s[start : end+1]
+		switch val, ok := lookup(name); {
+		case !isVarName(name):
+			b.WriteString(ref)
+		case !ok:
+			if keepUndefined {
+				b.WriteString(ref)
+			}
+		case depth < maxExpandDepth:
+			b.WriteString(expandVars(val, lookup, keepUndefined, depth+1))
+		default:
+			b.WriteString(val)
+		}
+		s = s[end+1:]
+	}
+}
+
+// isVarName reports whether name is a valid shell-style variable name.
+func isVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// MergeNoteKind classifies a MergeNote.
+type MergeNoteKind string
+
+const (
+	// MergeNoteDuplicate means the token was dropped because an identical token appeared earlier.
+	MergeNoteDuplicate MergeNoteKind = "duplicate"
+	// MergeNoteOverride means the flag was set again with a different value; Chromium uses the last one.
+	MergeNoteOverride MergeNoteKind = "override"
+	// MergeNoteDropped means the token was removed by the extension override rules.
+	MergeNoteDropped MergeNoteKind = "dropped"
+	// MergeNoteRewritten means the token was folded into a different flag.
+	MergeNoteRewritten MergeNoteKind = "rewritten"
+)
+
+const (
+	sourceBase    = "base"
+	sourceRuntime = "runtime"
+)
+
+// MergeNote describes a single token that did not reach the final flags as written.
+type MergeNote struct {
+	Kind   MergeNoteKind
+	Token  string // the token as supplied
+	Source string // "base" or "runtime"
+	Winner string // the token that took effect instead, if any
+	Reason string
+}
+
+func (n MergeNote) String() string {
+	s := fmt.Sprintf("%s: %s (%s)", n.Kind, n.Token, n.Source)
+	if n.Winner != "" {
+		s += " -> " + n.Winner
+	}
+	if n.Reason != "" {
+		s += ": " + n.Reason
+	}
+	return s
+}
+
+// MergeReport collects diagnostics produced while merging base and runtime flags.
+type MergeReport struct {
+	Notes []MergeNote
+}
+
+func (r *MergeReport) add(n MergeNote) {
+	r.Notes = append(r.Notes, n)
+}
+
 // MergeFlags merges base flags with runtime flags, returning the final merged flags as a string.
 // The merging logic respects extension-related flag semantics:
 // 1) If runtime specifies --disable-extensions, it overrides everything extension related
@@ -130,6 +283,15 @@ func ReadOptionalFlagFile(path string) ([]string, error) {
 //
 // Non-extension flags from both base and runtime are combined with deduplication (first occurrence preserved).
 func MergeFlags(baseTokens, runtimeTokens []string) []string {
+	final, _ := MergeFlagsWithReport(baseTokens, runtimeTokens)
+	return final
+}
+
+// MergeFlagsWithReport behaves like MergeFlags and additionally reports which tokens were
+// deduplicated, overridden, dropped or rewritten along the way.
+func MergeFlagsWithReport(baseTokens, runtimeTokens []string) ([]string, MergeReport) {
+	var report MergeReport
+
 	// Buckets
 	var (
 		baseNonExt     []string // Non-extension related flags contained in base
@@ -158,38 +320,98 @@ func MergeFlags(baseTokens, runtimeTokens []string) []string {
 	var extFlags []string
 	if rtDisableAll != "" {
 		extFlags = append(extFlags, rtDisableAll)
+		reportExtensionTokens(&report, baseTokens, sourceBase, MergeNoteDropped, rtDisableAll, "runtime disables all extensions")
+		reportExtensionTokens(&report, runtimeTokens, sourceRuntime, MergeNoteDropped, rtDisableAll, "runtime disables all extensions")
 	} else {
 		if baseDisableAll != "" && len(rtLoad) == 0 && len(rtExcept) == 0 {
 			extFlags = append(extFlags, baseDisableAll)
+			reportExtensionTokens(&report, baseTokens, sourceBase, MergeNoteDropped, baseDisableAll, "base disables all extensions")
 		} else if len(mergedLoad) > 0 {
-			extFlags = append(extFlags, "--load-extension="+strings.Join(mergedLoad, ","))
+			loadFlag := "--load-extension=" + strings.Join(mergedLoad, ",")
+			extFlags = append(extFlags, loadFlag)
+			if baseDisableAll != "" {
+				report.add(MergeNote{Kind: MergeNoteDropped, Token: baseDisableAll, Source: sourceBase, Winner: loadFlag, Reason: "runtime loads extensions"})
+			}
+			reportExceptTokens(&report, baseTokens, sourceBase, loadFlag)
+			reportExceptTokens(&report, runtimeTokens, sourceRuntime, loadFlag)
 		}
 		// NOTE: --disable-extensions-except is intentionally NOT emitted here
 	}
 
 	// Combine and dedupe (preserving first occurrence)
-	combined := append(append([]string{}, baseNonExt...), runtimeNonExt...)
-	combined = append(combined, extFlags...)
-	seen := make(map[string]struct{}, len(combined))
+	type sourced struct{ tok, source string }
+	combined := make([]sourced, 0, len(baseNonExt)+len(runtimeNonExt)+len(extFlags))
+	for _, tok := range baseNonExt {
+		combined = append(combined, sourced{tok, sourceBase})
+	}
+	for _, tok := range runtimeNonExt {
+		combined = append(combined, sourced{tok, sourceRuntime})
+	}
+	for _, tok := range extFlags {
+		combined = append(combined, sourced{tok, "merged"})
+	}
+	seen := make(map[string]string, len(combined))
 	final := make([]string, 0, len(combined))
-	for _, tok := range combined {
-		if tok == "" {
+	for _, c := range combined {
+		if c.tok == "" {
 			continue
 		}
-		if _, ok := seen[tok]; ok {
+		if prev, ok := seen[c.tok]; ok {
+			report.add(MergeNote{Kind: MergeNoteDuplicate, Token: c.tok, Source: c.source, Reason: "already set by " + prev})
 			continue
 		}
-		seen[tok] = struct{}{}
-		final = append(final, tok)
+		seen[c.tok] = c.source
+		final = append(final, c.tok)
+	}
+
+	// Flags repeated with different values are all passed through; Chromium keeps the last one.
+	lastByName := make(map[string]string, len(final))
+	for _, tok := range final {
+		lastByName[flagName(tok)] = tok
+	}
+	for _, c := range combined {
+		winner := lastByName[flagName(c.tok)]
+		if c.tok == "" || winner == c.tok || seen[c.tok] != c.source {
+			continue
+		}
+		report.add(MergeNote{Kind: MergeNoteOverride, Token: c.tok, Source: c.source, Winner: winner, Reason: "Chromium uses the last value"})
+	}
+	return final, report
+}
+
+// flagName returns the portion of a token before any '=' (e.g. "--foo" for "--foo=1").
+func flagName(tok string) string {
+	name, _, _ := strings.Cut(tok, "=")
+	return name
+}
+
+// reportExtensionTokens records every extension-related token in tokens (other than winner) as kind.
+func reportExtensionTokens(report *MergeReport, tokens []string, source string, kind MergeNoteKind, winner, reason string) {
+	for _, tok := range tokens {
+		if tok == winner {
+			continue
+		}
+		if strings.HasPrefix(tok, "--load-extension=") || strings.HasPrefix(tok, "--disable-extensions-except=") || tok == "--disable-extensions" {
+			report.add(MergeNote{Kind: kind, Token: tok, Source: source, Winner: winner, Reason: reason})
+		}
+	}
+}
+
+// reportExceptTokens records --disable-extensions-except tokens folded into the merged --load-extension flag.
+func reportExceptTokens(report *MergeReport, tokens []string, source, loadFlag string) {
+	for _, tok := range tokens {
+		if strings.HasPrefix(tok, "--disable-extensions-except=") {
+			report.add(MergeNote{Kind: MergeNoteRewritten, Token: tok, Source: source, Winner: loadFlag, Reason: "--disable-extensions-except is not emitted"})
+		}
 	}
-	return final
 }
 
 // MergeFlagsWithRuntimeTokens merges base flags (string, e.g. from env CHROMIUM_FLAGS)
-// with runtime token slice and returns final tokens.
-func MergeFlagsWithRuntimeTokens(baseFlags string, runtimeTokens []string) []string {
+// with runtime token slice and returns final tokens along with a report of how
+// duplicate and conflicting flags were resolved.
+func MergeFlagsWithRuntimeTokens(baseFlags string, runtimeTokens []string) ([]string, MergeReport) {
 	base := parseFlags(baseFlags)
-	return MergeFlags(base, runtimeTokens)
+	return MergeFlagsWithReport(base, runtimeTokens)
 }
 
 // MergeExtensionPath appends an extension path to existing --load-extension flags
@@ -298,3 +298,214 @@ func TestMergeFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeFlagsWithReport(t *testing.T) {
+	tests := []struct {
+		name         string
+		baseFlags    []string
+		runtimeFlags []string
+		want         []string
+		wantNotes    []MergeNote
+	}{
+		{
+			name:         "no conflicts",
+			baseFlags:    []string{"--foo"},
+			runtimeFlags: []string{"--bar=1"},
+			want:         []string{"--foo", "--bar=1"},
+			wantNotes:    nil,
+		},
+		{
+			name:         "runtime duplicate of base is dropped, base position kept",
+			baseFlags:    []string{"--foo", "--bar=1"},
+			runtimeFlags: []string{"--baz", "--foo"},
+			want:         []string{"--foo", "--bar=1", "--baz"},
+			wantNotes: []MergeNote{
+				{Kind: MergeNoteDuplicate, Token: "--foo", Source: "runtime", Reason: "already set by base"},
+			},
+		},
+		{
+			name:         "duplicate within base",
+			baseFlags:    []string{"--foo", "--foo"},
+			runtimeFlags: nil,
+			want:         []string{"--foo"},
+			wantNotes: []MergeNote{
+				{Kind: MergeNoteDuplicate, Token: "--foo", Source: "base", Reason: "already set by base"},
+			},
+		},
+		{
+			name:         "runtime value overrides base value",
+			baseFlags:    []string{"--lang=en"},
+			runtimeFlags: []string{"--lang=de"},
+			want:         []string{"--lang=en", "--lang=de"},
+			wantNotes: []MergeNote{
+				{Kind: MergeNoteOverride, Token: "--lang=en", Source: "base", Winner: "--lang=de", Reason: "Chromium uses the last value"},
+			},
+		},
+		{
+			name:         "runtime disable-extensions drops load-extension",
+			baseFlags:    []string{"--load-extension=/e1"},
+			runtimeFlags: []string{"--disable-extensions"},
+			want:         []string{"--disable-extensions"},
+			wantNotes: []MergeNote{
+				{Kind: MergeNoteDropped, Token: "--load-extension=/e1", Source: "base", Winner: "--disable-extensions", Reason: "runtime disables all extensions"},
+			},
+		},
+		{
+			name:         "runtime load-extension drops base disable-extensions",
+			baseFlags:    []string{"--disable-extensions"},
+			runtimeFlags: []string{"--load-extension=/e1"},
+			want:         []string{"--load-extension=/e1"},
+			wantNotes: []MergeNote{
+				{Kind: MergeNoteDropped, Token: "--disable-extensions", Source: "base", Winner: "--load-extension=/e1", Reason: "runtime loads extensions"},
+			},
+		},
+		{
+			name:         "disable-extensions-except rewritten into load-extension",
+			baseFlags:    nil,
+			runtimeFlags: []string{"--disable-extensions-except=/x1"},
+			want:         []string{"--load-extension=/x1"},
+			wantNotes: []MergeNote{
+				{Kind: MergeNoteRewritten, Token: "--disable-extensions-except=/x1", Source: "runtime", Winner: "--load-extension=/x1", Reason: "--disable-extensions-except is not emitted"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, report := MergeFlagsWithReport(tt.baseFlags, tt.runtimeFlags)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeFlagsWithReport() tokens mismatch:\n got: %#v\nwant: %#v", got, tt.want)
+			}
+			if !reflect.DeepEqual(report.Notes, tt.wantNotes) {
+				t.Errorf("MergeFlagsWithReport() notes mismatch:\n got: %#v\nwant: %#v", report.Notes, tt.wantNotes)
+			}
+		})
+	}
+}
+
+func TestMergeNoteString(t *testing.T) {
+	n := MergeNote{Kind: MergeNoteOverride, Token: "--lang=en", Source: "base", Winner: "--lang=de", Reason: "Chromium uses the last value"}
+	want := "override: --lang=en (base) -> --lang=de: Chromium uses the last value"
+	if got := n.String(); got != want {
+		t.Fatalf("MergeNote.String() = %q, want %q", got, want)
+	}
+}
+
+func TestReadFlagDir(t *testing.T) {
+	// Missing directory is not an error
+	if s, err := ReadFlagDir(filepath.Join(t.TempDir(), "not-there")); err != nil || s != nil {
+		t.Fatalf("expected nil slice and nil error for missing dir, got %#v, err=%v", s, err)
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"20-proxy.flags": `{"flags":["--proxy-server=http://p:1"]}`,
+		"10-base.flags":  `{"flags":["--foo","--bar=1"]}`,
+		"30-empty.flags": ``,
+		"ignored.json":   `{"flags":["--ignored"]}`,
+		"99-README":      `not a flags file`,
+		"40-last.flags":  `{"flags":["--baz"]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "sub.flags"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	got, err := ReadFlagDir(dir)
+	if err != nil {
+		t.Fatalf("ReadFlagDir error: %v", err)
+	}
+	want := []string{"--foo", "--bar=1", "--proxy-server=http://p:1", "--baz"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ReadFlagDir mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+
+	// A malformed file fails the whole read and names the file
+	bad := filepath.Join(dir, "50-bad.flags")
+	if err := os.WriteFile(bad, []byte("--not-json"), 0o644); err != nil {
+		t.Fatalf("write bad file: %v", err)
+	}
+	if _, err := ReadFlagDir(dir); err == nil || !strings.Contains(err.Error(), "50-bad.flags") {
+		t.Fatalf("expected error naming 50-bad.flags, got %v", err)
+	}
+}
+
+func TestExpandVars(t *testing.T) {
+	env := map[string]string{
+		"PROXY_HOST": "proxy.local",
+		"PROXY_PORT": "3128",
+		"PROXY_URL":  "http://${PROXY_HOST}:${PROXY_PORT}",
+		"LOOP":       "${LOOP}",
+		"EMPTY":      "",
+	}
+	lookup := func(k string) (string, bool) {
+		v, ok := env[k]
+		return v, ok
+	}
+
+	tests := []struct {
+		name          string
+		tokens        []string
+		keepUndefined bool
+		want          []string
+	}{
+		{
+			name:   "simple",
+			tokens: []string{"--proxy-server=${PROXY_HOST}:${PROXY_PORT}"},
+			want:   []string{"--proxy-server=proxy.local:3128"},
+		},
+		{
+			name:   "nested",
+			tokens: []string{"--proxy-server=${PROXY_URL}"},
+			want:   []string{"--proxy-server=http://proxy.local:3128"},
+		},
+		{
+			name:   "undefined expands to empty",
+			tokens: []string{"--foo=${MISSING}", "--bar"},
+			want:   []string{"--foo=", "--bar"},
+		},
+		{
+			name:          "undefined kept literal",
+			tokens:        []string{"--foo=${MISSING}"},
+			keepUndefined: true,
+			want:          []string{"--foo=${MISSING}"},
+		},
+		{
+			name:   "token expanding to nothing is dropped",
+			tokens: []string{"${MISSING}", "${EMPTY}", "--bar"},
+			want:   []string{"--bar"},
+		},
+		{
+			name:   "bare dollar and malformed references untouched",
+			tokens: []string{"--a=$PROXY_HOST", "--b=${PROXY_HOST", "--c=${1BAD}"},
+			want:   []string{"--a=$PROXY_HOST", "--b=${PROXY_HOST", "--c=${1BAD}"},
+		},
+		{
+			name:   "self reference terminates",
+			tokens: []string{"--loop=${LOOP}"},
+			want:   []string{"--loop=${LOOP}"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandVars(tt.tokens, lookup, tt.keepUndefined)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExpandVars() mismatch:\n got: %#v\nwant: %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("CHROMIUMFLAGS_TEST_URL", "http://p:1")
+	got := ExpandEnv([]string{"--proxy-server=${CHROMIUMFLAGS_TEST_URL}"}, false)
+	want := []string{"--proxy-server=http://p:1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ExpandEnv mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+}
@@ -0,0 +1,172 @@
+package devtoolsproxy
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// captureFlushInterval bounds how much of a capture can be lost if the server dies without
+// closing it; buffered messages are flushed to the file at most this often.
+const captureFlushInterval = time.Second
+
+// CaptureOptions configures writing every CDP message of a proxied session to a file.
+type CaptureOptions struct {
+	// Dir receives one file per client session. Empty disables capture.
+	Dir string
+	// Gzip compresses each file (*.cdp.gz) at GzipLevel as it is written.
+	Gzip      bool
+	GzipLevel int
+}
+
+// captureRecord is one line of a capture file.
+type captureRecord struct {
+	Time      time.Time       `json:"time"`
+	Direction string          `json:"direction"`
+	Message   json.RawMessage `json:"message"`
+}
+
+// cdpCapture writes the messages of one session to a capture file as JSON lines. It is
+// safe for concurrent use by both directions of a proxy; writes after close are dropped.
+type cdpCapture struct {
+	mu        sync.Mutex
+	f         *os.File
+	gz        *gzip.Writer
+	buf       *bufio.Writer
+	lastFlush time.Time
+	closed    bool
+}
+
+// openCapture creates a capture file for a new session, or returns nil when capture is
+// disabled.
+func openCapture(opts CaptureOptions) (*cdpCapture, error) {
+	if opts.Dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create capture dir: %w", err)
+	}
+	ext := ".cdp"
+	if opts.Gzip {
+		ext = ".cdp.gz"
+	}
+	f, err := os.CreateTemp(opts.Dir, "session-"+time.Now().UTC().Format("20060102T150405Z")+"-*"+ext)
+	if err != nil {
+		return nil, fmt.Errorf("create capture file: %w", err)
+	}
+
+	c := &cdpCapture{f: f, lastFlush: time.Now()}
+	var w io.Writer = f
+	if opts.Gzip {
+		if c.gz, err = gzip.NewWriterLevel(f, opts.GzipLevel); err != nil {
+			f.Close()
+			os.Remove(f.Name())
+			return nil, fmt.Errorf("create capture file: %w", err)
+		}
+		w = c.gz
+	}
+	c.buf = bufio.NewWriter(w)
+	return c, nil
+}
+
+// name returns the capture file's path.
+func (c *cdpCapture) name() string {
+	return c.f.Name()
+}
+
+// write appends a text message sent in direction ("->" to Chromium, "<-" from it).
+func (c *cdpCapture) write(direction string, mt websocket.MessageType, msg []byte) error {
+	if c == nil || mt != websocket.MessageText {
+		return nil
+	}
+	rec := captureRecord{Time: time.Now().UTC(), Direction: direction, Message: msg}
+	if !json.Valid(msg) {
+		quoted, _ := json.Marshal(string(msg))
+		rec.Message = quoted
+	}
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	if _, err := c.buf.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if time.Since(c.lastFlush) >= captureFlushInterval {
+		c.lastFlush = time.Now()
+		return c.flushLocked()
+	}
+	return nil
+}
+
+// flushLocked pushes buffered messages through to the file. For gzip this ends a deflate
+// block, so everything written so far can be decompressed even if the trailer never is.
+func (c *cdpCapture) flushLocked() error {
+	if err := c.buf.Flush(); err != nil {
+		return err
+	}
+	if c.gz != nil {
+		return c.gz.Flush()
+	}
+	return nil
+}
+
+// close flushes the capture and, for gzip, writes the trailer that completes the stream.
+func (c *cdpCapture) close() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	err := c.buf.Flush()
+	if c.gz != nil {
+		if gzErr := c.gz.Close(); err == nil {
+			err = gzErr
+		}
+	}
+	if closeErr := c.f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// startCapture opens a capture for a new session, logging rather than failing the session
+// when the file can't be created.
+func startCapture(opts CaptureOptions, logger *slog.Logger) *cdpCapture {
+	c, err := openCapture(opts)
+	if err != nil {
+		logger.Error("failed to start cdp capture", slog.String("err", err.Error()))
+		return nil
+	}
+	if c != nil {
+		logger.Info("capturing cdp session", slog.String("path", c.name()))
+	}
+	return c
+}
+
+// stopCapture closes a session's capture, if any.
+func stopCapture(c *cdpCapture, logger *slog.Logger) {
+	if c == nil {
+		return
+	}
+	if err := c.close(); err != nil {
+		logger.Error("failed to close cdp capture", slog.String("err", err.Error()), slog.String("path", c.name()))
+	}
+}
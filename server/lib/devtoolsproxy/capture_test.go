@@ -0,0 +1,185 @@
+package devtoolsproxy
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+)
+
+// readCapture decodes the records of a capture file, returning the error that ended the
+// read of a gzip stream, if any.
+func readCapture(t *testing.T, path string) ([]captureRecord, error) {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read capture: %v", err)
+	}
+	var data []byte
+	var readErr error
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(bytes.NewReader(raw))
+		if err != nil {
+			t.Fatalf("open gzip: %v", err)
+		}
+		data, readErr = io.ReadAll(zr)
+	} else {
+		data = raw
+	}
+
+	var recs []captureRecord
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		var rec captureRecord
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			t.Fatalf("decode capture line %q: %v", sc.Text(), err)
+		}
+		recs = append(recs, rec)
+	}
+	return recs, readErr
+}
+
+func TestCDPCapture(t *testing.T) {
+	for _, gz := range []bool{false, true} {
+		name := "plain"
+		if gz {
+			name = "gzip"
+		}
+		t.Run(name, func(t *testing.T) {
+			c, err := openCapture(CaptureOptions{Dir: t.TempDir(), Gzip: gz, GzipLevel: gzip.BestSpeed})
+			if err != nil {
+				t.Fatalf("open capture: %v", err)
+			}
+			if gz != strings.HasSuffix(c.name(), ".cdp.gz") {
+				t.Fatalf("unexpected capture file name %q", c.name())
+			}
+			if err := c.write("->", websocket.MessageText, []byte(`{"id":1,"method":"Page.navigate"}`)); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			if err := c.write("<-", websocket.MessageText, []byte("not json")); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			if err := c.write("<-", websocket.MessageBinary, []byte{0xff}); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			if err := c.close(); err != nil {
+				t.Fatalf("close: %v", err)
+			}
+			// writes racing the end of the session are dropped
+			if err := c.write("<-", websocket.MessageText, []byte(`{}`)); err != nil {
+				t.Fatalf("write after close: %v", err)
+			}
+
+			recs, err := readCapture(t, c.name())
+			if err != nil {
+				t.Fatalf("capture is not a complete stream: %v", err)
+			}
+			if len(recs) != 2 {
+				t.Fatalf("got %d records, want 2", len(recs))
+			}
+			if recs[0].Direction != "->" || string(recs[0].Message) != `{"id":1,"method":"Page.navigate"}` {
+				t.Fatalf("unexpected first record %+v", recs[0])
+			}
+			if recs[1].Direction != "<-" || string(recs[1].Message) != `"not json"` {
+				t.Fatalf("unexpected second record %+v", recs[1])
+			}
+		})
+	}
+}
+
+func TestCDPCapture_FlushedBeforeClose(t *testing.T) {
+	c, err := openCapture(CaptureOptions{Dir: t.TempDir(), Gzip: true, GzipLevel: gzip.DefaultCompression})
+	if err != nil {
+		t.Fatalf("open capture: %v", err)
+	}
+	defer c.close()
+	c.lastFlush = time.Now().Add(-captureFlushInterval)
+	if err := c.write("->", websocket.MessageText, []byte(`{"id":1}`)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	// a server that dies now leaves a stream without its trailer, but with the message
+	recs, err := readCapture(t, c.name())
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected a truncated stream, got %v", err)
+	}
+	if len(recs) != 1 || string(recs[0].Message) != `{"id":1}` {
+		t.Fatalf("unexpected records %+v", recs)
+	}
+}
+
+func TestWebSocketProxyHandler_Capture(t *testing.T) {
+	echoSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close(websocket.StatusNormalClosure, "")
+		for {
+			mt, msg, err := c.Read(r.Context())
+			if err != nil {
+				return
+			}
+			if err := c.Write(r.Context(), mt, msg); err != nil {
+				return
+			}
+		}
+	}))
+	defer echoSrv.Close()
+
+	logger := silentLogger()
+	mgr := NewUpstreamManager("/dev/null", logger)
+	mgr.setCurrent("ws" + strings.TrimPrefix(echoSrv.URL, "http"))
+
+	dir := t.TempDir()
+	capture := CaptureOptions{Dir: dir, Gzip: true, GzipLevel: gzip.BestSpeed}
+	proxySrv := httptest.NewServer(WebSocketProxyHandler(mgr, logger, false, capture, websocket.CompressionDisabled, scaletozero.NewNoopController()))
+	defer proxySrv.Close()
+
+	ctx := context.Background()
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(proxySrv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial proxy: %v", err)
+	}
+	if err := conn.Write(ctx, websocket.MessageText, []byte(`{"id":7}`)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, _, err := conn.Read(ctx); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	// drop the connection without a close handshake
+	conn.CloseNow()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		files, _ := filepath.Glob(filepath.Join(dir, "*.cdp.gz"))
+		// the gzip header is only written with the first flush
+		if len(files) == 1 {
+			if info, err := os.Stat(files[0]); err == nil && info.Size() > 0 {
+				if recs, err := readCapture(t, files[0]); err == nil {
+					if len(recs) != 2 || recs[0].Direction != "->" || recs[1].Direction != "<-" {
+						t.Fatalf("unexpected records %+v", recs)
+					}
+					return
+				}
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("capture was not completed after the connection dropped (files %v)", files)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
@@ -0,0 +1,564 @@
+package devtoolsproxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/coder/websocket"
+)
+
+// muxClientQueue is how many messages may wait for a slow multiplexed client before it is
+// disconnected, so one stalled client can't hold up the shared upstream connection.
+const muxClientQueue = 1024
+
+// Multiplexer lets several CDP clients share one upstream connection to Chromium.
+// Commands are forwarded under proxy-assigned IDs and their responses routed back to
+// the sender. A session belongs to the client that attached to it (or first used it),
+// and events carrying its sessionId go only to that client; other clients can't send
+// commands on it. Browser-level events such as Target.targetCreated go to every client.
+//
+// Browser-level state, e.g. Target.setDiscoverTargets, is shared by all clients of the
+// upstream connection. Targets auto-attached at browser level go to the client that most
+// recently enabled Target.setAutoAttach without a session.
+type Multiplexer struct {
+	mgr             *UpstreamManager
+	logger          *slog.Logger
+	logCDPMessages  bool
+	capture         CaptureOptions
+	compression     websocket.CompressionMode
+	allowedCommands map[string]bool
+
+	mu sync.Mutex
+	up *muxUpstream
+}
+
+// NewMultiplexer returns a multiplexing proxy with full CDP access, the shared-connection
+// counterpart of WebSocketProxyHandler.
+func NewMultiplexer(mgr *UpstreamManager, logger *slog.Logger, logCDPMessages bool, capture CaptureOptions, compression websocket.CompressionMode) *Multiplexer {
+	return &Multiplexer{mgr: mgr, logger: logger, logCDPMessages: logCDPMessages, capture: capture, compression: compression}
+}
+
+// NewFilteredMultiplexer returns a multiplexing proxy that only allows the whitelisted
+// commands, the shared-connection counterpart of WebSocketProxyHandlerFiltered.
+func NewFilteredMultiplexer(mgr *UpstreamManager, logger *slog.Logger, compression websocket.CompressionMode) *Multiplexer {
+	return &Multiplexer{mgr: mgr, logger: logger, compression: compression, allowedCommands: createAllowedCommandsMap()}
+}
+
+// Close disconnects the upstream connection and every client sharing it.
+func (m *Multiplexer) Close() {
+	m.mu.Lock()
+	up := m.up
+	m.up = nil
+	m.mu.Unlock()
+	if up != nil {
+		up.close()
+	}
+}
+
+// upstream returns the shared upstream connection, dialing a new one if there is none
+// or the previous one closed.
+func (m *Multiplexer) upstream(ctx context.Context, r *http.Request) (*muxUpstream, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.up != nil && m.up.ctx.Err() == nil {
+		return m.up, nil
+	}
+
+	urlCh, unsub := m.mgr.Subscribe()
+	upstreamCurrent := m.mgr.Current()
+	if upstreamCurrent == "" {
+		unsub()
+		return nil, errors.New("upstream not ready")
+	}
+	maybePauseAfterCurrentRead(ctx, m.logger, r)
+
+	conn, upstreamURL, err := dialUpstreamWithRetry(ctx, m.mgr, urlCh, upstreamCurrent, &websocket.DialOptions{CompressionMode: m.compression}, m.logger)
+	if err != nil {
+		unsub()
+		return nil, err
+	}
+	conn.SetReadLimit(m.mgr.readLimit())
+
+	upCtx, cancel := context.WithCancel(context.Background())
+	up := &muxUpstream{
+		mgr:            m.mgr,
+		conn:           conn,
+		url:            upstreamURL,
+		logger:         m.logger,
+		logCDPMessages: m.logCDPMessages,
+		ctx:            upCtx,
+		cancel:         cancel,
+		pending:        make(map[int64]muxCall),
+		sessions:       make(map[string]*muxClient),
+		attaching:      make(map[string]*muxClient),
+		clients:        make(map[*muxClient]struct{}),
+	}
+	m.logger.Debug("multiplexing websocket", slog.String("url", upstreamURL))
+
+	// Close the shared connection, and with it every client, when Chromium restarts.
+	go func() {
+		defer unsub()
+		for {
+			select {
+			case newURL, ok := <-urlCh:
+				if !ok {
+					return
+				}
+				newURL = normalizeUpstreamURL(newURL)
+				if newURL == "" || newURL == up.url {
+					continue
+				}
+				m.logger.Info("upstream URL changed, closing multiplexed sessions",
+					slog.String("old_url", up.url),
+					slog.String("new_url", newURL))
+				up.closeForRestart()
+				return
+			case <-up.ctx.Done():
+				return
+			}
+		}
+	}()
+	go up.readLoop()
+
+	m.up = up
+	return up, nil
+}
+
+// ServeHTTP upgrades the client connection and attaches it to the shared upstream.
+func (m *Multiplexer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	up, err := m.upstream(r.Context(), r)
+	if err != nil {
+		if r.Context().Err() == nil {
+			m.logger.Error("failed to connect to upstream", slog.String("err", err.Error()))
+		}
+		http.Error(w, "upstream unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	clientConn, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+		OriginPatterns:  []string{"*"},
+		CompressionMode: m.compression,
+	})
+	if err != nil {
+		m.logger.Error("websocket accept failed", slog.String("err", err.Error()))
+		return
+	}
+	clientConn.SetReadLimit(m.mgr.readLimit())
+	defer clientConn.Close(websocket.StatusNormalClosure, "")
+
+	// Each client gets its own capture, of the messages as it sends and receives them.
+	cdpCap := startCapture(m.capture, m.logger)
+	defer stopCapture(cdpCap, m.logger)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	c := &muxClient{conn: clientConn, send: make(chan []byte, muxClientQueue), ctx: ctx, cancel: cancel, capture: cdpCap, logger: m.logger}
+	if !up.addClient(c) {
+		return
+	}
+	defer up.removeClient(c)
+	go c.writeLoop()
+
+	for {
+		mt, msg, err := clientConn.Read(ctx)
+		if err != nil {
+			return
+		}
+		if mt != websocket.MessageText {
+			continue
+		}
+		if m.logCDPMessages {
+			logCDPMessage(m.logger, "->", mt, msg)
+		}
+		c.captureMessage("->", msg)
+		m.forward(up, c, msg)
+	}
+}
+
+// forward sends a client's command upstream under a proxy-assigned ID.
+func (m *Multiplexer) forward(up *muxUpstream, c *muxClient, msg []byte) {
+	var cmd struct {
+		ID        json.RawMessage `json:"id"`
+		Method    string          `json:"method"`
+		SessionID string          `json:"sessionId"`
+		Params    json.RawMessage `json:"params"`
+	}
+	if err := json.Unmarshal(msg, &cmd); err != nil || len(cmd.ID) == 0 || cmd.Method == "" {
+		m.logger.Warn("dropping malformed CDP command from multiplexed client")
+		return
+	}
+	if m.allowedCommands != nil && !m.allowedCommands[cmd.Method] {
+		m.logger.Warn("CDP command blocked by filter", slog.String("method", cmd.Method))
+		c.enqueue(cdpErrorReply(cmd.ID, -32000, "Command not allowed"))
+		return
+	}
+
+	up.mu.Lock()
+	if cmd.SessionID != "" {
+		if owner, ok := up.sessions[cmd.SessionID]; !ok {
+			up.sessions[cmd.SessionID] = c
+		} else if owner != c {
+			up.mu.Unlock()
+			c.enqueue(cdpErrorReply(cmd.ID, -32001, "Session belongs to another connection"))
+			return
+		}
+	}
+	call := muxCall{client: c, id: cmd.ID}
+	if cmd.SessionID == "" {
+		switch cmd.Method {
+		case "Target.setAutoAttach":
+			var p struct {
+				AutoAttach bool `json:"autoAttach"`
+			}
+			_ = json.Unmarshal(cmd.Params, &p)
+			up.autoAttach = removeMuxClient(up.autoAttach, c)
+			if p.AutoAttach {
+				up.autoAttach = append(up.autoAttach, c)
+			}
+		case "Target.attachToTarget":
+			var p struct {
+				TargetID string `json:"targetId"`
+			}
+			_ = json.Unmarshal(cmd.Params, &p)
+			call.attachKey = p.TargetID
+		case "Target.attachToBrowserTarget":
+			call.attachKey = browserAttachKey
+		}
+		if call.attachKey != "" {
+			up.attaching[call.attachKey] = c
+		}
+	}
+	up.nextID++
+	id := up.nextID
+	up.pending[id] = call
+	up.mu.Unlock()
+
+	out, err := replaceID(msg, json.RawMessage(strconv.FormatInt(id, 10)))
+	if err != nil {
+		return
+	}
+	if err := up.conn.Write(up.ctx, websocket.MessageText, out); err != nil {
+		if up.ctx.Err() == nil {
+			m.logger.Error("upstream write error", slog.String("err", err.Error()))
+		}
+		up.close()
+	}
+}
+
+// browserAttachKey marks a Target.attachToBrowserTarget in flight in muxUpstream.attaching.
+const browserAttachKey = "browser"
+
+// muxUpstream is an upstream connection shared by a Multiplexer's clients.
+type muxUpstream struct {
+	mgr            *UpstreamManager
+	conn           *websocket.Conn
+	url            string
+	logger         *slog.Logger
+	logCDPMessages bool
+	ctx            context.Context
+	cancel         context.CancelFunc
+
+	mu      sync.Mutex
+	closed  bool
+	nextID  int64
+	pending map[int64]muxCall
+	// sessions maps CDP session IDs to the client that owns them.
+	sessions map[string]*muxClient
+	// attaching maps targets with a Target.attachToTarget in flight to the attaching client.
+	attaching map[string]*muxClient
+	// autoAttach lists clients with browser-level auto-attach on, most recent last.
+	autoAttach []*muxClient
+	clients    map[*muxClient]struct{}
+}
+
+// muxCall is a command awaiting its upstream response.
+type muxCall struct {
+	// client is nil for commands the proxy sends itself.
+	client    *muxClient
+	id        json.RawMessage
+	attachKey string
+}
+
+func (u *muxUpstream) addClient(c *muxClient) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.closed {
+		return false
+	}
+	u.clients[c] = struct{}{}
+	return true
+}
+
+// removeClient forgets c and detaches the sessions it owned, leaving other clients alone.
+func (u *muxUpstream) removeClient(c *muxClient) {
+	u.mu.Lock()
+	delete(u.clients, c)
+	for id, call := range u.pending {
+		if call.client == c {
+			delete(u.pending, id)
+		}
+	}
+	for key, owner := range u.attaching {
+		if owner == c {
+			delete(u.attaching, key)
+		}
+	}
+	u.autoAttach = removeMuxClient(u.autoAttach, c)
+	var detach [][]byte
+	for sessionID, owner := range u.sessions {
+		if owner != c {
+			continue
+		}
+		delete(u.sessions, sessionID)
+		u.nextID++
+		u.pending[u.nextID] = muxCall{}
+		msg, _ := json.Marshal(map[string]any{
+			"id":     u.nextID,
+			"method": "Target.detachFromTarget",
+			"params": map[string]string{"sessionId": sessionID},
+		})
+		detach = append(detach, msg)
+	}
+	closed := u.closed
+	u.mu.Unlock()
+
+	if closed {
+		return
+	}
+	for _, msg := range detach {
+		if err := u.conn.Write(u.ctx, websocket.MessageText, msg); err != nil {
+			return
+		}
+	}
+}
+
+func (u *muxUpstream) close() {
+	u.mu.Lock()
+	if u.closed {
+		u.mu.Unlock()
+		return
+	}
+	u.closed = true
+	clients := make([]*muxClient, 0, len(u.clients))
+	for c := range u.clients {
+		clients = append(clients, c)
+	}
+	u.mu.Unlock()
+
+	u.cancel()
+	u.conn.Close(websocket.StatusNormalClosure, "")
+	for _, c := range clients {
+		c.cancel()
+	}
+}
+
+// closeForRestart closes the shared connection after its browser went away, giving each
+// client a restart close before its reads are cancelled. Sessions aren't moved to the new
+// browser: the proxy's session routing belongs to the old one.
+func (u *muxUpstream) closeForRestart() {
+	u.mu.Lock()
+	if u.closed {
+		u.mu.Unlock()
+		return
+	}
+	clients := make([]*muxClient, 0, len(u.clients))
+	for c := range u.clients {
+		clients = append(clients, c)
+	}
+	u.mu.Unlock()
+
+	for _, c := range clients {
+		u.mgr.restartCloses.Add(1)
+		closeForRestart(c.conn, "chromium restarted")
+	}
+	u.close()
+}
+
+func (u *muxUpstream) readLoop() {
+	defer u.close()
+	for {
+		mt, msg, err := u.conn.Read(u.ctx)
+		if err != nil {
+			if u.ctx.Err() == nil {
+				u.logger.Error("upstream read error", slog.String("err", err.Error()))
+				u.closeForRestart()
+			}
+			return
+		}
+		if mt != websocket.MessageText {
+			continue
+		}
+		if u.logCDPMessages {
+			logCDPMessage(u.logger, "<-", mt, msg)
+		}
+		u.route(msg)
+	}
+}
+
+// route delivers an upstream message to the client(s) it belongs to.
+func (u *muxUpstream) route(msg []byte) {
+	var in struct {
+		ID        json.RawMessage `json:"id"`
+		Method    string          `json:"method"`
+		SessionID string          `json:"sessionId"`
+		Params    json.RawMessage `json:"params"`
+		Result    json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(msg, &in); err != nil {
+		return
+	}
+
+	if len(in.ID) > 0 {
+		id, err := strconv.ParseInt(string(in.ID), 10, 64)
+		if err != nil {
+			return
+		}
+		u.mu.Lock()
+		call, ok := u.pending[id]
+		delete(u.pending, id)
+		if ok && call.attachKey != "" && u.attaching[call.attachKey] == call.client {
+			delete(u.attaching, call.attachKey)
+		}
+		if _, live := u.clients[call.client]; ok && live && call.attachKey != "" {
+			var res struct {
+				SessionID string `json:"sessionId"`
+			}
+			if json.Unmarshal(in.Result, &res) == nil && res.SessionID != "" {
+				u.sessions[res.SessionID] = call.client
+			}
+		}
+		u.mu.Unlock()
+		if !ok || call.client == nil {
+			return
+		}
+		if out, err := replaceID(msg, call.id); err == nil {
+			call.client.enqueue(out)
+		}
+		return
+	}
+
+	var target struct {
+		SessionID  string `json:"sessionId"`
+		TargetInfo struct {
+			TargetID string `json:"targetId"`
+			Type     string `json:"type"`
+		} `json:"targetInfo"`
+	}
+	if in.Method == "Target.attachedToTarget" || in.Method == "Target.detachedFromTarget" {
+		_ = json.Unmarshal(in.Params, &target)
+	}
+
+	u.mu.Lock()
+	var owner *muxClient
+	switch {
+	case in.SessionID != "":
+		owner = u.sessions[in.SessionID]
+		if owner != nil && in.Method == "Target.attachedToTarget" {
+			u.sessions[target.SessionID] = owner
+		}
+	case in.Method == "Target.attachedToTarget":
+		key := target.TargetInfo.TargetID
+		if target.TargetInfo.Type == "browser" {
+			key = browserAttachKey
+		}
+		owner = u.attaching[key]
+		if owner == nil && len(u.autoAttach) > 0 {
+			owner = u.autoAttach[len(u.autoAttach)-1]
+		}
+		if owner != nil {
+			u.sessions[target.SessionID] = owner
+		}
+	case in.Method == "Target.detachedFromTarget":
+		owner = u.sessions[target.SessionID]
+	}
+	if in.Method == "Target.detachedFromTarget" {
+		delete(u.sessions, target.SessionID)
+	}
+	var recipients []*muxClient
+	if owner != nil {
+		recipients = []*muxClient{owner}
+	} else if in.SessionID == "" {
+		for c := range u.clients {
+			recipients = append(recipients, c)
+		}
+	}
+	u.mu.Unlock()
+
+	for _, c := range recipients {
+		c.enqueue(msg)
+	}
+}
+
+// muxClient is a client connection of a Multiplexer.
+type muxClient struct {
+	conn    *websocket.Conn
+	send    chan []byte
+	ctx     context.Context
+	cancel  context.CancelFunc
+	capture *cdpCapture
+	logger  *slog.Logger
+}
+
+// captureMessage records a message the client sent or is sent, if its session is captured.
+func (c *muxClient) captureMessage(direction string, msg []byte) {
+	if err := c.capture.write(direction, websocket.MessageText, msg); err != nil {
+		c.logger.Error("failed to write cdp capture", slog.String("err", err.Error()))
+	}
+}
+
+func (c *muxClient) enqueue(msg []byte) {
+	select {
+	case c.send <- msg:
+	default:
+		c.cancel()
+	}
+}
+
+func (c *muxClient) writeLoop() {
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case msg := <-c.send:
+			c.captureMessage("<-", msg)
+			if err := c.conn.Write(c.ctx, websocket.MessageText, msg); err != nil {
+				c.cancel()
+				return
+			}
+		}
+	}
+}
+
+func removeMuxClient(clients []*muxClient, c *muxClient) []*muxClient {
+	out := clients[:0]
+	for _, o := range clients {
+		if o != c {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+// replaceID returns msg with its top-level "id" set to id.
+func replaceID(msg []byte, id json.RawMessage) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil {
+		return nil, err
+	}
+	fields["id"] = id
+	return json.Marshal(fields)
+}
+
+// cdpErrorReply builds a CDP error response to the command with the given id.
+func cdpErrorReply(id json.RawMessage, code int, message string) []byte {
+	b, _ := json.Marshal(map[string]any{
+		"id":    id,
+		"error": map[string]any{"code": code, "message": message},
+	})
+	return b
+}
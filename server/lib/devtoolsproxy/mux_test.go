@@ -0,0 +1,222 @@
+package devtoolsproxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// fakeMuxUpstream is a CDP server answering just enough of the Target domain to
+// exercise the multiplexer's routing.
+type fakeMuxUpstream struct {
+	accepts  atomic.Int32
+	detached chan string
+}
+
+func (f *fakeMuxUpstream) handler(w http.ResponseWriter, r *http.Request) {
+	f.accepts.Add(1)
+	c, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: []string{"*"}})
+	if err != nil {
+		return
+	}
+	defer c.CloseNow()
+	ctx := r.Context()
+	send := func(v any) {
+		b, _ := json.Marshal(v)
+		_ = c.Write(ctx, websocket.MessageText, b)
+	}
+	for {
+		_, msg, err := c.Read(ctx)
+		if err != nil {
+			return
+		}
+		var req struct {
+			ID        int64  `json:"id"`
+			Method    string `json:"method"`
+			SessionID string `json:"sessionId"`
+			Params    struct {
+				TargetID  string `json:"targetId"`
+				SessionID string `json:"sessionId"`
+			} `json:"params"`
+		}
+		if err := json.Unmarshal(msg, &req); err != nil {
+			continue
+		}
+		switch {
+		case req.SessionID != "":
+			send(map[string]any{"method": "Runtime.consoleAPICalled", "sessionId": req.SessionID, "params": map[string]any{}})
+			send(map[string]any{"id": req.ID, "sessionId": req.SessionID, "result": map[string]any{}})
+		case req.Method == "Target.attachToTarget":
+			sessionID := "session-" + req.Params.TargetID
+			send(map[string]any{"method": "Target.attachedToTarget", "params": map[string]any{
+				"sessionId":  sessionID,
+				"targetInfo": map[string]string{"targetId": req.Params.TargetID, "type": "page"},
+			}})
+			send(map[string]any{"id": req.ID, "result": map[string]string{"sessionId": sessionID}})
+		case req.Method == "Target.detachFromTarget":
+			f.detached <- req.Params.SessionID
+			send(map[string]any{"id": req.ID, "result": map[string]any{}})
+		case req.Method == "Target.createTarget":
+			send(map[string]any{"method": "Target.targetCreated", "params": map[string]any{}})
+			send(map[string]any{"id": req.ID, "result": map[string]string{"targetId": "new"}})
+		default:
+			send(map[string]any{"id": req.ID, "result": map[string]string{"method": req.Method}})
+		}
+	}
+}
+
+type muxTestClient struct {
+	t    *testing.T
+	conn *websocket.Conn
+}
+
+func dialMux(t *testing.T, url string) *muxTestClient {
+	t.Helper()
+	conn, _, err := websocket.Dial(context.Background(), url, nil)
+	if err != nil {
+		t.Fatalf("dial multiplexer: %v", err)
+	}
+	t.Cleanup(func() { conn.CloseNow() })
+	return &muxTestClient{t: t, conn: conn}
+}
+
+func (c *muxTestClient) send(msg string) {
+	c.t.Helper()
+	if err := c.conn.Write(context.Background(), websocket.MessageText, []byte(msg)); err != nil {
+		c.t.Fatalf("write: %v", err)
+	}
+}
+
+// next returns the next message. A read that times out closes the connection, so
+// tests check that nothing was delivered by sending a command and expecting its
+// response next.
+func (c *muxTestClient) next() string {
+	c.t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, msg, err := c.conn.Read(ctx)
+	if err != nil {
+		c.t.Fatalf("read: %v", err)
+	}
+	return string(msg)
+}
+
+func TestMultiplexer(t *testing.T) {
+	f := &fakeMuxUpstream{detached: make(chan string, 4)}
+	upstreamSrv := httptest.NewServer(http.HandlerFunc(f.handler))
+	defer upstreamSrv.Close()
+
+	logger := silentLogger()
+	mgr := NewUpstreamManager("/dev/null", logger)
+	mgr.setCurrent("ws" + strings.TrimPrefix(upstreamSrv.URL, "http") + "/devtools/browser/x")
+
+	mux := NewMultiplexer(mgr, logger, false, CaptureOptions{}, websocket.CompressionDisabled)
+	defer mux.Close()
+	proxySrv := httptest.NewServer(mux)
+	defer proxySrv.Close()
+	proxyURL := "ws" + strings.TrimPrefix(proxySrv.URL, "http")
+
+	a := dialMux(t, proxyURL)
+	b := dialMux(t, proxyURL)
+
+	// both clients use id 1; each gets its own response under its own id
+	a.send(`{"id":1,"method":"Browser.getVersion"}`)
+	b.send(`{"id":1,"method":"Target.getTargets"}`)
+	if got := a.next(); got != `{"id":1,"result":{"method":"Browser.getVersion"}}` {
+		t.Fatalf("client a response: %s", got)
+	}
+	if got := b.next(); got != `{"id":1,"result":{"method":"Target.getTargets"}}` {
+		t.Fatalf("client b response: %s", got)
+	}
+	if n := f.accepts.Load(); n != 1 {
+		t.Fatalf("expected one shared upstream connection, got %d", n)
+	}
+
+	// the attach event and the session's events only reach the attaching client
+	a.send(`{"id":2,"method":"Target.attachToTarget","params":{"targetId":"t1","flatten":true}}`)
+	if got := a.next(); !strings.Contains(got, `"method":"Target.attachedToTarget"`) {
+		t.Fatalf("expected attach event for client a, got %s", got)
+	}
+	if got := a.next(); got != `{"id":2,"result":{"sessionId":"session-t1"}}` {
+		t.Fatalf("client a attach response: %s", got)
+	}
+	a.send(`{"id":3,"method":"Runtime.enable","sessionId":"session-t1"}`)
+	if got := a.next(); !strings.Contains(got, `"method":"Runtime.consoleAPICalled"`) {
+		t.Fatalf("expected session event for client a, got %s", got)
+	}
+	if got := a.next(); !strings.Contains(got, `"id":3`) {
+		t.Fatalf("client a session response: %s", got)
+	}
+	b.send(`{"id":2,"method":"Browser.getVersion"}`)
+	if got := b.next(); got != `{"id":2,"result":{"method":"Browser.getVersion"}}` {
+		t.Fatalf("client b received another client's session traffic: %s", got)
+	}
+
+	// another client can't drive the session
+	b.send(`{"id":3,"method":"Runtime.evaluate","sessionId":"session-t1"}`)
+	if got := b.next(); !strings.Contains(got, `"id":3`) || !strings.Contains(got, "another connection") {
+		t.Fatalf("expected session ownership error, got %s", got)
+	}
+
+	// browser-level events go to everyone
+	b.send(`{"id":4,"method":"Target.createTarget","params":{"url":"about:blank"}}`)
+	if got := a.next(); !strings.Contains(got, "Target.targetCreated") {
+		t.Fatalf("expected broadcast event for client a, got %s", got)
+	}
+	if got := b.next(); !strings.Contains(got, "Target.targetCreated") {
+		t.Fatalf("expected broadcast event for client b, got %s", got)
+	}
+	if got := b.next(); !strings.Contains(got, `"id":4`) {
+		t.Fatalf("client b createTarget response: %s", got)
+	}
+
+	// a disconnecting client has its sessions detached without affecting the others
+	a.conn.Close(websocket.StatusNormalClosure, "")
+	select {
+	case sessionID := <-f.detached:
+		if sessionID != "session-t1" {
+			t.Fatalf("detached %q, want session-t1", sessionID)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("session of the disconnected client was not detached")
+	}
+	b.send(`{"id":5,"method":"Browser.getVersion"}`)
+	if got := b.next(); got != `{"id":5,"result":{"method":"Browser.getVersion"}}` {
+		t.Fatalf("client b response after a disconnected: %s", got)
+	}
+	if n := f.accepts.Load(); n != 1 {
+		t.Fatalf("expected the upstream connection to be kept, got %d connections", n)
+	}
+}
+
+func TestMultiplexer_Filtered(t *testing.T) {
+	f := &fakeMuxUpstream{detached: make(chan string, 4)}
+	upstreamSrv := httptest.NewServer(http.HandlerFunc(f.handler))
+	defer upstreamSrv.Close()
+
+	logger := silentLogger()
+	mgr := NewUpstreamManager("/dev/null", logger)
+	mgr.setCurrent("ws" + strings.TrimPrefix(upstreamSrv.URL, "http") + "/devtools/browser/x")
+
+	mux := NewFilteredMultiplexer(mgr, logger, websocket.CompressionDisabled)
+	defer mux.Close()
+	proxySrv := httptest.NewServer(mux)
+	defer proxySrv.Close()
+
+	c := dialMux(t, "ws"+strings.TrimPrefix(proxySrv.URL, "http"))
+	c.send(`{"id":7,"method":"Runtime.evaluate"}`)
+	if got := c.next(); !strings.Contains(got, `"id":7`) || !strings.Contains(got, "Command not allowed") {
+		t.Fatalf("expected blocked command error, got %s", got)
+	}
+	c.send(`{"id":8,"method":"Browser.getVersion"}`)
+	if got := c.next(); got != `{"id":8,"result":{"method":"Browser.getVersion"}}` {
+		t.Fatalf("allowed command response: %s", got)
+	}
+}
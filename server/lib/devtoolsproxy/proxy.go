@@ -25,11 +25,20 @@ import (
 
 var devtoolsListeningRegexp = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
 
+// defaultMaxMessageSize is the largest CDP message the proxies read from either side
+// unless SetMaxMessageSize changes it.
+const defaultMaxMessageSize = 100 * 1024 * 1024
+
 // UpstreamManager tails the Chromium supervisord log and extracts the current DevTools
-// websocket URL, updating it whenever Chromium restarts and emits a new line.
+// websocket URL, updating it whenever Chromium restarts and emits a new line. Without a
+// log it can instead poll Chromium's /json/version endpoint, see NewPollingUpstreamManager.
 type UpstreamManager struct {
 	logFilePath string
-	logger      *slog.Logger
+	// pollAddr is the host:port of Chromium's remote debugging server when discovering
+	// the upstream by polling instead of tailing logFilePath.
+	pollAddr     string
+	pollInterval time.Duration
+	logger       *slog.Logger
 
 	currentURL atomic.Value // string
 
@@ -39,6 +48,31 @@ type UpstreamManager struct {
 
 	subsMu sync.RWMutex
 	subs   map[chan string]struct{}
+
+	// maxMessageSize overrides defaultMaxMessageSize when set, see SetMaxMessageSize
+	maxMessageSize atomic.Int64
+
+	// reconnect and the counters behind Stats, see restart.go
+	reconnect     atomic.Bool
+	restarts      atomic.Int64
+	reconnects    atomic.Int64
+	restartCloses atomic.Int64
+}
+
+// SetMaxMessageSize sets the largest message, in bytes, the proxies using u read from a
+// client or from Chromium; a bigger one closes the connection with "message too big".
+// It applies to connections opened afterwards. Frames are streamed through the websocket
+// library's fixed-size buffers, so this is the only size limit on CDP traffic.
+func (u *UpstreamManager) SetMaxMessageSize(n int64) {
+	u.maxMessageSize.Store(n)
+}
+
+// readLimit is the read limit for websocket connections the proxies open.
+func (u *UpstreamManager) readLimit() int64 {
+	if n := u.maxMessageSize.Load(); n > 0 {
+		return n
+	}
+	return defaultMaxMessageSize
 }
 
 func NewUpstreamManager(logFilePath string, logger *slog.Logger) *UpstreamManager {
@@ -47,11 +81,24 @@ func NewUpstreamManager(logFilePath string, logger *slog.Logger) *UpstreamManage
 	return um
 }
 
+// NewPollingUpstreamManager returns an UpstreamManager that discovers the DevTools
+// websocket URL by polling http://<addr>/json/version, for images without a supervisord
+// log to tail.
+func NewPollingUpstreamManager(addr string, logger *slog.Logger) *UpstreamManager {
+	um := &UpstreamManager{pollAddr: addr, pollInterval: time.Second, logger: logger}
+	um.currentURL.Store("")
+	return um
+}
+
 // Start begins background tailing and updating the upstream URL until ctx is done.
 func (u *UpstreamManager) Start(ctx context.Context) {
 	u.startOnce.Do(func() {
 		ctx, cancel := context.WithCancel(ctx)
 		u.cancelTail = cancel
+		if u.pollAddr != "" {
+			go u.pollLoop(ctx)
+			return
+		}
 		go u.tailLoop(ctx)
 	})
 }
@@ -90,6 +137,9 @@ func (u *UpstreamManager) setCurrent(url string) {
 	if url != "" && url != prev {
 		u.logger.Info("devtools upstream updated", slog.String("url", url))
 		u.currentURL.Store(url)
+		if prev != "" {
+			u.restarts.Add(1)
+		}
 		// Broadcast update to subscribers without blocking. If a subscriber's
 		// channel buffer (size 1) is full, replace the buffered value with the
 		// latest update to avoid dropping notifications entirely.
@@ -196,6 +246,53 @@ func (u *UpstreamManager) runTailOnce(ctx context.Context) {
 	}
 }
 
+func (u *UpstreamManager) pollLoop(ctx context.Context) {
+	client := &http.Client{Timeout: 2 * time.Second}
+	ticker := time.NewTicker(u.pollInterval)
+	defer ticker.Stop()
+	for {
+		if wsURL, err := u.pollOnce(ctx, client); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			u.logger.Debug("devtools endpoint not available yet; will retry", slog.String("addr", u.pollAddr), slog.String("err", err.Error()))
+		} else {
+			u.setCurrent(wsURL)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollOnce fetches the browser websocket URL from Chromium's /json/version endpoint.
+func (u *UpstreamManager) pollOnce(ctx context.Context, client *http.Client) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+u.pollAddr+"/json/version", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var version struct {
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&version); err != nil {
+		return "", err
+	}
+	if version.WebSocketDebuggerURL == "" {
+		return "", errors.New("no webSocketDebuggerUrl in response")
+	}
+	return version.WebSocketDebuggerURL, nil
+}
+
 func dialUpstreamWithRetry(ctx context.Context, mgr *UpstreamManager, urlCh <-chan string, initialUpstreamURL string, dialOpts *websocket.DialOptions, logger *slog.Logger) (*websocket.Conn, string, error) {
 	upstreamURL := normalizeUpstreamURL(initialUpstreamURL)
 	if upstreamURL == "" {
@@ -297,25 +394,36 @@ func maybePauseAfterCurrentRead(ctx context.Context, logger *slog.Logger, r *htt
 	}
 }
 
+// ParseCompressionMode maps a DEVTOOLS_PROXY_COMPRESSION value ("disabled",
+// "no_context_takeover" or "context_takeover") to the permessage-deflate mode
+// offered on both legs of the proxy.
+func ParseCompressionMode(s string) (websocket.CompressionMode, error) {
+	switch s {
+	case "disabled":
+		return websocket.CompressionDisabled, nil
+	case "no_context_takeover":
+		return websocket.CompressionNoContextTakeover, nil
+	case "context_takeover":
+		return websocket.CompressionContextTakeover, nil
+	default:
+		return 0, fmt.Errorf("unknown compression mode %q", s)
+	}
+}
+
 // WebSocketProxyHandler returns an http.Handler that upgrades incoming connections and
 // proxies them to the current upstream websocket URL. It expects only websocket requests.
-// If logCDPMessages is true, all CDP messages will be logged with their direction.
-func WebSocketProxyHandler(mgr *UpstreamManager, logger *slog.Logger, logCDPMessages bool, ctrl scaletozero.Controller) http.Handler {
+// If logCDPMessages is true, all CDP messages will be logged with their direction, and
+// capture writes each session's messages to a file of its own.
+// compression is offered to the client and to Chromium; each leg negotiates it
+// independently, so either side may end up uncompressed.
+func WebSocketProxyHandler(mgr *UpstreamManager, logger *slog.Logger, logCDPMessages bool, capture CaptureOptions, compression websocket.CompressionMode, ctrl scaletozero.Controller) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var transform wsproxy.MessageTransform
-		if logCDPMessages {
-			transform = func(direction string, mt websocket.MessageType, msg []byte) []byte {
-				logCDPMessage(logger, direction, mt, msg)
-				return msg
-			}
-		}
-
 		acceptOpts := &websocket.AcceptOptions{
 			OriginPatterns:  []string{"*"},
-			CompressionMode: websocket.CompressionContextTakeover,
+			CompressionMode: compression,
 		}
 		dialOpts := &websocket.DialOptions{
-			CompressionMode: websocket.CompressionContextTakeover,
+			CompressionMode: compression,
 		}
 
 		// Subscribe to upstream URL changes so we can tear down stale sessions
@@ -337,7 +445,7 @@ func WebSocketProxyHandler(mgr *UpstreamManager, logger *slog.Logger, logCDPMess
 			logger.Error("websocket accept failed", slog.String("err", err.Error()))
 			return
 		}
-		clientConn.SetReadLimit(100 * 1024 * 1024)
+		clientConn.SetReadLimit(mgr.readLimit())
 
 		// Dial upstream. If the URL is stale (Chromium just restarted), first
 		// re-check the manager's latest URL in case we missed the notification,
@@ -353,46 +461,33 @@ func WebSocketProxyHandler(mgr *UpstreamManager, logger *slog.Logger, logCDPMess
 			}
 			return
 		}
-		upstreamConn.SetReadLimit(100 * 1024 * 1024)
+		upstreamConn.SetReadLimit(mgr.readLimit())
 
 		logger.Debug("proxying websocket", slog.String("url", upstreamURL))
 
-		// Cancel the pump when the upstream URL changes (Chromium restarted),
-		// forcing the client to reconnect with the new upstream.
-		pumpCtx, pumpCancel := context.WithCancel(r.Context())
-
-		go func(currentUpstreamURL string) {
-			for {
-				select {
-				case newURL, ok := <-urlCh:
-					if !ok {
-						return
-					}
-					newURL = normalizeUpstreamURL(newURL)
-					if newURL == "" || newURL == currentUpstreamURL {
-						continue
-					}
-					logger.Info("upstream URL changed, closing stale proxy session",
-						slog.String("old_url", currentUpstreamURL),
-						slog.String("new_url", newURL))
-					pumpCancel()
-					return
-				case <-pumpCtx.Done():
-					return
+		// Closed on every way out of the pump so a gzip capture always ends with its trailer.
+		cdpCap := startCapture(capture, logger)
+		defer stopCapture(cdpCap, logger)
+		var transform wsproxy.MessageTransform
+		if logCDPMessages || cdpCap != nil {
+			transform = func(direction string, mt websocket.MessageType, msg []byte) []byte {
+				if logCDPMessages {
+					logCDPMessage(logger, direction, mt, msg)
+				}
+				if err := cdpCap.write(direction, mt, msg); err != nil {
+					logger.Error("failed to write cdp capture", slog.String("err", err.Error()))
 				}
+				return msg
 			}
-		}(upstreamURL)
-
-		var once sync.Once
-		cleanup := func() {
-			once.Do(func() {
-				pumpCancel()
-				upstreamConn.Close(websocket.StatusNormalClosure, "")
-				clientConn.Close(websocket.StatusNormalClosure, "")
-			})
 		}
 
-		wsproxy.Pump(pumpCtx, clientConn, upstreamConn, cleanup, logger, transform)
+		// When the upstream URL changes (Chromium restarted), either move the session to
+		// the new browser or close it cleanly so the client reconnects.
+		pumpCtx, pumpCancel := context.WithCancel(r.Context())
+		sess := newProxySession(mgr, logger, clientConn, upstreamConn, upstreamURL, dialOpts, pumpCancel)
+		go sess.follow(pumpCtx, urlCh)
+
+		wsproxy.Pump(pumpCtx, clientConn, sess.upstream, sess.close, logger, transform)
 	})
 }
 
@@ -406,16 +501,16 @@ func normalizeUpstreamURL(raw string) string {
 }
 
 // WebSocketProxyHandlerFiltered returns a filtered CDP proxy handler that only allows whitelisted commands
-func WebSocketProxyHandlerFiltered(mgr *UpstreamManager, logger *slog.Logger, ctrl scaletozero.Controller) http.Handler {
+func WebSocketProxyHandlerFiltered(mgr *UpstreamManager, logger *slog.Logger, compression websocket.CompressionMode, ctrl scaletozero.Controller) http.Handler {
 	allowedCommands := createAllowedCommandsMap()
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		acceptOpts := &websocket.AcceptOptions{
 			OriginPatterns:  []string{"*"},
-			CompressionMode: websocket.CompressionContextTakeover,
+			CompressionMode: compression,
 		}
 		dialOpts := &websocket.DialOptions{
-			CompressionMode: websocket.CompressionContextTakeover,
+			CompressionMode: compression,
 		}
 
 		urlCh, unsub := mgr.Subscribe()
@@ -434,7 +529,7 @@ func WebSocketProxyHandlerFiltered(mgr *UpstreamManager, logger *slog.Logger, ct
 			logger.Error("websocket accept failed", slog.String("err", err.Error()))
 			return
 		}
-		clientConn.SetReadLimit(100 * 1024 * 1024)
+		clientConn.SetReadLimit(mgr.readLimit())
 
 		upstreamConn, upstreamURL, err := dialUpstreamWithRetry(r.Context(), mgr, urlCh, upstreamCurrent, dialOpts, logger)
 		if err != nil {
@@ -447,51 +542,22 @@ func WebSocketProxyHandlerFiltered(mgr *UpstreamManager, logger *slog.Logger, ct
 			}
 			return
 		}
-		upstreamConn.SetReadLimit(100 * 1024 * 1024)
+		upstreamConn.SetReadLimit(mgr.readLimit())
 
 		logger.Debug("proxying websocket with CDP filtering", slog.String("url", upstreamURL))
 
 		pumpCtx, pumpCancel := context.WithCancel(r.Context())
-
-		go func(currentUpstreamURL string) {
-			for {
-				select {
-				case newURL, ok := <-urlCh:
-					if !ok {
-						return
-					}
-					newURL = normalizeUpstreamURL(newURL)
-					if newURL == "" || newURL == currentUpstreamURL {
-						continue
-					}
-					logger.Info("upstream URL changed, closing stale proxy session",
-						slog.String("old_url", currentUpstreamURL),
-						slog.String("new_url", newURL))
-					pumpCancel()
-					return
-				case <-pumpCtx.Done():
-					return
-				}
-			}
-		}(upstreamURL)
-
-		var once sync.Once
-		cleanup := func() {
-			once.Do(func() {
-				pumpCancel()
-				upstreamConn.Close(websocket.StatusNormalClosure, "")
-				clientConn.Close(websocket.StatusNormalClosure, "")
-			})
-		}
+		sess := newProxySession(mgr, logger, clientConn, upstreamConn, upstreamURL, dialOpts, pumpCancel)
+		go sess.follow(pumpCtx, urlCh)
 
 		// Use custom pump with CDP filtering
-		pumpWithCDPFilter(pumpCtx, clientConn, upstreamConn, cleanup, logger, allowedCommands)
+		pumpWithCDPFilter(pumpCtx, clientConn, sess.upstream, sess.close, logger, allowedCommands)
 	})
 }
 
 // pumpWithCDPFilter bidirectionally copies messages between client and upstream
 // with filtering on client->upstream direction for CDP commands
-func pumpWithCDPFilter(ctx context.Context, client, upstream *websocket.Conn, onClose func(), logger *slog.Logger, allowedCommands map[string]bool) {
+func pumpWithCDPFilter(ctx context.Context, client, upstream wsproxy.Conn, onClose func(), logger *slog.Logger, allowedCommands map[string]bool) {
 	errChan := make(chan error, 2)
 
 	// Client -> Upstream (with filtering)
@@ -560,6 +626,14 @@ func pumpWithCDPFilter(ctx context.Context, client, upstream *websocket.Conn, on
 	onClose()
 }
 
+// restrictedCommands is the whitelist consulted by CommandAllowed.
+var restrictedCommands = createAllowedCommandsMap()
+
+// CommandAllowed reports whether method may be sent through the restricted CDP endpoint.
+func CommandAllowed(method string) bool {
+	return restrictedCommands[method]
+}
+
 // createAllowedCommandsMap returns the whitelist of CDP commands allowed on the restricted endpoint
 func createAllowedCommandsMap() map[string]bool {
 	return map[string]bool{
@@ -1,6 +1,7 @@
 package devtoolsproxy
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -89,6 +90,48 @@ func TestWaitForInitialTimeoutWhenLogMissing(t *testing.T) {
 	}
 }
 
+func TestPollingUpstreamManager(t *testing.T) {
+	var browserID atomic.Value
+	browserID.Store("first")
+	var ready atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/json/version" || !ready.Load() {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"Browser":"Chrome","webSocketDebuggerUrl":"ws://%s/devtools/browser/%s"}`, r.Host, browserID.Load())
+	}))
+	defer srv.Close()
+	addr := strings.TrimPrefix(srv.URL, "http://")
+
+	mgr := NewPollingUpstreamManager(addr, silentLogger())
+	mgr.pollInterval = 20 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mgr.Start(ctx)
+
+	// nothing is discovered while the endpoint isn't serving yet
+	if _, err := mgr.WaitForInitial(100 * time.Millisecond); err == nil {
+		t.Fatalf("expected timeout before the endpoint is ready")
+	}
+	ready.Store(true)
+	got, err := mgr.WaitForInitial(2 * time.Second)
+	if err != nil {
+		t.Fatalf("WaitForInitial: %v", err)
+	}
+	if want := "ws://" + addr + "/devtools/browser/first"; got != want {
+		t.Fatalf("upstream = %q, want %q", got, want)
+	}
+
+	// a restarted browser is picked up by the next poll
+	browserID.Store("second")
+	if !waitForCondition(2*time.Second, func() bool {
+		return strings.HasSuffix(mgr.Current(), "/devtools/browser/second")
+	}) {
+		t.Fatalf("upstream not updated after restart, still %q", mgr.Current())
+	}
+}
+
 func TestWebSocketProxyHandler_ProxiesEcho(t *testing.T) {
 	// Start an echo websocket server as upstream
 	echoSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -127,7 +170,7 @@ func TestWebSocketProxyHandler_ProxiesEcho(t *testing.T) {
 	// seed current upstream to echo server including path/query (bypass tailing)
 	mgr.setCurrent((&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path, RawQuery: u.RawQuery}).String())
 
-	proxy := WebSocketProxyHandler(mgr, logger, false, scaletozero.NewNoopController())
+	proxy := WebSocketProxyHandler(mgr, logger, false, CaptureOptions{}, websocket.CompressionDisabled, scaletozero.NewNoopController())
 	proxySrv := httptest.NewServer(proxy)
 	defer proxySrv.Close()
 
@@ -159,6 +202,87 @@ func TestWebSocketProxyHandler_ProxiesEcho(t *testing.T) {
 	}
 }
 
+func TestWebSocketProxyHandler_Compression(t *testing.T) {
+	for _, mode := range []string{"disabled", "no_context_takeover", "context_takeover"} {
+		t.Run(mode, func(t *testing.T) {
+			compression, err := ParseCompressionMode(mode)
+			if err != nil {
+				t.Fatalf("parse mode: %v", err)
+			}
+
+			upstreamExt := make(chan string, 1)
+			echoSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				upstreamExt <- r.Header.Get("Sec-WebSocket-Extensions")
+				c, err := websocket.Accept(w, r, &websocket.AcceptOptions{
+					OriginPatterns:  []string{"*"},
+					CompressionMode: websocket.CompressionContextTakeover,
+				})
+				if err != nil {
+					return
+				}
+				defer c.Close(websocket.StatusNormalClosure, "")
+				c.SetReadLimit(-1)
+				for {
+					mt, msg, err := c.Read(r.Context())
+					if err != nil {
+						return
+					}
+					if err := c.Write(r.Context(), mt, msg); err != nil {
+						return
+					}
+				}
+			}))
+			defer echoSrv.Close()
+
+			logger := silentLogger()
+			mgr := NewUpstreamManager("/dev/null", logger)
+			mgr.setCurrent("ws" + strings.TrimPrefix(echoSrv.URL, "http") + "/devtools/browser/x")
+
+			proxySrv := httptest.NewServer(WebSocketProxyHandler(mgr, logger, false, CaptureOptions{}, compression, scaletozero.NewNoopController()))
+			defer proxySrv.Close()
+
+			ctx := context.Background()
+			conn, resp, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(proxySrv.URL, "http"), &websocket.DialOptions{
+				CompressionMode: websocket.CompressionContextTakeover,
+			})
+			if err != nil {
+				t.Fatalf("dial proxy failed: %v", err)
+			}
+			defer conn.Close(websocket.StatusNormalClosure, "")
+			conn.SetReadLimit(-1)
+
+			wantDeflate := mode != "disabled"
+			if got := strings.Contains(resp.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate"); got != wantDeflate {
+				t.Fatalf("client leg deflate = %v, want %v", got, wantDeflate)
+			}
+			if got := strings.Contains(<-upstreamExt, "permessage-deflate"); got != wantDeflate {
+				t.Fatalf("upstream leg deflate = %v, want %v", got, wantDeflate)
+			}
+
+			// binary frames of mixed compressibility must arrive unchanged and keep their type,
+			// including across several messages sharing a context-takeover window
+			for i := 0; i < 3; i++ {
+				payload := make([]byte, 256<<10)
+				for j := range payload {
+					if j%3 == 0 {
+						payload[j] = byte(j * (i + 7))
+					}
+				}
+				if err := conn.Write(ctx, websocket.MessageBinary, payload); err != nil {
+					t.Fatalf("write failed: %v", err)
+				}
+				mt, got, err := conn.Read(ctx)
+				if err != nil {
+					t.Fatalf("read failed: %v", err)
+				}
+				if mt != websocket.MessageBinary || !bytes.Equal(got, payload) {
+					t.Fatalf("message %d corrupted (type %v, %d bytes)", i, mt, len(got))
+				}
+			}
+		})
+	}
+}
+
 func TestDialUpstreamWithRetry_RechecksCurrentAfterMissedUpdate(t *testing.T) {
 	// Start a working websocket upstream.
 	upstreamSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
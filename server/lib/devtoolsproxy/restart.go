@@ -0,0 +1,253 @@
+package devtoolsproxy
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/wsproxy"
+)
+
+// reconnectTimeout is how long a reconnecting session waits for Chromium to come back
+// after its upstream connection drops before giving up on the client.
+const reconnectTimeout = 10 * time.Second
+
+// restartCloseTimeout bounds the notification sent to a client whose browser went away.
+const restartCloseTimeout = 2 * time.Second
+
+// inspectorDetached is the event Chromium itself sends when a DevTools connection ends
+// because its target closed, so clients handle a restart like a browser shutdown.
+var inspectorDetached = []byte(`{"method":"Inspector.detached","params":{"reason":"target_closed"}}`)
+
+// UpstreamStats counts Chromium restarts seen by an UpstreamManager and what became of
+// the proxy sessions connected at the time.
+type UpstreamStats struct {
+	// Restarts is how many times the upstream URL changed after the first was discovered.
+	Restarts int64 `json:"restarts"`
+	// Reconnects counts sessions moved to a new upstream without closing the client.
+	Reconnects int64 `json:"reconnects"`
+	// RestartCloses counts sessions closed because their browser went away.
+	RestartCloses int64 `json:"restart_closes"`
+}
+
+// EnableReconnect makes the non-multiplexed proxies keep client connections open across
+// Chromium restarts, moving their upstream leg to the new browser. Clients then see the
+// new browser's targets: sessions and domains they enabled on the old one are gone.
+func (u *UpstreamManager) EnableReconnect() {
+	u.reconnect.Store(true)
+}
+
+// Stats returns the restart and reconnect counts.
+func (u *UpstreamManager) Stats() UpstreamStats {
+	return UpstreamStats{
+		Restarts:      u.restarts.Load(),
+		Reconnects:    u.reconnects.Load(),
+		RestartCloses: u.restartCloses.Load(),
+	}
+}
+
+// StatsHandler serves mgr's UpstreamStats as JSON.
+func StatsHandler(mgr *UpstreamManager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(mgr.Stats())
+	})
+}
+
+// closeForRestart tells a client its browser went away, with an Inspector.detached event
+// and a 1012 (service restart) close, so it can reconnect rather than treat it as an error.
+func closeForRestart(client wsproxy.Conn, reason string) {
+	ctx, cancel := context.WithTimeout(context.Background(), restartCloseTimeout)
+	defer cancel()
+	_ = client.Write(ctx, websocket.MessageText, inspectorDetached)
+	_ = client.Close(websocket.StatusServiceRestart, reason)
+}
+
+// rotatingConn is the upstream leg of a proxy session. It reads from and writes to the
+// current Chromium connection and, when reconnecting, rides out a restart by waiting for
+// the connection to be replaced instead of failing.
+type rotatingConn struct {
+	reconnect bool
+
+	mu      sync.Mutex
+	conn    *websocket.Conn
+	url     string
+	swapped chan struct{} // closed when conn is replaced
+	closed  chan struct{} // closed by Close; no replacement follows
+	lost    atomic.Bool   // a read failed other than by cancellation
+}
+
+func newRotatingConn(conn *websocket.Conn, url string, reconnect bool) *rotatingConn {
+	return &rotatingConn{reconnect: reconnect, conn: conn, url: url, swapped: make(chan struct{}), closed: make(chan struct{})}
+}
+
+func (rc *rotatingConn) current() (*websocket.Conn, string, <-chan struct{}) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.conn, rc.url, rc.swapped
+}
+
+// swap replaces the connection, closing the old one. It reports false, closing conn
+// instead, if the session already ended.
+func (rc *rotatingConn) swap(conn *websocket.Conn, url string) bool {
+	rc.mu.Lock()
+	select {
+	case <-rc.closed:
+		rc.mu.Unlock()
+		conn.Close(websocket.StatusNormalClosure, "")
+		return false
+	default:
+	}
+	old, swapped := rc.conn, rc.swapped
+	rc.conn, rc.url, rc.swapped = conn, url, make(chan struct{})
+	rc.mu.Unlock()
+	close(swapped)
+	old.Close(websocket.StatusGoingAway, "")
+	return true
+}
+
+// wait reports whether the connection was replaced after swapped was read, waiting up to
+// reconnectTimeout for it when reconnecting.
+func (rc *rotatingConn) wait(ctx context.Context, swapped <-chan struct{}) bool {
+	if !rc.reconnect {
+		return false
+	}
+	timer := time.NewTimer(reconnectTimeout)
+	defer timer.Stop()
+	select {
+	case <-swapped:
+		return true
+	case <-rc.closed:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	return false
+}
+
+func (rc *rotatingConn) Read(ctx context.Context) (websocket.MessageType, []byte, error) {
+	for {
+		conn, _, swapped := rc.current()
+		mt, msg, err := conn.Read(ctx)
+		if err == nil || ctx.Err() != nil {
+			return mt, msg, err
+		}
+		if !rc.wait(ctx, swapped) {
+			rc.lost.Store(true)
+			return mt, msg, err
+		}
+	}
+}
+
+// Write sends p upstream, resending it to the new browser once if the old one went away
+// mid-write.
+func (rc *rotatingConn) Write(ctx context.Context, mt websocket.MessageType, p []byte) error {
+	conn, _, swapped := rc.current()
+	err := conn.Write(ctx, mt, p)
+	if err != nil && ctx.Err() == nil && rc.wait(ctx, swapped) {
+		conn, _, _ = rc.current()
+		return conn.Write(ctx, mt, p)
+	}
+	return err
+}
+
+func (rc *rotatingConn) Close(code websocket.StatusCode, reason string) error {
+	rc.mu.Lock()
+	select {
+	case <-rc.closed:
+	default:
+		close(rc.closed)
+	}
+	conn := rc.conn
+	rc.mu.Unlock()
+	return conn.Close(code, reason)
+}
+
+// proxySession ties a proxied client connection to its upstream leg across Chromium
+// restarts.
+type proxySession struct {
+	mgr      *UpstreamManager
+	logger   *slog.Logger
+	client   *websocket.Conn
+	upstream *rotatingConn
+	dialOpts *websocket.DialOptions
+	// cancel stops the pump; the client must be closed first for the close to be clean
+	cancel context.CancelFunc
+
+	restarted atomic.Bool
+	once      sync.Once
+}
+
+func newProxySession(mgr *UpstreamManager, logger *slog.Logger, client, upstream *websocket.Conn, upstreamURL string, dialOpts *websocket.DialOptions, cancel context.CancelFunc) *proxySession {
+	return &proxySession{
+		mgr:      mgr,
+		logger:   logger,
+		client:   client,
+		upstream: newRotatingConn(upstream, upstreamURL, mgr.reconnect.Load()),
+		dialOpts: dialOpts,
+		cancel:   cancel,
+	}
+}
+
+// follow watches urlCh until ctx is done. When Chromium restarts it moves the upstream leg
+// to the new browser if the manager reconnects sessions, and otherwise ends the session.
+func (s *proxySession) follow(ctx context.Context, urlCh <-chan string) {
+	for {
+		select {
+		case newURL, ok := <-urlCh:
+			if !ok {
+				return
+			}
+			newURL = normalizeUpstreamURL(newURL)
+			_, currentURL, _ := s.upstream.current()
+			if newURL == "" || newURL == currentURL {
+				continue
+			}
+			if s.upstream.reconnect && s.reconnect(ctx, urlCh, newURL) {
+				continue
+			}
+			s.logger.Info("upstream URL changed, closing stale proxy session",
+				slog.String("old_url", currentURL),
+				slog.String("new_url", newURL))
+			s.restarted.Store(true)
+			s.close()
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reconnect dials the browser at newURL and moves the upstream leg to it.
+func (s *proxySession) reconnect(ctx context.Context, urlCh <-chan string, newURL string) bool {
+	conn, upstreamURL, err := dialUpstreamWithRetry(ctx, s.mgr, urlCh, newURL, s.dialOpts, s.logger)
+	if err != nil {
+		s.logger.Warn("failed to reconnect proxy session to new upstream", slog.String("err", err.Error()), slog.String("url", newURL))
+		return false
+	}
+	conn.SetReadLimit(s.mgr.readLimit())
+	if !s.upstream.swap(conn, upstreamURL) {
+		return false
+	}
+	s.mgr.reconnects.Add(1)
+	s.logger.Info("reconnected proxy session to new upstream", slog.String("url", upstreamURL))
+	return true
+}
+
+// close ends the session once. A session whose browser went away gets a restart close;
+// others close normally.
+func (s *proxySession) close() {
+	s.once.Do(func() {
+		if s.restarted.Load() || s.upstream.lost.Load() {
+			s.mgr.restartCloses.Add(1)
+			closeForRestart(s.client, "chromium restarted")
+		}
+		s.cancel()
+		s.upstream.Close(websocket.StatusNormalClosure, "")
+		s.client.Close(websocket.StatusNormalClosure, "")
+	})
+}
@@ -0,0 +1,192 @@
+package devtoolsproxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+)
+
+// namedEchoUpstream stands in for a browser: it echoes messages prefixed with name, and
+// drops the connection when sent "bye".
+func namedEchoUpstream(t *testing.T, name string) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.CloseNow()
+		for {
+			mt, msg, err := c.Read(r.Context())
+			if err != nil || string(msg) == "bye" {
+				return
+			}
+			if err := c.Write(r.Context(), mt, []byte(name+"|"+string(msg))); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http") + "/devtools/browser/" + name
+}
+
+// dialProxy connects a client to a proxy serving h.
+func dialProxy(t *testing.T, h http.Handler) *websocket.Conn {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	conn, _, err := websocket.Dial(context.Background(), "ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("dial proxy: %v", err)
+	}
+	t.Cleanup(func() { conn.CloseNow() })
+	return conn
+}
+
+func roundTrip(t *testing.T, conn *websocket.Conn, msg string) string {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := conn.Write(ctx, websocket.MessageText, []byte(msg)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_, resp, err := conn.Read(ctx)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	return string(resp)
+}
+
+// expectRestartClose checks the client is told its browser went away and closed with 1012.
+func expectRestartClose(t *testing.T, conn *websocket.Conn) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, msg, err := conn.Read(ctx)
+	if err != nil {
+		t.Fatalf("expected Inspector.detached, got error: %v", err)
+	}
+	if string(msg) != string(inspectorDetached) {
+		t.Fatalf("unexpected message: %s", msg)
+	}
+	_, _, err = conn.Read(ctx)
+	if status := websocket.CloseStatus(err); status != websocket.StatusServiceRestart {
+		t.Fatalf("expected close status %d, got %d (%v)", websocket.StatusServiceRestart, status, err)
+	}
+}
+
+func waitForStats(t *testing.T, mgr *UpstreamManager, ok func(UpstreamStats) bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !ok(mgr.Stats()) {
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected stats: %+v", mgr.Stats())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWebSocketProxyHandler_RestartClose(t *testing.T) {
+	mgr := NewUpstreamManager("/dev/null", silentLogger())
+	mgr.setCurrent(namedEchoUpstream(t, "a"))
+	proxy := WebSocketProxyHandler(mgr, silentLogger(), false, CaptureOptions{}, websocket.CompressionDisabled, scaletozero.NewNoopController())
+	restarted := namedEchoUpstream(t, "b")
+
+	t.Run("upstream changed", func(t *testing.T) {
+		conn := dialProxy(t, proxy)
+		if got := roundTrip(t, conn, "hi"); got != "a|hi" {
+			t.Fatalf("unexpected echo: %q", got)
+		}
+		mgr.setCurrent(restarted)
+		expectRestartClose(t, conn)
+		if stats := mgr.Stats(); stats.Restarts != 1 || stats.RestartCloses != 1 || stats.Reconnects != 0 {
+			t.Fatalf("unexpected stats: %+v", stats)
+		}
+	})
+
+	t.Run("upstream dropped", func(t *testing.T) {
+		conn := dialProxy(t, WebSocketProxyHandlerFiltered(mgr, silentLogger(), websocket.CompressionDisabled, scaletozero.NewNoopController()))
+		if err := conn.Write(context.Background(), websocket.MessageText, []byte("bye")); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		expectRestartClose(t, conn)
+		if stats := mgr.Stats(); stats.RestartCloses != 2 {
+			t.Fatalf("unexpected stats: %+v", stats)
+		}
+	})
+}
+
+func TestWebSocketProxyHandler_Reconnect(t *testing.T) {
+	mgr := NewUpstreamManager("/dev/null", silentLogger())
+	mgr.EnableReconnect()
+	mgr.setCurrent(namedEchoUpstream(t, "a"))
+	conn := dialProxy(t, WebSocketProxyHandler(mgr, silentLogger(), false, CaptureOptions{}, websocket.CompressionDisabled, scaletozero.NewNoopController()))
+	if got := roundTrip(t, conn, "hi"); got != "a|hi" {
+		t.Fatalf("unexpected echo: %q", got)
+	}
+
+	// a restart announced while the old browser is still up
+	mgr.setCurrent(namedEchoUpstream(t, "b"))
+	waitForStats(t, mgr, func(s UpstreamStats) bool { return s.Reconnects == 1 })
+	if got := roundTrip(t, conn, "hi"); got != "b|hi" {
+		t.Fatalf("unexpected echo after reconnect: %q", got)
+	}
+
+	// the browser dies before the new one is announced, as when Chromium crashes
+	if err := conn.Write(context.Background(), websocket.MessageText, []byte("bye")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	mgr.setCurrent(namedEchoUpstream(t, "c"))
+	waitForStats(t, mgr, func(s UpstreamStats) bool { return s.Reconnects == 2 })
+	if got := roundTrip(t, conn, "hi"); got != "c|hi" {
+		t.Fatalf("unexpected echo after reconnect: %q", got)
+	}
+	if stats := mgr.Stats(); stats.Restarts != 2 || stats.RestartCloses != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestMultiplexer_RestartClose(t *testing.T) {
+	f := &fakeMuxUpstream{detached: make(chan string, 4)}
+	upstreamSrv := httptest.NewServer(http.HandlerFunc(f.handler))
+	defer upstreamSrv.Close()
+	mgr := NewUpstreamManager("/dev/null", silentLogger())
+	mgr.setCurrent("ws" + strings.TrimPrefix(upstreamSrv.URL, "http") + "/devtools/browser/x")
+	mux := NewMultiplexer(mgr, silentLogger(), false, CaptureOptions{}, websocket.CompressionDisabled)
+	defer mux.Close()
+
+	conn := dialProxy(t, mux)
+	roundTrip(t, conn, `{"id":1,"method":"Browser.getVersion"}`)
+	mgr.setCurrent(namedEchoUpstream(t, "b"))
+	expectRestartClose(t, conn)
+	if stats := mgr.Stats(); stats.RestartCloses != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestWebSocketProxyHandler_MaxMessageSize(t *testing.T) {
+	mgr := NewUpstreamManager("/dev/null", silentLogger())
+	mgr.SetMaxMessageSize(1024)
+	mgr.setCurrent(namedEchoUpstream(t, "a"))
+	conn := dialProxy(t, WebSocketProxyHandler(mgr, silentLogger(), false, CaptureOptions{}, websocket.CompressionDisabled, scaletozero.NewNoopController()))
+	if got := roundTrip(t, conn, "hi"); got != "a|hi" {
+		t.Fatalf("unexpected echo: %q", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := conn.Write(ctx, websocket.MessageText, []byte(strings.Repeat("x", 2048))); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_, _, err := conn.Read(ctx)
+	if status := websocket.CloseStatus(err); status != websocket.StatusMessageTooBig {
+		t.Fatalf("expected close with message too big, got %v", err)
+	}
+}
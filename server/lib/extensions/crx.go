@@ -0,0 +1,338 @@
+package extensions
+
+import (
+	"archive/zip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// crxMagic starts every CRX file; CRX3 is the only version Chromium still installs.
+const (
+	crxMagic   = "Cr24"
+	crxVersion = 3
+	// maxCRXHeaderSize bounds the header read into memory; real headers are a few KB.
+	maxCRXHeaderSize = 1 << 20
+)
+
+// CrxFileHeader and SignedData field numbers, from Chromium's crx3.proto.
+const (
+	fieldSHA256WithRSA    = 2
+	fieldSHA256WithECDSA  = 3
+	fieldSignedHeaderData = 10000
+	fieldProofPublicKey   = 1
+	fieldProofSignature   = 2
+	fieldSignedDataCRXID  = 1
+)
+
+// CRX is a parsed CRX3 package.
+type CRX struct {
+	// ID is the Chrome extension ID the package declares.
+	ID string
+	// Version is the version in the packaged manifest.json.
+	Version string
+}
+
+// crxProof is one AsymmetricKeyProof of a CRX header.
+type crxProof struct {
+	field     uint64
+	publicKey []byte
+	signature []byte
+}
+
+// crxHeader is the parts of a CrxFileHeader the server uses.
+type crxHeader struct {
+	proofs     []crxProof
+	signedData []byte
+	declaredID []byte
+}
+
+// openCRX opens the CRX3 package at path and parses its header. It returns the file, its
+// size and the offset of its zip archive; the caller closes the file.
+func openCRX(path string) (f *os.File, size int64, archiveOffset int64, h crxHeader, err error) {
+	f, err = os.Open(path)
+	if err != nil {
+		return nil, 0, 0, h, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, 0, h, err
+	}
+
+	var prefix [12]byte
+	if _, err := io.ReadFull(f, prefix[:]); err != nil {
+		return nil, 0, 0, h, fmt.Errorf("read crx header: %w", err)
+	}
+	if string(prefix[:4]) != crxMagic {
+		return nil, 0, 0, h, errors.New("not a crx file")
+	}
+	if v := binary.LittleEndian.Uint32(prefix[4:8]); v != crxVersion {
+		return nil, 0, 0, h, fmt.Errorf("unsupported crx version %d", v)
+	}
+	headerSize := int64(binary.LittleEndian.Uint32(prefix[8:12]))
+	if headerSize > maxCRXHeaderSize || 12+headerSize > info.Size() {
+		return nil, 0, 0, h, fmt.Errorf("invalid crx header size %d", headerSize)
+	}
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(f, header); err != nil {
+		return nil, 0, 0, h, fmt.Errorf("read crx header: %w", err)
+	}
+	if h, err = parseCRXHeader(header); err != nil {
+		return nil, 0, 0, h, fmt.Errorf("parse crx header: %w", err)
+	}
+	return f, info.Size(), 12 + headerSize, h, nil
+}
+
+// ReadCRX parses the CRX3 package at path: its extension ID from the signed header and
+// its version from the manifest.json in the archive.
+func ReadCRX(path string) (*CRX, error) {
+	f, size, archiveOffset, h, err := openCRX(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	id, err := h.id()
+	if err != nil {
+		return nil, err
+	}
+	version, err := archiveVersion(io.NewSectionReader(f, archiveOffset, size-archiveOffset))
+	if err != nil {
+		return nil, err
+	}
+	return &CRX{ID: id, Version: version}, nil
+}
+
+// crxSignedDataPrefix starts the message every CRX3 proof signs.
+const crxSignedDataPrefix = "CRX3 SignedData\x00"
+
+// VerifyCRX checks the signatures of the CRX3 package at path the way Chromium does on
+// install: every proof must verify over the signed header data and the archive, and one
+// must be made with the key the declared extension ID derives from.
+func VerifyCRX(path string) error {
+	f, size, archiveOffset, h, err := openCRX(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if len(h.declaredID) != 16 {
+		return errors.New("crx header declares no extension id")
+	}
+	if len(h.proofs) == 0 {
+		return errors.New("crx has no signatures")
+	}
+	digest := sha256.New()
+	digest.Write([]byte(crxSignedDataPrefix))
+	_ = binary.Write(digest, binary.LittleEndian, uint32(len(h.signedData)))
+	digest.Write(h.signedData)
+	if _, err := io.Copy(digest, io.NewSectionReader(f, archiveOffset, size-archiveOffset)); err != nil {
+		return fmt.Errorf("read crx archive: %w", err)
+	}
+	sum := digest.Sum(nil)
+
+	id := encodeID(h.declaredID)
+	developerSigned := false
+	for i, p := range h.proofs {
+		if err := p.verify(sum); err != nil {
+			return fmt.Errorf("signature %d: %w", i, err)
+		}
+		if keyID(p.publicKey) == id {
+			developerSigned = true
+		}
+	}
+	if !developerSigned {
+		return fmt.Errorf("no signature with the key of extension id %s", id)
+	}
+	return nil
+}
+
+// verify checks the proof's signature of a SHA-256 digest.
+func (p crxProof) verify(digest []byte) error {
+	key, err := x509.ParsePKIXPublicKey(p.publicKey)
+	if err != nil {
+		return fmt.Errorf("parse public key: %w", err)
+	}
+	switch p.field {
+	case fieldSHA256WithRSA:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.New("sha256_with_rsa proof without an RSA key")
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest, p.signature)
+	default:
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.New("sha256_with_ecdsa proof without an ECDSA key")
+		}
+		if !ecdsa.VerifyASN1(ecKey, digest, p.signature) {
+			return errors.New("ecdsa signature does not verify")
+		}
+		return nil
+	}
+}
+
+// FileSHA256 returns the hex SHA-256 digest and size of the file at path.
+func FileSHA256(path string) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+// parseCRXHeader extracts the proofs and signed header data of a CrxFileHeader.
+func parseCRXHeader(header []byte) (crxHeader, error) {
+	var h crxHeader
+	err := walkProto(header, func(field uint64, value []byte) error {
+		switch field {
+		case fieldSignedHeaderData:
+			h.signedData = value
+			return walkProto(value, func(field uint64, value []byte) error {
+				if field == fieldSignedDataCRXID {
+					h.declaredID = value
+				}
+				return nil
+			})
+		case fieldSHA256WithRSA, fieldSHA256WithECDSA:
+			p := crxProof{field: field}
+			err := walkProto(value, func(field uint64, value []byte) error {
+				switch field {
+				case fieldProofPublicKey:
+					p.publicKey = value
+				case fieldProofSignature:
+					p.signature = value
+				}
+				return nil
+			})
+			h.proofs = append(h.proofs, p)
+			return err
+		}
+		return nil
+	})
+	return h, err
+}
+
+// id returns the extension ID the header declares: the crx_id of its signed header data,
+// or for packages without one, the ID derived from the first public key.
+func (h crxHeader) id() (string, error) {
+	switch {
+	case len(h.declaredID) == 16:
+		return encodeID(h.declaredID), nil
+	case h.declaredID != nil:
+		return "", fmt.Errorf("crx_id has %d bytes, want 16", len(h.declaredID))
+	case len(h.proofs) > 0:
+		return keyID(h.proofs[0].publicKey), nil
+	}
+	return "", errors.New("crx header declares no extension id")
+}
+
+// keyID returns the extension ID derived from a DER-encoded public key.
+func keyID(publicKey []byte) string {
+	sum := sha256.Sum256(publicKey)
+	return encodeID(sum[:16])
+}
+
+// encodeID renders a 16-byte crx_id the way Chrome does: each hex digit 0-f as a-p.
+func encodeID(raw []byte) string {
+	id := []byte(hex.EncodeToString(raw))
+	for i, c := range id {
+		if c <= '9' {
+			id[i] = 'a' + c - '0'
+		} else {
+			id[i] = 'a' + 10 + c - 'a'
+		}
+	}
+	return string(id)
+}
+
+// walkProto calls fn with the number and contents of every length-delimited field of the
+// protobuf message b, skipping fields of other wire types.
+func walkProto(b []byte, fn func(field uint64, value []byte) error) error {
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errors.New("bad field tag")
+		}
+		b = b[n:]
+		field, wireType := tag>>3, tag&7
+		switch wireType {
+		case 0: // varint
+			if _, n = binary.Uvarint(b); n <= 0 {
+				return errors.New("bad varint")
+			}
+			b = b[n:]
+		case 1: // 64-bit
+			if len(b) < 8 {
+				return errors.New("truncated field")
+			}
+			b = b[8:]
+		case 5: // 32-bit
+			if len(b) < 4 {
+				return errors.New("truncated field")
+			}
+			b = b[4:]
+		case 2: // length-delimited
+			size, n := binary.Uvarint(b)
+			if n <= 0 || size > uint64(len(b)-n) {
+				return errors.New("truncated field")
+			}
+			value := b[n : n+int(size)]
+			b = b[n+int(size):]
+			if err := fn(field, value); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported wire type %d", wireType)
+		}
+	}
+	return nil
+}
+
+// archiveVersion reads the version from the manifest.json in a CRX's zip archive.
+func archiveVersion(archive *io.SectionReader) (string, error) {
+	zr, err := zip.NewReader(archive, archive.Size())
+	if err != nil {
+		return "", fmt.Errorf("read crx archive: %w", err)
+	}
+	mf, err := zr.Open("manifest.json")
+	if err != nil {
+		return "", fmt.Errorf("read crx manifest: %w", err)
+	}
+	defer mf.Close()
+	return decodeVersion(mf)
+}
+
+// decodeVersion reads the version field of an extension manifest.
+func decodeVersion(r io.Reader) (string, error) {
+	var manifest struct {
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return "", fmt.Errorf("parse manifest: %w", err)
+	}
+	if manifest.Version == "" {
+		return "", errors.New("manifest has no version")
+	}
+	return manifest.Version, nil
+}
@@ -0,0 +1,216 @@
+// Package extensions describes the browser extensions installed under a directory and
+// serves them to Chromium, generating the update manifests policy installation needs.
+package extensions
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+)
+
+// UpdateXML is the update manifest file name Chromium's ExtensionSettings point at.
+const UpdateXML = "update.xml"
+
+// Extension describes one extension directory.
+type Extension struct {
+	// Name is the directory name, used in the extension's URLs.
+	Name string `json:"name"`
+	// ID is the Chrome extension ID declared by the directory's CRX.
+	ID string `json:"id,omitempty"`
+	// Version comes from the CRX, or the unpacked manifest.json without one.
+	Version string `json:"version,omitempty"`
+	// CRX is the file name of the packed extension; the first in name order if several.
+	CRX string `json:"crx,omitempty"`
+	// HasUpdateXML is set when the directory ships its own update.xml, which is then
+	// served instead of a generated one.
+	HasUpdateXML bool `json:"has_update_xml"`
+	// Error explains why the CRX or manifest could not be read.
+	Error string `json:"error,omitempty"`
+}
+
+// Describe reads the extension in dir/name. Unreadable CRX or manifest files are reported
+// in the Error field rather than failing, so one broken extension doesn't hide the rest.
+func Describe(dir, name string) (Extension, error) {
+	extDir := filepath.Join(dir, name)
+	entries, err := os.ReadDir(extDir)
+	if err != nil {
+		return Extension{}, err
+	}
+
+	ext := Extension{Name: name}
+	for _, e := range entries {
+		switch {
+		case e.IsDir():
+		case e.Name() == UpdateXML:
+			ext.HasUpdateXML = true
+		case filepath.Ext(e.Name()) == ".crx" && ext.CRX == "":
+			ext.CRX = e.Name()
+		}
+	}
+
+	if ext.CRX != "" {
+		crx, err := ReadCRX(filepath.Join(extDir, ext.CRX))
+		if err != nil {
+			ext.Error = fmt.Sprintf("%s: %v", ext.CRX, err)
+			return ext, nil
+		}
+		ext.ID, ext.Version = crx.ID, crx.Version
+		return ext, nil
+	}
+	f, err := os.Open(filepath.Join(extDir, "manifest.json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return ext, nil
+	}
+	if err != nil {
+		ext.Error = err.Error()
+		return ext, nil
+	}
+	defer f.Close()
+	if ext.Version, err = decodeVersion(f); err != nil {
+		ext.Error = fmt.Sprintf("manifest.json: %v", err)
+	}
+	return ext, nil
+}
+
+// Scan describes every extension directory in dir, sorted by name. A missing dir has none.
+func Scan(dir string) ([]Extension, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Extension{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exts := []Extension{}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		ext, err := Describe(dir, e.Name())
+		if err != nil {
+			return nil, err
+		}
+		exts = append(exts, ext)
+	}
+	return exts, nil
+}
+
+type gupdate struct {
+	XMLName  xml.Name     `xml:"http://www.google.com/update2/response gupdate"`
+	Protocol string       `xml:"protocol,attr"`
+	Apps     []gupdateApp `xml:"app"`
+}
+
+type gupdateApp struct {
+	AppID       string `xml:"appid,attr"`
+	UpdateCheck struct {
+		Codebase string `xml:"codebase,attr"`
+		Version  string `xml:"version,attr"`
+	} `xml:"updatecheck"`
+}
+
+// UpdateManifest renders a Chrome update manifest listing the CRX of each of exts that has
+// one, with codebase URLs under baseURL (the URL the extensions directory is served at).
+func UpdateManifest(exts []Extension, baseURL string) ([]byte, error) {
+	m := gupdate{Protocol: "2.0"}
+	for _, ext := range exts {
+		if ext.ID == "" || ext.CRX == "" {
+			continue
+		}
+		app := gupdateApp{AppID: ext.ID}
+		app.UpdateCheck.Codebase = strings.TrimSuffix(baseURL, "/") + "/" + path.Join(ext.Name, ext.CRX)
+		app.UpdateCheck.Version = ext.Version
+		m.Apps = append(m.Apps, app)
+	}
+	b, err := xml.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// Handler serves the extensions in dir to requests with the mount prefix already
+// stripped. Besides the files themselves it serves index.json, describing every extension,
+// update.xml, an update manifest listing every CRX, and <name>/update.xml for extensions
+// that ship a CRX without one. Codebase URLs point back at prefix on the requested host.
+// With verifyCRX set, CRX files are checked with VerifyCRX before they are served, and
+// ones that fail are logged with their digest and refused with a 500.
+func Handler(dir, prefix string, verifyCRX bool) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+		p := strings.TrimPrefix(r.URL.Path, "/")
+
+		var exts []Extension
+		switch name, file, nested := strings.Cut(p, "/"); {
+		case p == "index.json":
+			exts, err := Scan(dir)
+			if err != nil {
+				log.Error("failed to scan extensions", "err", err, "dir", dir)
+				http.Error(w, "failed to scan extensions", http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(exts)
+			return
+		case p == UpdateXML:
+			var err error
+			if exts, err = Scan(dir); err != nil {
+				log.Error("failed to scan extensions", "err", err, "dir", dir)
+				http.Error(w, "failed to scan extensions", http.StatusInternalServerError)
+				return
+			}
+		case verifyCRX && path.Ext(p) == ".crx":
+			crxPath := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+p)))
+			if err := VerifyCRX(crxPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				digest, size, _ := FileSHA256(crxPath)
+				log.Error("refusing to serve extension that fails verification", "err", err, "file", p, "sha256", digest, "size", size)
+				http.Error(w, "extension failed signature verification", http.StatusInternalServerError)
+				return
+			}
+			files.ServeHTTP(w, r)
+			return
+		case nested && file == UpdateXML && validName(name):
+			ext, err := Describe(dir, name)
+			if err != nil || ext.HasUpdateXML {
+				// serve the shipped file, or the file server's 404
+				files.ServeHTTP(w, r)
+				return
+			}
+			if ext.ID == "" {
+				http.NotFound(w, r)
+				return
+			}
+			exts = []Extension{ext}
+		default:
+			files.ServeHTTP(w, r)
+			return
+		}
+
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		b, err := UpdateManifest(exts, fmt.Sprintf("%s://%s%s", scheme, r.Host, prefix))
+		if err != nil {
+			log.Error("failed to render update manifest", "err", err)
+			http.Error(w, "failed to render update manifest", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write(b)
+	})
+}
+
+// validName reports whether name can be an extension directory name.
+func validName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,246 @@
+package extensions
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// protoField encodes a length-delimited protobuf field.
+func protoField(field uint64, value []byte) []byte {
+	b := binary.AppendUvarint(nil, field<<3|2)
+	b = binary.AppendUvarint(b, uint64(len(value)))
+	return append(b, value...)
+}
+
+// testKey returns a signing key and its DER-encoded public key.
+func testKey(t *testing.T) (crypto.Signer, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return key, der
+}
+
+// buildCRX returns a CRX3 package of an extension with version, signed with each of keys.
+// The signed header declares the ID of the declared key, or none if it is nil.
+func buildCRX(t *testing.T, version string, declared crypto.Signer, keys ...crypto.Signer) []byte {
+	t.Helper()
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("manifest.json")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`{"manifest_version":3,"name":"test","version":"` + version + `"}`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var signedData []byte
+	if declared != nil {
+		der, err := x509.MarshalPKIXPublicKey(declared.Public())
+		require.NoError(t, err)
+		sum := sha256.Sum256(der)
+		signedData = protoField(fieldSignedDataCRXID, sum[:16])
+	}
+	message := []byte(crxSignedDataPrefix)
+	message = binary.LittleEndian.AppendUint32(message, uint32(len(signedData)))
+	message = append(message, signedData...)
+	message = append(message, archive.Bytes()...)
+	digest := sha256.Sum256(message)
+
+	var header []byte
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		require.NoError(t, err)
+		sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		field := uint64(fieldSHA256WithECDSA)
+		if _, ok := key.(*rsa.PrivateKey); ok {
+			field = fieldSHA256WithRSA
+		}
+		header = append(header, protoField(field, append(protoField(fieldProofPublicKey, der), protoField(fieldProofSignature, sig)...))...)
+	}
+	if declared != nil {
+		header = append(header, protoField(fieldSignedHeaderData, signedData)...)
+	}
+
+	crx := []byte(crxMagic)
+	crx = binary.LittleEndian.AppendUint32(crx, crxVersion)
+	crx = binary.LittleEndian.AppendUint32(crx, uint32(len(header)))
+	crx = append(crx, header...)
+	return append(crx, archive.Bytes()...)
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+}
+
+func TestEncodeID(t *testing.T) {
+	raw := []byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0, 0, 0, 0, 0, 0, 0, 0}
+	assert.Equal(t, "abcdefghijklmnopaaaaaaaaaaaaaaaa", encodeID(raw))
+}
+
+func TestReadCRX(t *testing.T) {
+	dir := t.TempDir()
+	key, der := testKey(t)
+
+	path := filepath.Join(dir, "declared.crx")
+	writeFile(t, path, buildCRX(t, "1.2.3", key, key))
+	crx, err := ReadCRX(path)
+	require.NoError(t, err)
+	assert.Equal(t, keyID(der), crx.ID)
+	assert.Equal(t, "1.2.3", crx.Version)
+
+	path = filepath.Join(dir, "undeclared.crx")
+	writeFile(t, path, buildCRX(t, "2.0", nil, key))
+	crx, err = ReadCRX(path)
+	require.NoError(t, err)
+	assert.Equal(t, keyID(der), crx.ID, "ID should fall back to the first public key")
+
+	path = filepath.Join(dir, "bogus.crx")
+	writeFile(t, path, []byte("PK\x03\x04 not a crx"))
+	_, err = ReadCRX(path)
+	assert.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	dir := t.TempDir()
+	packedKey, packedDER := testKey(t)
+	shippedKey, _ := testKey(t)
+	writeFile(t, filepath.Join(dir, "packed", "packed.crx"), buildCRX(t, "1.0", packedKey, packedKey))
+	writeFile(t, filepath.Join(dir, "shipped", "shipped.crx"), buildCRX(t, "3.0", shippedKey, shippedKey))
+	writeFile(t, filepath.Join(dir, "shipped", UpdateXML), []byte("<gupdate>shipped</gupdate>"))
+	writeFile(t, filepath.Join(dir, "unpacked", "manifest.json"), []byte(`{"version":"0.9"}`))
+	writeFile(t, filepath.Join(dir, "broken", "broken.crx"), []byte("garbage"))
+
+	h := http.StripPrefix("/extensions", Handler(dir, "/extensions", false))
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://127.0.0.1:10001"+path, nil)
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("index", func(t *testing.T) {
+		rec := get("/extensions/index.json")
+		require.Equal(t, http.StatusOK, rec.Code)
+		var exts []Extension
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &exts))
+		require.Len(t, exts, 4)
+		assert.Equal(t, "broken", exts[0].Name)
+		assert.NotEmpty(t, exts[0].Error)
+		assert.Equal(t, Extension{Name: "packed", ID: keyID(packedDER), Version: "1.0", CRX: "packed.crx"}, exts[1])
+		assert.True(t, exts[2].HasUpdateXML)
+		assert.Equal(t, Extension{Name: "unpacked", Version: "0.9"}, exts[3])
+	})
+
+	t.Run("generated update.xml", func(t *testing.T) {
+		rec := get("/extensions/packed/update.xml")
+		require.Equal(t, http.StatusOK, rec.Code)
+		var m gupdate
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &m))
+		require.Len(t, m.Apps, 1)
+		assert.Equal(t, keyID(packedDER), m.Apps[0].AppID)
+		assert.Equal(t, "http://127.0.0.1:10001/extensions/packed/packed.crx", m.Apps[0].UpdateCheck.Codebase)
+		assert.Equal(t, "1.0", m.Apps[0].UpdateCheck.Version)
+	})
+
+	t.Run("shipped update.xml", func(t *testing.T) {
+		rec := get("/extensions/shipped/update.xml")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "<gupdate>shipped</gupdate>", rec.Body.String())
+	})
+
+	t.Run("no crx", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, get("/extensions/unpacked/update.xml").Code)
+		assert.Equal(t, http.StatusNotFound, get("/extensions/missing/update.xml").Code)
+	})
+
+	t.Run("combined update.xml", func(t *testing.T) {
+		rec := get("/extensions/update.xml")
+		require.Equal(t, http.StatusOK, rec.Code)
+		var m gupdate
+		require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &m))
+		require.Len(t, m.Apps, 2)
+		assert.Equal(t, keyID(packedDER), m.Apps[0].AppID)
+		assert.Equal(t, "http://127.0.0.1:10001/extensions/shipped/shipped.crx", m.Apps[1].UpdateCheck.Codebase)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		rec := get("/extensions/unpacked/manifest.json")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"version":"0.9"}`, rec.Body.String())
+	})
+}
+
+func TestVerifyCRX(t *testing.T) {
+	dir := t.TempDir()
+	ecKey, _ := testKey(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	write := func(name string, data []byte) string {
+		path := filepath.Join(dir, name)
+		writeFile(t, path, data)
+		return path
+	}
+
+	assert.NoError(t, VerifyCRX(write("ecdsa.crx", buildCRX(t, "1.0", ecKey, ecKey))))
+	assert.NoError(t, VerifyCRX(write("rsa.crx", buildCRX(t, "1.0", rsaKey, rsaKey, ecKey))))
+
+	tampered := buildCRX(t, "1.0", ecKey, ecKey)
+	tampered[len(tampered)-30] ^= 0xff
+	assert.Error(t, VerifyCRX(write("tampered.crx", tampered)))
+
+	// the declared ID must belong to one of the signing keys
+	otherKey, _ := testKey(t)
+	assert.Error(t, VerifyCRX(write("foreign.crx", buildCRX(t, "1.0", otherKey, ecKey))))
+	assert.Error(t, VerifyCRX(write("undeclared.crx", buildCRX(t, "1.0", nil, ecKey))))
+}
+
+func TestHandlerVerifyCRX(t *testing.T) {
+	dir := t.TempDir()
+	key, _ := testKey(t)
+	writeFile(t, filepath.Join(dir, "good", "good.crx"), buildCRX(t, "1.0", key, key))
+	bad := buildCRX(t, "1.0", key, key)
+	bad[len(bad)-30] ^= 0xff
+	writeFile(t, filepath.Join(dir, "bad", "bad.crx"), bad)
+
+	h := http.StripPrefix("/extensions", Handler(dir, "/extensions", true))
+	get := func(path string) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusOK, get("/extensions/good/good.crx"))
+	assert.Equal(t, http.StatusInternalServerError, get("/extensions/bad/bad.crx"))
+	assert.Equal(t, http.StatusNotFound, get("/extensions/missing/missing.crx"))
+}
+
+func TestFileSHA256(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "f")
+	writeFile(t, path, []byte("abc"))
+	digest, size, err := FileSHA256(path)
+	require.NoError(t, err)
+	assert.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", digest)
+	assert.Equal(t, int64(3), size)
+}
@@ -0,0 +1,104 @@
+// Package fsutil confines caller-supplied filesystem paths to a root directory.
+package fsutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrOutsideRoot is returned when a path resolves to a location outside the root directory.
+var ErrOutsideRoot = errors.New("path is outside the allowed root directory")
+
+// ResolveSafePath resolves p against base and returns a cleaned absolute path that is
+// guaranteed to lie within base. Relative paths are joined to base; absolute paths must
+// already point inside it. ".." segments are resolved lexically first, and symlinks in
+// the portion of the path that already exists are followed so a link cannot be used to
+// escape base. The returned path is the lexical one, not the symlink-resolved one.
+func ResolveSafePath(base, p string) (string, error) {
+	if base == "" {
+		return "", errors.New("base directory is required")
+	}
+	if p == "" {
+		return "", errors.New("path is required")
+	}
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return "", err
+	}
+
+	target := p
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(absBase, target)
+	}
+	target = filepath.Clean(target)
+	if !within(absBase, target) {
+		return "", ErrOutsideRoot
+	}
+
+	realBase, err := evalExisting(absBase)
+	if err != nil {
+		return "", err
+	}
+	realTarget, err := evalExisting(target)
+	if err != nil {
+		return "", err
+	}
+	if !within(realBase, realTarget) {
+		return "", ErrOutsideRoot
+	}
+	return target, nil
+}
+
+// within reports whether target is base or a descendant of it. Both must be clean and absolute.
+func within(base, target string) bool {
+	rel, err := filepath.Rel(base, target)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// maxLinkHops bounds how many dangling symlinks evalExisting follows before giving up.
+const maxLinkHops = 40
+
+// evalExisting resolves symlinks in the longest existing prefix of p and re-appends the
+// remaining, not-yet-existing components unchanged. Dangling symlinks are followed to
+// their (missing) target, since creating a file through one would write to the target.
+func evalExisting(p string) (string, error) {
+	var missing []string
+	cur := p
+	for hops := 0; ; {
+		resolved, err := filepath.EvalSymlinks(cur)
+		if err == nil {
+			for i := len(missing) - 1; i >= 0; i-- {
+				resolved = filepath.Join(resolved, missing[i])
+			}
+			return resolved, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		if fi, lerr := os.Lstat(cur); lerr == nil && fi.Mode()&os.ModeSymlink != 0 {
+			if hops++; hops > maxLinkHops {
+				return "", errors.New("too many levels of symbolic links")
+			}
+			dest, err := os.Readlink(cur)
+			if err != nil {
+				return "", err
+			}
+			if !filepath.IsAbs(dest) {
+				dest = filepath.Join(filepath.Dir(cur), dest)
+			}
+			cur = filepath.Clean(dest)
+			continue
+		}
+		parent := filepath.Dir(cur)
+		if parent == cur {
+			return p, nil
+		}
+		missing = append(missing, filepath.Base(cur))
+		cur = parent
+	}
+}
@@ -0,0 +1,88 @@
+package fsutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSafePath(t *testing.T) {
+	root := t.TempDir()
+	outside := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "a", "b"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a", "file.txt"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secret.txt"), []byte("s"), 0o644))
+	// link inside root pointing inside root
+	require.NoError(t, os.Symlink(filepath.Join(root, "a", "b"), filepath.Join(root, "inner-link")))
+	// link inside root pointing outside root
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "escape-link")))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "secret.txt"), filepath.Join(root, "a", "secret-link")))
+	// dangling links: writing through them would create the target
+	require.NoError(t, os.Symlink(filepath.Join(outside, "planted.txt"), filepath.Join(root, "dangling-out")))
+	require.NoError(t, os.Symlink("a/created-later.txt", filepath.Join(root, "dangling-in")))
+
+	testCases := []struct {
+		name    string
+		p       string
+		want    string
+		wantErr error
+	}{
+		{name: "relative file", p: "a/file.txt", want: filepath.Join(root, "a", "file.txt")},
+		{name: "absolute inside", p: filepath.Join(root, "a", "b"), want: filepath.Join(root, "a", "b")},
+		{name: "root itself", p: root, want: root},
+		{name: "dot", p: ".", want: root},
+		{name: "not yet existing", p: "a/new/dir/file.txt", want: filepath.Join(root, "a", "new", "dir", "file.txt")},
+		{name: "dotdot that stays inside", p: "a/b/../file.txt", want: filepath.Join(root, "a", "file.txt")},
+		{name: "relative traversal", p: "../etc/passwd", wantErr: ErrOutsideRoot},
+		{name: "deep traversal", p: "a/b/../../../x", wantErr: ErrOutsideRoot},
+		{name: "absolute escape", p: "/etc/passwd", wantErr: ErrOutsideRoot},
+		{name: "absolute traversal", p: root + "/../x", wantErr: ErrOutsideRoot},
+		{name: "sibling with shared prefix", p: root + "-other/x", wantErr: ErrOutsideRoot},
+		{name: "symlink inside root", p: "inner-link", want: filepath.Join(root, "inner-link")},
+		{name: "symlinked dir escape", p: "escape-link/secret.txt", wantErr: ErrOutsideRoot},
+		{name: "symlinked dir escape for new file", p: "escape-link/new.txt", wantErr: ErrOutsideRoot},
+		{name: "symlinked file escape", p: "a/secret-link", wantErr: ErrOutsideRoot},
+		{name: "dangling symlink escape", p: "dangling-out", wantErr: ErrOutsideRoot},
+		{name: "dangling symlink inside root", p: "dangling-in", want: filepath.Join(root, "dangling-in")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ResolveSafePath(root, tc.p)
+			if tc.wantErr != nil {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, tc.wantErr), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResolveSafePath_FilesystemRoot(t *testing.T) {
+	got, err := ResolveSafePath("/", "/etc/../tmp/x")
+	require.NoError(t, err)
+	require.Equal(t, "/tmp/x", got)
+}
+
+func TestResolveSafePath_SymlinkedBase(t *testing.T) {
+	real := t.TempDir()
+	link := filepath.Join(t.TempDir(), "root-link")
+	require.NoError(t, os.Symlink(real, link))
+
+	got, err := ResolveSafePath(link, "sub/file.txt")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(link, "sub", "file.txt"), got)
+}
+
+func TestResolveSafePath_Empty(t *testing.T) {
+	_, err := ResolveSafePath("", "x")
+	require.Error(t, err)
+	_, err = ResolveSafePath("/tmp", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// LevelHeader is the request header used to override the log level for a single request.
+const LevelHeader = "X-Log-Level"
+
+// Middleware stores a logger in each request's context. When newLogger is non-nil, a
+// request carrying LevelHeader (debug, info, warn, error) gets a logger built by
+// newLogger at that level instead of base; otherwise the header is ignored.
+func Middleware(base *slog.Logger, newLogger func(slog.Level) *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			log := base
+			if v := r.Header.Get(LevelHeader); v != "" && newLogger != nil {
+				var level slog.Level
+				if err := level.UnmarshalText([]byte(v)); err != nil {
+					base.Warn("ignoring invalid log level header", "value", v, "path", r.URL.Path)
+				} else {
+					log = newLogger(level)
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(AddToContext(r.Context(), log)))
+		})
+	}
+}
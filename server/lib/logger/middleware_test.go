@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	base := slog.New(slog.NewTextHandler(&buf, nil))
+	newLogger := func(level slog.Level) *slog.Logger {
+		return slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: level}))
+	}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		FromContext(r.Context()).Debug("debug line")
+	}
+
+	serve := func(mw func(http.Handler) http.Handler, level string) string {
+		buf.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if level != "" {
+			req.Header.Set(LevelHeader, level)
+		}
+		mw(http.HandlerFunc(handler)).ServeHTTP(httptest.NewRecorder(), req)
+		return buf.String()
+	}
+
+	if out := serve(Middleware(base, newLogger), ""); strings.Contains(out, "debug line") {
+		t.Fatalf("expected debug line to be filtered without header, got %q", out)
+	}
+	if out := serve(Middleware(base, newLogger), "debug"); !strings.Contains(out, "debug line") {
+		t.Fatalf("expected debug line with header, got %q", out)
+	}
+	if out := serve(Middleware(base, nil), "debug"); strings.Contains(out, "debug line") {
+		t.Fatalf("expected header to be ignored when overrides are disabled, got %q", out)
+	}
+	if out := serve(Middleware(base, newLogger), "verbose"); !strings.Contains(out, "invalid log level") || strings.Contains(out, "debug line") {
+		t.Fatalf("expected invalid level to fall back to base logger, got %q", out)
+	}
+}
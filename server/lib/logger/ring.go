@@ -0,0 +1,152 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxEntryAttrBytes bounds the attribute text kept per buffered entry, so the buffer's
+// memory use is capped by its line count regardless of what gets logged.
+const maxEntryAttrBytes = 4 << 10
+
+// Entry is a log record retained by a Ring.
+type Entry struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	// Attrs maps attribute keys, prefixed by their groups ("group.key"), to their values.
+	Attrs map[string]string
+}
+
+// Ring retains the most recent log entries in memory.
+type Ring struct {
+	mu      sync.Mutex
+	entries []Entry
+	// next is the index the next entry is written to once the buffer is full.
+	next int
+}
+
+// NewRing returns a Ring holding up to size entries.
+func NewRing(size int) *Ring {
+	return &Ring{entries: make([]Entry, 0, size)}
+}
+
+func (r *Ring) add(e Entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cap(r.entries) == 0 {
+		return
+	}
+	if len(r.entries) < cap(r.entries) {
+		r.entries = append(r.entries, e)
+		return
+	}
+	r.entries[r.next] = e
+	r.next = (r.next + 1) % len(r.entries)
+}
+
+// Recent returns up to n of the newest entries at or above level, oldest first.
+func (r *Ring) Recent(n int, level slog.Level) []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Entry
+	for i := len(r.entries) - 1; i >= 0 && len(out) < n; i-- {
+		e := r.entries[(r.next+i)%len(r.entries)]
+		if e.Level >= level {
+			out = append(out, e)
+		}
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
+
+// RingHandler is a slog.Handler that records every entry next handles into a Ring before
+// passing it on.
+type RingHandler struct {
+	next slog.Handler
+	ring *Ring
+	// attrs were added through WithAttrs; prefix is the group path from WithGroup.
+	attrs  []slog.Attr
+	prefix string
+}
+
+// NewRingHandler returns a handler that records entries into ring and passes them to next.
+func NewRingHandler(next slog.Handler, ring *Ring) *RingHandler {
+	return &RingHandler{next: next, ring: ring}
+}
+
+func (h *RingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *RingHandler) Handle(ctx context.Context, rec slog.Record) error {
+	e := Entry{Time: rec.Time, Level: rec.Level, Message: rec.Message, Attrs: make(map[string]string)}
+	size := 0
+	add := func(prefix string, a slog.Attr) bool {
+		size += addAttr(e.Attrs, prefix, a)
+		return size < maxEntryAttrBytes
+	}
+	for _, a := range h.attrs {
+		if !add("", a) {
+			break
+		}
+	}
+	if size < maxEntryAttrBytes {
+		rec.Attrs(func(a slog.Attr) bool { return add(h.prefix, a) })
+	}
+	h.ring.add(e)
+	return h.next.Handle(ctx, rec)
+}
+
+// addAttr flattens a into attrs under prefix and returns the number of bytes it added.
+func addAttr(attrs map[string]string, prefix string, a slog.Attr) int {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return 0
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		n := 0
+		for _, ga := range a.Value.Group() {
+			n += addAttr(attrs, prefix, ga)
+		}
+		return n
+	}
+	key, val := prefix+a.Key, a.Value.String()
+	if len(val) > maxEntryAttrBytes {
+		val = val[:maxEntryAttrBytes]
+	}
+	attrs[key] = val
+	return len(key) + len(val)
+}
+
+func (h *RingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.next = h.next.WithAttrs(attrs)
+	c.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	c.attrs = append(c.attrs, h.attrs...)
+	for _, a := range attrs {
+		if h.prefix != "" {
+			a = slog.Group(strings.TrimSuffix(h.prefix, "."), a)
+		}
+		c.attrs = append(c.attrs, a)
+	}
+	return &c
+}
+
+func (h *RingHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	c := *h
+	c.next = h.next.WithGroup(name)
+	c.prefix = h.prefix + name + "."
+	return &c
+}
@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRingHandler(t *testing.T) {
+	ring := NewRing(3)
+	log := slog.New(NewRingHandler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelDebug}), ring))
+
+	log.Debug("one")
+	log.With("req", "abc").WithGroup("g").Info("two", "k", "v")
+	log.Warn("three")
+	log.Error("four", "big", strings.Repeat("x", 2*maxEntryAttrBytes))
+
+	got := ring.Recent(10, slog.LevelDebug)
+	if len(got) != 3 || got[0].Message != "two" || got[2].Message != "four" {
+		t.Fatalf("expected the 3 newest entries oldest first, got %+v", got)
+	}
+	if got[0].Attrs["req"] != "abc" || got[0].Attrs["g.k"] != "v" {
+		t.Fatalf("expected flattened attrs, got %v", got[0].Attrs)
+	}
+	if n := len(got[2].Attrs["big"]); n != maxEntryAttrBytes {
+		t.Fatalf("expected attr truncated to %d bytes, got %d", maxEntryAttrBytes, n)
+	}
+
+	warn := ring.Recent(1, slog.LevelWarn)
+	if len(warn) != 1 || warn[0].Message != "four" {
+		t.Fatalf("expected newest warn+ entry, got %+v", warn)
+	}
+}
@@ -2,21 +2,37 @@ package nekoclient
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	nekooapi "github.com/m1k1o/neko/server/lib/oapi"
 )
 
+const (
+	// refreshLeadTime is how long before a token's expiry the background
+	// refresh replaces it. Short-lived tokens are refreshed at half their TTL.
+	refreshLeadTime = 30 * time.Second
+	// refreshTimeout bounds a single background login attempt.
+	refreshTimeout = 10 * time.Second
+)
+
 // AuthClient wraps the Neko OpenAPI client and handles authentication automatically.
-// It manages token caching and refresh on 401 responses.
+// It manages token caching, refresh on 401 responses, and proactive refresh of
+// tokens that carry an expiry.
 type AuthClient struct {
-	client   *nekooapi.ClientWithResponses
-	tokenMu  sync.Mutex
-	token    string
-	username string
-	password string
+	client       *nekooapi.ClientWithResponses
+	tokenMu      sync.Mutex
+	token        string
+	username     string
+	password     string
+	refreshTimer *time.Timer
+	closed       bool
 }
 
 // NewAuthClient creates a new authenticated Neko client.
@@ -61,49 +77,155 @@ func (c *AuthClient) ensureToken(ctx context.Context) error {
 	}
 
 	c.token = *resp.JSON200.Token
+	c.scheduleRefresh(c.token)
 	return nil
 }
 
+// Login authenticates against Neko, replacing any cached token. It is useful to
+// verify credentials up front instead of on the first API call, and to force
+// re-authentication when the caller knows the current token is no longer valid.
+func (c *AuthClient) Login(ctx context.Context) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.clearToken()
+	return c.ensureToken(ctx)
+}
+
+// Close stops the background token refresh. The client remains usable; later
+// calls simply log in on demand.
+func (c *AuthClient) Close() {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	c.closed = true
+	c.stopRefresh()
+}
+
 // clearToken clears the cached token, forcing a new login on next request.
 // Must be called with tokenMu held.
 func (c *AuthClient) clearToken() {
 	c.token = ""
+	c.stopRefresh()
 }
 
-// SessionsGet retrieves all active sessions from Neko API.
-func (c *AuthClient) SessionsGet(ctx context.Context) ([]nekooapi.SessionData, error) {
-	c.tokenMu.Lock()
-	defer c.tokenMu.Unlock()
+// stopRefresh cancels any pending background refresh.
+// Must be called with tokenMu held.
+func (c *AuthClient) stopRefresh() {
+	if c.refreshTimer != nil {
+		c.refreshTimer.Stop()
+		c.refreshTimer = nil
+	}
+}
+
+// scheduleRefresh arranges for token to be replaced shortly before it expires.
+// Tokens that do not carry an expiry (Neko's default opaque session tokens) are
+// only renewed reactively on 401. Must be called with tokenMu held.
+func (c *AuthClient) scheduleRefresh(token string) {
+	c.stopRefresh()
+	if c.closed {
+		return
+	}
+
+	exp, ok := tokenExpiry(token)
+	if !ok {
+		return
+	}
+	ttl := time.Until(exp)
+	if ttl <= 0 {
+		return
+	}
+	lead := refreshLeadTime
+	if lead > ttl/2 {
+		lead = ttl / 2
+	}
+
+	c.refreshTimer = time.AfterFunc(ttl-lead, func() {
+		c.tokenMu.Lock()
+		defer c.tokenMu.Unlock()
+
+		// Skip if the token was already replaced or the client closed
+		if c.closed || c.token != token {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+
+		c.clearToken()
+		// On failure the token stays empty and the next API call logs in again.
+		_ = c.ensureToken(ctx)
+	})
+}
+
+// tokenExpiry extracts the "exp" claim from a JWT-shaped token without
+// verifying its signature. It reports false for tokens that are not JWTs or
+// have no expiry.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp *float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
 
-	// Ensure we have a token
+	sec, frac := math.Modf(*claims.Exp)
+	return time.Unix(int64(sec), int64(frac*float64(time.Second))), true
+}
+
+// doAuthenticated runs call with a Bearer token attached. If Neko rejects the
+// token with a 401, the cached token is discarded and call is retried once with
+// a freshly issued one. Must be called with tokenMu held.
+func (c *AuthClient) doAuthenticated(ctx context.Context, call func(addAuth nekooapi.RequestEditorFn) (int, error)) error {
 	if err := c.ensureToken(ctx); err != nil {
-		return nil, err
+		return err
 	}
 
-	// Create request editor to add Bearer token
 	addAuth := func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
 		return nil
 	}
 
-	// Make the request
-	resp, err := c.client.SessionsGetWithResponse(ctx, addAuth)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	status, err := call(addAuth)
+	if err != nil || status != http.StatusUnauthorized {
+		return err
 	}
 
-	// Handle 401 by clearing token and retrying once
-	if resp.StatusCode() == http.StatusUnauthorized {
-		c.clearToken()
-		if err := c.ensureToken(ctx); err != nil {
-			return nil, err
-		}
+	// Token expired or was revoked: log in again and retry once
+	c.clearToken()
+	if err := c.ensureToken(ctx); err != nil {
+		return err
+	}
+	_, err = call(addAuth)
+	return err
+}
+
+// SessionsGet retrieves all active sessions from Neko API.
+func (c *AuthClient) SessionsGet(ctx context.Context) ([]nekooapi.SessionData, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
 
-		// Retry with fresh token
-		resp, err = c.client.SessionsGetWithResponse(ctx, addAuth)
+	var resp *nekooapi.SessionsGetResponse
+	err := c.doAuthenticated(ctx, func(addAuth nekooapi.RequestEditorFn) (int, error) {
+		r, err := c.client.SessionsGetWithResponse(ctx, addAuth)
 		if err != nil {
-			return nil, fmt.Errorf("failed to retry sessions query: %w", err)
+			return 0, fmt.Errorf("failed to query sessions: %w", err)
 		}
+		resp = r
+		return r.StatusCode(), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode() != http.StatusOK {
@@ -122,35 +244,17 @@ func (c *AuthClient) ScreenConfigurationChange(ctx context.Context, config nekoo
 	c.tokenMu.Lock()
 	defer c.tokenMu.Unlock()
 
-	// Ensure we have a token
-	if err := c.ensureToken(ctx); err != nil {
-		return err
-	}
-
-	// Create request editor to add Bearer token
-	addAuth := func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
-		return nil
-	}
-
-	// Make the request
-	resp, err := c.client.ScreenConfigurationChangeWithResponse(ctx, config, addAuth)
-	if err != nil {
-		return fmt.Errorf("failed to change screen configuration: %w", err)
-	}
-
-	// Handle 401 by clearing token and retrying once
-	if resp.StatusCode() == http.StatusUnauthorized {
-		c.clearToken()
-		if err := c.ensureToken(ctx); err != nil {
-			return err
-		}
-
-		// Retry with fresh token
-		resp, err = c.client.ScreenConfigurationChangeWithResponse(ctx, config, addAuth)
+	var resp *nekooapi.ScreenConfigurationChangeResponse
+	err := c.doAuthenticated(ctx, func(addAuth nekooapi.RequestEditorFn) (int, error) {
+		r, err := c.client.ScreenConfigurationChangeWithResponse(ctx, config, addAuth)
 		if err != nil {
-			return fmt.Errorf("failed to retry screen configuration change: %w", err)
+			return 0, fmt.Errorf("failed to change screen configuration: %w", err)
 		}
+		resp = r
+		return r.StatusCode(), nil
+	})
+	if err != nil {
+		return err
 	}
 
 	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusNoContent {
@@ -0,0 +1,142 @@
+package nekoclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNeko is a minimal Neko API that issues tokens from newToken and only
+// accepts the most recently issued one.
+type fakeNeko struct {
+	mu       sync.Mutex
+	current  string
+	logins   atomic.Int32
+	newToken func(n int32) string
+}
+
+func (f *fakeNeko) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/login", func(w http.ResponseWriter, r *http.Request) {
+		n := f.logins.Add(1)
+		tok := f.newToken(n)
+		f.mu.Lock()
+		f.current = tok
+		f.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"token": tok})
+	})
+	mux.HandleFunc("/api/sessions", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		ok := r.Header.Get("Authorization") == "Bearer "+f.current
+		f.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"s1"}]`))
+	})
+	return mux
+}
+
+// revoke invalidates the current token as if it had expired server-side.
+func (f *fakeNeko) revoke() {
+	f.mu.Lock()
+	f.current = ""
+	f.mu.Unlock()
+}
+
+func jwtWithExpiry(exp time.Time) string {
+	enc := base64.RawURLEncoding
+	payload := fmt.Sprintf(`{"exp":%d}`, exp.Unix())
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(payload)) + ".sig"
+}
+
+func newTestClient(t *testing.T, f *fakeNeko) *AuthClient {
+	t.Helper()
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	c, err := NewAuthClient(srv.URL, "admin", "admin")
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestSessionsGetRetriesOnUnauthorized(t *testing.T) {
+	f := &fakeNeko{newToken: func(n int32) string { return fmt.Sprintf("token-%d", n) }}
+	c := newTestClient(t, f)
+	ctx := context.Background()
+
+	sessions, err := c.SessionsGet(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, int32(1), f.logins.Load())
+
+	f.revoke()
+	sessions, err = c.SessionsGet(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, int32(2), f.logins.Load(), "expected exactly one re-login after 401")
+}
+
+func TestLoginForcesReauthentication(t *testing.T) {
+	f := &fakeNeko{newToken: func(n int32) string { return fmt.Sprintf("token-%d", n) }}
+	c := newTestClient(t, f)
+	ctx := context.Background()
+
+	require.NoError(t, c.Login(ctx))
+	require.NoError(t, c.Login(ctx))
+	assert.Equal(t, int32(2), f.logins.Load())
+
+	_, err := c.SessionsGet(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), f.logins.Load(), "cached token should be reused")
+}
+
+func TestBackgroundRefreshBeforeExpiry(t *testing.T) {
+	f := &fakeNeko{newToken: func(n int32) string {
+		return jwtWithExpiry(time.Now().Add(2 * time.Second))
+	}}
+	c := newTestClient(t, f)
+
+	require.NoError(t, c.Login(context.Background()))
+	require.Eventually(t, func() bool { return f.logins.Load() >= 2 }, 3*time.Second, 20*time.Millisecond)
+
+	_, err := c.SessionsGet(context.Background())
+	require.NoError(t, err)
+}
+
+func TestCloseStopsBackgroundRefresh(t *testing.T) {
+	f := &fakeNeko{newToken: func(n int32) string {
+		return jwtWithExpiry(time.Now().Add(1 * time.Second))
+	}}
+	c := newTestClient(t, f)
+
+	require.NoError(t, c.Login(context.Background()))
+	c.Close()
+	time.Sleep(800 * time.Millisecond)
+	assert.Equal(t, int32(1), f.logins.Load())
+}
+
+func TestTokenExpiry(t *testing.T) {
+	exp := time.Unix(1700000000, 0)
+	got, ok := tokenExpiry(jwtWithExpiry(exp))
+	require.True(t, ok)
+	assert.True(t, got.Equal(exp))
+
+	for _, tok := range []string{"", "opaque-token", "a.b.c", "a." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"x"}`)) + ".c"} {
+		_, ok := tokenExpiry(tok)
+		assert.False(t, ok, "token %q", tok)
+	}
+}
@@ -131,6 +131,99 @@ func (e DragMouseRequestButton) Valid() bool {
 	}
 }
 
+// Defines values for ErrorCode.
+const (
+	CircuitsInitializing   ErrorCode = "circuits_initializing"
+	ClaimSignatureInvalid  ErrorCode = "claim_signature_invalid"
+	DisplayBusy            ErrorCode = "display_busy"
+	Draining               ErrorCode = "draining"
+	IdempotencyKeyInvalid  ErrorCode = "idempotency_key_invalid"
+	IdempotencyKeyReused   ErrorCode = "idempotency_key_reused"
+	InvalidProviderParams  ErrorCode = "invalid_provider_params"
+	InvalidRecorderId      ErrorCode = "invalid_recorder_id"
+	InvalidRecordingParams ErrorCode = "invalid_recording_params"
+	OutputDirFull          ErrorCode = "output_dir_full"
+	OutputDirUnwritable    ErrorCode = "output_dir_unwritable"
+	ProofFailed            ErrorCode = "proof_failed"
+	ProofRecordingFailed   ErrorCode = "proof_recording_failed"
+	ProofTimeout           ErrorCode = "proof_timeout"
+	ProviderParamsTooLarge ErrorCode = "provider_params_too_large"
+	RecorderNotFound       ErrorCode = "recorder_not_found"
+	RecordingCompleted     ErrorCode = "recording_completed"
+	RecordingDeleted       ErrorCode = "recording_deleted"
+	RecordingFinalizing    ErrorCode = "recording_finalizing"
+	RecordingInProgress    ErrorCode = "recording_in_progress"
+	RecordingNotStopped    ErrorCode = "recording_not_stopped"
+	RecordingQueueFull     ErrorCode = "recording_queue_full"
+	RecordingQueueTimeout  ErrorCode = "recording_queue_timeout"
+	RequestTooLarge        ErrorCode = "request_too_large"
+	TenantQuotaExceeded    ErrorCode = "tenant_quota_exceeded"
+	TooManyProofs          ErrorCode = "too_many_proofs"
+	TooManyRecordings      ErrorCode = "too_many_recordings"
+)
+
+// Valid indicates whether the value is a known member of the ErrorCode enum.
+func (e ErrorCode) Valid() bool {
+	switch e {
+	case CircuitsInitializing:
+		return true
+	case ClaimSignatureInvalid:
+		return true
+	case DisplayBusy:
+		return true
+	case Draining:
+		return true
+	case IdempotencyKeyInvalid:
+		return true
+	case IdempotencyKeyReused:
+		return true
+	case InvalidProviderParams:
+		return true
+	case InvalidRecorderId:
+		return true
+	case InvalidRecordingParams:
+		return true
+	case OutputDirFull:
+		return true
+	case OutputDirUnwritable:
+		return true
+	case ProofFailed:
+		return true
+	case ProofRecordingFailed:
+		return true
+	case ProofTimeout:
+		return true
+	case ProviderParamsTooLarge:
+		return true
+	case RecorderNotFound:
+		return true
+	case RecordingCompleted:
+		return true
+	case RecordingDeleted:
+		return true
+	case RecordingFinalizing:
+		return true
+	case RecordingInProgress:
+		return true
+	case RecordingNotStopped:
+		return true
+	case RecordingQueueFull:
+		return true
+	case RecordingQueueTimeout:
+		return true
+	case RequestTooLarge:
+		return true
+	case TenantQuotaExceeded:
+		return true
+	case TooManyProofs:
+		return true
+	case TooManyRecordings:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for FileSystemEventType.
 const (
 	CREATE FileSystemEventType = "CREATE"
@@ -155,6 +248,27 @@ func (e FileSystemEventType) Valid() bool {
 	}
 }
 
+// Defines values for OnChainVerificationStatus.
+const (
+	Rejected    OnChainVerificationStatus = "rejected"
+	Unavailable OnChainVerificationStatus = "unavailable"
+	Verified    OnChainVerificationStatus = "verified"
+)
+
+// Valid indicates whether the value is a known member of the OnChainVerificationStatus enum.
+func (e OnChainVerificationStatus) Valid() bool {
+	switch e {
+	case Rejected:
+		return true
+	case Unavailable:
+		return true
+	case Verified:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PatchDisplayRequestRefreshRate.
 const (
 	N10 PatchDisplayRequestRefreshRate = 10
@@ -254,6 +368,84 @@ func (e ProcessStreamEventStream) Valid() bool {
 	}
 }
 
+// Defines values for RecordingLocationBackend.
+const (
+	Local RecordingLocationBackend = "local"
+)
+
+// Valid indicates whether the value is a known member of the RecordingLocationBackend enum.
+func (e RecordingLocationBackend) Valid() bool {
+	switch e {
+	case Local:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RecordingManifestExitReason.
+const (
+	Completed RecordingManifestExitReason = "completed"
+	Failed    RecordingManifestExitReason = "failed"
+	Killed    RecordingManifestExitReason = "killed"
+	Stopped   RecordingManifestExitReason = "stopped"
+)
+
+// Valid indicates whether the value is a known member of the RecordingManifestExitReason enum.
+func (e RecordingManifestExitReason) Valid() bool {
+	switch e {
+	case Completed:
+		return true
+	case Failed:
+		return true
+	case Killed:
+		return true
+	case Stopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RecordingProgressEventEvent.
+const (
+	Finished   RecordingProgressEventEvent = "finished"
+	NotStarted RecordingProgressEventEvent = "not_started"
+	Progress   RecordingProgressEventEvent = "progress"
+)
+
+// Valid indicates whether the value is a known member of the RecordingProgressEventEvent enum.
+func (e RecordingProgressEventEvent) Valid() bool {
+	switch e {
+	case Finished:
+		return true
+	case NotStarted:
+		return true
+	case Progress:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StartRecordingRequestMode.
+const (
+	Screen     StartRecordingRequestMode = "screen"
+	Screencast StartRecordingRequestMode = "screencast"
+)
+
+// Valid indicates whether the value is a known member of the StartRecordingRequestMode enum.
+func (e StartRecordingRequestMode) Valid() bool {
+	switch e {
+	case Screen:
+		return true
+	case Screencast:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for DownloadDirZstdParamsCompressionLevel.
 const (
 	Best    DownloadDirZstdParamsCompressionLevel = "best"
@@ -278,6 +470,30 @@ func (e DownloadDirZstdParamsCompressionLevel) Valid() bool {
 	}
 }
 
+// Defines values for GetLogsParamsLevel.
+const (
+	GetLogsParamsLevelDebug GetLogsParamsLevel = "debug"
+	GetLogsParamsLevelError GetLogsParamsLevel = "error"
+	GetLogsParamsLevelInfo  GetLogsParamsLevel = "info"
+	GetLogsParamsLevelWarn  GetLogsParamsLevel = "warn"
+)
+
+// Valid indicates whether the value is a known member of the GetLogsParamsLevel enum.
+func (e GetLogsParamsLevel) Valid() bool {
+	switch e {
+	case GetLogsParamsLevelDebug:
+		return true
+	case GetLogsParamsLevelError:
+		return true
+	case GetLogsParamsLevelInfo:
+		return true
+	case GetLogsParamsLevelWarn:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for LogsStreamParamsSource.
 const (
 	Path       LogsStreamParamsSource = "path"
@@ -302,6 +518,211 @@ type BatchComputerActionRequest struct {
 	Actions []ComputerAction `json:"actions"`
 }
 
+// CDPCommandRequest defines model for CDPCommandRequest.
+type CDPCommandRequest struct {
+	// Method CDP method, e.g. Page.reload
+	Method string `json:"method"`
+
+	// Params The method's parameters
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// SessionId Send the command on this session; only sessions created on the same connection exist, so prefer target_id
+	SessionId *string `json:"session_id,omitempty"`
+
+	// TargetId Attach to this target and send the command on its session
+	TargetId *string `json:"target_id,omitempty"`
+
+	// TimeoutSec Maximum time to wait for the browser's reply
+	TimeoutSec *int `json:"timeout_sec,omitempty"`
+}
+
+// CDPCommandResult defines model for CDPCommandResult.
+type CDPCommandResult struct {
+	// Error Error the browser returned for a CDP command.
+	Error *CDPError `json:"error,omitempty"`
+
+	// Result The command's result, when it succeeded
+	Result *map[string]interface{} `json:"result,omitempty"`
+}
+
+// CDPError Error the browser returned for a CDP command.
+type CDPError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ChromiumCookie A browser cookie, in the CDP Network.Cookie shape.
+type ChromiumCookie struct {
+	Domain string `json:"domain"`
+
+	// Expires Expiry in seconds since the Unix epoch; -1 for session cookies
+	Expires  float64 `json:"expires"`
+	HttpOnly bool    `json:"httpOnly"`
+	Name     string  `json:"name"`
+	Path     string  `json:"path"`
+
+	// SameSite Strict, Lax or None; omitted when the cookie does not set it
+	SameSite *string `json:"sameSite,omitempty"`
+	Secure   bool    `json:"secure"`
+	Session  bool    `json:"session"`
+	Value    string  `json:"value"`
+}
+
+// ChromiumCookieFailure defines model for ChromiumCookieFailure.
+type ChromiumCookieFailure struct {
+	Error string `json:"error"`
+
+	// Index Position of the cookie in the request
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+}
+
+// ChromiumCookieParam A cookie to set, in the CDP Network.CookieParam shape. Either url or domain is required.
+type ChromiumCookieParam struct {
+	Domain *string `json:"domain,omitempty"`
+
+	// Expires Expiry in seconds since the Unix epoch; omit for a session cookie
+	Expires  *float64 `json:"expires,omitempty"`
+	HttpOnly *bool    `json:"httpOnly,omitempty"`
+	Name     string   `json:"name"`
+	Path     *string  `json:"path,omitempty"`
+
+	// SameSite Strict, Lax or None
+	SameSite *string `json:"sameSite,omitempty"`
+	Secure   *bool   `json:"secure,omitempty"`
+
+	// Url URL the cookie applies to; domain, path and secure default from it
+	Url   *string `json:"url,omitempty"`
+	Value string  `json:"value"`
+}
+
+// ChromiumCookies defines model for ChromiumCookies.
+type ChromiumCookies struct {
+	Cookies []ChromiumCookie `json:"cookies"`
+}
+
+// ChromiumEmulation Overrides applied to the page; overrides that were not requested are absent.
+type ChromiumEmulation struct {
+	// Geolocation Position reported to pages by the Geolocation API.
+	Geolocation *ChromiumGeolocation `json:"geolocation,omitempty"`
+
+	// Locale BCP 47 language tag, in canonical form
+	Locale *string `json:"locale,omitempty"`
+
+	// Timezone IANA timezone ID
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// ChromiumEmulationRequest defines model for ChromiumEmulationRequest.
+type ChromiumEmulationRequest struct {
+	// Geolocation Position reported to pages by the Geolocation API.
+	Geolocation *ChromiumGeolocation `json:"geolocation,omitempty"`
+
+	// Locale BCP 47 language tag, e.g. de-DE
+	Locale *string `json:"locale,omitempty"`
+
+	// Timezone IANA timezone ID, e.g. Europe/Berlin
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// ChromiumGeolocation Position reported to pages by the Geolocation API.
+type ChromiumGeolocation struct {
+	// Accuracy Accuracy of the position in meters
+	Accuracy  *float64 `json:"accuracy,omitempty"`
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+}
+
+// ChromiumTarget A CDP target of the browser.
+type ChromiumTarget struct {
+	// Attached Whether a DevTools client is attached to the target
+	Attached bool `json:"attached"`
+
+	// OpenerId ID of the target that opened this one, if any
+	OpenerId *string `json:"opener_id,omitempty"`
+	TargetId string  `json:"target_id"`
+	Title    string  `json:"title"`
+
+	// Type Target type, e.g. page, iframe, service_worker or browser
+	Type string `json:"type"`
+	Url  string `json:"url"`
+}
+
+// ChromiumTargets defines model for ChromiumTargets.
+type ChromiumTargets struct {
+	Targets []ChromiumTarget `json:"targets"`
+}
+
+// ChromiumViewport Viewport metrics applied to the page.
+type ChromiumViewport struct {
+	DeviceScaleFactor float32 `json:"device_scale_factor"`
+	Height            int     `json:"height"`
+	Mobile            bool    `json:"mobile"`
+	Width             int     `json:"width"`
+
+	// WindowResized Whether the browser window was resized to the viewport
+	WindowResized bool `json:"window_resized"`
+}
+
+// ChromiumViewportRequest defines model for ChromiumViewportRequest.
+type ChromiumViewportRequest struct {
+	// DeviceScaleFactor Ratio of device pixels to CSS pixels
+	DeviceScaleFactor *float32 `json:"device_scale_factor,omitempty"`
+
+	// Height Viewport height in CSS pixels
+	Height int `json:"height"`
+
+	// Mobile Emulate a mobile device (meta viewport handling, overlay scrollbars)
+	Mobile *bool `json:"mobile,omitempty"`
+
+	// ResizeWindow Also resize the browser window to the viewport size
+	ResizeWindow *bool `json:"resize_window,omitempty"`
+
+	// Width Viewport width in CSS pixels
+	Width int `json:"width"`
+}
+
+// CircuitInfo A ZK circuit. The footprint fields are set once the circuit has been initialized.
+type CircuitInfo struct {
+	// EstimatedBytes Approximate memory the circuit holds, proving_key_bytes + r1cs_bytes + init_delta_bytes
+	EstimatedBytes *int64 `json:"estimated_bytes,omitempty"`
+
+	// InitDeltaBytes Growth of the server's resident memory while the prover initialized the circuit;
+	// absent where resident memory can't be read
+	InitDeltaBytes *int64 `json:"init_delta_bytes,omitempty"`
+
+	// Initialized Whether the prover has initialized the circuit
+	Initialized bool `json:"initialized"`
+
+	// Name Circuit name, e.g. chacha20
+	Name string `json:"name"`
+
+	// ProvingKeyBytes Size of the proving key the circuit was initialized from
+	ProvingKeyBytes *int64 `json:"proving_key_bytes,omitempty"`
+
+	// R1csBytes Size of the R1CS constraint system the circuit was initialized from
+	R1csBytes *int64 `json:"r1cs_bytes,omitempty"`
+}
+
+// CircuitStatus Initialization state and memory footprint of the ZK circuits
+type CircuitStatus struct {
+	// Circuits Every preloaded circuit, in initialization order
+	Circuits []CircuitInfo `json:"circuits"`
+
+	// EstimatedBytes Sum of estimated_bytes over the circuits initialized so far
+	EstimatedBytes int64 `json:"estimated_bytes"`
+}
+
+// CleanupResult defines model for CleanupResult.
+type CleanupResult struct {
+	// DryRun Whether the files were only listed, not removed
+	DryRun bool           `json:"dry_run"`
+	Files  []OrphanedFile `json:"files"`
+
+	// FreedBytes Total size of the files
+	FreedBytes int64 `json:"freed_bytes"`
+}
+
 // ClickMouseRequest defines model for ClickMouseRequest.
 type ClickMouseRequest struct {
 	// Button Mouse button to interact with
@@ -374,7 +795,7 @@ type DeletePathRequest struct {
 
 // DeleteRecordingRequest defines model for DeleteRecordingRequest.
 type DeleteRecordingRequest struct {
-	// Id Identifier of the recording to delete. Alphanumeric or hyphen.
+	// Id Identifier of the recording to delete. Letters, digits, hyphens or underscores, up to 64 characters.
 	Id *string `json:"id,omitempty"`
 }
 
@@ -390,6 +811,21 @@ type DisplayConfig struct {
 	Width *int `json:"width,omitempty"`
 }
 
+// DisplayInfo Resolution and color depth of the X display.
+type DisplayInfo struct {
+	// Depth Color depth in bits per pixel, or 0 if it could not be determined
+	Depth int `json:"depth"`
+
+	// Height Display height in pixels
+	Height int `json:"height"`
+
+	// Mode Display server, "xorg" (headful) or "xvfb" (headless)
+	Mode string `json:"mode"`
+
+	// Width Display width in pixels
+	Width int `json:"width"`
+}
+
 // DragMouseRequest defines model for DragMouseRequest.
 type DragMouseRequest struct {
 	// Button Mouse button to drag with
@@ -420,11 +856,43 @@ type DragMouseRequest struct {
 // DragMouseRequestButton Mouse button to drag with
 type DragMouseRequestButton string
 
+// EncoderStats ffmpeg's latest progress report for a recording. Reported when the server runs ffmpeg
+// with FFMPEG_PROGRESS enabled.
+type EncoderStats struct {
+	// BitrateKbps Output bitrate in kbit/s; 0 until ffmpeg can compute it.
+	BitrateKbps float32 `json:"bitrate_kbps"`
+
+	// DroppedFrames Frames dropped to keep the output frame rate.
+	DroppedFrames int64 `json:"dropped_frames"`
+
+	// DuplicatedFrames Frames repeated to keep the output frame rate.
+	DuplicatedFrames int64 `json:"duplicated_frames"`
+
+	// Fps Current encoding rate in frames per second.
+	Fps float32 `json:"fps"`
+
+	// Frames Number of frames encoded so far.
+	Frames int64 `json:"frames"`
+
+	// OutTimeSeconds Timestamp of the latest encoded frame in seconds.
+	OutTimeSeconds float32 `json:"out_time_seconds"`
+
+	// Speed Encoding speed relative to real time; below 1 means ffmpeg is falling behind.
+	Speed float32 `json:"speed"`
+}
+
 // Error defines model for Error.
 type Error struct {
-	Message string `json:"message"`
+	// Code Machine-readable reason for the error. Clients should branch on this rather than on
+	// the message text; it is omitted where no more specific reason than the status applies.
+	Code    *ErrorCode `json:"code,omitempty"`
+	Message string     `json:"message"`
 }
 
+// ErrorCode Machine-readable reason for the error. Clients should branch on this rather than on
+// the message text; it is omitted where no more specific reason than the status applies.
+type ErrorCode string
+
 // ExecutePlaywrightRequest Request to execute Playwright code
 type ExecutePlaywrightRequest struct {
 	// Code TypeScript/JavaScript code to execute. The code has access to 'page', 'context', and 'browser' variables.
@@ -453,6 +921,36 @@ type ExecutePlaywrightResult struct {
 	Success bool `json:"success"`
 }
 
+// ExtensionInfo An installed extension and the integrity of its packed .crx
+type ExtensionInfo struct {
+	// Crx File name of the packed extension
+	Crx *string `json:"crx,omitempty"`
+
+	// Error Why the .crx or manifest could not be read, or its signatures did not verify
+	Error *string `json:"error,omitempty"`
+
+	// HasUpdateXml Whether the directory ships its own update.xml instead of a generated one
+	HasUpdateXml bool `json:"has_update_xml"`
+
+	// Id Chrome extension ID declared by the .crx
+	Id *string `json:"id,omitempty"`
+
+	// Name Directory name under EXTENSIONS_DIR, used in the extension's URLs
+	Name string `json:"name"`
+
+	// Sha256 Hex SHA-256 digest of the .crx
+	Sha256 *string `json:"sha256,omitempty"`
+
+	// SignatureValid Whether the .crx signatures verify; only set when verification was requested
+	SignatureValid *bool `json:"signature_valid,omitempty"`
+
+	// Size Size of the .crx in bytes
+	Size *int64 `json:"size,omitempty"`
+
+	// Version Version from the .crx, or the unpacked manifest.json without one
+	Version *string `json:"version,omitempty"`
+}
+
 // FileInfo defines model for FileInfo.
 type FileInfo struct {
 	// IsDir Whether the path is a directory.
@@ -546,6 +1044,30 @@ type OkResponse struct {
 	Ok bool `json:"ok"`
 }
 
+// OnChainVerification Outcome of checking a claim against the Reclaim verifier contract
+type OnChainVerification struct {
+	// Contract Address of the verifier contract called
+	Contract string `json:"contract"`
+
+	// Message The revert reason or error; absent when verified
+	Message *string `json:"message,omitempty"`
+
+	// Status verified if the contract accepted the claim, rejected if it reverted (or the
+	// claim couldn't be encoded for it), unavailable if the chain couldn't be reached
+	Status OnChainVerificationStatus `json:"status"`
+}
+
+// OnChainVerificationStatus verified if the contract accepted the claim, rejected if it reverted (or the
+// claim couldn't be encoded for it), unavailable if the chain couldn't be reached
+type OnChainVerificationStatus string
+
+// OrphanedFile A recording or intermediate file that no registered recorder owns
+type OrphanedFile struct {
+	ModifiedAt time.Time `json:"modified_at"`
+	Path       string    `json:"path"`
+	Size       int64     `json:"size"`
+}
+
 // PatchDisplayRequest defines model for PatchDisplayRequest.
 type PatchDisplayRequest struct {
 	// Height Display height in pixels
@@ -638,6 +1160,13 @@ type ProcessResizeRequest struct {
 	Rows int `json:"rows"`
 }
 
+// ProcessSignalRequest Signal to send to a process's job.
+type ProcessSignalRequest struct {
+	// Signal Signal name, with or without the SIG prefix: HUP, INT, QUIT, KILL, USR1, USR2,
+	// TERM, CONT, STOP, TSTP, TTIN, TTOU or WINCH.
+	Signal string `json:"signal"`
+}
+
 // ProcessSpawnRequest defines model for ProcessSpawnRequest.
 type ProcessSpawnRequest struct {
 	// AllocateTty Allocate a pseudo-terminal (PTY) for the process to enable interactive shells.
@@ -734,6 +1263,61 @@ type ProcessStreamEventEvent string
 // ProcessStreamEventStream Source stream of the data chunk.
 type ProcessStreamEventStream string
 
+// ProofPoolStats Current state of the workers that run admitted proofs
+type ProofPoolStats struct {
+	// Busy Number of workers running a proof
+	Busy int `json:"busy"`
+
+	// Queued Number of admitted proofs waiting for a worker
+	Queued int `json:"queued"`
+
+	// Workers Number of proofs that can run the protocol at once
+	Workers int `json:"workers"`
+}
+
+// ProofStats Aggregate statistics of the proofs run since the server started
+type ProofStats struct {
+	// Overall Counts and latency of a group of proofs
+	Overall ProofStatsEntry `json:"overall"`
+
+	// Pool Current state of the workers that run admitted proofs
+	Pool ProofPoolStats `json:"pool"`
+
+	// Providers Statistics per provider name, sorted by name
+	Providers []ProofStatsEntry `json:"providers"`
+
+	// Since When the server started collecting statistics
+	Since time.Time `json:"since"`
+}
+
+// ProofStatsEntry Counts and latency of a group of proofs
+type ProofStatsEntry struct {
+	// Failed Number of proofs that failed, timed out or returned an invalid claim
+	Failed int64 `json:"failed"`
+
+	// LatencyP50Ms Median proof duration in milliseconds; absent before any proof has run
+	LatencyP50Ms *int64 `json:"latency_p50_ms,omitempty"`
+
+	// LatencyP90Ms 90th percentile proof duration in milliseconds
+	LatencyP90Ms *int64 `json:"latency_p90_ms,omitempty"`
+
+	// LatencyP99Ms 99th percentile proof duration in milliseconds
+	LatencyP99Ms *int64 `json:"latency_p99_ms,omitempty"`
+
+	// Provider Provider name; absent on the overall entry. Proofs of providers beyond the
+	// first 100 seen are grouped under "other".
+	Provider *string `json:"provider,omitempty"`
+
+	// SuccessRate succeeded / total, or 0 before any proof has run
+	SuccessRate float32 `json:"success_rate"`
+
+	// Succeeded Number of proofs that returned a claim
+	Succeeded int64 `json:"succeeded"`
+
+	// Total Number of proofs run
+	Total int64 `json:"total"`
+}
+
 // ReclaimClaim The verified claim data from the attestor
 type ReclaimClaim struct {
 	// Context Additional context data stored with the claim (JSON string)
@@ -765,9 +1349,23 @@ type ReclaimProveRequest struct {
 	ConfigJson *string `json:"config_json,omitempty"`
 
 	// ProviderParamsJson JSON-encoded provider parameters containing the target URL, HTTP method,
-	// response matching rules, and redaction specifications.
+	// response matching rules, and redaction specifications. Limited to
+	// RECLAIM_MAX_PROVIDER_PARAMS_KB; larger values get a 400 (provider_params_too_large).
 	// Example: {"name":"http","params":{"url":"https://example.com","method":"GET"}}
 	ProviderParamsJson string `json:"provider_params_json"`
+
+	// Record Record the screen while the proof runs, for an audit trail of it. The recorder
+	// starts before the protocol and stops when it ends or times out, and is tagged
+	// reclaim_request_id=<session_id> so it can be found with /recording/list even if
+	// the proof fails. Its ID is returned in recording_id. The proof is refused with a
+	// 500 (proof_recording_failed) if the recording can't be started.
+	Record *bool `json:"record,omitempty"`
+
+	// VerifyOnChain After the proof, check the claim against the Reclaim verifier contract with a
+	// read-only call and report the outcome in on_chain_verification. Requires
+	// RECLAIM_CHAIN_RPC_URL and RECLAIM_VERIFIER_CONTRACT; the request is rejected with
+	// 400 without them. The outcome never changes the response status.
+	VerifyOnChain *bool `json:"verify_on_chain,omitempty"`
 }
 
 // ReclaimProveResult Result of TEE+MPC proof protocol execution
@@ -775,6 +1373,16 @@ type ReclaimProveResult struct {
 	// Claim The verified claim data from the attestor
 	Claim ReclaimClaim `json:"claim"`
 
+	// OnChainVerification Outcome of checking a claim against the Reclaim verifier contract
+	OnChainVerification *OnChainVerification `json:"on_chain_verification,omitempty"`
+
+	// RawClaimJson The claim serialized as the claimData object Reclaim verifiers consume (camelCase
+	// keys in protocol field order, no HTML escaping). Pass it through unmodified.
+	RawClaimJson *string `json:"raw_claim_json,omitempty"`
+
+	// RecordingId ID of the recorder that recorded the proof, when the request set record
+	RecordingId *string `json:"recording_id,omitempty"`
+
 	// SessionId Unique session/request identifier for this proof execution
 	SessionId string `json:"session_id"`
 
@@ -790,28 +1398,251 @@ type ReclaimSignature struct {
 	// ClaimSignature Base64-encoded signature of the claim data
 	ClaimSignature *string `json:"claim_signature,omitempty"`
 
+	// ClaimSignatureHex 0x-prefixed hex signature of the claim data, as listed in a proof's signatures
+	ClaimSignatureHex *string `json:"claim_signature_hex,omitempty"`
+
 	// ResultSignature Base64-encoded signature of the complete response
 	ResultSignature *string `json:"result_signature,omitempty"`
+
+	// ResultSignatureHex 0x-prefixed hex signature of the complete response
+	ResultSignatureHex *string `json:"result_signature_hex,omitempty"`
 }
 
 // RecorderInfo defines model for RecorderInfo.
 type RecorderInfo struct {
 	// FinishedAt Timestamp when recording finished
-	FinishedAt  *time.Time `json:"finished_at,omitempty"`
-	Id          string     `json:"id"`
-	IsRecording bool       `json:"isRecording"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Healthy False once the recording's output file has stopped growing for
+	// RECORDING_STALL_TIMEOUT_SECONDS while ffmpeg is still running, which usually means
+	// capture failed silently. Stays false if the stalled recording was force-stopped.
+	Healthy     bool   `json:"healthy"`
+	Id          string `json:"id"`
+	IsRecording bool   `json:"isRecording"`
+
+	// Label Label the recording was started with; absent if it has none.
+	Label *string `json:"label,omitempty"`
 
 	// StartedAt Timestamp when recording started
 	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// Tags Tags the recording was started with; absent if it has none.
+	Tags *map[string]string `json:"tags,omitempty"`
+
+	// Tenant Tenant the recording belongs to; absent for recordings started without one.
+	Tenant *string `json:"tenant,omitempty"`
 }
 
-// ScreenshotRegion defines model for ScreenshotRegion.
-type ScreenshotRegion struct {
-	// Height Height of the region in pixels
-	Height int `json:"height"`
+// RecorderResourceUsage Resources used by a recorder's ffmpeg process, sampled at request time.
+type RecorderResourceUsage struct {
+	// CpuPercent Average CPU utilisation since ffmpeg started, as a percentage of one core.
+	CpuPercent float32 `json:"cpu_percent"`
 
-	// Width Width of the region in pixels
-	Width int `json:"width"`
+	// CpuSeconds User plus system CPU time consumed since ffmpeg started.
+	CpuSeconds float32 `json:"cpu_seconds"`
+
+	// RssBytes Resident memory of the ffmpeg process in bytes.
+	RssBytes int64 `json:"rss_bytes"`
+
+	// Threads Number of threads in the ffmpeg process.
+	Threads int `json:"threads"`
+}
+
+// RecordingLocation Where a recording is stored.
+type RecordingLocation struct {
+	// Backend Storage backend holding the recording. Recordings are only stored locally.
+	Backend RecordingLocationBackend `json:"backend"`
+	Id      string                   `json:"id"`
+
+	// Path Location of the recording within the backend. For local storage, a path relative to
+	// the server's output directory, e.g. "acme/default.mp4" for a tenant's recording.
+	Path string `json:"path"`
+
+	// Uploaded Whether the recording has been uploaded to remote storage. Always false for local storage.
+	Uploaded bool `json:"uploaded"`
+}
+
+// RecordingLocationBackend Storage backend holding the recording. Recordings are only stored locally.
+type RecordingLocationBackend string
+
+// RecordingManifest Sidecar describing a finalized recording, independent of the server's in-memory state.
+type RecordingManifest struct {
+	// Codec Video codec of the recording, e.g. h264.
+	Codec string `json:"codec"`
+
+	// ExitCode ffmpeg's exit code.
+	ExitCode int `json:"exitCode"`
+
+	// ExitReason Why the recording ended: "stopped" by StopRecording, "killed" by a forced stop,
+	// "completed" when ffmpeg exited cleanly on its own (e.g. at the maximum duration or
+	// size), or "failed" when it exited with an error.
+	ExitReason RecordingManifestExitReason `json:"exitReason"`
+
+	// File File name of the recording, in the same directory as the manifest.
+	File string `json:"file"`
+
+	// FinalizeError Set when the recording could not be remuxed and is kept as written.
+	FinalizeError *string   `json:"finalizeError,omitempty"`
+	FinishedAt    time.Time `json:"finishedAt"`
+	Id            string    `json:"id"`
+
+	// Label Label the recording was started with; absent if it has none.
+	Label *string `json:"label,omitempty"`
+
+	// Params Effective recording parameters, after applying request overrides to the server defaults.
+	Params RecordingParams `json:"params"`
+
+	// Size Size of the recording file in bytes.
+	Size      int64     `json:"size"`
+	StartedAt time.Time `json:"startedAt"`
+
+	// Tags Tags the recording was started with; absent if it has none.
+	Tags *map[string]string `json:"tags,omitempty"`
+
+	// Tenant Tenant the recording was made for; absent for recordings without one.
+	Tenant *string `json:"tenant,omitempty"`
+}
+
+// RecordingManifestExitReason Why the recording ended: "stopped" by StopRecording, "killed" by a forced stop,
+// "completed" when ffmpeg exited cleanly on its own (e.g. at the maximum duration or
+// size), or "failed" when it exited with an error.
+type RecordingManifestExitReason string
+
+// RecordingParams Effective recording parameters, after applying request overrides to the server defaults.
+type RecordingParams struct {
+	// Crf Constant rate factor; absent unless set.
+	Crf *int `json:"crf,omitempty"`
+
+	// DisplayNum X display that is recorded.
+	DisplayNum int `json:"displayNum"`
+
+	// DrawMouse Whether the mouse cursor is drawn; absent when left to ffmpeg's default.
+	DrawMouse *bool `json:"drawMouse,omitempty"`
+
+	// DropDuplicateFrames Whether near-duplicate frames are dropped.
+	DropDuplicateFrames bool `json:"dropDuplicateFrames"`
+
+	// Fragmented Whether the fragmented MP4 is kept instead of being remuxed.
+	Fragmented bool `json:"fragmented"`
+
+	// Framerate Recording framerate in fps.
+	Framerate int `json:"framerate"`
+
+	// KeyframeIntervalSeconds Maximum seconds between keyframes; absent when left to the encoder.
+	KeyframeIntervalSeconds *int `json:"keyframeIntervalSeconds,omitempty"`
+
+	// MaxDurationInSeconds Maximum recording duration in seconds; absent when unlimited.
+	MaxDurationInSeconds *int `json:"maxDurationInSeconds,omitempty"`
+
+	// MaxFileSizeInMB Maximum file size in MB.
+	MaxFileSizeInMB int `json:"maxFileSizeInMB"`
+
+	// Mode How frames are captured, "screen" or "screencast".
+	Mode string `json:"mode"`
+
+	// Preset Encoder preset; absent when left to the encoder's default.
+	Preset *string `json:"preset,omitempty"`
+
+	// Qp Constant quantizer; absent unless set.
+	Qp *int `json:"qp,omitempty"`
+}
+
+// RecordingProgressEvent SSE payload describing the progress of a recording.
+type RecordingProgressEvent struct {
+	// Bytes Current size of the recording file in bytes.
+	Bytes int64 `json:"bytes"`
+
+	// ElapsedSeconds Seconds since the recording started.
+	ElapsedSeconds float32 `json:"elapsed_seconds"`
+
+	// Encoder ffmpeg's latest progress report for a recording. Reported when the server runs ffmpeg
+	// with FFMPEG_PROGRESS enabled.
+	Encoder *EncoderStats `json:"encoder,omitempty"`
+
+	// Event "not_started" until the recorder is started; "progress" while it is running;
+	// "finished" once it has stopped and the recording is finalized. The stream closes
+	// after the "finished" event.
+	Event RecordingProgressEventEvent `json:"event"`
+}
+
+// RecordingProgressEventEvent "not_started" until the recorder is started; "progress" while it is running;
+// "finished" once it has stopped and the recording is finalized. The stream closes
+// after the "finished" event.
+type RecordingProgressEventEvent string
+
+// RecordingStatus defines model for RecordingStatus.
+// RecordingQueueStats Current recording concurrency and start queue
+type RecordingQueueStats struct {
+	// MaxConcurrent Maximum number of recordings running at once; 0 when unlimited
+	MaxConcurrent int `json:"maxConcurrent"`
+
+	// MaxQueued Maximum number of start requests that can wait at once
+	MaxQueued int `json:"maxQueued"`
+
+	// Queued Number of start requests waiting for a recording to end
+	Queued int `json:"queued"`
+
+	// Running Number of recordings running or starting
+	Running int `json:"running"`
+}
+
+type RecordingStatus struct {
+	// Encoder ffmpeg's latest progress report for a recording. Reported when the server runs ffmpeg
+	// with FFMPEG_PROGRESS enabled.
+	Encoder *EncoderStats `json:"encoder,omitempty"`
+
+	// FinishedAt Timestamp when recording finished
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	Id          string     `json:"id"`
+	IsRecording bool       `json:"isRecording"`
+
+	// Resources Resources used by a recorder's ffmpeg process, sampled at request time.
+	Resources *RecorderResourceUsage `json:"resources,omitempty"`
+
+	// StartedAt Timestamp when recording started
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// ScaleToZeroEvent The most recent hold taken, active or not; absent before the first
+type ScaleToZeroEvent struct {
+	// At When the hold was taken
+	At time.Time `json:"at"`
+
+	// Reason What took the hold
+	Reason string `json:"reason"`
+}
+
+// ScaleToZeroHold defines model for ScaleToZeroHold.
+type ScaleToZeroHold struct {
+	// Count Number of active holds with this reason
+	Count int `json:"count"`
+
+	// Reason What is holding scale-to-zero off
+	Reason string `json:"reason"`
+}
+
+// ScaleToZeroStatus Whether scale-to-zero is held off and why
+type ScaleToZeroStatus struct {
+	// Holds Active holds by reason, sorted by reason
+	Holds []ScaleToZeroHold `json:"holds"`
+
+	// LastSuppressedBy The most recent hold taken, active or not; absent before the first
+	LastSuppressedBy *ScaleToZeroEvent `json:"last_suppressed_by,omitempty"`
+
+	// Paused Whether scale-to-zero is paused through POST /scale-to-zero/pause
+	Paused bool `json:"paused"`
+
+	// Suppressed Whether scale-to-zero is currently held off
+	Suppressed bool `json:"suppressed"`
+}
+
+// ScreenshotRegion defines model for ScreenshotRegion.
+type ScreenshotRegion struct {
+	// Height Height of the region in pixels
+	Height int `json:"height"`
+
+	// Width Width of the region in pixels
+	Width int `json:"width"`
 
 	// X X coordinate of the region's top-left corner
 	X int `json:"x"`
@@ -843,6 +1674,38 @@ type ScrollRequest struct {
 	Y int `json:"y"`
 }
 
+// ServerConfig Server settings keyed by environment variable, e.g. {"FRAME_RATE": 10}
+type ServerConfig map[string]interface{}
+
+// ServerLogEntry A structured log entry written by the API server.
+type ServerLogEntry struct {
+	// Attrs Attributes of the entry, keyed by name with group names prefixed
+	// ("group.key"). Long values are truncated.
+	Attrs map[string]string `json:"attrs"`
+
+	// Level Log level (DEBUG, INFO, WARN or ERROR).
+	Level string `json:"level"`
+
+	// Message Log message text.
+	Message string `json:"message"`
+
+	// Time Time the entry was logged.
+	Time time.Time `json:"time"`
+}
+
+// SetChromiumCookiesRequest defines model for SetChromiumCookiesRequest.
+type SetChromiumCookiesRequest struct {
+	Cookies []ChromiumCookieParam `json:"cookies"`
+}
+
+// SetChromiumCookiesResult defines model for SetChromiumCookiesResult.
+type SetChromiumCookiesResult struct {
+	Failed []ChromiumCookieFailure `json:"failed"`
+
+	// Set Number of cookies that were set
+	Set int `json:"set"`
+}
+
 // SetCursorRequest defines model for SetCursorRequest.
 type SetCursorRequest struct {
 	// Hidden Whether the cursor should be hidden
@@ -879,27 +1742,107 @@ type StartFsWatchRequest struct {
 	Recursive *bool `json:"recursive,omitempty"`
 }
 
+// StartRecordingDryRun The recording a StartRecording request would start, resolved without starting it.
+type StartRecordingDryRun struct {
+	// Args Arguments ffmpeg would be started with.
+	Args []string `json:"args"`
+
+	// Command The ffmpeg binary that would be executed.
+	Command string `json:"command"`
+
+	// Id Identifier the recording would be registered under.
+	Id     string          `json:"id"`
+	Params RecordingParams `json:"params"`
+}
+
 // StartRecordingRequest defines model for StartRecordingRequest.
 type StartRecordingRequest struct {
+	// Crf Constant rate factor; lower values mean higher quality and larger files. 0-51 for
+	// libx264, whose default is 23. Mutually exclusive with qp.
+	Crf *int `json:"crf,omitempty"`
+
+	// DisplayNum X display to record, e.g. 2 for :2 (overrides server default). Displays other than
+	// the default must be listed in the server's RECORDING_ALLOWED_DISPLAYS.
+	DisplayNum *int `json:"displayNum,omitempty"`
+
+	// DrawMouse Whether the mouse cursor is drawn into the recording. Omit to keep ffmpeg's default,
+	// which draws it on Linux. Only supported for the screen capture mode.
+	DrawMouse *bool `json:"drawMouse,omitempty"`
+
+	// DropDuplicateFrames Drop frames that barely differ from the previous one (ffmpeg mpdecimate), shrinking
+	// recordings of mostly idle screens. Kept frames keep their capture timestamps, so
+	// playback still runs in real time. Enabled for every recording when the server's
+	// RECORDING_DROP_DUPLICATE_FRAMES is set.
+	DropDuplicateFrames *bool `json:"dropDuplicateFrames,omitempty"`
+
+	// ExtraArgs Extra ffmpeg arguments, one token per item, added after the generated output
+	// options and before the output file, so they can override them (e.g.
+	// ["-tune", "zerolatency"]). Only accepted when the server sets
+	// ALLOW_FFMPEG_EXTRA_ARGS. Tokens may not contain shell metacharacters.
+	ExtraArgs *[]string `json:"extraArgs,omitempty"`
+
 	// Framerate Recording framerate in fps (overrides server default)
 	Framerate *int `json:"framerate,omitempty"`
 
-	// Id Optional identifier for the recording session. Alphanumeric or hyphen.
+	// Id Optional identifier for the recording session. Letters, digits, hyphens or underscores, up to 64 characters.
 	Id *string `json:"id,omitempty"`
 
+	// KeyframeIntervalSeconds Force a keyframe at least this often, so players can seek to any point within that
+	// distance (overrides server default). Omit to leave keyframe placement to the encoder.
+	KeyframeIntervalSeconds *int `json:"keyframeIntervalSeconds,omitempty"`
+
+	// Label Free-form label for organizing recordings, stored with the recording and its manifest.
+	Label *string `json:"label,omitempty"`
+
 	// MaxDurationInSeconds Maximum recording duration in seconds (overrides server default)
 	MaxDurationInSeconds *int `json:"maxDurationInSeconds,omitempty"`
 
 	// MaxFileSizeInMB Maximum file size in MB (overrides server default)
 	MaxFileSizeInMB *int `json:"maxFileSizeInMB,omitempty"`
+
+	// Mode How frames are captured (overrides server default). "screen" grabs the X display;
+	// "screencast" records Chromium's CDP screencast of the first page, for environments
+	// without a usable display. Both produce the same MP4 output.
+	Mode *StartRecordingRequestMode `json:"mode,omitempty"`
+
+	// Preset Encoder preset, trading encoding speed for compression: slower presets make smaller
+	// files at the same quality but use more CPU. For libx264 one of ultrafast, superfast,
+	// veryfast, faster, fast, medium, slow, slower or veryslow. Omit to keep the encoder's
+	// default (medium).
+	Preset *string `json:"preset,omitempty"`
+
+	// Qp Constant quantizer, 0-51 for libx264 with 0 being lossless. Mutually exclusive with
+	// crf. Omit both to leave rate control to the encoder.
+	Qp *int `json:"qp,omitempty"`
+
+	// StopOnDisconnect Stop the recording when the client goes away. The client holds the recording's
+	// progress stream (GET /recordings/{id}/progress) open as a keepalive; the recording
+	// is stopped if no stream is attached within 10 seconds of starting, or 10 seconds
+	// after the last one closes.
+	StopOnDisconnect *bool `json:"stopOnDisconnect,omitempty"`
+
+	// Tags Key/value tags for organizing recordings, stored with the recording and its manifest.
+	// Recorders can be listed by tag. Keys are letters, digits, dots, hyphens or underscores
+	// (up to 64 characters), values up to 256 characters, and at most 32 tags.
+	Tags *map[string]string `json:"tags,omitempty"`
+
+	// Tenant Tenant to record for. The recording is written to a subdirectory of the output
+	// directory named after the tenant and counts against the tenant's disk quota
+	// (RECORDING_TENANT_QUOTA_MB).
+	Tenant *string `json:"tenant,omitempty"`
 }
 
+// StartRecordingRequestMode How frames are captured (overrides server default). "screen" grabs the X display;
+// "screencast" records Chromium's CDP screencast of the first page, for environments
+// without a usable display. Both produce the same MP4 output.
+type StartRecordingRequestMode string
+
 // StopRecordingRequest defines model for StopRecordingRequest.
 type StopRecordingRequest struct {
 	// ForceStop Immediately stop without graceful shutdown. This may result in a corrupted video file.
 	ForceStop *bool `json:"forceStop,omitempty"`
 
-	// Id Identifier of the recorder to stop. Alphanumeric or hyphen.
+	// Id Identifier of the recorder to stop. Letters, digits, hyphens or underscores, up to 64 characters.
 	Id *string `json:"id,omitempty"`
 }
 
@@ -912,6 +1855,18 @@ type TypeTextRequest struct {
 	Text string `json:"text"`
 }
 
+// WarmupResult Readiness of Chromium for CDP clients after a warmup.
+type WarmupResult struct {
+	// Created Whether this call opened the page target (false when one already existed)
+	Created bool `json:"created"`
+
+	// Ready Whether a page target is available for CDP clients
+	Ready bool `json:"ready"`
+
+	// TargetId ID of the page target that was found or created
+	TargetId string `json:"target_id"`
+}
+
 // WriteClipboardRequest defines model for WriteClipboardRequest.
 type WriteClipboardRequest struct {
 	// Text Text to write to the system clipboard
@@ -930,6 +1885,31 @@ type InternalError = Error
 // NotFoundError defines model for NotFoundError.
 type NotFoundError = Error
 
+type GetRecordingStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecordingStatus
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecordingStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecordingStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // PatchChromiumFlagsJSONBody defines parameters for PatchChromiumFlags.
 type PatchChromiumFlagsJSONBody struct {
 	// Flags Chromium flags to merge (e.g., ["--kiosk", "--disable-gpu"])
@@ -951,6 +1931,12 @@ type UploadExtensionsAndRestartMultipartBody struct {
 	} `json:"extensions"`
 }
 
+// GetExtensionsParams defines parameters for GetExtensions.
+type GetExtensionsParams struct {
+	// Verify Also verify the signatures of each .crx, as Chromium does on install.
+	Verify *bool `form:"verify,omitempty" json:"verify,omitempty"`
+}
+
 // DownloadDirZipParams defines parameters for DownloadDirZip.
 type DownloadDirZipParams struct {
 	// Path Absolute directory path to archive and download.
@@ -1028,6 +2014,18 @@ type WriteFileParams struct {
 	Mode *string `form:"mode,omitempty" json:"mode,omitempty"`
 }
 
+// GetLogsParams defines parameters for GetLogs.
+type GetLogsParams struct {
+	// Level Minimum level of the entries to return.
+	Level *GetLogsParamsLevel `form:"level,omitempty" json:"level,omitempty"`
+
+	// Lines Maximum number of entries to return, newest last.
+	Lines *int `form:"lines,omitempty" json:"lines,omitempty"`
+}
+
+// GetLogsParamsLevel defines parameters for GetLogs.
+type GetLogsParamsLevel string
+
 // LogsStreamParams defines parameters for LogsStream.
 type LogsStreamParams struct {
 	Source LogsStreamParamsSource `form:"source" json:"source"`
@@ -1043,12 +2041,88 @@ type LogsStreamParams struct {
 // LogsStreamParamsSource defines parameters for LogsStream.
 type LogsStreamParamsSource string
 
+// CleanupParams defines parameters for Cleanup.
+type CleanupParams struct {
+	// OlderThanSeconds Minimum age of the files to remove, overriding RECORDING_CLEANUP_MIN_AGE_SECONDS.
+	OlderThanSeconds *int `form:"olderThanSeconds,omitempty" json:"olderThanSeconds,omitempty"`
+
+	// DryRun List the files that would be removed without removing them.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // DownloadRecordingParams defines parameters for DownloadRecording.
 type DownloadRecordingParams struct {
 	// Id Optional recorder identifier. When omitted, the server uses the default recorder.
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// Snapshot While the recording is in progress, send what has been captured so far without
+	// stopping it: the file up to its last complete fragment, which plays on its own.
+	// Range is ignored for snapshots. Answered with 202 until a first fragment is
+	// complete, and ignored once the recording is finalized.
+	Snapshot *bool `form:"snapshot,omitempty" json:"snapshot,omitempty"`
+
+	// IfNoneMatch ETags of copies the client already has. When one matches the finalized recording the
+	// server answers 304 instead of sending it again.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of the client's copy; a finalized recording not modified since is answered
+	// with 304. Ignored when If-None-Match is present.
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
+
+	// Range A single byte range (e.g. "bytes=0-1023", "bytes=1024-" or "bytes=-1024") to send
+	// with 206 instead of the whole file. Requests for several ranges get the whole file.
+	Range *string `json:"Range,omitempty"`
+
+	// IfRange ETag or HTTP date the Range applies to; when the finalized recording no longer
+	// matches it, the whole file is sent.
+	IfRange *string `json:"If-Range,omitempty"`
 }
 
+// ListRecordersParams defines parameters for ListRecorders.
+type ListRecordersParams struct {
+	// Tenant Only list recorders of this tenant.
+	Tenant *string `form:"tenant,omitempty" json:"tenant,omitempty"`
+
+	// Tag Only list recorders with this tag, given as key=value, or as key to match any value.
+	// Repeat the parameter to require several tags.
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// StartRecordingParams defines parameters for StartRecording.
+type StartRecordingParams struct {
+	// DryRun Validate the request and resolve the ffmpeg command without starting the recording.
+	// Nothing is registered, so the recorder ID stays free.
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// IdempotencyKey Client-chosen key that makes retries safe. A request repeating the key of one that
+	// started a recording within the last 10 minutes returns that request's result
+	// instead of starting another recorder.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+
+	// Wait When RECORDING_MAX_CONCURRENT recordings are already running, wait in the start
+	// queue for one to end instead of failing with a 429. Queued requests start in
+	// arrival order, for at most RECORDING_START_QUEUE_TIMEOUT_SECONDS.
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
+// ConvertRecordingParams defines parameters for ConvertRecording.
+type ConvertRecordingParams struct {
+	// Fps Frame rate of the GIF.
+	Fps *int `form:"fps,omitempty" json:"fps,omitempty"`
+
+	// Width Width of the GIF in pixels; the height keeps the recording's aspect ratio.
+	Width *int `form:"width,omitempty" json:"width,omitempty"`
+}
+
+// SendCDPCommandJSONRequestBody defines body for SendCDPCommand for application/json ContentType.
+type SendCDPCommandJSONRequestBody = CDPCommandRequest
+
+// SetChromiumCookiesJSONRequestBody defines body for SetChromiumCookies for application/json ContentType.
+type SetChromiumCookiesJSONRequestBody = SetChromiumCookiesRequest
+
+// SetChromiumEmulationJSONRequestBody defines body for SetChromiumEmulation for application/json ContentType.
+type SetChromiumEmulationJSONRequestBody = ChromiumEmulationRequest
+
 // PatchChromiumFlagsJSONRequestBody defines body for PatchChromiumFlags for application/json ContentType.
 type PatchChromiumFlagsJSONRequestBody PatchChromiumFlagsJSONBody
 
@@ -1058,6 +2132,9 @@ type PatchChromiumPoliciesJSONRequestBody PatchChromiumPoliciesJSONBody
 // UploadExtensionsAndRestartMultipartRequestBody defines body for UploadExtensionsAndRestart for multipart/form-data ContentType.
 type UploadExtensionsAndRestartMultipartRequestBody UploadExtensionsAndRestartMultipartBody
 
+// SetChromiumViewportJSONRequestBody defines body for SetChromiumViewport for application/json ContentType.
+type SetChromiumViewportJSONRequestBody = ChromiumViewportRequest
+
 // BatchComputerActionJSONRequestBody defines body for BatchComputerAction for application/json ContentType.
 type BatchComputerActionJSONRequestBody = BatchComputerActionRequest
 
@@ -1133,6 +2210,9 @@ type ProcessKillJSONRequestBody = ProcessKillRequest
 // ProcessResizeJSONRequestBody defines body for ProcessResize for application/json ContentType.
 type ProcessResizeJSONRequestBody = ProcessResizeRequest
 
+// ProcessSignalJSONRequestBody defines body for ProcessSignal for application/json ContentType.
+type ProcessSignalJSONRequestBody = ProcessSignalRequest
+
 // ProcessStdinJSONRequestBody defines body for ProcessStdin for application/json ContentType.
 type ProcessStdinJSONRequestBody = ProcessStdinRequest
 
@@ -1221,6 +2301,27 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// SendCDPCommandWithBody request with any body
+	SendCDPCommandWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SendCDPCommand(ctx context.Context, body SendCDPCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetChromiumCookies request
+	GetChromiumCookies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetChromiumCookiesWithBody request with any body
+	SetChromiumCookiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetChromiumCookies(ctx context.Context, body SetChromiumCookiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetChromiumEmulationWithBody request with any body
+	SetChromiumEmulationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetChromiumEmulation(ctx context.Context, body SetChromiumEmulationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExtensions request
+	GetExtensions(ctx context.Context, params *GetExtensionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PatchChromiumFlagsWithBody request with any body
 	PatchChromiumFlagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1231,9 +2332,20 @@ type ClientInterface interface {
 
 	PatchChromiumPolicies(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetChromiumTargets request
+	GetChromiumTargets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UploadExtensionsAndRestartWithBody request with any body
 	UploadExtensionsAndRestartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SetChromiumViewportWithBody request with any body
+	SetChromiumViewportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetChromiumViewport(ctx context.Context, body SetChromiumViewportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WarmupChromium request
+	WarmupChromium(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// BatchComputerActionWithBody request with any body
 	BatchComputerActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1290,6 +2402,12 @@ type ClientInterface interface {
 
 	TypeText(ctx context.Context, body TypeTextJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetConfig request
+	GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDisplayInfo request
+	GetDisplayInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PatchDisplayWithBody request with any body
 	PatchDisplayWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1358,6 +2476,9 @@ type ClientInterface interface {
 	// WriteFileWithBody request with any body
 	WriteFileWithBody(ctx context.Context, params *WriteFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLogs request
+	GetLogs(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LogsStream request
 	LogsStream(ctx context.Context, params *LogsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1386,6 +2507,11 @@ type ClientInterface interface {
 
 	ProcessResize(ctx context.Context, processId openapi_types.UUID, body ProcessResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ProcessSignalWithBody request with any body
+	ProcessSignalWithBody(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProcessSignal(ctx context.Context, processId openapi_types.UUID, body ProcessSignalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ProcessStatus request
 	ProcessStatus(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1397,11 +2523,20 @@ type ClientInterface interface {
 	// ProcessStdoutStream request
 	ProcessStdoutStream(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCircuitStatus request
+	GetCircuitStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ReclaimProveWithBody request with any body
 	ReclaimProveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ReclaimProve(ctx context.Context, body ReclaimProveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetProofStats request
+	GetProofStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Cleanup request
+	Cleanup(ctx context.Context, params *CleanupParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteRecordingWithBody request with any body
 	DeleteRecordingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1411,21 +2546,48 @@ type ClientInterface interface {
 	DownloadRecording(ctx context.Context, params *DownloadRecordingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListRecorders request
-	ListRecorders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ListRecorders(ctx context.Context, params *ListRecordersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecordingQueue request
+	GetRecordingQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StartRecordingWithBody request with any body
-	StartRecordingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	StartRecordingWithBody(ctx context.Context, params *StartRecordingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	StartRecording(ctx context.Context, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	StartRecording(ctx context.Context, params *StartRecordingParams, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StopRecordingWithBody request with any body
 	StopRecordingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	StopRecording(ctx context.Context, body StopRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConvertRecording request
+	ConvertRecording(ctx context.Context, id string, params *ConvertRecordingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecordingLocation request
+	GetRecordingLocation(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecordingManifest request
+	GetRecordingManifest(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamRecordingProgress request
+	StreamRecordingProgress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecordingStatus request
+	GetRecordingStatus(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScaleToZeroStatus request
+	GetScaleToZeroStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeScaleToZero request
+	ResumeScaleToZero(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseScaleToZero request
+	PauseScaleToZero(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PatchChromiumFlagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchChromiumFlagsRequestWithBody(c.Server, contentType, body)
+func (c *Client) SendCDPCommandWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendCDPCommandRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1436,8 +2598,8 @@ func (c *Client) PatchChromiumFlagsWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchChromiumFlags(ctx context.Context, body PatchChromiumFlagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchChromiumFlagsRequest(c.Server, body)
+func (c *Client) SendCDPCommand(ctx context.Context, body SendCDPCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendCDPCommandRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1448,8 +2610,8 @@ func (c *Client) PatchChromiumFlags(ctx context.Context, body PatchChromiumFlags
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchChromiumPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchChromiumPoliciesRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetChromiumCookies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChromiumCookiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1460,8 +2622,8 @@ func (c *Client) PatchChromiumPoliciesWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchChromiumPolicies(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchChromiumPoliciesRequest(c.Server, body)
+func (c *Client) SetChromiumCookiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChromiumCookiesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1472,8 +2634,8 @@ func (c *Client) PatchChromiumPolicies(ctx context.Context, body PatchChromiumPo
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadExtensionsAndRestartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadExtensionsAndRestartRequestWithBody(c.Server, contentType, body)
+func (c *Client) SetChromiumCookies(ctx context.Context, body SetChromiumCookiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChromiumCookiesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1484,8 +2646,8 @@ func (c *Client) UploadExtensionsAndRestartWithBody(ctx context.Context, content
 	return c.Client.Do(req)
 }
 
-func (c *Client) BatchComputerActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBatchComputerActionRequestWithBody(c.Server, contentType, body)
+func (c *Client) SetChromiumEmulationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChromiumEmulationRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1496,8 +2658,8 @@ func (c *Client) BatchComputerActionWithBody(ctx context.Context, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) BatchComputerAction(ctx context.Context, body BatchComputerActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBatchComputerActionRequest(c.Server, body)
+func (c *Client) SetChromiumEmulation(ctx context.Context, body SetChromiumEmulationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChromiumEmulationRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1508,8 +2670,8 @@ func (c *Client) BatchComputerAction(ctx context.Context, body BatchComputerActi
 	return c.Client.Do(req)
 }
 
-func (c *Client) ClickMouseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewClickMouseRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetExtensions(ctx context.Context, params *GetExtensionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExtensionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1520,8 +2682,8 @@ func (c *Client) ClickMouseWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) ClickMouse(ctx context.Context, body ClickMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewClickMouseRequest(c.Server, body)
+func (c *Client) PatchChromiumFlagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchChromiumFlagsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1532,8 +2694,8 @@ func (c *Client) ClickMouse(ctx context.Context, body ClickMouseJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReadClipboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReadClipboardRequest(c.Server)
+func (c *Client) PatchChromiumFlags(ctx context.Context, body PatchChromiumFlagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchChromiumFlagsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1544,8 +2706,8 @@ func (c *Client) ReadClipboard(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) WriteClipboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWriteClipboardRequestWithBody(c.Server, contentType, body)
+func (c *Client) PatchChromiumPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchChromiumPoliciesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1556,8 +2718,8 @@ func (c *Client) WriteClipboardWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) WriteClipboard(ctx context.Context, body WriteClipboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWriteClipboardRequest(c.Server, body)
+func (c *Client) PatchChromiumPolicies(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchChromiumPoliciesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1568,8 +2730,8 @@ func (c *Client) WriteClipboard(ctx context.Context, body WriteClipboardJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetCursorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetCursorRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetChromiumTargets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChromiumTargetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1580,8 +2742,8 @@ func (c *Client) SetCursorWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetCursor(ctx context.Context, body SetCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetCursorRequest(c.Server, body)
+func (c *Client) UploadExtensionsAndRestartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadExtensionsAndRestartRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1592,8 +2754,8 @@ func (c *Client) SetCursor(ctx context.Context, body SetCursorJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DragMouseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDragMouseRequestWithBody(c.Server, contentType, body)
+func (c *Client) SetChromiumViewportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChromiumViewportRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1604,8 +2766,8 @@ func (c *Client) DragMouseWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) DragMouse(ctx context.Context, body DragMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDragMouseRequest(c.Server, body)
+func (c *Client) SetChromiumViewport(ctx context.Context, body SetChromiumViewportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChromiumViewportRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1616,8 +2778,8 @@ func (c *Client) DragMouse(ctx context.Context, body DragMouseJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMousePosition(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMousePositionRequest(c.Server)
+func (c *Client) WarmupChromium(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWarmupChromiumRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1628,8 +2790,8 @@ func (c *Client) GetMousePosition(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveMouseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveMouseRequestWithBody(c.Server, contentType, body)
+func (c *Client) BatchComputerActionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchComputerActionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1640,8 +2802,8 @@ func (c *Client) MoveMouseWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) MoveMouse(ctx context.Context, body MoveMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMoveMouseRequest(c.Server, body)
+func (c *Client) BatchComputerAction(ctx context.Context, body BatchComputerActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchComputerActionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1652,8 +2814,8 @@ func (c *Client) MoveMouse(ctx context.Context, body MoveMouseJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) PressKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPressKeyRequestWithBody(c.Server, contentType, body)
+func (c *Client) ClickMouseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClickMouseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1664,8 +2826,8 @@ func (c *Client) PressKeyWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PressKey(ctx context.Context, body PressKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPressKeyRequest(c.Server, body)
+func (c *Client) ClickMouse(ctx context.Context, body ClickMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClickMouseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1676,8 +2838,8 @@ func (c *Client) PressKey(ctx context.Context, body PressKeyJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) TakeScreenshotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTakeScreenshotRequestWithBody(c.Server, contentType, body)
+func (c *Client) ReadClipboard(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadClipboardRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1688,8 +2850,152 @@ func (c *Client) TakeScreenshotWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) TakeScreenshot(ctx context.Context, body TakeScreenshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTakeScreenshotRequest(c.Server, body)
+func (c *Client) WriteClipboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteClipboardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WriteClipboard(ctx context.Context, body WriteClipboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteClipboardRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetCursorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCursorRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetCursor(ctx context.Context, body SetCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCursorRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DragMouseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDragMouseRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DragMouse(ctx context.Context, body DragMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDragMouseRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMousePosition(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMousePositionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveMouseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveMouseRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveMouse(ctx context.Context, body MoveMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveMouseRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PressKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPressKeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PressKey(ctx context.Context, body PressKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPressKeyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TakeScreenshotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTakeScreenshotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TakeScreenshot(ctx context.Context, body TakeScreenshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTakeScreenshotRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1748,6 +3054,30 @@ func (c *Client) TypeText(ctx context.Context, body TypeTextJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDisplayInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDisplayInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) PatchDisplayWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPatchDisplayRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -2048,6 +3378,18 @@ func (c *Client) WriteFileWithBody(ctx context.Context, params *WriteFileParams,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetLogs(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) LogsStream(ctx context.Context, params *LogsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewLogsStreamRequest(c.Server, params)
 	if err != nil {
@@ -2180,6 +3522,30 @@ func (c *Client) ProcessResize(ctx context.Context, processId openapi_types.UUID
 	return c.Client.Do(req)
 }
 
+func (c *Client) ProcessSignalWithBody(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessSignalRequestWithBody(c.Server, processId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessSignal(ctx context.Context, processId openapi_types.UUID, body ProcessSignalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessSignalRequest(c.Server, processId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ProcessStatus(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewProcessStatusRequest(c.Server, processId)
 	if err != nil {
@@ -2228,6 +3594,18 @@ func (c *Client) ProcessStdoutStream(ctx context.Context, processId openapi_type
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetCircuitStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCircuitStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ReclaimProveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewReclaimProveRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -2252,6 +3630,30 @@ func (c *Client) ReclaimProve(ctx context.Context, body ReclaimProveJSONRequestB
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetProofStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProofStatsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Cleanup(ctx context.Context, params *CleanupParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCleanupRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteRecordingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteRecordingRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -2288,8 +3690,8 @@ func (c *Client) DownloadRecording(ctx context.Context, params *DownloadRecordin
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListRecorders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListRecordersRequest(c.Server)
+func (c *Client) ListRecorders(ctx context.Context, params *ListRecordersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRecordersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2300,8 +3702,8 @@ func (c *Client) ListRecorders(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartRecordingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartRecordingRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetRecordingQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecordingQueueRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2312,8 +3714,20 @@ func (c *Client) StartRecordingWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartRecording(ctx context.Context, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartRecordingRequest(c.Server, body)
+func (c *Client) StartRecordingWithBody(ctx context.Context, params *StartRecordingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartRecordingRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartRecording(ctx context.Context, params *StartRecordingParams, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartRecordingRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2348,59 +3762,115 @@ func (c *Client) StopRecording(ctx context.Context, body StopRecordingJSONReques
 	return c.Client.Do(req)
 }
 
-// NewPatchChromiumFlagsRequest calls the generic PatchChromiumFlags builder with application/json body
-func NewPatchChromiumFlagsRequest(server string, body PatchChromiumFlagsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ConvertRecording(ctx context.Context, id string, params *ConvertRecordingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertRecordingRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchChromiumFlagsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchChromiumFlagsRequestWithBody generates requests for PatchChromiumFlags with any type of body
-func NewPatchChromiumFlagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRecordingLocation(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecordingLocationRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/chromium/flags")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GetRecordingManifest(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecordingManifestRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StreamRecordingProgress(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamRecordingProgressRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) GetRecordingStatus(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecordingStatusRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) GetScaleToZeroStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScaleToZeroStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) ResumeScaleToZero(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeScaleToZeroRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchChromiumPoliciesRequest calls the generic PatchChromiumPolicies builder with application/json body
-func NewPatchChromiumPoliciesRequest(server string, body PatchChromiumPoliciesJSONRequestBody) (*http.Request, error) {
+func (c *Client) PauseScaleToZero(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseScaleToZeroRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewSendCDPCommandRequest calls the generic SendCDPCommand builder with application/json body
+func NewSendCDPCommandRequest(server string, body SendCDPCommandJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchChromiumPoliciesRequestWithBody(server, "application/json", bodyReader)
+	return NewSendCDPCommandRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPatchChromiumPoliciesRequestWithBody generates requests for PatchChromiumPolicies with any type of body
-func NewPatchChromiumPoliciesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSendCDPCommandRequestWithBody generates requests for SendCDPCommand with any type of body
+func NewSendCDPCommandRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2408,7 +3878,7 @@ func NewPatchChromiumPoliciesRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/chromium/policies")
+	operationPath := fmt.Sprintf("/chromium/cdp")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2418,7 +3888,7 @@ func NewPatchChromiumPoliciesRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2428,8 +3898,8 @@ func NewPatchChromiumPoliciesRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
-// NewUploadExtensionsAndRestartRequestWithBody generates requests for UploadExtensionsAndRestart with any type of body
-func NewUploadExtensionsAndRestartRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetChromiumCookiesRequest generates requests for GetChromiumCookies
+func NewGetChromiumCookiesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2437,7 +3907,7 @@ func NewUploadExtensionsAndRestartRequestWithBody(server string, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/chromium/upload-extensions-and-restart")
+	operationPath := fmt.Sprintf("/chromium/cookies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2447,29 +3917,27 @@ func NewUploadExtensionsAndRestartRequestWithBody(server string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewBatchComputerActionRequest calls the generic BatchComputerAction builder with application/json body
-func NewBatchComputerActionRequest(server string, body BatchComputerActionJSONRequestBody) (*http.Request, error) {
+// NewSetChromiumCookiesRequest calls the generic SetChromiumCookies builder with application/json body
+func NewSetChromiumCookiesRequest(server string, body SetChromiumCookiesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewBatchComputerActionRequestWithBody(server, "application/json", bodyReader)
+	return NewSetChromiumCookiesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewBatchComputerActionRequestWithBody generates requests for BatchComputerAction with any type of body
-func NewBatchComputerActionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetChromiumCookiesRequestWithBody generates requests for SetChromiumCookies with any type of body
+func NewSetChromiumCookiesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2477,7 +3945,7 @@ func NewBatchComputerActionRequestWithBody(server string, contentType string, bo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/batch")
+	operationPath := fmt.Sprintf("/chromium/cookies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2497,19 +3965,19 @@ func NewBatchComputerActionRequestWithBody(server string, contentType string, bo
 	return req, nil
 }
 
-// NewClickMouseRequest calls the generic ClickMouse builder with application/json body
-func NewClickMouseRequest(server string, body ClickMouseJSONRequestBody) (*http.Request, error) {
+// NewSetChromiumEmulationRequest calls the generic SetChromiumEmulation builder with application/json body
+func NewSetChromiumEmulationRequest(server string, body SetChromiumEmulationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewClickMouseRequestWithBody(server, "application/json", bodyReader)
+	return NewSetChromiumEmulationRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewClickMouseRequestWithBody generates requests for ClickMouse with any type of body
-func NewClickMouseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetChromiumEmulationRequestWithBody generates requests for SetChromiumEmulation with any type of body
+func NewSetChromiumEmulationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2517,7 +3985,7 @@ func NewClickMouseRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/click_mouse")
+	operationPath := fmt.Sprintf("/chromium/emulation")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2537,8 +4005,8 @@ func NewClickMouseRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewReadClipboardRequest generates requests for ReadClipboard
-func NewReadClipboardRequest(server string) (*http.Request, error) {
+// NewGetExtensionsRequest generates requests for GetExtensions
+func NewGetExtensionsRequest(server string, params *GetExtensionsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2546,7 +4014,7 @@ func NewReadClipboardRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/clipboard/read")
+	operationPath := fmt.Sprintf("/chromium/extensions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2556,7 +4024,29 @@ func NewReadClipboardRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Verify != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "verify", *params.Verify, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2564,19 +4054,19 @@ func NewReadClipboardRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewWriteClipboardRequest calls the generic WriteClipboard builder with application/json body
-func NewWriteClipboardRequest(server string, body WriteClipboardJSONRequestBody) (*http.Request, error) {
+// NewPatchChromiumFlagsRequest calls the generic PatchChromiumFlags builder with application/json body
+func NewPatchChromiumFlagsRequest(server string, body PatchChromiumFlagsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewWriteClipboardRequestWithBody(server, "application/json", bodyReader)
+	return NewPatchChromiumFlagsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewWriteClipboardRequestWithBody generates requests for WriteClipboard with any type of body
-func NewWriteClipboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchChromiumFlagsRequestWithBody generates requests for PatchChromiumFlags with any type of body
+func NewPatchChromiumFlagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2584,7 +4074,7 @@ func NewWriteClipboardRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/clipboard/write")
+	operationPath := fmt.Sprintf("/chromium/flags")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2594,7 +4084,7 @@ func NewWriteClipboardRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2604,19 +4094,19 @@ func NewWriteClipboardRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewSetCursorRequest calls the generic SetCursor builder with application/json body
-func NewSetCursorRequest(server string, body SetCursorJSONRequestBody) (*http.Request, error) {
+// NewPatchChromiumPoliciesRequest calls the generic PatchChromiumPolicies builder with application/json body
+func NewPatchChromiumPoliciesRequest(server string, body PatchChromiumPoliciesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetCursorRequestWithBody(server, "application/json", bodyReader)
+	return NewPatchChromiumPoliciesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSetCursorRequestWithBody generates requests for SetCursor with any type of body
-func NewSetCursorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchChromiumPoliciesRequestWithBody generates requests for PatchChromiumPolicies with any type of body
+func NewPatchChromiumPoliciesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2624,7 +4114,7 @@ func NewSetCursorRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/cursor")
+	operationPath := fmt.Sprintf("/chromium/policies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2634,7 +4124,7 @@ func NewSetCursorRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2644,19 +4134,8 @@ func NewSetCursorRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewDragMouseRequest calls the generic DragMouse builder with application/json body
-func NewDragMouseRequest(server string, body DragMouseJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDragMouseRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDragMouseRequestWithBody generates requests for DragMouse with any type of body
-func NewDragMouseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUploadExtensionsAndRestartRequestWithBody generates requests for UploadExtensionsAndRestart with any type of body
+func NewUploadExtensionsAndRestartRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2664,7 +4143,7 @@ func NewDragMouseRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/drag_mouse")
+	operationPath := fmt.Sprintf("/chromium/upload-extensions-and-restart")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2684,8 +4163,8 @@ func NewDragMouseRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewGetMousePositionRequest generates requests for GetMousePosition
-func NewGetMousePositionRequest(server string) (*http.Request, error) {
+// NewGetChromiumTargetsRequest generates requests for GetChromiumTargets
+func NewGetChromiumTargetsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2693,7 +4172,7 @@ func NewGetMousePositionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/get_mouse_position")
+	operationPath := fmt.Sprintf("/chromium/targets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2703,7 +4182,7 @@ func NewGetMousePositionRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2711,19 +4190,19 @@ func NewGetMousePositionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewMoveMouseRequest calls the generic MoveMouse builder with application/json body
-func NewMoveMouseRequest(server string, body MoveMouseJSONRequestBody) (*http.Request, error) {
+// NewSetChromiumViewportRequest calls the generic SetChromiumViewport builder with application/json body
+func NewSetChromiumViewportRequest(server string, body SetChromiumViewportJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewMoveMouseRequestWithBody(server, "application/json", bodyReader)
+	return NewSetChromiumViewportRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewMoveMouseRequestWithBody generates requests for MoveMouse with any type of body
-func NewMoveMouseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetChromiumViewportRequestWithBody generates requests for SetChromiumViewport with any type of body
+func NewSetChromiumViewportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2731,7 +4210,7 @@ func NewMoveMouseRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/move_mouse")
+	operationPath := fmt.Sprintf("/chromium/viewport")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2751,19 +4230,8 @@ func NewMoveMouseRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewPressKeyRequest calls the generic PressKey builder with application/json body
-func NewPressKeyRequest(server string, body PressKeyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPressKeyRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPressKeyRequestWithBody generates requests for PressKey with any type of body
-func NewPressKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWarmupChromiumRequest generates requests for WarmupChromium
+func NewWarmupChromiumRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2771,7 +4239,7 @@ func NewPressKeyRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/press_key")
+	operationPath := fmt.Sprintf("/chromium/warmup")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2781,29 +4249,27 @@ func NewPressKeyRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewTakeScreenshotRequest calls the generic TakeScreenshot builder with application/json body
-func NewTakeScreenshotRequest(server string, body TakeScreenshotJSONRequestBody) (*http.Request, error) {
+// NewBatchComputerActionRequest calls the generic BatchComputerAction builder with application/json body
+func NewBatchComputerActionRequest(server string, body BatchComputerActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewTakeScreenshotRequestWithBody(server, "application/json", bodyReader)
+	return NewBatchComputerActionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewTakeScreenshotRequestWithBody generates requests for TakeScreenshot with any type of body
-func NewTakeScreenshotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewBatchComputerActionRequestWithBody generates requests for BatchComputerAction with any type of body
+func NewBatchComputerActionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2811,7 +4277,7 @@ func NewTakeScreenshotRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/screenshot")
+	operationPath := fmt.Sprintf("/computer/batch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2831,19 +4297,19 @@ func NewTakeScreenshotRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewScrollRequest calls the generic Scroll builder with application/json body
-func NewScrollRequest(server string, body ScrollJSONRequestBody) (*http.Request, error) {
+// NewClickMouseRequest calls the generic ClickMouse builder with application/json body
+func NewClickMouseRequest(server string, body ClickMouseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewScrollRequestWithBody(server, "application/json", bodyReader)
+	return NewClickMouseRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewScrollRequestWithBody generates requests for Scroll with any type of body
-func NewScrollRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewClickMouseRequestWithBody generates requests for ClickMouse with any type of body
+func NewClickMouseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2851,7 +4317,7 @@ func NewScrollRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/scroll")
+	operationPath := fmt.Sprintf("/computer/click_mouse")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2871,19 +4337,8 @@ func NewScrollRequestWithBody(server string, contentType string, body io.Reader)
 	return req, nil
 }
 
-// NewTypeTextRequest calls the generic TypeText builder with application/json body
-func NewTypeTextRequest(server string, body TypeTextJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTypeTextRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewTypeTextRequestWithBody generates requests for TypeText with any type of body
-func NewTypeTextRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewReadClipboardRequest generates requests for ReadClipboard
+func NewReadClipboardRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2891,7 +4346,7 @@ func NewTypeTextRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/computer/type")
+	operationPath := fmt.Sprintf("/computer/clipboard/read")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2901,29 +4356,27 @@ func NewTypeTextRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPatchDisplayRequest calls the generic PatchDisplay builder with application/json body
-func NewPatchDisplayRequest(server string, body PatchDisplayJSONRequestBody) (*http.Request, error) {
+// NewWriteClipboardRequest calls the generic WriteClipboard builder with application/json body
+func NewWriteClipboardRequest(server string, body WriteClipboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchDisplayRequestWithBody(server, "application/json", bodyReader)
+	return NewWriteClipboardRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPatchDisplayRequestWithBody generates requests for PatchDisplay with any type of body
-func NewPatchDisplayRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWriteClipboardRequestWithBody generates requests for WriteClipboard with any type of body
+func NewWriteClipboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2931,7 +4384,7 @@ func NewPatchDisplayRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/display")
+	operationPath := fmt.Sprintf("/computer/clipboard/write")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2941,7 +4394,7 @@ func NewPatchDisplayRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2951,19 +4404,19 @@ func NewPatchDisplayRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewCreateDirectoryRequest calls the generic CreateDirectory builder with application/json body
-func NewCreateDirectoryRequest(server string, body CreateDirectoryJSONRequestBody) (*http.Request, error) {
+// NewSetCursorRequest calls the generic SetCursor builder with application/json body
+func NewSetCursorRequest(server string, body SetCursorJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDirectoryRequestWithBody(server, "application/json", bodyReader)
+	return NewSetCursorRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateDirectoryRequestWithBody generates requests for CreateDirectory with any type of body
-func NewCreateDirectoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetCursorRequestWithBody generates requests for SetCursor with any type of body
+func NewSetCursorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2971,7 +4424,7 @@ func NewCreateDirectoryRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/create_directory")
+	operationPath := fmt.Sprintf("/computer/cursor")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2981,7 +4434,7 @@ func NewCreateDirectoryRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2991,19 +4444,19 @@ func NewCreateDirectoryRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewDeleteDirectoryRequest calls the generic DeleteDirectory builder with application/json body
-func NewDeleteDirectoryRequest(server string, body DeleteDirectoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
+// NewDragMouseRequest calls the generic DragMouse builder with application/json body
+func NewDragMouseRequest(server string, body DragMouseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDeleteDirectoryRequestWithBody(server, "application/json", bodyReader)
+	return NewDragMouseRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDeleteDirectoryRequestWithBody generates requests for DeleteDirectory with any type of body
-func NewDeleteDirectoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDragMouseRequestWithBody generates requests for DragMouse with any type of body
+func NewDragMouseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3011,7 +4464,7 @@ func NewDeleteDirectoryRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/delete_directory")
+	operationPath := fmt.Sprintf("/computer/drag_mouse")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3021,7 +4474,7 @@ func NewDeleteDirectoryRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3031,19 +4484,8 @@ func NewDeleteDirectoryRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewDeleteFileRequest calls the generic DeleteFile builder with application/json body
-func NewDeleteFileRequest(server string, body DeleteFileJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeleteFileRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDeleteFileRequestWithBody generates requests for DeleteFile with any type of body
-func NewDeleteFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetMousePositionRequest generates requests for GetMousePosition
+func NewGetMousePositionRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3051,7 +4493,7 @@ func NewDeleteFileRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/delete_file")
+	operationPath := fmt.Sprintf("/computer/get_mouse_position")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3061,18 +4503,27 @@ func NewDeleteFileRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDownloadDirZipRequest generates requests for DownloadDirZip
-func NewDownloadDirZipRequest(server string, params *DownloadDirZipParams) (*http.Request, error) {
+// NewMoveMouseRequest calls the generic MoveMouse builder with application/json body
+func NewMoveMouseRequest(server string, body MoveMouseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveMouseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewMoveMouseRequestWithBody generates requests for MoveMouse with any type of body
+func NewMoveMouseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3080,7 +4531,7 @@ func NewDownloadDirZipRequest(server string, params *DownloadDirZipParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/download_dir_zip")
+	operationPath := fmt.Sprintf("/computer/move_mouse")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3090,34 +4541,29 @@ func NewDownloadDirZipRequest(server string, params *DownloadDirZipParams) (*htt
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	return req, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewPressKeyRequest calls the generic PressKey builder with application/json body
+func NewPressKeyRequest(server string, body PressKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPressKeyRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDownloadDirZstdRequest generates requests for DownloadDirZstd
-func NewDownloadDirZstdRequest(server string, params *DownloadDirZstdParams) (*http.Request, error) {
+// NewPressKeyRequestWithBody generates requests for PressKey with any type of body
+func NewPressKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3125,7 +4571,7 @@ func NewDownloadDirZstdRequest(server string, params *DownloadDirZstdParams) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/download_dir_zstd")
+	operationPath := fmt.Sprintf("/computer/press_key")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3135,50 +4581,29 @@ func NewDownloadDirZstdRequest(server string, params *DownloadDirZstdParams) (*h
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.CompressionLevel != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "compression_level", *params.CompressionLevel, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	return req, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewTakeScreenshotRequest calls the generic TakeScreenshot builder with application/json body
+func NewTakeScreenshotRequest(server string, body TakeScreenshotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewTakeScreenshotRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewFileInfoRequest generates requests for FileInfo
-func NewFileInfoRequest(server string, params *FileInfoParams) (*http.Request, error) {
+// NewTakeScreenshotRequestWithBody generates requests for TakeScreenshot with any type of body
+func NewTakeScreenshotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3186,7 +4611,7 @@ func NewFileInfoRequest(server string, params *FileInfoParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/file_info")
+	operationPath := fmt.Sprintf("/computer/screenshot")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3196,34 +4621,29 @@ func NewFileInfoRequest(server string, params *FileInfoParams) (*http.Request, e
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	return req, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// NewScrollRequest calls the generic Scroll builder with application/json body
+func NewScrollRequest(server string, body ScrollJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewScrollRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewListFilesRequest generates requests for ListFiles
-func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request, error) {
+// NewScrollRequestWithBody generates requests for Scroll with any type of body
+func NewScrollRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3231,7 +4651,7 @@ func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/list_files")
+	operationPath := fmt.Sprintf("/computer/scroll")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3241,45 +4661,29 @@ func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request,
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewMovePathRequest calls the generic MovePath builder with application/json body
-func NewMovePathRequest(server string, body MovePathJSONRequestBody) (*http.Request, error) {
+// NewTypeTextRequest calls the generic TypeText builder with application/json body
+func NewTypeTextRequest(server string, body TypeTextJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewMovePathRequestWithBody(server, "application/json", bodyReader)
+	return NewTypeTextRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewMovePathRequestWithBody generates requests for MovePath with any type of body
-func NewMovePathRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewTypeTextRequestWithBody generates requests for TypeText with any type of body
+func NewTypeTextRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3287,7 +4691,7 @@ func NewMovePathRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/move")
+	operationPath := fmt.Sprintf("/computer/type")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3297,7 +4701,7 @@ func NewMovePathRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3307,8 +4711,8 @@ func NewMovePathRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-// NewReadFileRequest generates requests for ReadFile
-func NewReadFileRequest(server string, params *ReadFileParams) (*http.Request, error) {
+// NewGetDisplayInfoRequest generates requests for GetDisplayInfo
+func NewGetDisplayInfoRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3316,7 +4720,7 @@ func NewReadFileRequest(server string, params *ReadFileParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/read_file")
+	operationPath := fmt.Sprintf("/display")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3326,24 +4730,6 @@ func NewReadFileRequest(server string, params *ReadFileParams) (*http.Request, e
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3352,19 +4738,19 @@ func NewReadFileRequest(server string, params *ReadFileParams) (*http.Request, e
 	return req, nil
 }
 
-// NewSetFilePermissionsRequest calls the generic SetFilePermissions builder with application/json body
-func NewSetFilePermissionsRequest(server string, body SetFilePermissionsJSONRequestBody) (*http.Request, error) {
+// NewPatchDisplayRequest calls the generic PatchDisplay builder with application/json body
+func NewPatchDisplayRequest(server string, body PatchDisplayJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetFilePermissionsRequestWithBody(server, "application/json", bodyReader)
+	return NewPatchDisplayRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSetFilePermissionsRequestWithBody generates requests for SetFilePermissions with any type of body
-func NewSetFilePermissionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchDisplayRequestWithBody generates requests for PatchDisplay with any type of body
+func NewPatchDisplayRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3372,7 +4758,7 @@ func NewSetFilePermissionsRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/set_file_permissions")
+	operationPath := fmt.Sprintf("/display")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3382,7 +4768,7 @@ func NewSetFilePermissionsRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3392,37 +4778,19 @@ func NewSetFilePermissionsRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewUploadFilesRequestWithBody generates requests for UploadFiles with any type of body
-func NewUploadFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/fs/upload")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+// NewCreateDirectoryRequest calls the generic CreateDirectory builder with application/json body
+func NewCreateDirectoryRequest(server string, body CreateDirectoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDirectoryRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUploadZipRequestWithBody generates requests for UploadZip with any type of body
-func NewUploadZipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDirectoryRequestWithBody generates requests for CreateDirectory with any type of body
+func NewCreateDirectoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3430,7 +4798,7 @@ func NewUploadZipRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/upload_zip")
+	operationPath := fmt.Sprintf("/fs/create_directory")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3440,7 +4808,7 @@ func NewUploadZipRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3450,8 +4818,19 @@ func NewUploadZipRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewUploadZstdRequestWithBody generates requests for UploadZstd with any type of body
-func NewUploadZstdRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteDirectoryRequest calls the generic DeleteDirectory builder with application/json body
+func NewDeleteDirectoryRequest(server string, body DeleteDirectoryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteDirectoryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteDirectoryRequestWithBody generates requests for DeleteDirectory with any type of body
+func NewDeleteDirectoryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3459,7 +4838,7 @@ func NewUploadZstdRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/upload_zstd")
+	operationPath := fmt.Sprintf("/fs/delete_directory")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3469,7 +4848,7 @@ func NewUploadZstdRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3479,19 +4858,19 @@ func NewUploadZstdRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewStartFsWatchRequest calls the generic StartFsWatch builder with application/json body
-func NewStartFsWatchRequest(server string, body StartFsWatchJSONRequestBody) (*http.Request, error) {
+// NewDeleteFileRequest calls the generic DeleteFile builder with application/json body
+func NewDeleteFileRequest(server string, body DeleteFileJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewStartFsWatchRequestWithBody(server, "application/json", bodyReader)
+	return NewDeleteFileRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewStartFsWatchRequestWithBody generates requests for StartFsWatch with any type of body
-func NewStartFsWatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteFileRequestWithBody generates requests for DeleteFile with any type of body
+func NewDeleteFileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3499,7 +4878,7 @@ func NewStartFsWatchRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/watch")
+	operationPath := fmt.Sprintf("/fs/delete_file")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3509,7 +4888,7 @@ func NewStartFsWatchRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3519,23 +4898,16 @@ func NewStartFsWatchRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewStopFsWatchRequest generates requests for StopFsWatch
-func NewStopFsWatchRequest(server string, watchId string) (*http.Request, error) {
+// NewDownloadDirZipRequest generates requests for DownloadDirZip
+func NewDownloadDirZipRequest(server string, params *DownloadDirZipParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "watch_id", watchId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/watch/%s", pathParam0)
+	operationPath := fmt.Sprintf("/fs/download_dir_zip")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3545,38 +4917,22 @@ func NewStopFsWatchRequest(server string, watchId string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewStreamFsEventsRequest generates requests for StreamFsEvents
-func NewStreamFsEventsRequest(server string, watchId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "watch_id", watchId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	operationPath := fmt.Sprintf("/fs/watch/%s/events", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -3587,8 +4943,8 @@ func NewStreamFsEventsRequest(server string, watchId string) (*http.Request, err
 	return req, nil
 }
 
-// NewWriteFileRequestWithBody generates requests for WriteFile with any type of body
-func NewWriteFileRequestWithBody(server string, params *WriteFileParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewDownloadDirZstdRequest generates requests for DownloadDirZstd
+func NewDownloadDirZstdRequest(server string, params *DownloadDirZstdParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3596,7 +4952,7 @@ func NewWriteFileRequestWithBody(server string, params *WriteFileParams, content
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/fs/write_file")
+	operationPath := fmt.Sprintf("/fs/download_dir_zstd")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3621,9 +4977,9 @@ func NewWriteFileRequestWithBody(server string, params *WriteFileParams, content
 			}
 		}
 
-		if params.Mode != nil {
+		if params.CompressionLevel != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "mode", *params.Mode, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "compression_level", *params.CompressionLevel, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3640,18 +4996,16 @@ func NewWriteFileRequestWithBody(server string, params *WriteFileParams, content
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewLogsStreamRequest generates requests for LogsStream
-func NewLogsStreamRequest(server string, params *LogsStreamParams) (*http.Request, error) {
+// NewFileInfoRequest generates requests for FileInfo
+func NewFileInfoRequest(server string, params *FileInfoParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3659,7 +5013,7 @@ func NewLogsStreamRequest(server string, params *LogsStreamParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/logs/stream")
+	operationPath := fmt.Sprintf("/fs/file_info")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3672,7 +5026,7 @@ func NewLogsStreamRequest(server string, params *LogsStreamParams) (*http.Reques
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "source", params.Source, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3684,52 +5038,49 @@ func NewLogsStreamRequest(server string, params *LogsStreamParams) (*http.Reques
 			}
 		}
 
-		if params.Follow != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "follow", *params.Follow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Path != nil {
+// NewListFilesRequest generates requests for ListFiles
+func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/fs/list_files")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.SupervisorProcess != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "supervisor_process", *params.SupervisorProcess, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
 				}
 			}
-
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -3743,19 +5094,19 @@ func NewLogsStreamRequest(server string, params *LogsStreamParams) (*http.Reques
 	return req, nil
 }
 
-// NewExecutePlaywrightCodeRequest calls the generic ExecutePlaywrightCode builder with application/json body
-func NewExecutePlaywrightCodeRequest(server string, body ExecutePlaywrightCodeJSONRequestBody) (*http.Request, error) {
+// NewMovePathRequest calls the generic MovePath builder with application/json body
+func NewMovePathRequest(server string, body MovePathJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewExecutePlaywrightCodeRequestWithBody(server, "application/json", bodyReader)
+	return NewMovePathRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewExecutePlaywrightCodeRequestWithBody generates requests for ExecutePlaywrightCode with any type of body
-func NewExecutePlaywrightCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewMovePathRequestWithBody generates requests for MovePath with any type of body
+func NewMovePathRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3763,7 +5114,7 @@ func NewExecutePlaywrightCodeRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/playwright/execute")
+	operationPath := fmt.Sprintf("/fs/move")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3773,7 +5124,7 @@ func NewExecutePlaywrightCodeRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3783,19 +5134,8 @@ func NewExecutePlaywrightCodeRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
-// NewProcessExecRequest calls the generic ProcessExec builder with application/json body
-func NewProcessExecRequest(server string, body ProcessExecJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewProcessExecRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewProcessExecRequestWithBody generates requests for ProcessExec with any type of body
-func NewProcessExecRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewReadFileRequest generates requests for ReadFile
+func NewReadFileRequest(server string, params *ReadFileParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3803,7 +5143,7 @@ func NewProcessExecRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/exec")
+	operationPath := fmt.Sprintf("/fs/read_file")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3813,29 +5153,45 @@ func NewProcessExecRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// NewProcessSpawnRequest calls the generic ProcessSpawn builder with application/json body
-func NewProcessSpawnRequest(server string, body ProcessSpawnJSONRequestBody) (*http.Request, error) {
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetFilePermissionsRequest calls the generic SetFilePermissions builder with application/json body
+func NewSetFilePermissionsRequest(server string, body SetFilePermissionsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewProcessSpawnRequestWithBody(server, "application/json", bodyReader)
+	return NewSetFilePermissionsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewProcessSpawnRequestWithBody generates requests for ProcessSpawn with any type of body
-func NewProcessSpawnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetFilePermissionsRequestWithBody generates requests for SetFilePermissions with any type of body
+func NewSetFilePermissionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3843,7 +5199,7 @@ func NewProcessSpawnRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/spawn")
+	operationPath := fmt.Sprintf("/fs/set_file_permissions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3853,7 +5209,7 @@ func NewProcessSpawnRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3863,34 +5219,16 @@ func NewProcessSpawnRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewProcessKillRequest calls the generic ProcessKill builder with application/json body
-func NewProcessKillRequest(server string, processId openapi_types.UUID, body ProcessKillJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewProcessKillRequestWithBody(server, processId, "application/json", bodyReader)
-}
-
-// NewProcessKillRequestWithBody generates requests for ProcessKill with any type of body
-func NewProcessKillRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUploadFilesRequestWithBody generates requests for UploadFiles with any type of body
+func NewUploadFilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/%s/kill", pathParam0)
+	operationPath := fmt.Sprintf("/fs/upload")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3910,34 +5248,16 @@ func NewProcessKillRequestWithBody(server string, processId openapi_types.UUID,
 	return req, nil
 }
 
-// NewProcessResizeRequest calls the generic ProcessResize builder with application/json body
-func NewProcessResizeRequest(server string, processId openapi_types.UUID, body ProcessResizeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewProcessResizeRequestWithBody(server, processId, "application/json", bodyReader)
-}
-
-// NewProcessResizeRequestWithBody generates requests for ProcessResize with any type of body
-func NewProcessResizeRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUploadZipRequestWithBody generates requests for UploadZip with any type of body
+func NewUploadZipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/%s/resize", pathParam0)
+	operationPath := fmt.Sprintf("/fs/upload_zip")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3957,23 +5277,16 @@ func NewProcessResizeRequestWithBody(server string, processId openapi_types.UUID
 	return req, nil
 }
 
-// NewProcessStatusRequest generates requests for ProcessStatus
-func NewProcessStatusRequest(server string, processId openapi_types.UUID) (*http.Request, error) {
+// NewUploadZstdRequestWithBody generates requests for UploadZstd with any type of body
+func NewUploadZstdRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/fs/upload_zstd")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3983,42 +5296,37 @@ func NewProcessStatusRequest(server string, processId openapi_types.UUID) (*http
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewProcessStdinRequest calls the generic ProcessStdin builder with application/json body
-func NewProcessStdinRequest(server string, processId openapi_types.UUID, body ProcessStdinJSONRequestBody) (*http.Request, error) {
+// NewStartFsWatchRequest calls the generic StartFsWatch builder with application/json body
+func NewStartFsWatchRequest(server string, body StartFsWatchJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewProcessStdinRequestWithBody(server, processId, "application/json", bodyReader)
+	return NewStartFsWatchRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewProcessStdinRequestWithBody generates requests for ProcessStdin with any type of body
-func NewProcessStdinRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+// NewStartFsWatchRequestWithBody generates requests for StartFsWatch with any type of body
+func NewStartFsWatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/%s/stdin", pathParam0)
+	operationPath := fmt.Sprintf("/fs/watch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4038,13 +5346,13 @@ func NewProcessStdinRequestWithBody(server string, processId openapi_types.UUID,
 	return req, nil
 }
 
-// NewProcessStdoutStreamRequest generates requests for ProcessStdoutStream
-func NewProcessStdoutStreamRequest(server string, processId openapi_types.UUID) (*http.Request, error) {
+// NewStopFsWatchRequest generates requests for StopFsWatch
+func NewStopFsWatchRequest(server string, watchId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "watch_id", watchId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4054,7 +5362,7 @@ func NewProcessStdoutStreamRequest(server string, processId openapi_types.UUID)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/process/%s/stdout/stream", pathParam0)
+	operationPath := fmt.Sprintf("/fs/watch/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4064,7 +5372,7 @@ func NewProcessStdoutStreamRequest(server string, processId openapi_types.UUID)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4072,27 +5380,23 @@ func NewProcessStdoutStreamRequest(server string, processId openapi_types.UUID)
 	return req, nil
 }
 
-// NewReclaimProveRequest calls the generic ReclaimProve builder with application/json body
-func NewReclaimProveRequest(server string, body ReclaimProveJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewStreamFsEventsRequest generates requests for StreamFsEvents
+func NewStreamFsEventsRequest(server string, watchId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "watch_id", watchId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewReclaimProveRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewReclaimProveRequestWithBody generates requests for ReclaimProve with any type of body
-func NewReclaimProveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/reclaim/prove")
+	operationPath := fmt.Sprintf("/fs/watch/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4102,29 +5406,16 @@ func NewReclaimProveRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteRecordingRequest calls the generic DeleteRecording builder with application/json body
-func NewDeleteRecordingRequest(server string, body DeleteRecordingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeleteRecordingRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewDeleteRecordingRequestWithBody generates requests for DeleteRecording with any type of body
-func NewDeleteRecordingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewWriteFileRequestWithBody generates requests for WriteFile with any type of body
+func NewWriteFileRequestWithBody(server string, params *WriteFileParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4132,7 +5423,7 @@ func NewDeleteRecordingRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/recording/delete")
+	operationPath := fmt.Sprintf("/fs/write_file")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4142,7 +5433,41 @@ func NewDeleteRecordingRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "mode", *params.Mode, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4152,8 +5477,8 @@ func NewDeleteRecordingRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewDownloadRecordingRequest generates requests for DownloadRecording
-func NewDownloadRecordingRequest(server string, params *DownloadRecordingParams) (*http.Request, error) {
+// NewGetLogsRequest generates requests for GetLogs
+func NewGetLogsRequest(server string, params *GetLogsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4161,7 +5486,7 @@ func NewDownloadRecordingRequest(server string, params *DownloadRecordingParams)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/recording/download")
+	operationPath := fmt.Sprintf("/logs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4174,9 +5499,25 @@ func NewDownloadRecordingRequest(server string, params *DownloadRecordingParams)
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Id != nil {
+		if params.Level != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", *params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "level", *params.Level, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Lines != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "lines", *params.Lines, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -4201,8 +5542,8 @@ func NewDownloadRecordingRequest(server string, params *DownloadRecordingParams)
 	return req, nil
 }
 
-// NewListRecordersRequest generates requests for ListRecorders
-func NewListRecordersRequest(server string) (*http.Request, error) {
+// NewLogsStreamRequest generates requests for LogsStream
+func NewLogsStreamRequest(server string, params *LogsStreamParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4210,7 +5551,7 @@ func NewListRecordersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/recording/list")
+	operationPath := fmt.Sprintf("/logs/stream")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4220,27 +5561,93 @@ func NewListRecordersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "source", params.Source, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// NewStartRecordingRequest calls the generic StartRecording builder with application/json body
-func NewStartRecordingRequest(server string, body StartRecordingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
+		if params.Follow != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "follow", *params.Follow, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Path != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "path", *params.Path, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SupervisorProcess != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "supervisor_process", *params.SupervisorProcess, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExecutePlaywrightCodeRequest calls the generic ExecutePlaywrightCode builder with application/json body
+func NewExecutePlaywrightCodeRequest(server string, body ExecutePlaywrightCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
 	bodyReader = bytes.NewReader(buf)
-	return NewStartRecordingRequestWithBody(server, "application/json", bodyReader)
+	return NewExecutePlaywrightCodeRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewStartRecordingRequestWithBody generates requests for StartRecording with any type of body
-func NewStartRecordingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewExecutePlaywrightCodeRequestWithBody generates requests for ExecutePlaywrightCode with any type of body
+func NewExecutePlaywrightCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4248,7 +5655,7 @@ func NewStartRecordingRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/recording/start")
+	operationPath := fmt.Sprintf("/playwright/execute")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4268,19 +5675,19 @@ func NewStartRecordingRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewStopRecordingRequest calls the generic StopRecording builder with application/json body
-func NewStopRecordingRequest(server string, body StopRecordingJSONRequestBody) (*http.Request, error) {
+// NewProcessExecRequest calls the generic ProcessExec builder with application/json body
+func NewProcessExecRequest(server string, body ProcessExecJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewStopRecordingRequestWithBody(server, "application/json", bodyReader)
+	return NewProcessExecRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewStopRecordingRequestWithBody generates requests for StopRecording with any type of body
-func NewStopRecordingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewProcessExecRequestWithBody generates requests for ProcessExec with any type of body
+func NewProcessExecRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4288,7 +5695,7 @@ func NewStopRecordingRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/recording/stop")
+	operationPath := fmt.Sprintf("/process/exec")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4308,7592 +5715,12673 @@ func NewStopRecordingRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewProcessSpawnRequest calls the generic ProcessSpawn builder with application/json body
+func NewProcessSpawnRequest(server string, body ProcessSpawnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessSpawnRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewProcessSpawnRequestWithBody generates requests for ProcessSpawn with any type of body
+func NewProcessSpawnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/process/spawn")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PatchChromiumFlagsWithBodyWithResponse request with any body
-	PatchChromiumFlagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PatchChromiumFlagsWithResponse(ctx context.Context, body PatchChromiumFlagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PatchChromiumPoliciesWithBodyWithResponse request with any body
-	PatchChromiumPoliciesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	PatchChromiumPoliciesWithResponse(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error)
+	return req, nil
+}
 
-	// UploadExtensionsAndRestartWithBodyWithResponse request with any body
-	UploadExtensionsAndRestartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadExtensionsAndRestartResponse, error)
+// NewProcessKillRequest calls the generic ProcessKill builder with application/json body
+func NewProcessKillRequest(server string, processId openapi_types.UUID, body ProcessKillJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessKillRequestWithBody(server, processId, "application/json", bodyReader)
+}
 
-	// BatchComputerActionWithBodyWithResponse request with any body
-	BatchComputerActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error)
+// NewProcessKillRequestWithBody generates requests for ProcessKill with any type of body
+func NewProcessKillRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	BatchComputerActionWithResponse(ctx context.Context, body BatchComputerActionJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error)
+	var pathParam0 string
 
-	// ClickMouseWithBodyWithResponse request with any body
-	ClickMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
 
-	ClickMouseWithResponse(ctx context.Context, body ClickMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ReadClipboardWithResponse request
-	ReadClipboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadClipboardResponse, error)
+	operationPath := fmt.Sprintf("/process/%s/kill", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// WriteClipboardWithBodyWithResponse request with any body
-	WriteClipboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	WriteClipboardWithResponse(ctx context.Context, body WriteClipboardJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// SetCursorWithBodyWithResponse request with any body
-	SetCursorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCursorResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	SetCursorWithResponse(ctx context.Context, body SetCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCursorResponse, error)
+	return req, nil
+}
 
-	// DragMouseWithBodyWithResponse request with any body
-	DragMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DragMouseResponse, error)
+// NewProcessResizeRequest calls the generic ProcessResize builder with application/json body
+func NewProcessResizeRequest(server string, processId openapi_types.UUID, body ProcessResizeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessResizeRequestWithBody(server, processId, "application/json", bodyReader)
+}
 
-	DragMouseWithResponse(ctx context.Context, body DragMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*DragMouseResponse, error)
+// NewProcessResizeRequestWithBody generates requests for ProcessResize with any type of body
+func NewProcessResizeRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetMousePositionWithResponse request
-	GetMousePositionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMousePositionResponse, error)
+	var pathParam0 string
 
-	// MoveMouseWithBodyWithResponse request with any body
-	MoveMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
 
-	MoveMouseWithResponse(ctx context.Context, body MoveMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PressKeyWithBodyWithResponse request with any body
-	PressKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PressKeyResponse, error)
+	operationPath := fmt.Sprintf("/process/%s/resize", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PressKeyWithResponse(ctx context.Context, body PressKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PressKeyResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// TakeScreenshotWithBodyWithResponse request with any body
-	TakeScreenshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	TakeScreenshotWithResponse(ctx context.Context, body TakeScreenshotJSONRequestBody, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ScrollWithBodyWithResponse request with any body
-	ScrollWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScrollResponse, error)
+	return req, nil
+}
 
-	ScrollWithResponse(ctx context.Context, body ScrollJSONRequestBody, reqEditors ...RequestEditorFn) (*ScrollResponse, error)
-
-	// TypeTextWithBodyWithResponse request with any body
-	TypeTextWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TypeTextResponse, error)
+// NewProcessSignalRequest calls the generic ProcessSignal builder with application/json body
+func NewProcessSignalRequest(server string, processId openapi_types.UUID, body ProcessSignalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessSignalRequestWithBody(server, processId, "application/json", bodyReader)
+}
 
-	TypeTextWithResponse(ctx context.Context, body TypeTextJSONRequestBody, reqEditors ...RequestEditorFn) (*TypeTextResponse, error)
+// NewProcessSignalRequestWithBody generates requests for ProcessSignal with any type of body
+func NewProcessSignalRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// PatchDisplayWithBodyWithResponse request with any body
-	PatchDisplayWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error)
+	var pathParam0 string
 
-	PatchDisplayWithResponse(ctx context.Context, body PatchDisplayJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDirectoryWithBodyWithResponse request with any body
-	CreateDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDirectoryWithResponse(ctx context.Context, body CreateDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error)
+	operationPath := fmt.Sprintf("/process/%s/signal", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteDirectoryWithBodyWithResponse request with any body
-	DeleteDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	DeleteDirectoryWithResponse(ctx context.Context, body DeleteDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteFileWithBodyWithResponse request with any body
-	DeleteFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	DeleteFileWithResponse(ctx context.Context, body DeleteFileJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error)
+	return req, nil
+}
 
-	// DownloadDirZipWithResponse request
-	DownloadDirZipWithResponse(ctx context.Context, params *DownloadDirZipParams, reqEditors ...RequestEditorFn) (*DownloadDirZipResponse, error)
+// NewProcessStatusRequest generates requests for ProcessStatus
+func NewProcessStatusRequest(server string, processId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// DownloadDirZstdWithResponse request
-	DownloadDirZstdWithResponse(ctx context.Context, params *DownloadDirZstdParams, reqEditors ...RequestEditorFn) (*DownloadDirZstdResponse, error)
+	var pathParam0 string
 
-	// FileInfoWithResponse request
-	FileInfoWithResponse(ctx context.Context, params *FileInfoParams, reqEditors ...RequestEditorFn) (*FileInfoResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
 
-	// ListFilesWithResponse request
-	ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// MovePathWithBodyWithResponse request with any body
-	MovePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MovePathResponse, error)
+	operationPath := fmt.Sprintf("/process/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	MovePathWithResponse(ctx context.Context, body MovePathJSONRequestBody, reqEditors ...RequestEditorFn) (*MovePathResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ReadFileWithResponse request
-	ReadFileWithResponse(ctx context.Context, params *ReadFileParams, reqEditors ...RequestEditorFn) (*ReadFileResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// SetFilePermissionsWithBodyWithResponse request with any body
-	SetFilePermissionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error)
+	return req, nil
+}
 
-	SetFilePermissionsWithResponse(ctx context.Context, body SetFilePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error)
+// NewProcessStdinRequest calls the generic ProcessStdin builder with application/json body
+func NewProcessStdinRequest(server string, processId openapi_types.UUID, body ProcessStdinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProcessStdinRequestWithBody(server, processId, "application/json", bodyReader)
+}
 
-	// UploadFilesWithBodyWithResponse request with any body
-	UploadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFilesResponse, error)
+// NewProcessStdinRequestWithBody generates requests for ProcessStdin with any type of body
+func NewProcessStdinRequestWithBody(server string, processId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// UploadZipWithBodyWithResponse request with any body
-	UploadZipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZipResponse, error)
+	var pathParam0 string
 
-	// UploadZstdWithBodyWithResponse request with any body
-	UploadZstdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZstdResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
 
-	// StartFsWatchWithBodyWithResponse request with any body
-	StartFsWatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	StartFsWatchWithResponse(ctx context.Context, body StartFsWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error)
+	operationPath := fmt.Sprintf("/process/%s/stdin", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// StopFsWatchWithResponse request
-	StopFsWatchWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StopFsWatchResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// StreamFsEventsWithResponse request
-	StreamFsEventsWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StreamFsEventsResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// WriteFileWithBodyWithResponse request with any body
-	WriteFileWithBodyWithResponse(ctx context.Context, params *WriteFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteFileResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// LogsStreamWithResponse request
-	LogsStreamWithResponse(ctx context.Context, params *LogsStreamParams, reqEditors ...RequestEditorFn) (*LogsStreamResponse, error)
+	return req, nil
+}
 
-	// ExecutePlaywrightCodeWithBodyWithResponse request with any body
-	ExecutePlaywrightCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error)
+// NewProcessStdoutStreamRequest generates requests for ProcessStdoutStream
+func NewProcessStdoutStreamRequest(server string, processId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	ExecutePlaywrightCodeWithResponse(ctx context.Context, body ExecutePlaywrightCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error)
+	var pathParam0 string
 
-	// ProcessExecWithBodyWithResponse request with any body
-	ProcessExecWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "process_id", processId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
 
-	ProcessExecWithResponse(ctx context.Context, body ProcessExecJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ProcessSpawnWithBodyWithResponse request with any body
-	ProcessSpawnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error)
+	operationPath := fmt.Sprintf("/process/%s/stdout/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	ProcessSpawnWithResponse(ctx context.Context, body ProcessSpawnJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ProcessKillWithBodyWithResponse request with any body
-	ProcessKillWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ProcessKillWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessKillJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error)
+	return req, nil
+}
 
-	// ProcessResizeWithBodyWithResponse request with any body
-	ProcessResizeWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error)
+// NewGetConfigRequest generates requests for GetConfig
+func NewGetConfigRequest(server string) (*http.Request, error) {
+	var err error
 
-	ProcessResizeWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ProcessStatusWithResponse request
-	ProcessStatusWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStatusResponse, error)
+	operationPath := fmt.Sprintf("/config")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ProcessStdinWithBodyWithResponse request with any body
-	ProcessStdinWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	ProcessStdinWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessStdinJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ProcessStdoutStreamWithResponse request
-	ProcessStdoutStreamWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStdoutStreamResponse, error)
+	return req, nil
+}
 
-	// ReclaimProveWithBodyWithResponse request with any body
-	ReclaimProveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error)
+// NewGetCircuitStatusRequest generates requests for GetCircuitStatus
+func NewGetCircuitStatusRequest(server string) (*http.Request, error) {
+	var err error
 
-	ReclaimProveWithResponse(ctx context.Context, body ReclaimProveJSONRequestBody, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteRecordingWithBodyWithResponse request with any body
-	DeleteRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error)
+	operationPath := fmt.Sprintf("/reclaim/circuits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	DeleteRecordingWithResponse(ctx context.Context, body DeleteRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DownloadRecordingWithResponse request
-	DownloadRecordingWithResponse(ctx context.Context, params *DownloadRecordingParams, reqEditors ...RequestEditorFn) (*DownloadRecordingResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListRecordersWithResponse request
-	ListRecordersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRecordersResponse, error)
+	return req, nil
+}
 
-	// StartRecordingWithBodyWithResponse request with any body
-	StartRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error)
+// NewReclaimProveRequest calls the generic ReclaimProve builder with application/json body
+func NewReclaimProveRequest(server string, body ReclaimProveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReclaimProveRequestWithBody(server, "application/json", bodyReader)
+}
 
-	StartRecordingWithResponse(ctx context.Context, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error)
+// NewReclaimProveRequestWithBody generates requests for ReclaimProve with any type of body
+func NewReclaimProveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// StopRecordingWithBodyWithResponse request with any body
-	StopRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	StopRecordingWithResponse(ctx context.Context, body StopRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error)
-}
-
-type PatchChromiumFlagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/reclaim/prove")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PatchChromiumFlagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchChromiumFlagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PatchChromiumPoliciesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r PatchChromiumPoliciesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchChromiumPoliciesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetProofStatsRequest generates requests for GetProofStats
+func NewGetProofStatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UploadExtensionsAndRestartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/reclaim/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UploadExtensionsAndRestartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadExtensionsAndRestartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type BatchComputerActionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r BatchComputerActionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCleanupRequest generates requests for Cleanup
+func NewCleanupRequest(server string, params *CleanupParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r BatchComputerActionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/recording/cleanup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type ClickMouseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ClickMouseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OlderThanSeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "olderThanSeconds", *params.OlderThanSeconds, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "dryRun", *params.DryRun, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ClickMouseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ReadClipboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClipboardContent
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ReadClipboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteRecordingRequest calls the generic DeleteRecording builder with application/json body
+func NewDeleteRecordingRequest(server string, body DeleteRecordingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteRecordingRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReadClipboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewDeleteRecordingRequestWithBody generates requests for DeleteRecording with any type of body
+func NewDeleteRecordingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type WriteClipboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/recording/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r WriteClipboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WriteClipboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SetCursorResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OkResponse
-	JSON400      *BadRequestError
-	JSON500      *InternalError
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SetCursorResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDownloadRecordingRequest generates requests for DownloadRecording
+func NewDownloadRecordingRequest(server string, params *DownloadRecordingParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetCursorResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/recording/download")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type DragMouseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
-
-// Status returns HTTPResponse.Status
-func (r DragMouseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r DragMouseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMousePositionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MousePositionResponse
-	JSON500      *InternalError
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetMousePositionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Id != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMousePositionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "id", *params.Id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type MoveMouseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r MoveMouseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Snapshot != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MoveMouseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "snapshot", *params.Snapshot, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type PressKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r PressKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PressKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type TakeScreenshotResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	if params != nil {
 
-// Status returns HTTPResponse.Status
-func (r TakeScreenshotResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TakeScreenshotResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "If-None-Match", *params.IfNoneMatch, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
 
-type ScrollResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+			req.Header.Set("If-None-Match", headerParam0)
+		}
 
-// Status returns HTTPResponse.Status
-func (r ScrollResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.IfModifiedSince != nil {
+			var headerParam1 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ScrollResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			headerParam1, err = runtime.StyleParamWithOptions("simple", false, "If-Modified-Since", *params.IfModifiedSince, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
 
-type TypeTextResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+			req.Header.Set("If-Modified-Since", headerParam1)
+		}
 
-// Status returns HTTPResponse.Status
-func (r TypeTextResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Range != nil {
+			var headerParam2 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TypeTextResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			headerParam2, err = runtime.StyleParamWithOptions("simple", false, "Range", *params.Range, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
 
-type PatchDisplayResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DisplayConfig
-	JSON400      *BadRequestError
-	JSON409      *ConflictError
-	JSON500      *InternalError
-}
+			req.Header.Set("Range", headerParam2)
+		}
 
-// Status returns HTTPResponse.Status
-func (r PatchDisplayResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.IfRange != nil {
+			var headerParam3 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchDisplayResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			headerParam3, err = runtime.StyleParamWithOptions("simple", false, "If-Range", *params.IfRange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
 
-type CreateDirectoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+			req.Header.Set("If-Range", headerParam3)
+		}
 
-// Status returns HTTPResponse.Status
-func (r CreateDirectoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDirectoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type DeleteDirectoryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+// NewListRecordersRequest generates requests for ListRecorders
+func NewListRecordersRequest(server string, params *ListRecordersParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteDirectoryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDirectoryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/recording/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type DeleteFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
-
-// Status returns HTTPResponse.Status
-func (r DeleteFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type DownloadDirZipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+		if params.Tenant != nil {
 
-// Status returns HTTPResponse.Status
-func (r DownloadDirZipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tenant", *params.Tenant, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "tag", *params.Tag, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DownloadDirZipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DownloadDirZstdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DownloadDirZstdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetRecordingQueueRequest generates requests for GetRecordingQueue
+func NewGetRecordingQueueRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DownloadDirZstdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/recording/queue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type FileInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FileInfo
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r FileInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FileInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewStartRecordingRequest calls the generic StartRecording builder with application/json body
+func NewStartRecordingRequest(server string, params *StartRecordingParams, body StartRecordingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewStartRecordingRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-type ListFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ListFiles
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+// NewStartRecordingRequestWithBody generates requests for StartRecording with any type of body
+func NewStartRecordingRequestWithBody(server string, params *StartRecordingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/recording/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type MovePathResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r MovePathResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "dryRun", *params.DryRun, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "wait", *params.Wait, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MovePathResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ReadFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithOptions("simple", false, "Idempotency-Key", *params.IdempotencyKey, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationHeader, Type: "string", Format: ""})
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
 
-// Status returns HTTPResponse.Status
-func (r ReadFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReadFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewStopRecordingRequest calls the generic StopRecording builder with application/json body
+func NewStopRecordingRequest(server string, body StopRecordingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewStopRecordingRequestWithBody(server, "application/json", bodyReader)
 }
 
-type SetFilePermissionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+// NewStopRecordingRequestWithBody generates requests for StopRecording with any type of body
+func NewStopRecordingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r SetFilePermissionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetFilePermissionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/recording/stop")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type UploadFilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
 
-// Status returns HTTPResponse.Status
-func (r UploadFilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadFilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UploadZipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r UploadZipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadZipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewConvertRecordingRequest generates requests for ConvertRecording
+func NewConvertRecordingRequest(server string, id string, params *ConvertRecordingParams) (*http.Request, error) {
+	var err error
 
-type UploadZstdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r UploadZstdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadZstdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StartFsWatchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		// WatchId Unique identifier for the directory watch
-		WatchId *string `json:"watch_id,omitempty"`
+	operationPath := fmt.Sprintf("/recordings/%s/gif", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON400 *BadRequestError
-	JSON404 *NotFoundError
-	JSON500 *InternalError
-}
 
-// Status returns HTTPResponse.Status
-func (r StartFsWatchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartFsWatchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type StopFsWatchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+		if params.Fps != nil {
 
-// Status returns HTTPResponse.Status
-func (r StopFsWatchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "fps", *params.Fps, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StopFsWatchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-type StreamFsEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+		if params.Width != nil {
 
-// Status returns HTTPResponse.Status
-func (r StreamFsEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "width", *params.Width, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StreamFsEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type WriteFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r WriteFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetRecordingLocationRequest generates requests for GetRecordingLocation
+func NewGetRecordingLocationRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WriteFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type LogsStreamResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/recordings/%s/location", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r LogsStreamResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LogsStreamResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type ExecutePlaywrightCodeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ExecutePlaywrightResult
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+// NewGetRecordingManifestRequest generates requests for GetRecordingManifest
+func NewGetRecordingManifestRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ExecutePlaywrightCodeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ExecutePlaywrightCodeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessExecResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProcessExecResult
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/recordings/%s/manifest", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ProcessExecResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessExecResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessSpawnResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProcessSpawnResult
-	JSON400      *BadRequestError
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ProcessSpawnResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewStreamRecordingProgressRequest generates requests for StreamRecordingProgress
+func NewStreamRecordingProgressRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessSpawnResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessKillResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OkResponse
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/recordings/%s/progress", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ProcessKillResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessKillResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessResizeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OkResponse
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ProcessResizeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetRecordingStatusRequest generates requests for GetRecordingStatus
+func NewGetRecordingStatusRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessResizeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProcessStatus
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/recordings/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ProcessStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ProcessStdinResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProcessStdinResult
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ProcessStdinResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetScaleToZeroStatusRequest generates requests for GetScaleToZeroStatus
+func NewGetScaleToZeroStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessStdinResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/scale-to-zero")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type ProcessStdoutStreamResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ProcessStdoutStreamResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProcessStdoutStreamResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewResumeScaleToZeroRequest generates requests for ResumeScaleToZero
+func NewResumeScaleToZeroRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ReclaimProveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ReclaimProveResult
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+	operationPath := fmt.Sprintf("/scale-to-zero/pause")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ReclaimProveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReclaimProveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type DeleteRecordingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON409      *ConflictError
-	JSON500      *InternalError
-}
+// NewPauseScaleToZeroRequest generates requests for PauseScaleToZero
+func NewPauseScaleToZeroRequest(server string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteRecordingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteRecordingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/scale-to-zero/pause")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type DownloadRecordingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON404      *NotFoundError
-	JSON500      *InternalError
-}
 
-// Status returns HTTPResponse.Status
-func (r DownloadRecordingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DownloadRecordingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListRecordersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RecorderInfo
-	JSON500      *InternalError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListRecordersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRecordersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
 	}
-	return 0
+	return nil
 }
 
-type StartRecordingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON409      *ConflictError
-	JSON500      *InternalError
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
 }
 
-// Status returns HTTPResponse.Status
-func (r StartRecordingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return &ClientWithResponses{client}, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartRecordingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
 	}
-	return 0
 }
 
-type StopRecordingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequestError
-	JSON500      *InternalError
-}
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// SendCDPCommandWithBodyWithResponse request with any body
+	SendCDPCommandWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendCDPCommandResponse, error)
 
-// Status returns HTTPResponse.Status
-func (r StopRecordingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	SendCDPCommandWithResponse(ctx context.Context, body SendCDPCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*SendCDPCommandResponse, error)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StopRecordingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	// GetChromiumCookiesWithResponse request
+	GetChromiumCookiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChromiumCookiesResponse, error)
 
-// PatchChromiumFlagsWithBodyWithResponse request with arbitrary body returning *PatchChromiumFlagsResponse
-func (c *ClientWithResponses) PatchChromiumFlagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error) {
-	rsp, err := c.PatchChromiumFlagsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchChromiumFlagsResponse(rsp)
-}
+	// SetChromiumCookiesWithBodyWithResponse request with any body
+	SetChromiumCookiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChromiumCookiesResponse, error)
 
-func (c *ClientWithResponses) PatchChromiumFlagsWithResponse(ctx context.Context, body PatchChromiumFlagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error) {
-	rsp, err := c.PatchChromiumFlags(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchChromiumFlagsResponse(rsp)
-}
+	SetChromiumCookiesWithResponse(ctx context.Context, body SetChromiumCookiesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChromiumCookiesResponse, error)
 
-// PatchChromiumPoliciesWithBodyWithResponse request with arbitrary body returning *PatchChromiumPoliciesResponse
-func (c *ClientWithResponses) PatchChromiumPoliciesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error) {
-	rsp, err := c.PatchChromiumPoliciesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchChromiumPoliciesResponse(rsp)
-}
+	// SetChromiumEmulationWithBodyWithResponse request with any body
+	SetChromiumEmulationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChromiumEmulationResponse, error)
 
-func (c *ClientWithResponses) PatchChromiumPoliciesWithResponse(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error) {
-	rsp, err := c.PatchChromiumPolicies(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchChromiumPoliciesResponse(rsp)
-}
+	SetChromiumEmulationWithResponse(ctx context.Context, body SetChromiumEmulationJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChromiumEmulationResponse, error)
 
-// UploadExtensionsAndRestartWithBodyWithResponse request with arbitrary body returning *UploadExtensionsAndRestartResponse
-func (c *ClientWithResponses) UploadExtensionsAndRestartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadExtensionsAndRestartResponse, error) {
-	rsp, err := c.UploadExtensionsAndRestartWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUploadExtensionsAndRestartResponse(rsp)
-}
+	// GetExtensionsWithResponse request
+	GetExtensionsWithResponse(ctx context.Context, params *GetExtensionsParams, reqEditors ...RequestEditorFn) (*GetExtensionsResponse, error)
 
-// BatchComputerActionWithBodyWithResponse request with arbitrary body returning *BatchComputerActionResponse
-func (c *ClientWithResponses) BatchComputerActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error) {
-	rsp, err := c.BatchComputerActionWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseBatchComputerActionResponse(rsp)
-}
+	// PatchChromiumFlagsWithBodyWithResponse request with any body
+	PatchChromiumFlagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error)
 
-func (c *ClientWithResponses) BatchComputerActionWithResponse(ctx context.Context, body BatchComputerActionJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error) {
-	rsp, err := c.BatchComputerAction(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseBatchComputerActionResponse(rsp)
-}
+	PatchChromiumFlagsWithResponse(ctx context.Context, body PatchChromiumFlagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error)
 
-// ClickMouseWithBodyWithResponse request with arbitrary body returning *ClickMouseResponse
-func (c *ClientWithResponses) ClickMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error) {
-	rsp, err := c.ClickMouseWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseClickMouseResponse(rsp)
-}
+	// PatchChromiumPoliciesWithBodyWithResponse request with any body
+	PatchChromiumPoliciesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error)
 
-func (c *ClientWithResponses) ClickMouseWithResponse(ctx context.Context, body ClickMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error) {
-	rsp, err := c.ClickMouse(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseClickMouseResponse(rsp)
-}
+	PatchChromiumPoliciesWithResponse(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error)
 
-// ReadClipboardWithResponse request returning *ReadClipboardResponse
-func (c *ClientWithResponses) ReadClipboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadClipboardResponse, error) {
-	rsp, err := c.ReadClipboard(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReadClipboardResponse(rsp)
-}
+	// GetChromiumTargetsWithResponse request
+	GetChromiumTargetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChromiumTargetsResponse, error)
 
-// WriteClipboardWithBodyWithResponse request with arbitrary body returning *WriteClipboardResponse
-func (c *ClientWithResponses) WriteClipboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error) {
-	rsp, err := c.WriteClipboardWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWriteClipboardResponse(rsp)
-}
+	// UploadExtensionsAndRestartWithBodyWithResponse request with any body
+	UploadExtensionsAndRestartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadExtensionsAndRestartResponse, error)
 
-func (c *ClientWithResponses) WriteClipboardWithResponse(ctx context.Context, body WriteClipboardJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error) {
-	rsp, err := c.WriteClipboard(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWriteClipboardResponse(rsp)
-}
+	// SetChromiumViewportWithBodyWithResponse request with any body
+	SetChromiumViewportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChromiumViewportResponse, error)
 
-// SetCursorWithBodyWithResponse request with arbitrary body returning *SetCursorResponse
-func (c *ClientWithResponses) SetCursorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCursorResponse, error) {
-	rsp, err := c.SetCursorWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSetCursorResponse(rsp)
-}
+	SetChromiumViewportWithResponse(ctx context.Context, body SetChromiumViewportJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChromiumViewportResponse, error)
 
-func (c *ClientWithResponses) SetCursorWithResponse(ctx context.Context, body SetCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCursorResponse, error) {
-	rsp, err := c.SetCursor(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSetCursorResponse(rsp)
-}
+	// WarmupChromiumWithResponse request
+	WarmupChromiumWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarmupChromiumResponse, error)
 
-// DragMouseWithBodyWithResponse request with arbitrary body returning *DragMouseResponse
-func (c *ClientWithResponses) DragMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DragMouseResponse, error) {
-	rsp, err := c.DragMouseWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDragMouseResponse(rsp)
-}
+	// BatchComputerActionWithBodyWithResponse request with any body
+	BatchComputerActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error)
 
-func (c *ClientWithResponses) DragMouseWithResponse(ctx context.Context, body DragMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*DragMouseResponse, error) {
-	rsp, err := c.DragMouse(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDragMouseResponse(rsp)
-}
+	BatchComputerActionWithResponse(ctx context.Context, body BatchComputerActionJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error)
 
-// GetMousePositionWithResponse request returning *GetMousePositionResponse
-func (c *ClientWithResponses) GetMousePositionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMousePositionResponse, error) {
-	rsp, err := c.GetMousePosition(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetMousePositionResponse(rsp)
-}
+	// ClickMouseWithBodyWithResponse request with any body
+	ClickMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error)
 
-// MoveMouseWithBodyWithResponse request with arbitrary body returning *MoveMouseResponse
-func (c *ClientWithResponses) MoveMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error) {
-	rsp, err := c.MoveMouseWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMoveMouseResponse(rsp)
-}
+	ClickMouseWithResponse(ctx context.Context, body ClickMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error)
 
-func (c *ClientWithResponses) MoveMouseWithResponse(ctx context.Context, body MoveMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error) {
-	rsp, err := c.MoveMouse(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMoveMouseResponse(rsp)
-}
+	// ReadClipboardWithResponse request
+	ReadClipboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadClipboardResponse, error)
 
-// PressKeyWithBodyWithResponse request with arbitrary body returning *PressKeyResponse
-func (c *ClientWithResponses) PressKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PressKeyResponse, error) {
-	rsp, err := c.PressKeyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePressKeyResponse(rsp)
-}
+	// WriteClipboardWithBodyWithResponse request with any body
+	WriteClipboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error)
 
-func (c *ClientWithResponses) PressKeyWithResponse(ctx context.Context, body PressKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PressKeyResponse, error) {
-	rsp, err := c.PressKey(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePressKeyResponse(rsp)
-}
+	WriteClipboardWithResponse(ctx context.Context, body WriteClipboardJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error)
 
-// TakeScreenshotWithBodyWithResponse request with arbitrary body returning *TakeScreenshotResponse
-func (c *ClientWithResponses) TakeScreenshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error) {
-	rsp, err := c.TakeScreenshotWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTakeScreenshotResponse(rsp)
-}
+	// SetCursorWithBodyWithResponse request with any body
+	SetCursorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCursorResponse, error)
 
-func (c *ClientWithResponses) TakeScreenshotWithResponse(ctx context.Context, body TakeScreenshotJSONRequestBody, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error) {
-	rsp, err := c.TakeScreenshot(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTakeScreenshotResponse(rsp)
-}
+	SetCursorWithResponse(ctx context.Context, body SetCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCursorResponse, error)
 
-// ScrollWithBodyWithResponse request with arbitrary body returning *ScrollResponse
-func (c *ClientWithResponses) ScrollWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScrollResponse, error) {
-	rsp, err := c.ScrollWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseScrollResponse(rsp)
-}
+	// DragMouseWithBodyWithResponse request with any body
+	DragMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DragMouseResponse, error)
 
-func (c *ClientWithResponses) ScrollWithResponse(ctx context.Context, body ScrollJSONRequestBody, reqEditors ...RequestEditorFn) (*ScrollResponse, error) {
-	rsp, err := c.Scroll(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseScrollResponse(rsp)
-}
+	DragMouseWithResponse(ctx context.Context, body DragMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*DragMouseResponse, error)
 
-// TypeTextWithBodyWithResponse request with arbitrary body returning *TypeTextResponse
-func (c *ClientWithResponses) TypeTextWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TypeTextResponse, error) {
-	rsp, err := c.TypeTextWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTypeTextResponse(rsp)
-}
+	// GetMousePositionWithResponse request
+	GetMousePositionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMousePositionResponse, error)
 
-func (c *ClientWithResponses) TypeTextWithResponse(ctx context.Context, body TypeTextJSONRequestBody, reqEditors ...RequestEditorFn) (*TypeTextResponse, error) {
-	rsp, err := c.TypeText(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTypeTextResponse(rsp)
-}
+	// MoveMouseWithBodyWithResponse request with any body
+	MoveMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error)
 
-// PatchDisplayWithBodyWithResponse request with arbitrary body returning *PatchDisplayResponse
-func (c *ClientWithResponses) PatchDisplayWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error) {
-	rsp, err := c.PatchDisplayWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchDisplayResponse(rsp)
-}
+	MoveMouseWithResponse(ctx context.Context, body MoveMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error)
 
-func (c *ClientWithResponses) PatchDisplayWithResponse(ctx context.Context, body PatchDisplayJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error) {
-	rsp, err := c.PatchDisplay(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePatchDisplayResponse(rsp)
-}
+	// PressKeyWithBodyWithResponse request with any body
+	PressKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PressKeyResponse, error)
 
-// CreateDirectoryWithBodyWithResponse request with arbitrary body returning *CreateDirectoryResponse
-func (c *ClientWithResponses) CreateDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error) {
-	rsp, err := c.CreateDirectoryWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDirectoryResponse(rsp)
-}
+	PressKeyWithResponse(ctx context.Context, body PressKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PressKeyResponse, error)
 
-func (c *ClientWithResponses) CreateDirectoryWithResponse(ctx context.Context, body CreateDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error) {
-	rsp, err := c.CreateDirectory(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateDirectoryResponse(rsp)
-}
+	// TakeScreenshotWithBodyWithResponse request with any body
+	TakeScreenshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error)
 
-// DeleteDirectoryWithBodyWithResponse request with arbitrary body returning *DeleteDirectoryResponse
-func (c *ClientWithResponses) DeleteDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error) {
-	rsp, err := c.DeleteDirectoryWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteDirectoryResponse(rsp)
-}
+	TakeScreenshotWithResponse(ctx context.Context, body TakeScreenshotJSONRequestBody, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error)
 
-func (c *ClientWithResponses) DeleteDirectoryWithResponse(ctx context.Context, body DeleteDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error) {
-	rsp, err := c.DeleteDirectory(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteDirectoryResponse(rsp)
-}
+	// ScrollWithBodyWithResponse request with any body
+	ScrollWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScrollResponse, error)
 
-// DeleteFileWithBodyWithResponse request with arbitrary body returning *DeleteFileResponse
-func (c *ClientWithResponses) DeleteFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error) {
-	rsp, err := c.DeleteFileWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteFileResponse(rsp)
-}
+	ScrollWithResponse(ctx context.Context, body ScrollJSONRequestBody, reqEditors ...RequestEditorFn) (*ScrollResponse, error)
 
-func (c *ClientWithResponses) DeleteFileWithResponse(ctx context.Context, body DeleteFileJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error) {
-	rsp, err := c.DeleteFile(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteFileResponse(rsp)
-}
+	// TypeTextWithBodyWithResponse request with any body
+	TypeTextWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TypeTextResponse, error)
 
-// DownloadDirZipWithResponse request returning *DownloadDirZipResponse
-func (c *ClientWithResponses) DownloadDirZipWithResponse(ctx context.Context, params *DownloadDirZipParams, reqEditors ...RequestEditorFn) (*DownloadDirZipResponse, error) {
-	rsp, err := c.DownloadDirZip(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDownloadDirZipResponse(rsp)
-}
+	TypeTextWithResponse(ctx context.Context, body TypeTextJSONRequestBody, reqEditors ...RequestEditorFn) (*TypeTextResponse, error)
 
-// DownloadDirZstdWithResponse request returning *DownloadDirZstdResponse
-func (c *ClientWithResponses) DownloadDirZstdWithResponse(ctx context.Context, params *DownloadDirZstdParams, reqEditors ...RequestEditorFn) (*DownloadDirZstdResponse, error) {
-	rsp, err := c.DownloadDirZstd(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDownloadDirZstdResponse(rsp)
-}
+	// GetConfigWithResponse request
+	GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResponse, error)
 
-// FileInfoWithResponse request returning *FileInfoResponse
-func (c *ClientWithResponses) FileInfoWithResponse(ctx context.Context, params *FileInfoParams, reqEditors ...RequestEditorFn) (*FileInfoResponse, error) {
-	rsp, err := c.FileInfo(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFileInfoResponse(rsp)
-}
+	// GetDisplayInfoWithResponse request
+	GetDisplayInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDisplayInfoResponse, error)
 
-// ListFilesWithResponse request returning *ListFilesResponse
-func (c *ClientWithResponses) ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error) {
-	rsp, err := c.ListFiles(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListFilesResponse(rsp)
-}
+	// PatchDisplayWithBodyWithResponse request with any body
+	PatchDisplayWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error)
 
-// MovePathWithBodyWithResponse request with arbitrary body returning *MovePathResponse
-func (c *ClientWithResponses) MovePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MovePathResponse, error) {
-	rsp, err := c.MovePathWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMovePathResponse(rsp)
-}
+	PatchDisplayWithResponse(ctx context.Context, body PatchDisplayJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error)
 
-func (c *ClientWithResponses) MovePathWithResponse(ctx context.Context, body MovePathJSONRequestBody, reqEditors ...RequestEditorFn) (*MovePathResponse, error) {
-	rsp, err := c.MovePath(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseMovePathResponse(rsp)
-}
+	// CreateDirectoryWithBodyWithResponse request with any body
+	CreateDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error)
 
-// ReadFileWithResponse request returning *ReadFileResponse
-func (c *ClientWithResponses) ReadFileWithResponse(ctx context.Context, params *ReadFileParams, reqEditors ...RequestEditorFn) (*ReadFileResponse, error) {
-	rsp, err := c.ReadFile(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseReadFileResponse(rsp)
-}
+	CreateDirectoryWithResponse(ctx context.Context, body CreateDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error)
 
-// SetFilePermissionsWithBodyWithResponse request with arbitrary body returning *SetFilePermissionsResponse
-func (c *ClientWithResponses) SetFilePermissionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error) {
-	rsp, err := c.SetFilePermissionsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSetFilePermissionsResponse(rsp)
-}
+	// DeleteDirectoryWithBodyWithResponse request with any body
+	DeleteDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error)
 
-func (c *ClientWithResponses) SetFilePermissionsWithResponse(ctx context.Context, body SetFilePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error) {
-	rsp, err := c.SetFilePermissions(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSetFilePermissionsResponse(rsp)
-}
+	DeleteDirectoryWithResponse(ctx context.Context, body DeleteDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error)
 
-// UploadFilesWithBodyWithResponse request with arbitrary body returning *UploadFilesResponse
-func (c *ClientWithResponses) UploadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFilesResponse, error) {
-	rsp, err := c.UploadFilesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUploadFilesResponse(rsp)
+	// DeleteFileWithBodyWithResponse request with any body
+	DeleteFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error)
+
+	DeleteFileWithResponse(ctx context.Context, body DeleteFileJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error)
+
+	// DownloadDirZipWithResponse request
+	DownloadDirZipWithResponse(ctx context.Context, params *DownloadDirZipParams, reqEditors ...RequestEditorFn) (*DownloadDirZipResponse, error)
+
+	// DownloadDirZstdWithResponse request
+	DownloadDirZstdWithResponse(ctx context.Context, params *DownloadDirZstdParams, reqEditors ...RequestEditorFn) (*DownloadDirZstdResponse, error)
+
+	// FileInfoWithResponse request
+	FileInfoWithResponse(ctx context.Context, params *FileInfoParams, reqEditors ...RequestEditorFn) (*FileInfoResponse, error)
+
+	// ListFilesWithResponse request
+	ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error)
+
+	// MovePathWithBodyWithResponse request with any body
+	MovePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MovePathResponse, error)
+
+	MovePathWithResponse(ctx context.Context, body MovePathJSONRequestBody, reqEditors ...RequestEditorFn) (*MovePathResponse, error)
+
+	// ReadFileWithResponse request
+	ReadFileWithResponse(ctx context.Context, params *ReadFileParams, reqEditors ...RequestEditorFn) (*ReadFileResponse, error)
+
+	// SetFilePermissionsWithBodyWithResponse request with any body
+	SetFilePermissionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error)
+
+	SetFilePermissionsWithResponse(ctx context.Context, body SetFilePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error)
+
+	// UploadFilesWithBodyWithResponse request with any body
+	UploadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFilesResponse, error)
+
+	// UploadZipWithBodyWithResponse request with any body
+	UploadZipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZipResponse, error)
+
+	// UploadZstdWithBodyWithResponse request with any body
+	UploadZstdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZstdResponse, error)
+
+	// StartFsWatchWithBodyWithResponse request with any body
+	StartFsWatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error)
+
+	StartFsWatchWithResponse(ctx context.Context, body StartFsWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error)
+
+	// StopFsWatchWithResponse request
+	StopFsWatchWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StopFsWatchResponse, error)
+
+	// StreamFsEventsWithResponse request
+	StreamFsEventsWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StreamFsEventsResponse, error)
+
+	// WriteFileWithBodyWithResponse request with any body
+	WriteFileWithBodyWithResponse(ctx context.Context, params *WriteFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteFileResponse, error)
+
+	// GetLogsWithResponse request
+	GetLogsWithResponse(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*GetLogsResponse, error)
+
+	// LogsStreamWithResponse request
+	LogsStreamWithResponse(ctx context.Context, params *LogsStreamParams, reqEditors ...RequestEditorFn) (*LogsStreamResponse, error)
+
+	// ExecutePlaywrightCodeWithBodyWithResponse request with any body
+	ExecutePlaywrightCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error)
+
+	ExecutePlaywrightCodeWithResponse(ctx context.Context, body ExecutePlaywrightCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error)
+
+	// ProcessExecWithBodyWithResponse request with any body
+	ProcessExecWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error)
+
+	ProcessExecWithResponse(ctx context.Context, body ProcessExecJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error)
+
+	// ProcessSpawnWithBodyWithResponse request with any body
+	ProcessSpawnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error)
+
+	ProcessSpawnWithResponse(ctx context.Context, body ProcessSpawnJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error)
+
+	// ProcessKillWithBodyWithResponse request with any body
+	ProcessKillWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error)
+
+	ProcessKillWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessKillJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error)
+
+	// ProcessResizeWithBodyWithResponse request with any body
+	ProcessResizeWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error)
+
+	ProcessResizeWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error)
+
+	// ProcessSignalWithBodyWithResponse request with any body
+	ProcessSignalWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessSignalResponse, error)
+
+	ProcessSignalWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessSignalJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessSignalResponse, error)
+
+	// ProcessStatusWithResponse request
+	ProcessStatusWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStatusResponse, error)
+
+	// ProcessStdinWithBodyWithResponse request with any body
+	ProcessStdinWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error)
+
+	ProcessStdinWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessStdinJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error)
+
+	// ProcessStdoutStreamWithResponse request
+	ProcessStdoutStreamWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStdoutStreamResponse, error)
+
+	// GetCircuitStatusWithResponse request
+	GetCircuitStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCircuitStatusResponse, error)
+
+	// ReclaimProveWithBodyWithResponse request with any body
+	ReclaimProveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error)
+
+	ReclaimProveWithResponse(ctx context.Context, body ReclaimProveJSONRequestBody, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error)
+
+	// GetProofStatsWithResponse request
+	GetProofStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProofStatsResponse, error)
+
+	// CleanupWithResponse request
+	CleanupWithResponse(ctx context.Context, params *CleanupParams, reqEditors ...RequestEditorFn) (*CleanupResponse, error)
+
+	// DeleteRecordingWithBodyWithResponse request with any body
+	DeleteRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error)
+
+	DeleteRecordingWithResponse(ctx context.Context, body DeleteRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error)
+
+	// DownloadRecordingWithResponse request
+	DownloadRecordingWithResponse(ctx context.Context, params *DownloadRecordingParams, reqEditors ...RequestEditorFn) (*DownloadRecordingResponse, error)
+
+	// ListRecordersWithResponse request
+	ListRecordersWithResponse(ctx context.Context, params *ListRecordersParams, reqEditors ...RequestEditorFn) (*ListRecordersResponse, error)
+
+	// GetRecordingQueueWithResponse request
+	GetRecordingQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRecordingQueueResponse, error)
+
+	// StartRecordingWithBodyWithResponse request with any body
+	StartRecordingWithBodyWithResponse(ctx context.Context, params *StartRecordingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error)
+
+	StartRecordingWithResponse(ctx context.Context, params *StartRecordingParams, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error)
+
+	// StopRecordingWithBodyWithResponse request with any body
+	StopRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error)
+
+	StopRecordingWithResponse(ctx context.Context, body StopRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error)
+
+	// ConvertRecordingWithResponse request
+	ConvertRecordingWithResponse(ctx context.Context, id string, params *ConvertRecordingParams, reqEditors ...RequestEditorFn) (*ConvertRecordingResponse, error)
+
+	// GetRecordingLocationWithResponse request
+	GetRecordingLocationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetRecordingLocationResponse, error)
+
+	// GetRecordingManifestWithResponse request
+	GetRecordingManifestWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetRecordingManifestResponse, error)
+
+	// StreamRecordingProgressWithResponse request
+	StreamRecordingProgressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StreamRecordingProgressResponse, error)
+
+	// GetRecordingStatusWithResponse request
+	GetRecordingStatusWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetRecordingStatusResponse, error)
+
+	// GetScaleToZeroStatusWithResponse request
+	GetScaleToZeroStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScaleToZeroStatusResponse, error)
+
+	// ResumeScaleToZeroWithResponse request
+	ResumeScaleToZeroWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResumeScaleToZeroResponse, error)
+
+	// PauseScaleToZeroWithResponse request
+	PauseScaleToZeroWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PauseScaleToZeroResponse, error)
 }
 
-// UploadZipWithBodyWithResponse request with arbitrary body returning *UploadZipResponse
-func (c *ClientWithResponses) UploadZipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZipResponse, error) {
-	rsp, err := c.UploadZipWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUploadZipResponse(rsp)
+type SendCDPCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CDPCommandResult
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+	JSON503      *Error
+	JSON504      *Error
 }
 
-// UploadZstdWithBodyWithResponse request with arbitrary body returning *UploadZstdResponse
-func (c *ClientWithResponses) UploadZstdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZstdResponse, error) {
-	rsp, err := c.UploadZstdWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r SendCDPCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseUploadZstdResponse(rsp)
+	return http.StatusText(0)
 }
 
-// StartFsWatchWithBodyWithResponse request with arbitrary body returning *StartFsWatchResponse
-func (c *ClientWithResponses) StartFsWatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error) {
-	rsp, err := c.StartFsWatchWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r SendCDPCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseStartFsWatchResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) StartFsWatchWithResponse(ctx context.Context, body StartFsWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error) {
-	rsp, err := c.StartFsWatch(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseStartFsWatchResponse(rsp)
+type GetChromiumCookiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChromiumCookies
+	JSON500      *InternalError
+	JSON503      *Error
 }
 
-// StopFsWatchWithResponse request returning *StopFsWatchResponse
-func (c *ClientWithResponses) StopFsWatchWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StopFsWatchResponse, error) {
-	rsp, err := c.StopFsWatch(ctx, watchId, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetChromiumCookiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseStopFsWatchResponse(rsp)
+	return http.StatusText(0)
 }
 
-// StreamFsEventsWithResponse request returning *StreamFsEventsResponse
-func (c *ClientWithResponses) StreamFsEventsWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StreamFsEventsResponse, error) {
-	rsp, err := c.StreamFsEvents(ctx, watchId, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChromiumCookiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseStreamFsEventsResponse(rsp)
+	return 0
 }
 
-// WriteFileWithBodyWithResponse request with arbitrary body returning *WriteFileResponse
-func (c *ClientWithResponses) WriteFileWithBodyWithResponse(ctx context.Context, params *WriteFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteFileResponse, error) {
-	rsp, err := c.WriteFileWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseWriteFileResponse(rsp)
+type SetChromiumCookiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SetChromiumCookiesResult
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+	JSON503      *Error
 }
 
-// LogsStreamWithResponse request returning *LogsStreamResponse
-func (c *ClientWithResponses) LogsStreamWithResponse(ctx context.Context, params *LogsStreamParams, reqEditors ...RequestEditorFn) (*LogsStreamResponse, error) {
-	rsp, err := c.LogsStream(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r SetChromiumCookiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseLogsStreamResponse(rsp)
+	return http.StatusText(0)
 }
 
-// ExecutePlaywrightCodeWithBodyWithResponse request with arbitrary body returning *ExecutePlaywrightCodeResponse
-func (c *ClientWithResponses) ExecutePlaywrightCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error) {
-	rsp, err := c.ExecutePlaywrightCodeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetChromiumCookiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseExecutePlaywrightCodeResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) ExecutePlaywrightCodeWithResponse(ctx context.Context, body ExecutePlaywrightCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error) {
-	rsp, err := c.ExecutePlaywrightCode(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseExecutePlaywrightCodeResponse(rsp)
+type SetChromiumEmulationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChromiumEmulation
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+	JSON503      *Error
 }
 
-// ProcessExecWithBodyWithResponse request with arbitrary body returning *ProcessExecResponse
-func (c *ClientWithResponses) ProcessExecWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error) {
-	rsp, err := c.ProcessExecWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r SetChromiumEmulationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseProcessExecResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) ProcessExecWithResponse(ctx context.Context, body ProcessExecJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error) {
-	rsp, err := c.ProcessExec(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetChromiumEmulationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseProcessExecResponse(rsp)
+	return 0
 }
 
-// ProcessSpawnWithBodyWithResponse request with arbitrary body returning *ProcessSpawnResponse
-func (c *ClientWithResponses) ProcessSpawnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error) {
-	rsp, err := c.ProcessSpawnWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseProcessSpawnResponse(rsp)
+type GetExtensionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExtensionInfo
+	JSON500      *InternalError
 }
 
-func (c *ClientWithResponses) ProcessSpawnWithResponse(ctx context.Context, body ProcessSpawnJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error) {
-	rsp, err := c.ProcessSpawn(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetExtensionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseProcessSpawnResponse(rsp)
+	return http.StatusText(0)
 }
 
-// ProcessKillWithBodyWithResponse request with arbitrary body returning *ProcessKillResponse
-func (c *ClientWithResponses) ProcessKillWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error) {
-	rsp, err := c.ProcessKillWithBody(ctx, processId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExtensionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseProcessKillResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) ProcessKillWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessKillJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error) {
-	rsp, err := c.ProcessKill(ctx, processId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseProcessKillResponse(rsp)
+type PatchChromiumFlagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ProcessResizeWithBodyWithResponse request with arbitrary body returning *ProcessResizeResponse
-func (c *ClientWithResponses) ProcessResizeWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error) {
-	rsp, err := c.ProcessResizeWithBody(ctx, processId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchChromiumFlagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseProcessResizeResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) ProcessResizeWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error) {
-	rsp, err := c.ProcessResize(ctx, processId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchChromiumFlagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseProcessResizeResponse(rsp)
+	return 0
 }
 
-// ProcessStatusWithResponse request returning *ProcessStatusResponse
-func (c *ClientWithResponses) ProcessStatusWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStatusResponse, error) {
-	rsp, err := c.ProcessStatus(ctx, processId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseProcessStatusResponse(rsp)
+type PatchChromiumPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ProcessStdinWithBodyWithResponse request with arbitrary body returning *ProcessStdinResponse
-func (c *ClientWithResponses) ProcessStdinWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error) {
-	rsp, err := c.ProcessStdinWithBody(ctx, processId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchChromiumPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseProcessStdinResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) ProcessStdinWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessStdinJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error) {
-	rsp, err := c.ProcessStdin(ctx, processId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchChromiumPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseProcessStdinResponse(rsp)
+	return 0
 }
 
-// ProcessStdoutStreamWithResponse request returning *ProcessStdoutStreamResponse
-func (c *ClientWithResponses) ProcessStdoutStreamWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStdoutStreamResponse, error) {
-	rsp, err := c.ProcessStdoutStream(ctx, processId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseProcessStdoutStreamResponse(rsp)
+type GetChromiumTargetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChromiumTargets
+	JSON500      *InternalError
+	JSON503      *Error
 }
 
-// ReclaimProveWithBodyWithResponse request with arbitrary body returning *ReclaimProveResponse
-func (c *ClientWithResponses) ReclaimProveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error) {
-	rsp, err := c.ReclaimProveWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetChromiumTargetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseReclaimProveResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) ReclaimProveWithResponse(ctx context.Context, body ReclaimProveJSONRequestBody, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error) {
-	rsp, err := c.ReclaimProve(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChromiumTargetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseReclaimProveResponse(rsp)
+	return 0
 }
 
-// DeleteRecordingWithBodyWithResponse request with arbitrary body returning *DeleteRecordingResponse
-func (c *ClientWithResponses) DeleteRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error) {
-	rsp, err := c.DeleteRecordingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteRecordingResponse(rsp)
+type UploadExtensionsAndRestartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-func (c *ClientWithResponses) DeleteRecordingWithResponse(ctx context.Context, body DeleteRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error) {
-	rsp, err := c.DeleteRecording(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UploadExtensionsAndRestartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseDeleteRecordingResponse(rsp)
+	return http.StatusText(0)
 }
 
-// DownloadRecordingWithResponse request returning *DownloadRecordingResponse
-func (c *ClientWithResponses) DownloadRecordingWithResponse(ctx context.Context, params *DownloadRecordingParams, reqEditors ...RequestEditorFn) (*DownloadRecordingResponse, error) {
-	rsp, err := c.DownloadRecording(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadExtensionsAndRestartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseDownloadRecordingResponse(rsp)
+	return 0
 }
 
-// ListRecordersWithResponse request returning *ListRecordersResponse
-func (c *ClientWithResponses) ListRecordersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRecordersResponse, error) {
-	rsp, err := c.ListRecorders(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListRecordersResponse(rsp)
+type SetChromiumViewportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChromiumViewport
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+	JSON503      *Error
 }
 
-// StartRecordingWithBodyWithResponse request with arbitrary body returning *StartRecordingResponse
-func (c *ClientWithResponses) StartRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error) {
-	rsp, err := c.StartRecordingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r SetChromiumViewportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseStartRecordingResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) StartRecordingWithResponse(ctx context.Context, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error) {
-	rsp, err := c.StartRecording(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetChromiumViewportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseStartRecordingResponse(rsp)
+	return 0
 }
 
-// StopRecordingWithBodyWithResponse request with arbitrary body returning *StopRecordingResponse
-func (c *ClientWithResponses) StopRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error) {
-	rsp, err := c.StopRecordingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseStopRecordingResponse(rsp)
+type WarmupChromiumResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WarmupResult
+	JSON500      *InternalError
+	JSON503      *Error
 }
 
-func (c *ClientWithResponses) StopRecordingWithResponse(ctx context.Context, body StopRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error) {
-	rsp, err := c.StopRecording(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r WarmupChromiumResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseStopRecordingResponse(rsp)
+	return http.StatusText(0)
 }
 
-// ParsePatchChromiumFlagsResponse parses an HTTP response from a PatchChromiumFlagsWithResponse call
-func ParsePatchChromiumFlagsResponse(rsp *http.Response) (*PatchChromiumFlagsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &PatchChromiumFlagsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r WarmupChromiumResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type BatchComputerActionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
+// Status returns HTTPResponse.Status
+func (r BatchComputerActionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	return response, nil
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchComputerActionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-// ParsePatchChromiumPoliciesResponse parses an HTTP response from a PatchChromiumPoliciesWithResponse call
-func ParsePatchChromiumPoliciesResponse(rsp *http.Response) (*PatchChromiumPoliciesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+type ClickMouseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ClickMouseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PatchChromiumPoliciesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClickMouseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type ReadClipboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClipboardContent
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ReadClipboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadClipboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type WriteClipboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseUploadExtensionsAndRestartResponse parses an HTTP response from a UploadExtensionsAndRestartWithResponse call
-func ParseUploadExtensionsAndRestartResponse(rsp *http.Response) (*UploadExtensionsAndRestartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r WriteClipboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &UploadExtensionsAndRestartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r WriteClipboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type SetCursorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OkResponse
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r SetCursorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCursorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type DragMouseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseBatchComputerActionResponse parses an HTTP response from a BatchComputerActionWithResponse call
-func ParseBatchComputerActionResponse(rsp *http.Response) (*BatchComputerActionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DragMouseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &BatchComputerActionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r DragMouseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetMousePositionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MousePositionResponse
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetMousePositionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMousePositionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type MoveMouseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseClickMouseResponse parses an HTTP response from a ClickMouseWithResponse call
-func ParseClickMouseResponse(rsp *http.Response) (*ClickMouseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r MoveMouseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &ClickMouseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveMouseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type PressKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r PressKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r PressKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type TakeScreenshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseReadClipboardResponse parses an HTTP response from a ReadClipboardWithResponse call
-func ParseReadClipboardResponse(rsp *http.Response) (*ReadClipboardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r TakeScreenshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &ReadClipboardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r TakeScreenshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClipboardContent
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type ScrollResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ScrollResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ScrollResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type TypeTextResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseWriteClipboardResponse parses an HTTP response from a WriteClipboardWithResponse call
-func ParseWriteClipboardResponse(rsp *http.Response) (*WriteClipboardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r TypeTextResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &WriteClipboardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r TypeTextResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type GetDisplayInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DisplayInfo
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetDisplayInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDisplayInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type PatchDisplayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DisplayConfig
+	JSON400      *BadRequestError
+	JSON409      *ConflictError
+	JSON500      *InternalError
 }
 
-// ParseSetCursorResponse parses an HTTP response from a SetCursorWithResponse call
-func ParseSetCursorResponse(rsp *http.Response) (*SetCursorResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r PatchDisplayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &SetCursorResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchDisplayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OkResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type CreateDirectoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r CreateDirectoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDirectoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type DeleteDirectoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseDragMouseResponse parses an HTTP response from a DragMouseWithResponse call
-func ParseDragMouseResponse(rsp *http.Response) (*DragMouseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteDirectoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DragMouseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDirectoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type DeleteFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r DeleteFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type DownloadDirZipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseGetMousePositionResponse parses an HTTP response from a GetMousePositionWithResponse call
-func ParseGetMousePositionResponse(rsp *http.Response) (*GetMousePositionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DownloadDirZipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &GetMousePositionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadDirZipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MousePositionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type DownloadDirZstdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r DownloadDirZstdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadDirZstdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type FileInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FileInfo
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseMoveMouseResponse parses an HTTP response from a MoveMouseWithResponse call
-func ParseMoveMouseResponse(rsp *http.Response) (*MoveMouseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r FileInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &MoveMouseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r FileInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type ListFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ListFiles
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ListFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type MovePathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParsePressKeyResponse parses an HTTP response from a PressKeyWithResponse call
-func ParsePressKeyResponse(rsp *http.Response) (*PressKeyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r MovePathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PressKeyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r MovePathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type ReadFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ReadFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type SetFilePermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseTakeScreenshotResponse parses an HTTP response from a TakeScreenshotWithResponse call
-func ParseTakeScreenshotResponse(rsp *http.Response) (*TakeScreenshotResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r SetFilePermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &TakeScreenshotResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetFilePermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type UploadFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r UploadFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type UploadZipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseScrollResponse parses an HTTP response from a ScrollWithResponse call
-func ParseScrollResponse(rsp *http.Response) (*ScrollResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UploadZipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &ScrollResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadZipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type UploadZstdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r UploadZstdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadZstdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type StartFsWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		// WatchId Unique identifier for the directory watch
+		WatchId *string `json:"watch_id,omitempty"`
+	}
+	JSON400 *BadRequestError
+	JSON404 *NotFoundError
+	JSON500 *InternalError
 }
 
-// ParseTypeTextResponse parses an HTTP response from a TypeTextWithResponse call
-func ParseTypeTextResponse(rsp *http.Response) (*TypeTextResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r StartFsWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &TypeTextResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartFsWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type StopFsWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r StopFsWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopFsWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type StreamFsEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParsePatchDisplayResponse parses an HTTP response from a PatchDisplayWithResponse call
-func ParsePatchDisplayResponse(rsp *http.Response) (*PatchDisplayResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r StreamFsEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &PatchDisplayResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamFsEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DisplayConfig
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+type WriteFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r WriteFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r WriteFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ServerLogEntry
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
 }
 
-// ParseCreateDirectoryResponse parses an HTTP response from a CreateDirectoryWithResponse call
-func ParseCreateDirectoryResponse(rsp *http.Response) (*CreateDirectoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &CreateDirectoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type LogsStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r LogsStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ExecutePlaywrightCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExecutePlaywrightResult
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseDeleteDirectoryResponse parses an HTTP response from a DeleteDirectoryWithResponse call
-func ParseDeleteDirectoryResponse(rsp *http.Response) (*DeleteDirectoryResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ExecutePlaywrightCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DeleteDirectoryResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecutePlaywrightCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+type ProcessExecResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessExecResult
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ProcessExecResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessExecResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ProcessSpawnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessSpawnResult
+	JSON400      *BadRequestError
+	JSON500      *InternalError
 }
 
-// ParseDeleteFileResponse parses an HTTP response from a DeleteFileWithResponse call
-func ParseDeleteFileResponse(rsp *http.Response) (*DeleteFileResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ProcessSpawnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DeleteFileResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessSpawnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type ProcessKillResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OkResponse
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ProcessKillResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessKillResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ProcessResizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OkResponse
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseDownloadDirZipResponse parses an HTTP response from a DownloadDirZipWithResponse call
-func ParseDownloadDirZipResponse(rsp *http.Response) (*DownloadDirZipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ProcessResizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DownloadDirZipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessResizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type ProcessSignalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OkResponse
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ProcessSignalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessSignalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ProcessStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessStatus
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
 }
 
-// ParseDownloadDirZstdResponse parses an HTTP response from a DownloadDirZstdWithResponse call
-func ParseDownloadDirZstdResponse(rsp *http.Response) (*DownloadDirZstdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ProcessStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &DownloadDirZstdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type ProcessStdinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProcessStdinResult
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
+// Status returns HTTPResponse.Status
+func (r ProcessStdinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-
-	return response, nil
+	return http.StatusText(0)
 }
 
-// ParseFileInfoResponse parses an HTTP response from a FileInfoWithResponse call
-func ParseFileInfoResponse(rsp *http.Response) (*FileInfoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessStdinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	response := &FileInfoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+type ProcessStdoutStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessStdoutStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FileInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessStdoutStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetCircuitStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CircuitStatus
+	JSON500      *InternalError
 }
 
-// ParseListFilesResponse parses an HTTP response from a ListFilesWithResponse call
-func ParseListFilesResponse(rsp *http.Response) (*ListFilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetCircuitStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &ListFilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCircuitStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ListFiles
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type ReclaimProveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReclaimProveResult
+	JSON400      *BadRequestError
+	JSON429      *Error
+	JSON500      *InternalError
+	JSON502      *Error
+	JSON503      *Error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r ReclaimProveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReclaimProveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetProofStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProofStats
+	JSON500      *InternalError
 }
 
-// ParseMovePathResponse parses an HTTP response from a MovePathWithResponse call
-func ParseMovePathResponse(rsp *http.Response) (*MovePathResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetProofStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &MovePathResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProofStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type CleanupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CleanupResult
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r CleanupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r CleanupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type DeleteRecordingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON409      *Error
+	JSON500      *InternalError
 }
 
-// ParseReadFileResponse parses an HTTP response from a ReadFileWithResponse call
-func ParseReadFileResponse(rsp *http.Response) (*ReadFileResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteRecordingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &ReadFileResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRecordingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type DownloadRecordingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r DownloadRecordingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadRecordingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ListRecordersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RecorderInfo
+	JSON500      *InternalError
 }
 
-// ParseSetFilePermissionsResponse parses an HTTP response from a SetFilePermissionsWithResponse call
-func ParseSetFilePermissionsResponse(rsp *http.Response) (*SetFilePermissionsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListRecordersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &SetFilePermissionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRecordersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type GetRecordingQueueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecordingQueueStats
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetRecordingQueueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecordingQueueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type StartRecordingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StartRecordingDryRun
+	JSON400      *BadRequestError
+	JSON409      *ConflictError
+	JSON429      *Error
+	JSON500      *InternalError
+	JSON503      *Error
+	JSON507      *Error
 }
 
-// ParseUploadFilesResponse parses an HTTP response from a UploadFilesWithResponse call
-func ParseUploadFilesResponse(rsp *http.Response) (*UploadFilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r StartRecordingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &UploadFilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartRecordingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type StopRecordingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r StopRecordingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopRecordingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type ConvertRecordingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON409      *ConflictError
+	JSON500      *InternalError
 }
 
-// ParseUploadZipResponse parses an HTTP response from a UploadZipWithResponse call
-func ParseUploadZipResponse(rsp *http.Response) (*UploadZipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ConvertRecordingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &UploadZipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertRecordingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type GetRecordingLocationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecordingLocation
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r GetRecordingLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecordingLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetRecordingManifestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecordingManifest
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON409      *ConflictError
+	JSON500      *InternalError
 }
 
-// ParseUploadZstdResponse parses an HTTP response from a UploadZstdWithResponse call
-func ParseUploadZstdResponse(rsp *http.Response) (*UploadZstdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetRecordingManifestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &UploadZstdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecordingManifestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+type StreamRecordingProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequestError
+	JSON404      *NotFoundError
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// Status returns HTTPResponse.Status
+func (r StreamRecordingProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamRecordingProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return response, nil
+type GetScaleToZeroStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScaleToZeroStatus
+	JSON500      *InternalError
 }
 
-// ParseStartFsWatchResponse parses an HTTP response from a StartFsWatchWithResponse call
-func ParseStartFsWatchResponse(rsp *http.Response) (*StartFsWatchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GetScaleToZeroStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	response := &StartFsWatchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScaleToZeroStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			// WatchId Unique identifier for the directory watch
-			WatchId *string `json:"watch_id,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+type ResumeScaleToZeroResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScaleToZeroStatus
+	JSON500      *InternalError
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// Status returns HTTPResponse.Status
+func (r ResumeScaleToZeroResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeScaleToZeroResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PauseScaleToZeroResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScaleToZeroStatus
+	JSON500      *InternalError
+}
 
+// Status returns HTTPResponse.Status
+func (r PauseScaleToZeroResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	return response, nil
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseScaleToZeroResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-// ParseStopFsWatchResponse parses an HTTP response from a StopFsWatchWithResponse call
-func ParseStopFsWatchResponse(rsp *http.Response) (*StopFsWatchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// SendCDPCommandWithBodyWithResponse request with arbitrary body returning *SendCDPCommandResponse
+func (c *ClientWithResponses) SendCDPCommandWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendCDPCommandResponse, error) {
+	rsp, err := c.SendCDPCommandWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSendCDPCommandResponse(rsp)
+}
 
-	response := &StopFsWatchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) SendCDPCommandWithResponse(ctx context.Context, body SendCDPCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*SendCDPCommandResponse, error) {
+	rsp, err := c.SendCDPCommand(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSendCDPCommandResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// GetChromiumCookiesWithResponse request returning *GetChromiumCookiesResponse
+func (c *ClientWithResponses) GetChromiumCookiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChromiumCookiesResponse, error) {
+	rsp, err := c.GetChromiumCookies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseGetChromiumCookiesResponse(rsp)
 }
 
-// ParseStreamFsEventsResponse parses an HTTP response from a StreamFsEventsWithResponse call
-func ParseStreamFsEventsResponse(rsp *http.Response) (*StreamFsEventsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// SetChromiumCookiesWithBodyWithResponse request with arbitrary body returning *SetChromiumCookiesResponse
+func (c *ClientWithResponses) SetChromiumCookiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChromiumCookiesResponse, error) {
+	rsp, err := c.SetChromiumCookiesWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSetChromiumCookiesResponse(rsp)
+}
 
-	response := &StreamFsEventsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) SetChromiumCookiesWithResponse(ctx context.Context, body SetChromiumCookiesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChromiumCookiesResponse, error) {
+	rsp, err := c.SetChromiumCookies(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSetChromiumCookiesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// SetChromiumEmulationWithBodyWithResponse request with arbitrary body returning *SetChromiumEmulationResponse
+func (c *ClientWithResponses) SetChromiumEmulationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChromiumEmulationResponse, error) {
+	rsp, err := c.SetChromiumEmulationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseSetChromiumEmulationResponse(rsp)
 }
 
-// ParseWriteFileResponse parses an HTTP response from a WriteFileWithResponse call
-func ParseWriteFileResponse(rsp *http.Response) (*WriteFileResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) SetChromiumEmulationWithResponse(ctx context.Context, body SetChromiumEmulationJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChromiumEmulationResponse, error) {
+	rsp, err := c.SetChromiumEmulation(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSetChromiumEmulationResponse(rsp)
+}
 
-	response := &WriteFileResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetExtensionsWithResponse request returning *GetExtensionsResponse
+func (c *ClientWithResponses) GetExtensionsWithResponse(ctx context.Context, params *GetExtensionsParams, reqEditors ...RequestEditorFn) (*GetExtensionsResponse, error) {
+	rsp, err := c.GetExtensions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetExtensionsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// PatchChromiumFlagsWithBodyWithResponse request with arbitrary body returning *PatchChromiumFlagsResponse
+func (c *ClientWithResponses) PatchChromiumFlagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error) {
+	rsp, err := c.PatchChromiumFlagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePatchChromiumFlagsResponse(rsp)
 }
 
-// ParseLogsStreamResponse parses an HTTP response from a LogsStreamWithResponse call
-func ParseLogsStreamResponse(rsp *http.Response) (*LogsStreamResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PatchChromiumFlagsWithResponse(ctx context.Context, body PatchChromiumFlagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumFlagsResponse, error) {
+	rsp, err := c.PatchChromiumFlags(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePatchChromiumFlagsResponse(rsp)
+}
 
-	response := &LogsStreamResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// PatchChromiumPoliciesWithBodyWithResponse request with arbitrary body returning *PatchChromiumPoliciesResponse
+func (c *ClientWithResponses) PatchChromiumPoliciesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error) {
+	rsp, err := c.PatchChromiumPoliciesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePatchChromiumPoliciesResponse(rsp)
 }
 
-// ParseExecutePlaywrightCodeResponse parses an HTTP response from a ExecutePlaywrightCodeWithResponse call
-func ParseExecutePlaywrightCodeResponse(rsp *http.Response) (*ExecutePlaywrightCodeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PatchChromiumPoliciesWithResponse(ctx context.Context, body PatchChromiumPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchChromiumPoliciesResponse, error) {
+	rsp, err := c.PatchChromiumPolicies(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePatchChromiumPoliciesResponse(rsp)
+}
 
-	response := &ExecutePlaywrightCodeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// GetChromiumTargetsWithResponse request returning *GetChromiumTargetsResponse
+func (c *ClientWithResponses) GetChromiumTargetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChromiumTargetsResponse, error) {
+	rsp, err := c.GetChromiumTargets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseGetChromiumTargetsResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ExecutePlaywrightResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// UploadExtensionsAndRestartWithBodyWithResponse request with arbitrary body returning *UploadExtensionsAndRestartResponse
+func (c *ClientWithResponses) UploadExtensionsAndRestartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadExtensionsAndRestartResponse, error) {
+	rsp, err := c.UploadExtensionsAndRestartWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadExtensionsAndRestartResponse(rsp)
+}
 
+// SetChromiumViewportWithBodyWithResponse request with arbitrary body returning *SetChromiumViewportResponse
+func (c *ClientWithResponses) SetChromiumViewportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChromiumViewportResponse, error) {
+	rsp, err := c.SetChromiumViewportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSetChromiumViewportResponse(rsp)
+}
 
-	return response, nil
+func (c *ClientWithResponses) SetChromiumViewportWithResponse(ctx context.Context, body SetChromiumViewportJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChromiumViewportResponse, error) {
+	rsp, err := c.SetChromiumViewport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetChromiumViewportResponse(rsp)
 }
 
-// ParseProcessExecResponse parses an HTTP response from a ProcessExecWithResponse call
-func ParseProcessExecResponse(rsp *http.Response) (*ProcessExecResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// WarmupChromiumWithResponse request returning *WarmupChromiumResponse
+func (c *ClientWithResponses) WarmupChromiumWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WarmupChromiumResponse, error) {
+	rsp, err := c.WarmupChromium(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseWarmupChromiumResponse(rsp)
+}
 
-	response := &ProcessExecResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// BatchComputerActionWithBodyWithResponse request with arbitrary body returning *BatchComputerActionResponse
+func (c *ClientWithResponses) BatchComputerActionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error) {
+	rsp, err := c.BatchComputerActionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseBatchComputerActionResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProcessExecResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) BatchComputerActionWithResponse(ctx context.Context, body BatchComputerActionJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchComputerActionResponse, error) {
+	rsp, err := c.BatchComputerAction(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseBatchComputerActionResponse(rsp)
 }
 
-// ParseProcessSpawnResponse parses an HTTP response from a ProcessSpawnWithResponse call
-func ParseProcessSpawnResponse(rsp *http.Response) (*ProcessSpawnResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ClickMouseWithBodyWithResponse request with arbitrary body returning *ClickMouseResponse
+func (c *ClientWithResponses) ClickMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error) {
+	rsp, err := c.ClickMouseWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseClickMouseResponse(rsp)
+}
 
-	response := &ProcessSpawnResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) ClickMouseWithResponse(ctx context.Context, body ClickMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*ClickMouseResponse, error) {
+	rsp, err := c.ClickMouse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseClickMouseResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProcessSpawnResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// ReadClipboardWithResponse request returning *ReadClipboardResponse
+func (c *ClientWithResponses) ReadClipboardWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadClipboardResponse, error) {
+	rsp, err := c.ReadClipboard(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseReadClipboardResponse(rsp)
 }
 
-// ParseProcessKillResponse parses an HTTP response from a ProcessKillWithResponse call
-func ParseProcessKillResponse(rsp *http.Response) (*ProcessKillResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// WriteClipboardWithBodyWithResponse request with arbitrary body returning *WriteClipboardResponse
+func (c *ClientWithResponses) WriteClipboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error) {
+	rsp, err := c.WriteClipboardWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseWriteClipboardResponse(rsp)
+}
 
-	response := &ProcessKillResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) WriteClipboardWithResponse(ctx context.Context, body WriteClipboardJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteClipboardResponse, error) {
+	rsp, err := c.WriteClipboard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseWriteClipboardResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OkResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// SetCursorWithBodyWithResponse request with arbitrary body returning *SetCursorResponse
+func (c *ClientWithResponses) SetCursorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCursorResponse, error) {
+	rsp, err := c.SetCursorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseSetCursorResponse(rsp)
 }
 
-// ParseProcessResizeResponse parses an HTTP response from a ProcessResizeWithResponse call
-func ParseProcessResizeResponse(rsp *http.Response) (*ProcessResizeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) SetCursorWithResponse(ctx context.Context, body SetCursorJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCursorResponse, error) {
+	rsp, err := c.SetCursor(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseSetCursorResponse(rsp)
+}
 
-	response := &ProcessResizeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// DragMouseWithBodyWithResponse request with arbitrary body returning *DragMouseResponse
+func (c *ClientWithResponses) DragMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DragMouseResponse, error) {
+	rsp, err := c.DragMouseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDragMouseResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OkResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) DragMouseWithResponse(ctx context.Context, body DragMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*DragMouseResponse, error) {
+	rsp, err := c.DragMouse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseDragMouseResponse(rsp)
 }
 
-// ParseProcessStatusResponse parses an HTTP response from a ProcessStatusWithResponse call
-func ParseProcessStatusResponse(rsp *http.Response) (*ProcessStatusResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetMousePositionWithResponse request returning *GetMousePositionResponse
+func (c *ClientWithResponses) GetMousePositionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMousePositionResponse, error) {
+	rsp, err := c.GetMousePosition(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetMousePositionResponse(rsp)
+}
 
-	response := &ProcessStatusResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// MoveMouseWithBodyWithResponse request with arbitrary body returning *MoveMouseResponse
+func (c *ClientWithResponses) MoveMouseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error) {
+	rsp, err := c.MoveMouseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseMoveMouseResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProcessStatus
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) MoveMouseWithResponse(ctx context.Context, body MoveMouseJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveMouseResponse, error) {
+	rsp, err := c.MoveMouse(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseMoveMouseResponse(rsp)
 }
 
-// ParseProcessStdinResponse parses an HTTP response from a ProcessStdinWithResponse call
-func ParseProcessStdinResponse(rsp *http.Response) (*ProcessStdinResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PressKeyWithBodyWithResponse request with arbitrary body returning *PressKeyResponse
+func (c *ClientWithResponses) PressKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PressKeyResponse, error) {
+	rsp, err := c.PressKeyWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePressKeyResponse(rsp)
+}
 
-	response := &ProcessStdinResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) PressKeyWithResponse(ctx context.Context, body PressKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*PressKeyResponse, error) {
+	rsp, err := c.PressKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParsePressKeyResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProcessStdinResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// TakeScreenshotWithBodyWithResponse request with arbitrary body returning *TakeScreenshotResponse
+func (c *ClientWithResponses) TakeScreenshotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error) {
+	rsp, err := c.TakeScreenshotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseTakeScreenshotResponse(rsp)
 }
 
-// ParseProcessStdoutStreamResponse parses an HTTP response from a ProcessStdoutStreamWithResponse call
-func ParseProcessStdoutStreamResponse(rsp *http.Response) (*ProcessStdoutStreamResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) TakeScreenshotWithResponse(ctx context.Context, body TakeScreenshotJSONRequestBody, reqEditors ...RequestEditorFn) (*TakeScreenshotResponse, error) {
+	rsp, err := c.TakeScreenshot(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseTakeScreenshotResponse(rsp)
+}
 
-	response := &ProcessStdoutStreamResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// ScrollWithBodyWithResponse request with arbitrary body returning *ScrollResponse
+func (c *ClientWithResponses) ScrollWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScrollResponse, error) {
+	rsp, err := c.ScrollWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseScrollResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) ScrollWithResponse(ctx context.Context, body ScrollJSONRequestBody, reqEditors ...RequestEditorFn) (*ScrollResponse, error) {
+	rsp, err := c.Scroll(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseScrollResponse(rsp)
 }
 
-// ParseReclaimProveResponse parses an HTTP response from a ReclaimProveWithResponse call
-func ParseReclaimProveResponse(rsp *http.Response) (*ReclaimProveResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// TypeTextWithBodyWithResponse request with arbitrary body returning *TypeTextResponse
+func (c *ClientWithResponses) TypeTextWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TypeTextResponse, error) {
+	rsp, err := c.TypeTextWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseTypeTextResponse(rsp)
+}
 
-	response := &ReclaimProveResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) TypeTextWithResponse(ctx context.Context, body TypeTextJSONRequestBody, reqEditors ...RequestEditorFn) (*TypeTextResponse, error) {
+	rsp, err := c.TypeText(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseTypeTextResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ReclaimProveResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// GetConfigWithResponse request returning *GetConfigResponse
+func (c *ClientWithResponses) GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResponse, error) {
+	rsp, err := c.GetConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServerConfig
+	JSON500      *InternalError
+}
 
+// Status returns HTTPResponse.Status
+func (r GetConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	return response, nil
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-// ParseDeleteRecordingResponse parses an HTTP response from a DeleteRecordingWithResponse call
-func ParseDeleteRecordingResponse(rsp *http.Response) (*DeleteRecordingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetDisplayInfoWithResponse request returning *GetDisplayInfoResponse
+func (c *ClientWithResponses) GetDisplayInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDisplayInfoResponse, error) {
+	rsp, err := c.GetDisplayInfo(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseGetDisplayInfoResponse(rsp)
+}
 
-	response := &DeleteRecordingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+// PatchDisplayWithBodyWithResponse request with arbitrary body returning *PatchDisplayResponse
+func (c *ClientWithResponses) PatchDisplayWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error) {
+	rsp, err := c.PatchDisplayWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParsePatchDisplayResponse(rsp)
 }
 
-// ParseDownloadRecordingResponse parses an HTTP response from a DownloadRecordingWithResponse call
-func ParseDownloadRecordingResponse(rsp *http.Response) (*DownloadRecordingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *ClientWithResponses) PatchDisplayWithResponse(ctx context.Context, body PatchDisplayJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchDisplayResponse, error) {
+	rsp, err := c.PatchDisplay(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParsePatchDisplayResponse(rsp)
+}
 
-	response := &DownloadRecordingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// CreateDirectoryWithBodyWithResponse request with arbitrary body returning *CreateDirectoryResponse
+func (c *ClientWithResponses) CreateDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error) {
+	rsp, err := c.CreateDirectoryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseCreateDirectoryResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
+func (c *ClientWithResponses) CreateDirectoryWithResponse(ctx context.Context, body CreateDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDirectoryResponse, error) {
+	rsp, err := c.CreateDirectory(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	return ParseCreateDirectoryResponse(rsp)
 }
 
-// ParseListRecordersResponse parses an HTTP response from a ListRecordersWithResponse call
-func ParseListRecordersResponse(rsp *http.Response) (*ListRecordersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteDirectoryWithBodyWithResponse request with arbitrary body returning *DeleteDirectoryResponse
+func (c *ClientWithResponses) DeleteDirectoryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error) {
+	rsp, err := c.DeleteDirectoryWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeleteDirectoryResponse(rsp)
+}
 
-	response := &ListRecordersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+func (c *ClientWithResponses) DeleteDirectoryWithResponse(ctx context.Context, body DeleteDirectoryJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteDirectoryResponse, error) {
+	rsp, err := c.DeleteDirectory(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteDirectoryResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RecorderInfo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// DeleteFileWithBodyWithResponse request with arbitrary body returning *DeleteFileResponse
+func (c *ClientWithResponses) DeleteFileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error) {
+	rsp, err := c.DeleteFileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFileResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (c *ClientWithResponses) DeleteFileWithResponse(ctx context.Context, body DeleteFileJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteFileResponse, error) {
+	rsp, err := c.DeleteFile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFileResponse(rsp)
+}
 
+// DownloadDirZipWithResponse request returning *DownloadDirZipResponse
+func (c *ClientWithResponses) DownloadDirZipWithResponse(ctx context.Context, params *DownloadDirZipParams, reqEditors ...RequestEditorFn) (*DownloadDirZipResponse, error) {
+	rsp, err := c.DownloadDirZip(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDownloadDirZipResponse(rsp)
+}
 
-	return response, nil
+// DownloadDirZstdWithResponse request returning *DownloadDirZstdResponse
+func (c *ClientWithResponses) DownloadDirZstdWithResponse(ctx context.Context, params *DownloadDirZstdParams, reqEditors ...RequestEditorFn) (*DownloadDirZstdResponse, error) {
+	rsp, err := c.DownloadDirZstd(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadDirZstdResponse(rsp)
 }
 
-// ParseStartRecordingResponse parses an HTTP response from a StartRecordingWithResponse call
-func ParseStartRecordingResponse(rsp *http.Response) (*StartRecordingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// FileInfoWithResponse request returning *FileInfoResponse
+func (c *ClientWithResponses) FileInfoWithResponse(ctx context.Context, params *FileInfoParams, reqEditors ...RequestEditorFn) (*FileInfoResponse, error) {
+	rsp, err := c.FileInfo(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseFileInfoResponse(rsp)
+}
 
-	response := &StartRecordingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// ListFilesWithResponse request returning *ListFilesResponse
+func (c *ClientWithResponses) ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error) {
+	rsp, err := c.ListFiles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseListFilesResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// MovePathWithBodyWithResponse request with arbitrary body returning *MovePathResponse
+func (c *ClientWithResponses) MovePathWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MovePathResponse, error) {
+	rsp, err := c.MovePathWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMovePathResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+func (c *ClientWithResponses) MovePathWithResponse(ctx context.Context, body MovePathJSONRequestBody, reqEditors ...RequestEditorFn) (*MovePathResponse, error) {
+	rsp, err := c.MovePath(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMovePathResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// ReadFileWithResponse request returning *ReadFileResponse
+func (c *ClientWithResponses) ReadFileWithResponse(ctx context.Context, params *ReadFileParams, reqEditors ...RequestEditorFn) (*ReadFileResponse, error) {
+	rsp, err := c.ReadFile(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadFileResponse(rsp)
+}
 
+// SetFilePermissionsWithBodyWithResponse request with arbitrary body returning *SetFilePermissionsResponse
+func (c *ClientWithResponses) SetFilePermissionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error) {
+	rsp, err := c.SetFilePermissionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSetFilePermissionsResponse(rsp)
+}
 
-	return response, nil
+func (c *ClientWithResponses) SetFilePermissionsWithResponse(ctx context.Context, body SetFilePermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFilePermissionsResponse, error) {
+	rsp, err := c.SetFilePermissions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFilePermissionsResponse(rsp)
 }
 
-// ParseStopRecordingResponse parses an HTTP response from a StopRecordingWithResponse call
-func ParseStopRecordingResponse(rsp *http.Response) (*StopRecordingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// UploadFilesWithBodyWithResponse request with arbitrary body returning *UploadFilesResponse
+func (c *ClientWithResponses) UploadFilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadFilesResponse, error) {
+	rsp, err := c.UploadFilesWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseUploadFilesResponse(rsp)
+}
 
-	response := &StopRecordingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+// UploadZipWithBodyWithResponse request with arbitrary body returning *UploadZipResponse
+func (c *ClientWithResponses) UploadZipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZipResponse, error) {
+	rsp, err := c.UploadZipWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseUploadZipResponse(rsp)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// UploadZstdWithBodyWithResponse request with arbitrary body returning *UploadZstdResponse
+func (c *ClientWithResponses) UploadZstdWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadZstdResponse, error) {
+	rsp, err := c.UploadZstdWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadZstdResponse(rsp)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StartFsWatchWithBodyWithResponse request with arbitrary body returning *StartFsWatchResponse
+func (c *ClientWithResponses) StartFsWatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error) {
+	rsp, err := c.StartFsWatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartFsWatchResponse(rsp)
+}
 
+func (c *ClientWithResponses) StartFsWatchWithResponse(ctx context.Context, body StartFsWatchJSONRequestBody, reqEditors ...RequestEditorFn) (*StartFsWatchResponse, error) {
+	rsp, err := c.StartFsWatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseStartFsWatchResponse(rsp)
+}
 
-	return response, nil
+// StopFsWatchWithResponse request returning *StopFsWatchResponse
+func (c *ClientWithResponses) StopFsWatchWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StopFsWatchResponse, error) {
+	rsp, err := c.StopFsWatch(ctx, watchId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopFsWatchResponse(rsp)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Update Chromium launch flags and restart
-	// (PATCH /chromium/flags)
-	PatchChromiumFlags(w http.ResponseWriter, r *http.Request)
-	// Update Chromium enterprise policies and restart
-	// (PATCH /chromium/policies)
-	PatchChromiumPolicies(w http.ResponseWriter, r *http.Request)
-	// Upload one or more unpacked extensions (as zips) and restart Chromium
-	// (POST /chromium/upload-extensions-and-restart)
-	UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request)
-	// Execute a batch of computer actions sequentially
-	// (POST /computer/batch)
-	BatchComputerAction(w http.ResponseWriter, r *http.Request)
-	// Simulate a mouse click action on the host computer
-	// (POST /computer/click_mouse)
-	ClickMouse(w http.ResponseWriter, r *http.Request)
-	// Read text from the system clipboard
-	// (POST /computer/clipboard/read)
-	ReadClipboard(w http.ResponseWriter, r *http.Request)
-	// Write text to the system clipboard
-	// (POST /computer/clipboard/write)
-	WriteClipboard(w http.ResponseWriter, r *http.Request)
-	// Hide or show the cursor
-	// (POST /computer/cursor)
-	SetCursor(w http.ResponseWriter, r *http.Request)
-	// Drag the mouse along a path
-	// (POST /computer/drag_mouse)
-	DragMouse(w http.ResponseWriter, r *http.Request)
-	// Get the current mouse cursor position on the host computer
-	// (POST /computer/get_mouse_position)
-	GetMousePosition(w http.ResponseWriter, r *http.Request)
-	// Move the mouse cursor to the specified coordinates on the host computer
-	// (POST /computer/move_mouse)
-	MoveMouse(w http.ResponseWriter, r *http.Request)
-	// Press one or more keys on the host computer
-	// (POST /computer/press_key)
-	PressKey(w http.ResponseWriter, r *http.Request)
-	// Capture a screenshot of the host computer
-	// (POST /computer/screenshot)
-	TakeScreenshot(w http.ResponseWriter, r *http.Request)
-	// Scroll the mouse wheel at a position on the host computer
-	// (POST /computer/scroll)
-	Scroll(w http.ResponseWriter, r *http.Request)
-	// Type text on the host computer
-	// (POST /computer/type)
-	TypeText(w http.ResponseWriter, r *http.Request)
-	// Update display configuration
-	// (PATCH /display)
-	PatchDisplay(w http.ResponseWriter, r *http.Request)
-	// Create a new directory
-	// (PUT /fs/create_directory)
-	CreateDirectory(w http.ResponseWriter, r *http.Request)
-	// Delete a directory
-	// (PUT /fs/delete_directory)
-	DeleteDirectory(w http.ResponseWriter, r *http.Request)
-	// Delete a file
-	// (PUT /fs/delete_file)
-	DeleteFile(w http.ResponseWriter, r *http.Request)
-	// Download a directory as a ZIP archive
-	// (GET /fs/download_dir_zip)
-	DownloadDirZip(w http.ResponseWriter, r *http.Request, params DownloadDirZipParams)
-	// Download a directory as a tar.zst archive
-	// (GET /fs/download_dir_zstd)
-	DownloadDirZstd(w http.ResponseWriter, r *http.Request, params DownloadDirZstdParams)
-	// Get information about a file or directory
-	// (GET /fs/file_info)
-	FileInfo(w http.ResponseWriter, r *http.Request, params FileInfoParams)
-	// List files in a directory
-	// (GET /fs/list_files)
-	ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams)
-	// Move or rename a file or directory
-	// (PUT /fs/move)
-	MovePath(w http.ResponseWriter, r *http.Request)
-	// Read file contents
-	// (GET /fs/read_file)
-	ReadFile(w http.ResponseWriter, r *http.Request, params ReadFileParams)
-	// Set file or directory permissions/ownership
-	// (PUT /fs/set_file_permissions)
-	SetFilePermissions(w http.ResponseWriter, r *http.Request)
-	// Upload one or more files
-	// (POST /fs/upload)
-	UploadFiles(w http.ResponseWriter, r *http.Request)
-	// Upload a zip archive and extract it
-	// (POST /fs/upload_zip)
-	UploadZip(w http.ResponseWriter, r *http.Request)
-	// Upload a tar.zst archive and extract it
-	// (POST /fs/upload_zstd)
-	UploadZstd(w http.ResponseWriter, r *http.Request)
-	// Watch a directory for changes
-	// (POST /fs/watch)
-	StartFsWatch(w http.ResponseWriter, r *http.Request)
-	// Stop watching a directory
-	// (DELETE /fs/watch/{watch_id})
-	StopFsWatch(w http.ResponseWriter, r *http.Request, watchId string)
-	// Stream filesystem events for a watch
-	// (GET /fs/watch/{watch_id}/events)
-	StreamFsEvents(w http.ResponseWriter, r *http.Request, watchId string)
-	// Write or create a file
-	// (PUT /fs/write_file)
-	WriteFile(w http.ResponseWriter, r *http.Request, params WriteFileParams)
-	// Stream logs over SSE
-	// (GET /logs/stream)
-	LogsStream(w http.ResponseWriter, r *http.Request, params LogsStreamParams)
-	// Execute Playwright/TypeScript code against the browser
-	// (POST /playwright/execute)
-	ExecutePlaywrightCode(w http.ResponseWriter, r *http.Request)
-	// Execute a command synchronously
-	// (POST /process/exec)
-	ProcessExec(w http.ResponseWriter, r *http.Request)
-	// Execute a command asynchronously
-	// (POST /process/spawn)
-	ProcessSpawn(w http.ResponseWriter, r *http.Request)
-	// Send signal to process
-	// (POST /process/{process_id}/kill)
-	ProcessKill(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
-	// Resize a PTY-backed process
-	// (POST /process/{process_id}/resize)
-	ProcessResize(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
-	// Get process status
-	// (GET /process/{process_id}/status)
-	ProcessStatus(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
-	// Write to process stdin
-	// (POST /process/{process_id}/stdin)
-	ProcessStdin(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
-	// Stream process stdout over SSE
-	// (GET /process/{process_id}/stdout/stream)
-	ProcessStdoutStream(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
-	// Execute TEE+MPC proof protocol to generate a verifiable claim
-	// (POST /reclaim/prove)
-	ReclaimProve(w http.ResponseWriter, r *http.Request)
-	// Delete a previously recorded video file
-	// (POST /recording/delete)
-	DeleteRecording(w http.ResponseWriter, r *http.Request)
-	// Download the most recently recorded video file
-	// (GET /recording/download)
-	DownloadRecording(w http.ResponseWriter, r *http.Request, params DownloadRecordingParams)
-	// List all recorders
-	// (GET /recording/list)
-	ListRecorders(w http.ResponseWriter, r *http.Request)
-	// Start a screen recording. Only one recording per ID can be registered at a time.
-	// (POST /recording/start)
-	StartRecording(w http.ResponseWriter, r *http.Request)
-	// Stop the recording
-	// (POST /recording/stop)
-	StopRecording(w http.ResponseWriter, r *http.Request)
+// StreamFsEventsWithResponse request returning *StreamFsEventsResponse
+func (c *ClientWithResponses) StreamFsEventsWithResponse(ctx context.Context, watchId string, reqEditors ...RequestEditorFn) (*StreamFsEventsResponse, error) {
+	rsp, err := c.StreamFsEvents(ctx, watchId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamFsEventsResponse(rsp)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
-
-type Unimplemented struct{}
+// WriteFileWithBodyWithResponse request with arbitrary body returning *WriteFileResponse
+func (c *ClientWithResponses) WriteFileWithBodyWithResponse(ctx context.Context, params *WriteFileParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteFileResponse, error) {
+	rsp, err := c.WriteFileWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWriteFileResponse(rsp)
+}
 
-// Update Chromium launch flags and restart
-// (PATCH /chromium/flags)
-func (_ Unimplemented) PatchChromiumFlags(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetLogsWithResponse request returning *GetLogsResponse
+func (c *ClientWithResponses) GetLogsWithResponse(ctx context.Context, params *GetLogsParams, reqEditors ...RequestEditorFn) (*GetLogsResponse, error) {
+	rsp, err := c.GetLogs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLogsResponse(rsp)
 }
 
-// Update Chromium enterprise policies and restart
-// (PATCH /chromium/policies)
-func (_ Unimplemented) PatchChromiumPolicies(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// LogsStreamWithResponse request returning *LogsStreamResponse
+func (c *ClientWithResponses) LogsStreamWithResponse(ctx context.Context, params *LogsStreamParams, reqEditors ...RequestEditorFn) (*LogsStreamResponse, error) {
+	rsp, err := c.LogsStream(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsStreamResponse(rsp)
 }
 
-// Upload one or more unpacked extensions (as zips) and restart Chromium
-// (POST /chromium/upload-extensions-and-restart)
-func (_ Unimplemented) UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ExecutePlaywrightCodeWithBodyWithResponse request with arbitrary body returning *ExecutePlaywrightCodeResponse
+func (c *ClientWithResponses) ExecutePlaywrightCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error) {
+	rsp, err := c.ExecutePlaywrightCodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecutePlaywrightCodeResponse(rsp)
 }
 
-// Execute a batch of computer actions sequentially
-// (POST /computer/batch)
-func (_ Unimplemented) BatchComputerAction(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ExecutePlaywrightCodeWithResponse(ctx context.Context, body ExecutePlaywrightCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecutePlaywrightCodeResponse, error) {
+	rsp, err := c.ExecutePlaywrightCode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecutePlaywrightCodeResponse(rsp)
 }
 
-// Simulate a mouse click action on the host computer
-// (POST /computer/click_mouse)
-func (_ Unimplemented) ClickMouse(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessExecWithBodyWithResponse request with arbitrary body returning *ProcessExecResponse
+func (c *ClientWithResponses) ProcessExecWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error) {
+	rsp, err := c.ProcessExecWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessExecResponse(rsp)
 }
 
-// Read text from the system clipboard
-// (POST /computer/clipboard/read)
-func (_ Unimplemented) ReadClipboard(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ProcessExecWithResponse(ctx context.Context, body ProcessExecJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessExecResponse, error) {
+	rsp, err := c.ProcessExec(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessExecResponse(rsp)
 }
 
-// Write text to the system clipboard
-// (POST /computer/clipboard/write)
-func (_ Unimplemented) WriteClipboard(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessSpawnWithBodyWithResponse request with arbitrary body returning *ProcessSpawnResponse
+func (c *ClientWithResponses) ProcessSpawnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error) {
+	rsp, err := c.ProcessSpawnWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessSpawnResponse(rsp)
 }
 
-// Hide or show the cursor
-// (POST /computer/cursor)
-func (_ Unimplemented) SetCursor(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ProcessSpawnWithResponse(ctx context.Context, body ProcessSpawnJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessSpawnResponse, error) {
+	rsp, err := c.ProcessSpawn(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessSpawnResponse(rsp)
 }
 
-// Drag the mouse along a path
-// (POST /computer/drag_mouse)
-func (_ Unimplemented) DragMouse(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessKillWithBodyWithResponse request with arbitrary body returning *ProcessKillResponse
+func (c *ClientWithResponses) ProcessKillWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error) {
+	rsp, err := c.ProcessKillWithBody(ctx, processId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessKillResponse(rsp)
 }
 
-// Get the current mouse cursor position on the host computer
-// (POST /computer/get_mouse_position)
-func (_ Unimplemented) GetMousePosition(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ProcessKillWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessKillJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessKillResponse, error) {
+	rsp, err := c.ProcessKill(ctx, processId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessKillResponse(rsp)
 }
 
-// Move the mouse cursor to the specified coordinates on the host computer
-// (POST /computer/move_mouse)
-func (_ Unimplemented) MoveMouse(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessResizeWithBodyWithResponse request with arbitrary body returning *ProcessResizeResponse
+func (c *ClientWithResponses) ProcessResizeWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error) {
+	rsp, err := c.ProcessResizeWithBody(ctx, processId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessResizeResponse(rsp)
 }
 
-// Press one or more keys on the host computer
-// (POST /computer/press_key)
-func (_ Unimplemented) PressKey(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ProcessResizeWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessResizeJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessResizeResponse, error) {
+	rsp, err := c.ProcessResize(ctx, processId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessResizeResponse(rsp)
 }
 
-// Capture a screenshot of the host computer
-// (POST /computer/screenshot)
-func (_ Unimplemented) TakeScreenshot(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessSignalWithBodyWithResponse request with arbitrary body returning *ProcessSignalResponse
+func (c *ClientWithResponses) ProcessSignalWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessSignalResponse, error) {
+	rsp, err := c.ProcessSignalWithBody(ctx, processId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessSignalResponse(rsp)
 }
 
-// Scroll the mouse wheel at a position on the host computer
-// (POST /computer/scroll)
-func (_ Unimplemented) Scroll(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ProcessSignalWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessSignalJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessSignalResponse, error) {
+	rsp, err := c.ProcessSignal(ctx, processId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessSignalResponse(rsp)
 }
 
-// Type text on the host computer
-// (POST /computer/type)
-func (_ Unimplemented) TypeText(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessStatusWithResponse request returning *ProcessStatusResponse
+func (c *ClientWithResponses) ProcessStatusWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStatusResponse, error) {
+	rsp, err := c.ProcessStatus(ctx, processId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessStatusResponse(rsp)
 }
 
-// Update display configuration
-// (PATCH /display)
-func (_ Unimplemented) PatchDisplay(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessStdinWithBodyWithResponse request with arbitrary body returning *ProcessStdinResponse
+func (c *ClientWithResponses) ProcessStdinWithBodyWithResponse(ctx context.Context, processId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error) {
+	rsp, err := c.ProcessStdinWithBody(ctx, processId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessStdinResponse(rsp)
 }
 
-// Create a new directory
-// (PUT /fs/create_directory)
-func (_ Unimplemented) CreateDirectory(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ProcessStdinWithResponse(ctx context.Context, processId openapi_types.UUID, body ProcessStdinJSONRequestBody, reqEditors ...RequestEditorFn) (*ProcessStdinResponse, error) {
+	rsp, err := c.ProcessStdin(ctx, processId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessStdinResponse(rsp)
 }
 
-// Delete a directory
-// (PUT /fs/delete_directory)
-func (_ Unimplemented) DeleteDirectory(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
-
-// Delete a file
-// (PUT /fs/delete_file)
-func (_ Unimplemented) DeleteFile(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ProcessStdoutStreamWithResponse request returning *ProcessStdoutStreamResponse
+func (c *ClientWithResponses) ProcessStdoutStreamWithResponse(ctx context.Context, processId openapi_types.UUID, reqEditors ...RequestEditorFn) (*ProcessStdoutStreamResponse, error) {
+	rsp, err := c.ProcessStdoutStream(ctx, processId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessStdoutStreamResponse(rsp)
 }
 
-// Download a directory as a ZIP archive
-// (GET /fs/download_dir_zip)
-func (_ Unimplemented) DownloadDirZip(w http.ResponseWriter, r *http.Request, params DownloadDirZipParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetCircuitStatusWithResponse request returning *GetCircuitStatusResponse
+func (c *ClientWithResponses) GetCircuitStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCircuitStatusResponse, error) {
+	rsp, err := c.GetCircuitStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCircuitStatusResponse(rsp)
 }
 
-// Download a directory as a tar.zst archive
-// (GET /fs/download_dir_zstd)
-func (_ Unimplemented) DownloadDirZstd(w http.ResponseWriter, r *http.Request, params DownloadDirZstdParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ReclaimProveWithBodyWithResponse request with arbitrary body returning *ReclaimProveResponse
+func (c *ClientWithResponses) ReclaimProveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error) {
+	rsp, err := c.ReclaimProveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReclaimProveResponse(rsp)
 }
 
-// Get information about a file or directory
-// (GET /fs/file_info)
-func (_ Unimplemented) FileInfo(w http.ResponseWriter, r *http.Request, params FileInfoParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) ReclaimProveWithResponse(ctx context.Context, body ReclaimProveJSONRequestBody, reqEditors ...RequestEditorFn) (*ReclaimProveResponse, error) {
+	rsp, err := c.ReclaimProve(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReclaimProveResponse(rsp)
 }
 
-// List files in a directory
-// (GET /fs/list_files)
-func (_ Unimplemented) ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetProofStatsWithResponse request returning *GetProofStatsResponse
+func (c *ClientWithResponses) GetProofStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProofStatsResponse, error) {
+	rsp, err := c.GetProofStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProofStatsResponse(rsp)
 }
 
-// Move or rename a file or directory
-// (PUT /fs/move)
-func (_ Unimplemented) MovePath(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// CleanupWithResponse request returning *CleanupResponse
+func (c *ClientWithResponses) CleanupWithResponse(ctx context.Context, params *CleanupParams, reqEditors ...RequestEditorFn) (*CleanupResponse, error) {
+	rsp, err := c.Cleanup(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCleanupResponse(rsp)
 }
 
-// Read file contents
-// (GET /fs/read_file)
-func (_ Unimplemented) ReadFile(w http.ResponseWriter, r *http.Request, params ReadFileParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// DeleteRecordingWithBodyWithResponse request with arbitrary body returning *DeleteRecordingResponse
+func (c *ClientWithResponses) DeleteRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error) {
+	rsp, err := c.DeleteRecordingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRecordingResponse(rsp)
 }
 
-// Set file or directory permissions/ownership
-// (PUT /fs/set_file_permissions)
-func (_ Unimplemented) SetFilePermissions(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) DeleteRecordingWithResponse(ctx context.Context, body DeleteRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteRecordingResponse, error) {
+	rsp, err := c.DeleteRecording(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRecordingResponse(rsp)
 }
 
-// Upload one or more files
-// (POST /fs/upload)
-func (_ Unimplemented) UploadFiles(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// DownloadRecordingWithResponse request returning *DownloadRecordingResponse
+func (c *ClientWithResponses) DownloadRecordingWithResponse(ctx context.Context, params *DownloadRecordingParams, reqEditors ...RequestEditorFn) (*DownloadRecordingResponse, error) {
+	rsp, err := c.DownloadRecording(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadRecordingResponse(rsp)
 }
 
-// Upload a zip archive and extract it
-// (POST /fs/upload_zip)
-func (_ Unimplemented) UploadZip(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ListRecordersWithResponse request returning *ListRecordersResponse
+func (c *ClientWithResponses) ListRecordersWithResponse(ctx context.Context, params *ListRecordersParams, reqEditors ...RequestEditorFn) (*ListRecordersResponse, error) {
+	rsp, err := c.ListRecorders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRecordersResponse(rsp)
 }
 
-// Upload a tar.zst archive and extract it
-// (POST /fs/upload_zstd)
-func (_ Unimplemented) UploadZstd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetRecordingQueueWithResponse request returning *GetRecordingQueueResponse
+func (c *ClientWithResponses) GetRecordingQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetRecordingQueueResponse, error) {
+	rsp, err := c.GetRecordingQueue(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecordingQueueResponse(rsp)
 }
 
-// Watch a directory for changes
-// (POST /fs/watch)
-func (_ Unimplemented) StartFsWatch(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// StartRecordingWithBodyWithResponse request with arbitrary body returning *StartRecordingResponse
+func (c *ClientWithResponses) StartRecordingWithBodyWithResponse(ctx context.Context, params *StartRecordingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error) {
+	rsp, err := c.StartRecordingWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartRecordingResponse(rsp)
 }
 
-// Stop watching a directory
-// (DELETE /fs/watch/{watch_id})
-func (_ Unimplemented) StopFsWatch(w http.ResponseWriter, r *http.Request, watchId string) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) StartRecordingWithResponse(ctx context.Context, params *StartRecordingParams, body StartRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StartRecordingResponse, error) {
+	rsp, err := c.StartRecording(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartRecordingResponse(rsp)
 }
 
-// Stream filesystem events for a watch
-// (GET /fs/watch/{watch_id}/events)
-func (_ Unimplemented) StreamFsEvents(w http.ResponseWriter, r *http.Request, watchId string) {
-	w.WriteHeader(http.StatusNotImplemented)
+// StopRecordingWithBodyWithResponse request with arbitrary body returning *StopRecordingResponse
+func (c *ClientWithResponses) StopRecordingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error) {
+	rsp, err := c.StopRecordingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopRecordingResponse(rsp)
 }
 
-// Write or create a file
-// (PUT /fs/write_file)
-func (_ Unimplemented) WriteFile(w http.ResponseWriter, r *http.Request, params WriteFileParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+func (c *ClientWithResponses) StopRecordingWithResponse(ctx context.Context, body StopRecordingJSONRequestBody, reqEditors ...RequestEditorFn) (*StopRecordingResponse, error) {
+	rsp, err := c.StopRecording(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopRecordingResponse(rsp)
 }
 
-// Stream logs over SSE
-// (GET /logs/stream)
-func (_ Unimplemented) LogsStream(w http.ResponseWriter, r *http.Request, params LogsStreamParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ConvertRecordingWithResponse request returning *ConvertRecordingResponse
+func (c *ClientWithResponses) ConvertRecordingWithResponse(ctx context.Context, id string, params *ConvertRecordingParams, reqEditors ...RequestEditorFn) (*ConvertRecordingResponse, error) {
+	rsp, err := c.ConvertRecording(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertRecordingResponse(rsp)
 }
 
-// Execute Playwright/TypeScript code against the browser
-// (POST /playwright/execute)
-func (_ Unimplemented) ExecutePlaywrightCode(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetRecordingLocationWithResponse request returning *GetRecordingLocationResponse
+func (c *ClientWithResponses) GetRecordingLocationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetRecordingLocationResponse, error) {
+	rsp, err := c.GetRecordingLocation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecordingLocationResponse(rsp)
 }
 
-// Execute a command synchronously
-// (POST /process/exec)
-func (_ Unimplemented) ProcessExec(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetRecordingManifestWithResponse request returning *GetRecordingManifestResponse
+func (c *ClientWithResponses) GetRecordingManifestWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetRecordingManifestResponse, error) {
+	rsp, err := c.GetRecordingManifest(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecordingManifestResponse(rsp)
 }
 
-// Execute a command asynchronously
-// (POST /process/spawn)
-func (_ Unimplemented) ProcessSpawn(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// StreamRecordingProgressWithResponse request returning *StreamRecordingProgressResponse
+func (c *ClientWithResponses) StreamRecordingProgressWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StreamRecordingProgressResponse, error) {
+	rsp, err := c.StreamRecordingProgress(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamRecordingProgressResponse(rsp)
 }
 
-// Send signal to process
-// (POST /process/{process_id}/kill)
-func (_ Unimplemented) ProcessKill(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetRecordingStatusWithResponse request returning *GetRecordingStatusResponse
+func (c *ClientWithResponses) GetRecordingStatusWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetRecordingStatusResponse, error) {
+	rsp, err := c.GetRecordingStatus(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecordingStatusResponse(rsp)
 }
 
-// Resize a PTY-backed process
-// (POST /process/{process_id}/resize)
-func (_ Unimplemented) ProcessResize(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetScaleToZeroStatusWithResponse request returning *GetScaleToZeroStatusResponse
+func (c *ClientWithResponses) GetScaleToZeroStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetScaleToZeroStatusResponse, error) {
+	rsp, err := c.GetScaleToZeroStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScaleToZeroStatusResponse(rsp)
 }
 
-// Get process status
-// (GET /process/{process_id}/status)
-func (_ Unimplemented) ProcessStatus(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ResumeScaleToZeroWithResponse request returning *ResumeScaleToZeroResponse
+func (c *ClientWithResponses) ResumeScaleToZeroWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ResumeScaleToZeroResponse, error) {
+	rsp, err := c.ResumeScaleToZero(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeScaleToZeroResponse(rsp)
 }
 
-// Write to process stdin
-// (POST /process/{process_id}/stdin)
-func (_ Unimplemented) ProcessStdin(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// PauseScaleToZeroWithResponse request returning *PauseScaleToZeroResponse
+func (c *ClientWithResponses) PauseScaleToZeroWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PauseScaleToZeroResponse, error) {
+	rsp, err := c.PauseScaleToZero(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseScaleToZeroResponse(rsp)
 }
 
-// Stream process stdout over SSE
-// (GET /process/{process_id}/stdout/stream)
-func (_ Unimplemented) ProcessStdoutStream(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ParseSendCDPCommandResponse parses an HTTP response from a SendCDPCommandWithResponse call
+func ParseSendCDPCommandResponse(rsp *http.Response) (*SendCDPCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Execute TEE+MPC proof protocol to generate a verifiable claim
-// (POST /reclaim/prove)
-func (_ Unimplemented) ReclaimProve(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	response := &SendCDPCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// Delete a previously recorded video file
-// (POST /recording/delete)
-func (_ Unimplemented) DeleteRecording(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CDPCommandResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Download the most recently recorded video file
-// (GET /recording/download)
-func (_ Unimplemented) DownloadRecording(w http.ResponseWriter, r *http.Request, params DownloadRecordingParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// List all recorders
-// (GET /recording/list)
-func (_ Unimplemented) ListRecorders(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// Start a screen recording. Only one recording per ID can be registered at a time.
-// (POST /recording/start)
-func (_ Unimplemented) StartRecording(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-// Stop the recording
-// (POST /recording/stop)
-func (_ Unimplemented) StopRecording(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
-
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
-
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// PatchChromiumFlags operation middleware
-func (siw *ServerInterfaceWrapper) PatchChromiumFlags(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchChromiumFlags(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 504:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON504 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// PatchChromiumPolicies operation middleware
-func (siw *ServerInterfaceWrapper) PatchChromiumPolicies(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchChromiumPolicies(w, r)
-	}))
+// ParseGetChromiumCookiesResponse parses an HTTP response from a GetChromiumCookiesWithResponse call
+func ParseGetChromiumCookiesResponse(rsp *http.Response) (*GetChromiumCookiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &GetChromiumCookiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChromiumCookies
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// UploadExtensionsAndRestart operation middleware
-func (siw *ServerInterfaceWrapper) UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request) {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadExtensionsAndRestart(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// BatchComputerAction operation middleware
-func (siw *ServerInterfaceWrapper) BatchComputerAction(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BatchComputerAction(w, r)
-	}))
+// ParseSetChromiumCookiesResponse parses an HTTP response from a SetChromiumCookiesWithResponse call
+func ParseSetChromiumCookiesResponse(rsp *http.Response) (*SetChromiumCookiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &SetChromiumCookiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SetChromiumCookiesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// ClickMouse operation middleware
-func (siw *ServerInterfaceWrapper) ClickMouse(w http.ResponseWriter, r *http.Request) {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ClickMouse(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// ReadClipboard operation middleware
-func (siw *ServerInterfaceWrapper) ReadClipboard(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadClipboard(w, r)
-	}))
+// ParseSetChromiumEmulationResponse parses an HTTP response from a SetChromiumEmulationWithResponse call
+func ParseSetChromiumEmulationResponse(rsp *http.Response) (*SetChromiumEmulationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &SetChromiumEmulationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChromiumEmulation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// WriteClipboard operation middleware
-func (siw *ServerInterfaceWrapper) WriteClipboard(w http.ResponseWriter, r *http.Request) {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WriteClipboard(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// SetCursor operation middleware
-func (siw *ServerInterfaceWrapper) SetCursor(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCursor(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// ParseGetExtensionsResponse parses an HTTP response from a GetExtensionsWithResponse call
+func ParseGetExtensionsResponse(rsp *http.Response) (*GetExtensionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response := &GetExtensionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// DragMouse operation middleware
-func (siw *ServerInterfaceWrapper) DragMouse(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExtensionInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DragMouse(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// GetMousePosition operation middleware
-func (siw *ServerInterfaceWrapper) GetMousePosition(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMousePosition(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// ParsePatchChromiumFlagsResponse parses an HTTP response from a PatchChromiumFlagsWithResponse call
+func ParsePatchChromiumFlagsResponse(rsp *http.Response) (*PatchChromiumFlagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response := &PatchChromiumFlagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// MoveMouse operation middleware
-func (siw *ServerInterfaceWrapper) MoveMouse(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MoveMouse(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// PressKey operation middleware
-func (siw *ServerInterfaceWrapper) PressKey(w http.ResponseWriter, r *http.Request) {
+// ParsePatchChromiumPoliciesResponse parses an HTTP response from a PatchChromiumPoliciesWithResponse call
+func ParsePatchChromiumPoliciesResponse(rsp *http.Response) (*PatchChromiumPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PressKey(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &PatchChromiumPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// TakeScreenshot operation middleware
-func (siw *ServerInterfaceWrapper) TakeScreenshot(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TakeScreenshot(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// Scroll operation middleware
-func (siw *ServerInterfaceWrapper) Scroll(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Scroll(w, r)
-	}))
+// ParseGetChromiumTargetsResponse parses an HTTP response from a GetChromiumTargetsWithResponse call
+func ParseGetChromiumTargetsResponse(rsp *http.Response) (*GetChromiumTargetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &GetChromiumTargetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChromiumTargets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// TypeText operation middleware
-func (siw *ServerInterfaceWrapper) TypeText(w http.ResponseWriter, r *http.Request) {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TypeText(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// PatchDisplay operation middleware
-func (siw *ServerInterfaceWrapper) PatchDisplay(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchDisplay(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// ParseUploadExtensionsAndRestartResponse parses an HTTP response from a UploadExtensionsAndRestartWithResponse call
+func ParseUploadExtensionsAndRestartResponse(rsp *http.Response) (*UploadExtensionsAndRestartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	response := &UploadExtensionsAndRestartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// CreateDirectory operation middleware
-func (siw *ServerInterfaceWrapper) CreateDirectory(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDirectory(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// DeleteDirectory operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDirectory(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDirectory(w, r)
-	}))
+// ParseSetChromiumViewportResponse parses an HTTP response from a SetChromiumViewportWithResponse call
+func ParseSetChromiumViewportResponse(rsp *http.Response) (*SetChromiumViewportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &SetChromiumViewportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChromiumViewport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// DeleteFile operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFile(w http.ResponseWriter, r *http.Request) {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFile(w, r)
-	}))
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return response, nil
 }
 
-// DownloadDirZip operation middleware
-func (siw *ServerInterfaceWrapper) DownloadDirZip(w http.ResponseWriter, r *http.Request) {
+// ParseWarmupChromiumResponse parses an HTTP response from a WarmupChromiumWithResponse call
+func ParseWarmupChromiumResponse(rsp *http.Response) (*WarmupChromiumResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
+	response := &WarmupChromiumResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DownloadDirZipParams
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WarmupResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// ------------- Required query parameter "path" -------------
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
 	}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	return response, nil
+}
+
+// ParseBatchComputerActionResponse parses an HTTP response from a BatchComputerActionWithResponse call
+func ParseBatchComputerActionResponse(rsp *http.Response) (*BatchComputerActionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
+		return nil, err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DownloadDirZip(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	response := &BatchComputerActionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// DownloadDirZstd operation middleware
-func (siw *ServerInterfaceWrapper) DownloadDirZstd(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DownloadDirZstdParams
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	// ------------- Required query parameter "path" -------------
+	}
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	return response, nil
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
+// ParseClickMouseResponse parses an HTTP response from a ClickMouseWithResponse call
+func ParseClickMouseResponse(rsp *http.Response) (*ClickMouseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	response := &ClickMouseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadClipboardResponse parses an HTTP response from a ReadClipboardWithResponse call
+func ParseReadClipboardResponse(rsp *http.Response) (*ReadClipboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
+		return nil, err
+	}
+
+	response := &ReadClipboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClipboardContent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWriteClipboardResponse parses an HTTP response from a WriteClipboardWithResponse call
+func ParseWriteClipboardResponse(rsp *http.Response) (*WriteClipboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WriteClipboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetCursorResponse parses an HTTP response from a SetCursorWithResponse call
+func ParseSetCursorResponse(rsp *http.Response) (*SetCursorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetCursorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDragMouseResponse parses an HTTP response from a DragMouseWithResponse call
+func ParseDragMouseResponse(rsp *http.Response) (*DragMouseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DragMouseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMousePositionResponse parses an HTTP response from a GetMousePositionWithResponse call
+func ParseGetMousePositionResponse(rsp *http.Response) (*GetMousePositionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMousePositionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MousePositionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveMouseResponse parses an HTTP response from a MoveMouseWithResponse call
+func ParseMoveMouseResponse(rsp *http.Response) (*MoveMouseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveMouseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePressKeyResponse parses an HTTP response from a PressKeyWithResponse call
+func ParsePressKeyResponse(rsp *http.Response) (*PressKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PressKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	// ------------- Optional query parameter "compression_level" -------------
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTakeScreenshotResponse parses an HTTP response from a TakeScreenshotWithResponse call
+func ParseTakeScreenshotResponse(rsp *http.Response) (*TakeScreenshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TakeScreenshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseScrollResponse parses an HTTP response from a ScrollWithResponse call
+func ParseScrollResponse(rsp *http.Response) (*ScrollResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ScrollResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTypeTextResponse parses an HTTP response from a TypeTextWithResponse call
+func ParseTypeTextResponse(rsp *http.Response) (*TypeTextResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TypeTextResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDisplayInfoResponse parses an HTTP response from a GetDisplayInfoWithResponse call
+func ParseGetDisplayInfoResponse(rsp *http.Response) (*GetDisplayInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDisplayInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisplayInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchDisplayResponse parses an HTTP response from a PatchDisplayWithResponse call
+func ParsePatchDisplayResponse(rsp *http.Response) (*PatchDisplayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchDisplayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DisplayConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDirectoryResponse parses an HTTP response from a CreateDirectoryWithResponse call
+func ParseCreateDirectoryResponse(rsp *http.Response) (*CreateDirectoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDirectoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteDirectoryResponse parses an HTTP response from a DeleteDirectoryWithResponse call
+func ParseDeleteDirectoryResponse(rsp *http.Response) (*DeleteDirectoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteDirectoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFileResponse parses an HTTP response from a DeleteFileWithResponse call
+func ParseDeleteFileResponse(rsp *http.Response) (*DeleteFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadDirZipResponse parses an HTTP response from a DownloadDirZipWithResponse call
+func ParseDownloadDirZipResponse(rsp *http.Response) (*DownloadDirZipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadDirZipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadDirZstdResponse parses an HTTP response from a DownloadDirZstdWithResponse call
+func ParseDownloadDirZstdResponse(rsp *http.Response) (*DownloadDirZstdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadDirZstdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseFileInfoResponse parses an HTTP response from a FileInfoWithResponse call
+func ParseFileInfoResponse(rsp *http.Response) (*FileInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FileInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FileInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFilesResponse parses an HTTP response from a ListFilesWithResponse call
+func ParseListFilesResponse(rsp *http.Response) (*ListFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ListFiles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMovePathResponse parses an HTTP response from a MovePathWithResponse call
+func ParseMovePathResponse(rsp *http.Response) (*MovePathResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MovePathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReadFileResponse parses an HTTP response from a ReadFileWithResponse call
+func ParseReadFileResponse(rsp *http.Response) (*ReadFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReadFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetFilePermissionsResponse parses an HTTP response from a SetFilePermissionsWithResponse call
+func ParseSetFilePermissionsResponse(rsp *http.Response) (*SetFilePermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetFilePermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadFilesResponse parses an HTTP response from a UploadFilesWithResponse call
+func ParseUploadFilesResponse(rsp *http.Response) (*UploadFilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadZipResponse parses an HTTP response from a UploadZipWithResponse call
+func ParseUploadZipResponse(rsp *http.Response) (*UploadZipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadZipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadZstdResponse parses an HTTP response from a UploadZstdWithResponse call
+func ParseUploadZstdResponse(rsp *http.Response) (*UploadZstdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadZstdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartFsWatchResponse parses an HTTP response from a StartFsWatchWithResponse call
+func ParseStartFsWatchResponse(rsp *http.Response) (*StartFsWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartFsWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			// WatchId Unique identifier for the directory watch
+			WatchId *string `json:"watch_id,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopFsWatchResponse parses an HTTP response from a StopFsWatchWithResponse call
+func ParseStopFsWatchResponse(rsp *http.Response) (*StopFsWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopFsWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamFsEventsResponse parses an HTTP response from a StreamFsEventsWithResponse call
+func ParseStreamFsEventsResponse(rsp *http.Response) (*StreamFsEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamFsEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWriteFileResponse parses an HTTP response from a WriteFileWithResponse call
+func ParseWriteFileResponse(rsp *http.Response) (*WriteFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WriteFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLogsResponse parses an HTTP response from a GetLogsWithResponse call
+func ParseGetLogsResponse(rsp *http.Response) (*GetLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ServerLogEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogsStreamResponse parses an HTTP response from a LogsStreamWithResponse call
+func ParseLogsStreamResponse(rsp *http.Response) (*LogsStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseExecutePlaywrightCodeResponse parses an HTTP response from a ExecutePlaywrightCodeWithResponse call
+func ParseExecutePlaywrightCodeResponse(rsp *http.Response) (*ExecutePlaywrightCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExecutePlaywrightCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExecutePlaywrightResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessExecResponse parses an HTTP response from a ProcessExecWithResponse call
+func ParseProcessExecResponse(rsp *http.Response) (*ProcessExecResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessExecResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessExecResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessSpawnResponse parses an HTTP response from a ProcessSpawnWithResponse call
+func ParseProcessSpawnResponse(rsp *http.Response) (*ProcessSpawnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessSpawnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessSpawnResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessKillResponse parses an HTTP response from a ProcessKillWithResponse call
+func ParseProcessKillResponse(rsp *http.Response) (*ProcessKillResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessKillResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessResizeResponse parses an HTTP response from a ProcessResizeWithResponse call
+func ParseProcessResizeResponse(rsp *http.Response) (*ProcessResizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessResizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessSignalResponse parses an HTTP response from a ProcessSignalWithResponse call
+func ParseProcessSignalResponse(rsp *http.Response) (*ProcessSignalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessSignalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessStatusResponse parses an HTTP response from a ProcessStatusWithResponse call
+func ParseProcessStatusResponse(rsp *http.Response) (*ProcessStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessStdinResponse parses an HTTP response from a ProcessStdinWithResponse call
+func ParseProcessStdinResponse(rsp *http.Response) (*ProcessStdinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessStdinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProcessStdinResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessStdoutStreamResponse parses an HTTP response from a ProcessStdoutStreamWithResponse call
+func ParseProcessStdoutStreamResponse(rsp *http.Response) (*ProcessStdoutStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessStdoutStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConfigResponse parses an HTTP response from a GetConfigWithResponse call
+func ParseGetConfigResponse(rsp *http.Response) (*GetConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ServerConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCircuitStatusResponse parses an HTTP response from a GetCircuitStatusWithResponse call
+func ParseGetCircuitStatusResponse(rsp *http.Response) (*GetCircuitStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCircuitStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CircuitStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReclaimProveResponse parses an HTTP response from a ReclaimProveWithResponse call
+func ParseReclaimProveResponse(rsp *http.Response) (*ReclaimProveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReclaimProveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReclaimProveResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProofStatsResponse parses an HTTP response from a GetProofStatsWithResponse call
+func ParseGetProofStatsResponse(rsp *http.Response) (*GetProofStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProofStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProofStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCleanupResponse parses an HTTP response from a CleanupWithResponse call
+func ParseCleanupResponse(rsp *http.Response) (*CleanupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CleanupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CleanupResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRecordingResponse parses an HTTP response from a DeleteRecordingWithResponse call
+func ParseDeleteRecordingResponse(rsp *http.Response) (*DeleteRecordingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRecordingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadRecordingResponse parses an HTTP response from a DownloadRecordingWithResponse call
+func ParseDownloadRecordingResponse(rsp *http.Response) (*DownloadRecordingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadRecordingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRecordersResponse parses an HTTP response from a ListRecordersWithResponse call
+func ParseListRecordersResponse(rsp *http.Response) (*ListRecordersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRecordersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RecorderInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecordingQueueResponse parses an HTTP response from a GetRecordingQueueWithResponse call
+func ParseGetRecordingQueueResponse(rsp *http.Response) (*GetRecordingQueueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecordingQueueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecordingQueueStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartRecordingResponse parses an HTTP response from a StartRecordingWithResponse call
+func ParseStartRecordingResponse(rsp *http.Response) (*StartRecordingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartRecordingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StartRecordingDryRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 507:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON507 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopRecordingResponse parses an HTTP response from a StopRecordingWithResponse call
+func ParseStopRecordingResponse(rsp *http.Response) (*StopRecordingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopRecordingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConvertRecordingResponse parses an HTTP response from a ConvertRecordingWithResponse call
+func ParseConvertRecordingResponse(rsp *http.Response) (*ConvertRecordingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConvertRecordingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecordingLocationResponse parses an HTTP response from a GetRecordingLocationWithResponse call
+func ParseGetRecordingLocationResponse(rsp *http.Response) (*GetRecordingLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecordingLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecordingLocation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecordingManifestResponse parses an HTTP response from a GetRecordingManifestWithResponse call
+func ParseGetRecordingManifestResponse(rsp *http.Response) (*GetRecordingManifestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecordingManifestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecordingManifest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamRecordingProgressResponse parses an HTTP response from a StreamRecordingProgressWithResponse call
+func ParseStreamRecordingProgressResponse(rsp *http.Response) (*StreamRecordingProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamRecordingProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRecordingStatusResponse parses an HTTP response from a GetRecordingStatusWithResponse call
+func ParseGetRecordingStatusResponse(rsp *http.Response) (*GetRecordingStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecordingStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecordingStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScaleToZeroStatusResponse parses an HTTP response from a GetScaleToZeroStatusWithResponse call
+func ParseGetScaleToZeroStatusResponse(rsp *http.Response) (*GetScaleToZeroStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScaleToZeroStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScaleToZeroStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumeScaleToZeroResponse parses an HTTP response from a ResumeScaleToZeroWithResponse call
+func ParseResumeScaleToZeroResponse(rsp *http.Response) (*ResumeScaleToZeroResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumeScaleToZeroResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScaleToZeroStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePauseScaleToZeroResponse parses an HTTP response from a PauseScaleToZeroWithResponse call
+func ParsePauseScaleToZeroResponse(rsp *http.Response) (*PauseScaleToZeroResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseScaleToZeroResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScaleToZeroStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Send a single CDP command to Chromium
+	// (POST /chromium/cdp)
+	SendCDPCommand(w http.ResponseWriter, r *http.Request)
+	// Export all browser cookies
+	// (GET /chromium/cookies)
+	GetChromiumCookies(w http.ResponseWriter, r *http.Request)
+	// Import cookies into the browser
+	// (POST /chromium/cookies)
+	SetChromiumCookies(w http.ResponseWriter, r *http.Request)
+	// Override Chromium's geolocation, timezone and locale
+	// (POST /chromium/emulation)
+	SetChromiumEmulation(w http.ResponseWriter, r *http.Request)
+	// List installed extensions with integrity metadata
+	// (GET /chromium/extensions)
+	GetExtensions(w http.ResponseWriter, r *http.Request, params GetExtensionsParams)
+	// Update Chromium launch flags and restart
+	// (PATCH /chromium/flags)
+	PatchChromiumFlags(w http.ResponseWriter, r *http.Request)
+	// Update Chromium enterprise policies and restart
+	// (PATCH /chromium/policies)
+	PatchChromiumPolicies(w http.ResponseWriter, r *http.Request)
+	// List Chromium's CDP targets
+	// (GET /chromium/targets)
+	GetChromiumTargets(w http.ResponseWriter, r *http.Request)
+	// Upload one or more unpacked extensions (as zips) and restart Chromium
+	// (POST /chromium/upload-extensions-and-restart)
+	UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request)
+	// Set Chromium's viewport size and scale at runtime
+	// (POST /chromium/viewport)
+	SetChromiumViewport(w http.ResponseWriter, r *http.Request)
+	// Warm up Chromium so the first CDP client connects quickly
+	// (POST /chromium/warmup)
+	WarmupChromium(w http.ResponseWriter, r *http.Request)
+	// Execute a batch of computer actions sequentially
+	// (POST /computer/batch)
+	BatchComputerAction(w http.ResponseWriter, r *http.Request)
+	// Simulate a mouse click action on the host computer
+	// (POST /computer/click_mouse)
+	ClickMouse(w http.ResponseWriter, r *http.Request)
+	// Read text from the system clipboard
+	// (POST /computer/clipboard/read)
+	ReadClipboard(w http.ResponseWriter, r *http.Request)
+	// Write text to the system clipboard
+	// (POST /computer/clipboard/write)
+	WriteClipboard(w http.ResponseWriter, r *http.Request)
+	// Hide or show the cursor
+	// (POST /computer/cursor)
+	SetCursor(w http.ResponseWriter, r *http.Request)
+	// Drag the mouse along a path
+	// (POST /computer/drag_mouse)
+	DragMouse(w http.ResponseWriter, r *http.Request)
+	// Get the current mouse cursor position on the host computer
+	// (POST /computer/get_mouse_position)
+	GetMousePosition(w http.ResponseWriter, r *http.Request)
+	// Move the mouse cursor to the specified coordinates on the host computer
+	// (POST /computer/move_mouse)
+	MoveMouse(w http.ResponseWriter, r *http.Request)
+	// Press one or more keys on the host computer
+	// (POST /computer/press_key)
+	PressKey(w http.ResponseWriter, r *http.Request)
+	// Capture a screenshot of the host computer
+	// (POST /computer/screenshot)
+	TakeScreenshot(w http.ResponseWriter, r *http.Request)
+	// Scroll the mouse wheel at a position on the host computer
+	// (POST /computer/scroll)
+	Scroll(w http.ResponseWriter, r *http.Request)
+	// Type text on the host computer
+	// (POST /computer/type)
+	TypeText(w http.ResponseWriter, r *http.Request)
+	// Get the server's non-sensitive configuration
+	// (GET /config)
+	GetConfig(w http.ResponseWriter, r *http.Request)
+	// Get the display resolution and color depth
+	// (GET /display)
+	GetDisplayInfo(w http.ResponseWriter, r *http.Request)
+	// Update display configuration
+	// (PATCH /display)
+	PatchDisplay(w http.ResponseWriter, r *http.Request)
+	// Create a new directory
+	// (PUT /fs/create_directory)
+	CreateDirectory(w http.ResponseWriter, r *http.Request)
+	// Delete a directory
+	// (PUT /fs/delete_directory)
+	DeleteDirectory(w http.ResponseWriter, r *http.Request)
+	// Delete a file
+	// (PUT /fs/delete_file)
+	DeleteFile(w http.ResponseWriter, r *http.Request)
+	// Download a directory as a ZIP archive
+	// (GET /fs/download_dir_zip)
+	DownloadDirZip(w http.ResponseWriter, r *http.Request, params DownloadDirZipParams)
+	// Download a directory as a tar.zst archive
+	// (GET /fs/download_dir_zstd)
+	DownloadDirZstd(w http.ResponseWriter, r *http.Request, params DownloadDirZstdParams)
+	// Get information about a file or directory
+	// (GET /fs/file_info)
+	FileInfo(w http.ResponseWriter, r *http.Request, params FileInfoParams)
+	// List files in a directory
+	// (GET /fs/list_files)
+	ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams)
+	// Move or rename a file or directory
+	// (PUT /fs/move)
+	MovePath(w http.ResponseWriter, r *http.Request)
+	// Read file contents
+	// (GET /fs/read_file)
+	ReadFile(w http.ResponseWriter, r *http.Request, params ReadFileParams)
+	// Set file or directory permissions/ownership
+	// (PUT /fs/set_file_permissions)
+	SetFilePermissions(w http.ResponseWriter, r *http.Request)
+	// Upload one or more files
+	// (POST /fs/upload)
+	UploadFiles(w http.ResponseWriter, r *http.Request)
+	// Upload a zip archive and extract it
+	// (POST /fs/upload_zip)
+	UploadZip(w http.ResponseWriter, r *http.Request)
+	// Upload a tar.zst archive and extract it
+	// (POST /fs/upload_zstd)
+	UploadZstd(w http.ResponseWriter, r *http.Request)
+	// Watch a directory for changes
+	// (POST /fs/watch)
+	StartFsWatch(w http.ResponseWriter, r *http.Request)
+	// Stop watching a directory
+	// (DELETE /fs/watch/{watch_id})
+	StopFsWatch(w http.ResponseWriter, r *http.Request, watchId string)
+	// Stream filesystem events for a watch
+	// (GET /fs/watch/{watch_id}/events)
+	StreamFsEvents(w http.ResponseWriter, r *http.Request, watchId string)
+	// Write or create a file
+	// (PUT /fs/write_file)
+	WriteFile(w http.ResponseWriter, r *http.Request, params WriteFileParams)
+	// Get recent server log entries
+	// (GET /logs)
+	GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams)
+	// Stream logs over SSE
+	// (GET /logs/stream)
+	LogsStream(w http.ResponseWriter, r *http.Request, params LogsStreamParams)
+	// Execute Playwright/TypeScript code against the browser
+	// (POST /playwright/execute)
+	ExecutePlaywrightCode(w http.ResponseWriter, r *http.Request)
+	// Execute a command synchronously
+	// (POST /process/exec)
+	ProcessExec(w http.ResponseWriter, r *http.Request)
+	// Execute a command asynchronously
+	// (POST /process/spawn)
+	ProcessSpawn(w http.ResponseWriter, r *http.Request)
+	// Send signal to process
+	// (POST /process/{process_id}/kill)
+	ProcessKill(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
+	// Resize a PTY-backed process
+	// (POST /process/{process_id}/resize)
+	ProcessResize(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
+	// Send a signal to a process's job
+	// (POST /process/{process_id}/signal)
+	ProcessSignal(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
+	// Get process status
+	// (GET /process/{process_id}/status)
+	ProcessStatus(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
+	// Write to process stdin
+	// (POST /process/{process_id}/stdin)
+	ProcessStdin(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
+	// Stream process stdout over SSE
+	// (GET /process/{process_id}/stdout/stream)
+	ProcessStdoutStream(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID)
+	// Get ZK circuit initialization state and memory footprint
+	// (GET /reclaim/circuits)
+	GetCircuitStatus(w http.ResponseWriter, r *http.Request)
+	// Execute TEE+MPC proof protocol to generate a verifiable claim
+	// (POST /reclaim/prove)
+	ReclaimProve(w http.ResponseWriter, r *http.Request)
+	// Get aggregate proof statistics
+	// (GET /reclaim/stats)
+	GetProofStats(w http.ResponseWriter, r *http.Request)
+	// Remove orphaned recording and temp files
+	// (POST /recording/cleanup)
+	Cleanup(w http.ResponseWriter, r *http.Request, params CleanupParams)
+	// Delete a previously recorded video file
+	// (POST /recording/delete)
+	DeleteRecording(w http.ResponseWriter, r *http.Request)
+	// Download the most recently recorded video file
+	// (GET /recording/download)
+	DownloadRecording(w http.ResponseWriter, r *http.Request, params DownloadRecordingParams)
+	// List all recorders
+	// (GET /recording/list)
+	ListRecorders(w http.ResponseWriter, r *http.Request, params ListRecordersParams)
+	// Get recording concurrency and start queue depth
+	// (GET /recording/queue)
+	GetRecordingQueue(w http.ResponseWriter, r *http.Request)
+	// Start a screen recording. Only one recording per ID can be registered at a time.
+	// (POST /recording/start)
+	StartRecording(w http.ResponseWriter, r *http.Request, params StartRecordingParams)
+	// Stop the recording
+	// (POST /recording/stop)
+	StopRecording(w http.ResponseWriter, r *http.Request)
+	// Convert a recording to an animated GIF
+	// (GET /recordings/{id}/gif)
+	ConvertRecording(w http.ResponseWriter, r *http.Request, id string, params ConvertRecordingParams)
+	// Get where a recording is stored
+	// (GET /recordings/{id}/location)
+	GetRecordingLocation(w http.ResponseWriter, r *http.Request, id string)
+	// Get a recording's manifest
+	// (GET /recordings/{id}/manifest)
+	GetRecordingManifest(w http.ResponseWriter, r *http.Request, id string)
+	// Stream recording progress
+	// (GET /recordings/{id}/progress)
+	StreamRecordingProgress(w http.ResponseWriter, r *http.Request, id string)
+	// Get recording status
+	// (GET /recordings/{id}/status)
+	GetRecordingStatus(w http.ResponseWriter, r *http.Request, id string)
+	// Get whether scale-to-zero is suppressed and why
+	// (GET /scale-to-zero)
+	GetScaleToZeroStatus(w http.ResponseWriter, r *http.Request)
+	// Resume scale-to-zero
+	// (DELETE /scale-to-zero/pause)
+	ResumeScaleToZero(w http.ResponseWriter, r *http.Request)
+	// Pause scale-to-zero
+	// (POST /scale-to-zero/pause)
+	PauseScaleToZero(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Send a single CDP command to Chromium
+// (POST /chromium/cdp)
+func (_ Unimplemented) SendCDPCommand(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export all browser cookies
+// (GET /chromium/cookies)
+func (_ Unimplemented) GetChromiumCookies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import cookies into the browser
+// (POST /chromium/cookies)
+func (_ Unimplemented) SetChromiumCookies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Override Chromium's geolocation, timezone and locale
+// (POST /chromium/emulation)
+func (_ Unimplemented) SetChromiumEmulation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List installed extensions with integrity metadata
+// (GET /chromium/extensions)
+func (_ Unimplemented) GetExtensions(w http.ResponseWriter, r *http.Request, params GetExtensionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Chromium launch flags and restart
+// (PATCH /chromium/flags)
+func (_ Unimplemented) PatchChromiumFlags(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Chromium enterprise policies and restart
+// (PATCH /chromium/policies)
+func (_ Unimplemented) PatchChromiumPolicies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Chromium's CDP targets
+// (GET /chromium/targets)
+func (_ Unimplemented) GetChromiumTargets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload one or more unpacked extensions (as zips) and restart Chromium
+// (POST /chromium/upload-extensions-and-restart)
+func (_ Unimplemented) UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set Chromium's viewport size and scale at runtime
+// (POST /chromium/viewport)
+func (_ Unimplemented) SetChromiumViewport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Warm up Chromium so the first CDP client connects quickly
+// (POST /chromium/warmup)
+func (_ Unimplemented) WarmupChromium(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute a batch of computer actions sequentially
+// (POST /computer/batch)
+func (_ Unimplemented) BatchComputerAction(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Simulate a mouse click action on the host computer
+// (POST /computer/click_mouse)
+func (_ Unimplemented) ClickMouse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read text from the system clipboard
+// (POST /computer/clipboard/read)
+func (_ Unimplemented) ReadClipboard(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Write text to the system clipboard
+// (POST /computer/clipboard/write)
+func (_ Unimplemented) WriteClipboard(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Hide or show the cursor
+// (POST /computer/cursor)
+func (_ Unimplemented) SetCursor(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Drag the mouse along a path
+// (POST /computer/drag_mouse)
+func (_ Unimplemented) DragMouse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current mouse cursor position on the host computer
+// (POST /computer/get_mouse_position)
+func (_ Unimplemented) GetMousePosition(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move the mouse cursor to the specified coordinates on the host computer
+// (POST /computer/move_mouse)
+func (_ Unimplemented) MoveMouse(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Press one or more keys on the host computer
+// (POST /computer/press_key)
+func (_ Unimplemented) PressKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Capture a screenshot of the host computer
+// (POST /computer/screenshot)
+func (_ Unimplemented) TakeScreenshot(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Scroll the mouse wheel at a position on the host computer
+// (POST /computer/scroll)
+func (_ Unimplemented) Scroll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Type text on the host computer
+// (POST /computer/type)
+func (_ Unimplemented) TypeText(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the server's non-sensitive configuration
+// (GET /config)
+func (_ Unimplemented) GetConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the display resolution and color depth
+// (GET /display)
+func (_ Unimplemented) GetDisplayInfo(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update display configuration
+// (PATCH /display)
+func (_ Unimplemented) PatchDisplay(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new directory
+// (PUT /fs/create_directory)
+func (_ Unimplemented) CreateDirectory(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a directory
+// (PUT /fs/delete_directory)
+func (_ Unimplemented) DeleteDirectory(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a file
+// (PUT /fs/delete_file)
+func (_ Unimplemented) DeleteFile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a directory as a ZIP archive
+// (GET /fs/download_dir_zip)
+func (_ Unimplemented) DownloadDirZip(w http.ResponseWriter, r *http.Request, params DownloadDirZipParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a directory as a tar.zst archive
+// (GET /fs/download_dir_zstd)
+func (_ Unimplemented) DownloadDirZstd(w http.ResponseWriter, r *http.Request, params DownloadDirZstdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get information about a file or directory
+// (GET /fs/file_info)
+func (_ Unimplemented) FileInfo(w http.ResponseWriter, r *http.Request, params FileInfoParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List files in a directory
+// (GET /fs/list_files)
+func (_ Unimplemented) ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move or rename a file or directory
+// (PUT /fs/move)
+func (_ Unimplemented) MovePath(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Read file contents
+// (GET /fs/read_file)
+func (_ Unimplemented) ReadFile(w http.ResponseWriter, r *http.Request, params ReadFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set file or directory permissions/ownership
+// (PUT /fs/set_file_permissions)
+func (_ Unimplemented) SetFilePermissions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload one or more files
+// (POST /fs/upload)
+func (_ Unimplemented) UploadFiles(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a zip archive and extract it
+// (POST /fs/upload_zip)
+func (_ Unimplemented) UploadZip(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload a tar.zst archive and extract it
+// (POST /fs/upload_zstd)
+func (_ Unimplemented) UploadZstd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Watch a directory for changes
+// (POST /fs/watch)
+func (_ Unimplemented) StartFsWatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop watching a directory
+// (DELETE /fs/watch/{watch_id})
+func (_ Unimplemented) StopFsWatch(w http.ResponseWriter, r *http.Request, watchId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream filesystem events for a watch
+// (GET /fs/watch/{watch_id}/events)
+func (_ Unimplemented) StreamFsEvents(w http.ResponseWriter, r *http.Request, watchId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Write or create a file
+// (PUT /fs/write_file)
+func (_ Unimplemented) WriteFile(w http.ResponseWriter, r *http.Request, params WriteFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get recent server log entries
+// (GET /logs)
+func (_ Unimplemented) GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream logs over SSE
+// (GET /logs/stream)
+func (_ Unimplemented) LogsStream(w http.ResponseWriter, r *http.Request, params LogsStreamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute Playwright/TypeScript code against the browser
+// (POST /playwright/execute)
+func (_ Unimplemented) ExecutePlaywrightCode(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute a command synchronously
+// (POST /process/exec)
+func (_ Unimplemented) ProcessExec(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute a command asynchronously
+// (POST /process/spawn)
+func (_ Unimplemented) ProcessSpawn(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send signal to process
+// (POST /process/{process_id}/kill)
+func (_ Unimplemented) ProcessKill(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resize a PTY-backed process
+// (POST /process/{process_id}/resize)
+func (_ Unimplemented) ProcessResize(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a signal to a process's job
+// (POST /process/{process_id}/signal)
+func (_ Unimplemented) ProcessSignal(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get process status
+// (GET /process/{process_id}/status)
+func (_ Unimplemented) ProcessStatus(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Write to process stdin
+// (POST /process/{process_id}/stdin)
+func (_ Unimplemented) ProcessStdin(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream process stdout over SSE
+// (GET /process/{process_id}/stdout/stream)
+func (_ Unimplemented) ProcessStdoutStream(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get ZK circuit initialization state and memory footprint
+// (GET /reclaim/circuits)
+func (_ Unimplemented) GetCircuitStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute TEE+MPC proof protocol to generate a verifiable claim
+// (POST /reclaim/prove)
+func (_ Unimplemented) ReclaimProve(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get aggregate proof statistics
+// (GET /reclaim/stats)
+func (_ Unimplemented) GetProofStats(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove orphaned recording and temp files
+// (POST /recording/cleanup)
+func (_ Unimplemented) Cleanup(w http.ResponseWriter, r *http.Request, params CleanupParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a previously recorded video file
+// (POST /recording/delete)
+func (_ Unimplemented) DeleteRecording(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download the most recently recorded video file
+// (GET /recording/download)
+func (_ Unimplemented) DownloadRecording(w http.ResponseWriter, r *http.Request, params DownloadRecordingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all recorders
+// (GET /recording/list)
+func (_ Unimplemented) ListRecorders(w http.ResponseWriter, r *http.Request, params ListRecordersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get recording concurrency and start queue depth
+// (GET /recording/queue)
+func (_ Unimplemented) GetRecordingQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a screen recording. Only one recording per ID can be registered at a time.
+// (POST /recording/start)
+func (_ Unimplemented) StartRecording(w http.ResponseWriter, r *http.Request, params StartRecordingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop the recording
+// (POST /recording/stop)
+func (_ Unimplemented) StopRecording(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Convert a recording to an animated GIF
+// (GET /recordings/{id}/gif)
+func (_ Unimplemented) ConvertRecording(w http.ResponseWriter, r *http.Request, id string, params ConvertRecordingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get where a recording is stored
+// (GET /recordings/{id}/location)
+func (_ Unimplemented) GetRecordingLocation(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a recording's manifest
+// (GET /recordings/{id}/manifest)
+func (_ Unimplemented) GetRecordingManifest(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream recording progress
+// (GET /recordings/{id}/progress)
+func (_ Unimplemented) StreamRecordingProgress(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get recording status
+// (GET /recordings/{id}/status)
+func (_ Unimplemented) GetRecordingStatus(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get whether scale-to-zero is suppressed and why
+// (GET /scale-to-zero)
+func (_ Unimplemented) GetScaleToZeroStatus(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resume scale-to-zero
+// (DELETE /scale-to-zero/pause)
+func (_ Unimplemented) ResumeScaleToZero(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pause scale-to-zero
+// (POST /scale-to-zero/pause)
+func (_ Unimplemented) PauseScaleToZero(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// SendCDPCommand operation middleware
+func (siw *ServerInterfaceWrapper) SendCDPCommand(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendCDPCommand(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChromiumCookies operation middleware
+func (siw *ServerInterfaceWrapper) GetChromiumCookies(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChromiumCookies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetChromiumCookies operation middleware
+func (siw *ServerInterfaceWrapper) SetChromiumCookies(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetChromiumCookies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetChromiumEmulation operation middleware
+func (siw *ServerInterfaceWrapper) SetChromiumEmulation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetChromiumEmulation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExtensions operation middleware
+func (siw *ServerInterfaceWrapper) GetExtensions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExtensionsParams
+
+	// ------------- Optional query parameter "verify" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "verify", r.URL.Query(), &params.Verify, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "verify", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExtensions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchChromiumFlags operation middleware
+func (siw *ServerInterfaceWrapper) PatchChromiumFlags(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchChromiumFlags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchChromiumPolicies operation middleware
+func (siw *ServerInterfaceWrapper) PatchChromiumPolicies(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchChromiumPolicies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChromiumTargets operation middleware
+func (siw *ServerInterfaceWrapper) GetChromiumTargets(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChromiumTargets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadExtensionsAndRestart operation middleware
+func (siw *ServerInterfaceWrapper) UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadExtensionsAndRestart(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetChromiumViewport operation middleware
+func (siw *ServerInterfaceWrapper) SetChromiumViewport(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetChromiumViewport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WarmupChromium operation middleware
+func (siw *ServerInterfaceWrapper) WarmupChromium(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WarmupChromium(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BatchComputerAction operation middleware
+func (siw *ServerInterfaceWrapper) BatchComputerAction(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchComputerAction(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ClickMouse operation middleware
+func (siw *ServerInterfaceWrapper) ClickMouse(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClickMouse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadClipboard operation middleware
+func (siw *ServerInterfaceWrapper) ReadClipboard(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadClipboard(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WriteClipboard operation middleware
+func (siw *ServerInterfaceWrapper) WriteClipboard(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WriteClipboard(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetCursor operation middleware
+func (siw *ServerInterfaceWrapper) SetCursor(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCursor(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DragMouse operation middleware
+func (siw *ServerInterfaceWrapper) DragMouse(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DragMouse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMousePosition operation middleware
+func (siw *ServerInterfaceWrapper) GetMousePosition(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMousePosition(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveMouse operation middleware
+func (siw *ServerInterfaceWrapper) MoveMouse(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveMouse(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PressKey operation middleware
+func (siw *ServerInterfaceWrapper) PressKey(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PressKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TakeScreenshot operation middleware
+func (siw *ServerInterfaceWrapper) TakeScreenshot(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TakeScreenshot(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Scroll operation middleware
+func (siw *ServerInterfaceWrapper) Scroll(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Scroll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TypeText operation middleware
+func (siw *ServerInterfaceWrapper) TypeText(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TypeText(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDisplayInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetDisplayInfo(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDisplayInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchDisplay operation middleware
+func (siw *ServerInterfaceWrapper) PatchDisplay(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchDisplay(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateDirectory operation middleware
+func (siw *ServerInterfaceWrapper) CreateDirectory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDirectory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDirectory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDirectory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDirectory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFile operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFile(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadDirZip operation middleware
+func (siw *ServerInterfaceWrapper) DownloadDirZip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadDirZipParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadDirZip(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadDirZstd operation middleware
+func (siw *ServerInterfaceWrapper) DownloadDirZstd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadDirZstdParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "compression_level" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "compression_level", r.URL.Query(), &params.CompressionLevel, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "compression_level", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadDirZstd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FileInfo operation middleware
+func (siw *ServerInterfaceWrapper) FileInfo(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FileInfoParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FileInfo(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListFiles operation middleware
+func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFilesParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListFiles(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MovePath operation middleware
+func (siw *ServerInterfaceWrapper) MovePath(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MovePath(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadFile operation middleware
+func (siw *ServerInterfaceWrapper) ReadFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadFileParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadFile(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetFilePermissions operation middleware
+func (siw *ServerInterfaceWrapper) SetFilePermissions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetFilePermissions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadFiles operation middleware
+func (siw *ServerInterfaceWrapper) UploadFiles(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadFiles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadZip operation middleware
+func (siw *ServerInterfaceWrapper) UploadZip(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadZip(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadZstd operation middleware
+func (siw *ServerInterfaceWrapper) UploadZstd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadZstd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartFsWatch operation middleware
+func (siw *ServerInterfaceWrapper) StartFsWatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartFsWatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopFsWatch operation middleware
+func (siw *ServerInterfaceWrapper) StopFsWatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "watch_id" -------------
+	var watchId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "watch_id", chi.URLParam(r, "watch_id"), &watchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopFsWatch(w, r, watchId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamFsEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamFsEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "watch_id" -------------
+	var watchId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "watch_id", chi.URLParam(r, "watch_id"), &watchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamFsEvents(w, r, watchId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WriteFile operation middleware
+func (siw *ServerInterfaceWrapper) WriteFile(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WriteFileParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "mode", r.URL.Query(), &params.Mode, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WriteFile(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLogsParams
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "level", r.URL.Query(), &params.Level, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lines" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "lines", r.URL.Query(), &params.Lines, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lines", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogs(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LogsStream operation middleware
+func (siw *ServerInterfaceWrapper) LogsStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogsStreamParams
+
+	// ------------- Required query parameter "source" -------------
+
+	if paramValue := r.URL.Query().Get("source"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "source"})
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "source", r.URL.Query(), &params.Source, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "follow", r.URL.Query(), &params.Follow, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "supervisor_process" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "supervisor_process", r.URL.Query(), &params.SupervisorProcess, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisor_process", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogsStream(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExecutePlaywrightCode operation middleware
+func (siw *ServerInterfaceWrapper) ExecutePlaywrightCode(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecutePlaywrightCode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessExec operation middleware
+func (siw *ServerInterfaceWrapper) ProcessExec(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessExec(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessSpawn operation middleware
+func (siw *ServerInterfaceWrapper) ProcessSpawn(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessSpawn(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessKill operation middleware
+func (siw *ServerInterfaceWrapper) ProcessKill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "process_id" -------------
+	var processId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessKill(w, r, processId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessResize operation middleware
+func (siw *ServerInterfaceWrapper) ProcessResize(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "process_id" -------------
+	var processId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessResize(w, r, processId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessSignal operation middleware
+func (siw *ServerInterfaceWrapper) ProcessSignal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "process_id" -------------
+	var processId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessSignal(w, r, processId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessStatus operation middleware
+func (siw *ServerInterfaceWrapper) ProcessStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "process_id" -------------
+	var processId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessStatus(w, r, processId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessStdin operation middleware
+func (siw *ServerInterfaceWrapper) ProcessStdin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "process_id" -------------
+	var processId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessStdin(w, r, processId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProcessStdoutStream operation middleware
+func (siw *ServerInterfaceWrapper) ProcessStdoutStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "process_id" -------------
+	var processId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProcessStdoutStream(w, r, processId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCircuitStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetCircuitStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCircuitStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReclaimProve operation middleware
+func (siw *ServerInterfaceWrapper) ReclaimProve(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReclaimProve(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProofStats operation middleware
+func (siw *ServerInterfaceWrapper) GetProofStats(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProofStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Cleanup operation middleware
+func (siw *ServerInterfaceWrapper) Cleanup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CleanupParams
+
+	// ------------- Optional query parameter "olderThanSeconds" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "olderThanSeconds", r.URL.Query(), &params.OlderThanSeconds, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "olderThanSeconds", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dryRun", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Cleanup(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRecording operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRecording(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRecording(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadRecording operation middleware
+func (siw *ServerInterfaceWrapper) DownloadRecording(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadRecordingParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "snapshot" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "snapshot", r.URL.Query(), &params.Snapshot, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "snapshot", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Range", valueList[0], &Range, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
+
+	}
+
+	// ------------- Optional header parameter "If-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Range")]; found {
+		var IfRange string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Range", valueList[0], &IfRange, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Range", Err: err})
+			return
+		}
+
+		params.IfRange = &IfRange
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadRecording(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRecorders operation middleware
+func (siw *ServerInterfaceWrapper) ListRecorders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRecordersParams
+
+	// ------------- Optional query parameter "tenant" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tenant", r.URL.Query(), &params.Tenant, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenant", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "tag", r.URL.Query(), &params.Tag, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRecorders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecordingQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetRecordingQueue(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecordingQueue(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartRecording operation middleware
+func (siw *ServerInterfaceWrapper) StartRecording(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartRecordingParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dryRun", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "wait", r.URL.Query(), &params.Wait, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartRecording(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopRecording operation middleware
+func (siw *ServerInterfaceWrapper) StopRecording(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopRecording(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConvertRecording operation middleware
+func (siw *ServerInterfaceWrapper) ConvertRecording(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConvertRecordingParams
+
+	// ------------- Optional query parameter "fps" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "fps", r.URL.Query(), &params.Fps, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fps", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "width" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "width", r.URL.Query(), &params.Width, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "width", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertRecording(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecordingLocation operation middleware
+func (siw *ServerInterfaceWrapper) GetRecordingLocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecordingLocation(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecordingManifest operation middleware
+func (siw *ServerInterfaceWrapper) GetRecordingManifest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecordingManifest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamRecordingProgress operation middleware
+func (siw *ServerInterfaceWrapper) StreamRecordingProgress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamRecordingProgress(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRecordingStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetRecordingStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRecordingStatus(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScaleToZeroStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetScaleToZeroStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScaleToZeroStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeScaleToZero operation middleware
+func (siw *ServerInterfaceWrapper) ResumeScaleToZero(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeScaleToZero(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseScaleToZero operation middleware
+func (siw *ServerInterfaceWrapper) PauseScaleToZero(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseScaleToZero(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chromium/cdp", wrapper.SendCDPCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/chromium/cookies", wrapper.GetChromiumCookies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chromium/cookies", wrapper.SetChromiumCookies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chromium/emulation", wrapper.SetChromiumEmulation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/chromium/extensions", wrapper.GetExtensions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/chromium/flags", wrapper.PatchChromiumFlags)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/chromium/policies", wrapper.PatchChromiumPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/chromium/targets", wrapper.GetChromiumTargets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chromium/upload-extensions-and-restart", wrapper.UploadExtensionsAndRestart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chromium/viewport", wrapper.SetChromiumViewport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chromium/warmup", wrapper.WarmupChromium)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/batch", wrapper.BatchComputerAction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/click_mouse", wrapper.ClickMouse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/clipboard/read", wrapper.ReadClipboard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/clipboard/write", wrapper.WriteClipboard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/cursor", wrapper.SetCursor)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/drag_mouse", wrapper.DragMouse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/get_mouse_position", wrapper.GetMousePosition)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/move_mouse", wrapper.MoveMouse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/press_key", wrapper.PressKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/screenshot", wrapper.TakeScreenshot)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/scroll", wrapper.Scroll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/computer/type", wrapper.TypeText)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/config", wrapper.GetConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/display", wrapper.GetDisplayInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/display", wrapper.PatchDisplay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/fs/create_directory", wrapper.CreateDirectory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/fs/delete_directory", wrapper.DeleteDirectory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/fs/delete_file", wrapper.DeleteFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fs/download_dir_zip", wrapper.DownloadDirZip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fs/download_dir_zstd", wrapper.DownloadDirZstd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fs/file_info", wrapper.FileInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fs/list_files", wrapper.ListFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/fs/move", wrapper.MovePath)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fs/read_file", wrapper.ReadFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/fs/set_file_permissions", wrapper.SetFilePermissions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fs/upload", wrapper.UploadFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fs/upload_zip", wrapper.UploadZip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fs/upload_zstd", wrapper.UploadZstd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fs/watch", wrapper.StartFsWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/fs/watch/{watch_id}", wrapper.StopFsWatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fs/watch/{watch_id}/events", wrapper.StreamFsEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/fs/write_file", wrapper.WriteFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/logs", wrapper.GetLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/logs/stream", wrapper.LogsStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/playwright/execute", wrapper.ExecutePlaywrightCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/process/exec", wrapper.ProcessExec)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/process/spawn", wrapper.ProcessSpawn)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/process/{process_id}/kill", wrapper.ProcessKill)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/process/{process_id}/resize", wrapper.ProcessResize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/process/{process_id}/signal", wrapper.ProcessSignal)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/process/{process_id}/status", wrapper.ProcessStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/process/{process_id}/stdin", wrapper.ProcessStdin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/process/{process_id}/stdout/stream", wrapper.ProcessStdoutStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reclaim/circuits", wrapper.GetCircuitStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reclaim/prove", wrapper.ReclaimProve)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reclaim/stats", wrapper.GetProofStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/recording/cleanup", wrapper.Cleanup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/recording/delete", wrapper.DeleteRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recording/download", wrapper.DownloadRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recording/list", wrapper.ListRecorders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recording/queue", wrapper.GetRecordingQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/recording/start", wrapper.StartRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/recording/stop", wrapper.StopRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/gif", wrapper.ConvertRecording)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/location", wrapper.GetRecordingLocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/manifest", wrapper.GetRecordingManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/progress", wrapper.StreamRecordingProgress)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/recordings/{id}/status", wrapper.GetRecordingStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/scale-to-zero", wrapper.GetScaleToZeroStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/scale-to-zero/pause", wrapper.ResumeScaleToZero)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/scale-to-zero/pause", wrapper.PauseScaleToZero)
+	})
+
+	return r
+}
+
+type BadRequestErrorJSONResponse Error
+
+type ConflictErrorJSONResponse Error
+
+type InternalErrorJSONResponse Error
+
+type NotFoundErrorJSONResponse Error
+
+type SendCDPCommandRequestObject struct {
+	Body *SendCDPCommandJSONRequestBody
+}
+
+type SendCDPCommandResponseObject interface {
+	VisitSendCDPCommandResponse(w http.ResponseWriter) error
+}
+
+type SendCDPCommand200JSONResponse CDPCommandResult
+
+func (response SendCDPCommand200JSONResponse) VisitSendCDPCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCDPCommand400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response SendCDPCommand400JSONResponse) VisitSendCDPCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCDPCommand500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SendCDPCommand500JSONResponse) VisitSendCDPCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SendCDPCommand503JSONResponse Error
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "compression_level", r.URL.Query(), &params.CompressionLevel, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "compression_level", Err: err})
-		return
-	}
+func (response SendCDPCommand503JSONResponse) VisitSendCDPCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DownloadDirZstd(w, r, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type SendCDPCommand504JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response SendCDPCommand504JSONResponse) VisitSendCDPCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// FileInfo operation middleware
-func (siw *ServerInterfaceWrapper) FileInfo(w http.ResponseWriter, r *http.Request) {
+type GetChromiumCookiesRequestObject struct {
+}
 
-	var err error
+type GetChromiumCookiesResponseObject interface {
+	VisitGetChromiumCookiesResponse(w http.ResponseWriter) error
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params FileInfoParams
+type GetChromiumCookies200JSONResponse ChromiumCookies
 
-	// ------------- Required query parameter "path" -------------
+func (response GetChromiumCookies200JSONResponse) VisitGetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
-	}
+type GetChromiumCookies500JSONResponse struct{ InternalErrorJSONResponse }
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+func (response GetChromiumCookies500JSONResponse) VisitGetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FileInfo(w, r, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type GetChromiumCookies503JSONResponse Error
 
-	handler.ServeHTTP(w, r)
+func (response GetChromiumCookies503JSONResponse) VisitGetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ListFiles operation middleware
-func (siw *ServerInterfaceWrapper) ListFiles(w http.ResponseWriter, r *http.Request) {
+type SetChromiumCookiesRequestObject struct {
+	Body *SetChromiumCookiesJSONRequestBody
+}
 
-	var err error
+type SetChromiumCookiesResponseObject interface {
+	VisitSetChromiumCookiesResponse(w http.ResponseWriter) error
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListFilesParams
+type SetChromiumCookies200JSONResponse SetChromiumCookiesResult
 
-	// ------------- Required query parameter "path" -------------
+func (response SetChromiumCookies200JSONResponse) VisitSetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
-	}
+type SetChromiumCookies400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+func (response SetChromiumCookies400JSONResponse) VisitSetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListFiles(w, r, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type SetChromiumCookies500JSONResponse struct{ InternalErrorJSONResponse }
 
-	handler.ServeHTTP(w, r)
+func (response SetChromiumCookies500JSONResponse) VisitSetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// MovePath operation middleware
-func (siw *ServerInterfaceWrapper) MovePath(w http.ResponseWriter, r *http.Request) {
+type SetChromiumCookies503JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MovePath(w, r)
-	}))
+func (response SetChromiumCookies503JSONResponse) VisitSetChromiumCookiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type SetChromiumEmulationRequestObject struct {
+	Body *SetChromiumEmulationJSONRequestBody
 }
 
-// ReadFile operation middleware
-func (siw *ServerInterfaceWrapper) ReadFile(w http.ResponseWriter, r *http.Request) {
+type SetChromiumEmulationResponseObject interface {
+	VisitSetChromiumEmulationResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type SetChromiumEmulation200JSONResponse ChromiumEmulation
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ReadFileParams
+func (response SetChromiumEmulation200JSONResponse) VisitSetChromiumEmulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// ------------- Required query parameter "path" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+type SetChromiumEmulation400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
-	}
+func (response SetChromiumEmulation400JSONResponse) VisitSetChromiumEmulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadFile(w, r, params)
-	}))
+type SetChromiumEmulation500JSONResponse struct{ InternalErrorJSONResponse }
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response SetChromiumEmulation500JSONResponse) VisitSetChromiumEmulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// SetFilePermissions operation middleware
-func (siw *ServerInterfaceWrapper) SetFilePermissions(w http.ResponseWriter, r *http.Request) {
+type SetChromiumEmulation503JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFilePermissions(w, r)
-	}))
+func (response SetChromiumEmulation503JSONResponse) VisitSetChromiumEmulationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetExtensionsRequestObject struct {
+	Params GetExtensionsParams
 }
 
-// UploadFiles operation middleware
-func (siw *ServerInterfaceWrapper) UploadFiles(w http.ResponseWriter, r *http.Request) {
+type GetExtensionsResponseObject interface {
+	VisitGetExtensionsResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadFiles(w, r)
-	}))
+type GetExtensions200JSONResponse []ExtensionInfo
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetExtensions200JSONResponse) VisitGetExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// UploadZip operation middleware
-func (siw *ServerInterfaceWrapper) UploadZip(w http.ResponseWriter, r *http.Request) {
+type GetExtensions500JSONResponse struct{ InternalErrorJSONResponse }
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadZip(w, r)
-	}))
+func (response GetExtensions500JSONResponse) VisitGetExtensionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type PatchChromiumFlagsRequestObject struct {
+	Body *PatchChromiumFlagsJSONRequestBody
 }
 
-// UploadZstd operation middleware
-func (siw *ServerInterfaceWrapper) UploadZstd(w http.ResponseWriter, r *http.Request) {
+type PatchChromiumFlagsResponseObject interface {
+	VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadZstd(w, r)
-	}))
+type PatchChromiumFlags200Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response PatchChromiumFlags200Response) VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	handler.ServeHTTP(w, r)
+type PatchChromiumFlags400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response PatchChromiumFlags400JSONResponse) VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StartFsWatch operation middleware
-func (siw *ServerInterfaceWrapper) StartFsWatch(w http.ResponseWriter, r *http.Request) {
+type PatchChromiumFlags500JSONResponse struct{ InternalErrorJSONResponse }
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartFsWatch(w, r)
-	}))
+func (response PatchChromiumFlags500JSONResponse) VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type PatchChromiumPoliciesRequestObject struct {
+	Body *PatchChromiumPoliciesJSONRequestBody
 }
 
-// StopFsWatch operation middleware
-func (siw *ServerInterfaceWrapper) StopFsWatch(w http.ResponseWriter, r *http.Request) {
+type PatchChromiumPoliciesResponseObject interface {
+	VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type PatchChromiumPolicies200Response struct {
+}
 
-	// ------------- Path parameter "watch_id" -------------
-	var watchId string
+func (response PatchChromiumPolicies200Response) VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "watch_id", chi.URLParam(r, "watch_id"), &watchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch_id", Err: err})
-		return
-	}
+type PatchChromiumPolicies400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StopFsWatch(w, r, watchId)
-	}))
+func (response PatchChromiumPolicies400JSONResponse) VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchChromiumPolicies500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PatchChromiumPolicies500JSONResponse) VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetChromiumTargetsRequestObject struct {
 }
 
-// StreamFsEvents operation middleware
-func (siw *ServerInterfaceWrapper) StreamFsEvents(w http.ResponseWriter, r *http.Request) {
+type GetChromiumTargetsResponseObject interface {
+	VisitGetChromiumTargetsResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type GetChromiumTargets200JSONResponse ChromiumTargets
 
-	// ------------- Path parameter "watch_id" -------------
-	var watchId string
+func (response GetChromiumTargets200JSONResponse) VisitGetChromiumTargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "watch_id", chi.URLParam(r, "watch_id"), &watchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch_id", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StreamFsEvents(w, r, watchId)
-	}))
+type GetChromiumTargets500JSONResponse struct{ InternalErrorJSONResponse }
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetChromiumTargets500JSONResponse) VisitGetChromiumTargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// WriteFile operation middleware
-func (siw *ServerInterfaceWrapper) WriteFile(w http.ResponseWriter, r *http.Request) {
+type GetChromiumTargets503JSONResponse Error
 
-	var err error
+func (response GetChromiumTargets503JSONResponse) VisitGetChromiumTargetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WriteFileParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Required query parameter "path" -------------
+type UploadExtensionsAndRestartRequestObject struct {
+	Body *multipart.Reader
+}
 
-	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+type UploadExtensionsAndRestartResponseObject interface {
+	VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
-		return
-	}
+type UploadExtensionsAndRestart201Response struct {
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+func (response UploadExtensionsAndRestart201Response) VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
 
-	// ------------- Optional query parameter "mode" -------------
+type UploadExtensionsAndRestart400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "mode", r.URL.Query(), &params.Mode, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
-		return
-	}
+func (response UploadExtensionsAndRestart400JSONResponse) VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.WriteFile(w, r, params)
-	}))
+	return json.NewEncoder(w).Encode(response)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type UploadExtensionsAndRestart500JSONResponse struct{ InternalErrorJSONResponse }
 
-	handler.ServeHTTP(w, r)
-}
+func (response UploadExtensionsAndRestart500JSONResponse) VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-// LogsStream operation middleware
-func (siw *ServerInterfaceWrapper) LogsStream(w http.ResponseWriter, r *http.Request) {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	var err error
+type SetChromiumViewportRequestObject struct {
+	Body *SetChromiumViewportJSONRequestBody
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params LogsStreamParams
+type SetChromiumViewportResponseObject interface {
+	VisitSetChromiumViewportResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Required query parameter "source" -------------
+type SetChromiumViewport200JSONResponse ChromiumViewport
 
-	if paramValue := r.URL.Query().Get("source"); paramValue != "" {
+func (response SetChromiumViewport200JSONResponse) VisitSetChromiumViewportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "source"})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "source", r.URL.Query(), &params.Source, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
-		return
-	}
+type SetChromiumViewport400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	// ------------- Optional query parameter "follow" -------------
+func (response SetChromiumViewport400JSONResponse) VisitSetChromiumViewportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "follow", r.URL.Query(), &params.Follow, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "path" -------------
+type SetChromiumViewport500JSONResponse struct{ InternalErrorJSONResponse }
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "path", r.URL.Query(), &params.Path, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
-		return
-	}
+func (response SetChromiumViewport500JSONResponse) VisitSetChromiumViewportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Optional query parameter "supervisor_process" -------------
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "supervisor_process", r.URL.Query(), &params.SupervisorProcess, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "supervisor_process", Err: err})
-		return
-	}
+type SetChromiumViewport503JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LogsStream(w, r, params)
-	}))
+func (response SetChromiumViewport503JSONResponse) VisitSetChromiumViewportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type WarmupChromiumRequestObject struct {
 }
 
-// ExecutePlaywrightCode operation middleware
-func (siw *ServerInterfaceWrapper) ExecutePlaywrightCode(w http.ResponseWriter, r *http.Request) {
+type WarmupChromiumResponseObject interface {
+	VisitWarmupChromiumResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExecutePlaywrightCode(w, r)
-	}))
+type WarmupChromium200JSONResponse WarmupResult
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response WarmupChromium200JSONResponse) VisitWarmupChromiumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ProcessExec operation middleware
-func (siw *ServerInterfaceWrapper) ProcessExec(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessExec(w, r)
-	}))
+type WarmupChromium500JSONResponse struct{ InternalErrorJSONResponse }
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response WarmupChromium500JSONResponse) VisitWarmupChromiumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ProcessSpawn operation middleware
-func (siw *ServerInterfaceWrapper) ProcessSpawn(w http.ResponseWriter, r *http.Request) {
+type WarmupChromium503JSONResponse Error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessSpawn(w, r)
-	}))
+func (response WarmupChromium503JSONResponse) VisitWarmupChromiumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type BatchComputerActionRequestObject struct {
+	Body *BatchComputerActionJSONRequestBody
 }
 
-// ProcessKill operation middleware
-func (siw *ServerInterfaceWrapper) ProcessKill(w http.ResponseWriter, r *http.Request) {
+type BatchComputerActionResponseObject interface {
+	VisitBatchComputerActionResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type BatchComputerAction200Response struct {
+}
 
-	// ------------- Path parameter "process_id" -------------
-	var processId openapi_types.UUID
+func (response BatchComputerAction200Response) VisitBatchComputerActionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
-		return
-	}
+type BatchComputerAction400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessKill(w, r, processId)
-	}))
+func (response BatchComputerAction400JSONResponse) VisitBatchComputerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type BatchComputerAction500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response BatchComputerAction500JSONResponse) VisitBatchComputerActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ProcessResize operation middleware
-func (siw *ServerInterfaceWrapper) ProcessResize(w http.ResponseWriter, r *http.Request) {
+type ClickMouseRequestObject struct {
+	Body *ClickMouseJSONRequestBody
+}
 
-	var err error
+type ClickMouseResponseObject interface {
+	VisitClickMouseResponse(w http.ResponseWriter) error
+}
 
-	// ------------- Path parameter "process_id" -------------
-	var processId openapi_types.UUID
+type ClickMouse200Response struct {
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
-		return
-	}
+func (response ClickMouse200Response) VisitClickMouseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessResize(w, r, processId)
-	}))
+type ClickMouse400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response ClickMouse400JSONResponse) VisitClickMouseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ProcessStatus operation middleware
-func (siw *ServerInterfaceWrapper) ProcessStatus(w http.ResponseWriter, r *http.Request) {
+type ClickMouse500JSONResponse struct{ InternalErrorJSONResponse }
 
-	var err error
+func (response ClickMouse500JSONResponse) VisitClickMouseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "process_id" -------------
-	var processId openapi_types.UUID
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
-		return
-	}
+type ReadClipboardRequestObject struct {
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessStatus(w, r, processId)
-	}))
+type ReadClipboardResponseObject interface {
+	VisitReadClipboardResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type ReadClipboard200JSONResponse ClipboardContent
 
-	handler.ServeHTTP(w, r)
+func (response ReadClipboard200JSONResponse) VisitReadClipboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ProcessStdin operation middleware
-func (siw *ServerInterfaceWrapper) ProcessStdin(w http.ResponseWriter, r *http.Request) {
+type ReadClipboard500JSONResponse struct{ InternalErrorJSONResponse }
 
-	var err error
+func (response ReadClipboard500JSONResponse) VisitReadClipboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// ------------- Path parameter "process_id" -------------
-	var processId openapi_types.UUID
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
-		return
-	}
+type WriteClipboardRequestObject struct {
+	Body *WriteClipboardJSONRequestBody
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessStdin(w, r, processId)
-	}))
+type WriteClipboardResponseObject interface {
+	VisitWriteClipboardResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+type WriteClipboard200Response struct {
+}
 
-	handler.ServeHTTP(w, r)
+func (response WriteClipboard200Response) VisitWriteClipboardResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-// ProcessStdoutStream operation middleware
-func (siw *ServerInterfaceWrapper) ProcessStdoutStream(w http.ResponseWriter, r *http.Request) {
+type WriteClipboard400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	var err error
+func (response WriteClipboard400JSONResponse) VisitWriteClipboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// ------------- Path parameter "process_id" -------------
-	var processId openapi_types.UUID
+	return json.NewEncoder(w).Encode(response)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "process_id", chi.URLParam(r, "process_id"), &processId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "process_id", Err: err})
-		return
-	}
+type WriteClipboard500JSONResponse struct{ InternalErrorJSONResponse }
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessStdoutStream(w, r, processId)
-	}))
+func (response WriteClipboard500JSONResponse) VisitWriteClipboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type SetCursorRequestObject struct {
+	Body *SetCursorJSONRequestBody
 }
 
-// ReclaimProve operation middleware
-func (siw *ServerInterfaceWrapper) ReclaimProve(w http.ResponseWriter, r *http.Request) {
+type SetCursorResponseObject interface {
+	VisitSetCursorResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReclaimProve(w, r)
-	}))
+type SetCursor200JSONResponse OkResponse
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response SetCursor200JSONResponse) VisitSetCursorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DeleteRecording operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRecording(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRecording(w, r)
-	}))
+type SetCursor400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response SetCursor400JSONResponse) VisitSetCursorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// DownloadRecording operation middleware
-func (siw *ServerInterfaceWrapper) DownloadRecording(w http.ResponseWriter, r *http.Request) {
+type SetCursor500JSONResponse struct{ InternalErrorJSONResponse }
 
-	var err error
+func (response SetCursor500JSONResponse) VisitSetCursorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DownloadRecordingParams
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// ------------- Optional query parameter "id" -------------
+type DragMouseRequestObject struct {
+	Body *DragMouseJSONRequestBody
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+type DragMouseResponseObject interface {
+	VisitDragMouseResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DownloadRecording(w, r, params)
-	}))
+type DragMouse200Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response DragMouse200Response) VisitDragMouseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-	handler.ServeHTTP(w, r)
+type DragMouse400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response DragMouse400JSONResponse) VisitDragMouseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ListRecorders operation middleware
-func (siw *ServerInterfaceWrapper) ListRecorders(w http.ResponseWriter, r *http.Request) {
+type DragMouse500JSONResponse struct{ InternalErrorJSONResponse }
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRecorders(w, r)
-	}))
+func (response DragMouse500JSONResponse) VisitDragMouseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler.ServeHTTP(w, r)
+type GetMousePositionRequestObject struct {
 }
 
-// StartRecording operation middleware
-func (siw *ServerInterfaceWrapper) StartRecording(w http.ResponseWriter, r *http.Request) {
+type GetMousePositionResponseObject interface {
+	VisitGetMousePositionResponse(w http.ResponseWriter) error
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartRecording(w, r)
-	}))
+type GetMousePosition200JSONResponse MousePositionResponse
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetMousePosition200JSONResponse) VisitGetMousePositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StopRecording operation middleware
-func (siw *ServerInterfaceWrapper) StopRecording(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StopRecording(w, r)
-	}))
+type GetMousePosition500JSONResponse struct{ InternalErrorJSONResponse }
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetMousePosition500JSONResponse) VisitGetMousePositionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+type MoveMouseRequestObject struct {
+	Body *MoveMouseJSONRequestBody
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+type MoveMouseResponseObject interface {
+	VisitMoveMouseResponse(w http.ResponseWriter) error
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type MoveMouse200Response struct {
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+func (response MoveMouse200Response) VisitMoveMouseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type MoveMouse400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response MoveMouse400JSONResponse) VisitMoveMouseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+type MoveMouse500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response MoveMouse500JSONResponse) VisitMoveMouseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredParamError struct {
-	ParamName string
+type PressKeyRequestObject struct {
+	Body *PressKeyJSONRequestBody
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type PressKeyResponseObject interface {
+	VisitPressKeyResponse(w http.ResponseWriter) error
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type PressKey200Response struct {
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+func (response PressKey200Response) VisitPressKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+type PressKey400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+func (response PressKey400JSONResponse) VisitPressKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+type PressKey500JSONResponse struct{ InternalErrorJSONResponse }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+func (response PressKey500JSONResponse) VisitPressKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type TakeScreenshotRequestObject struct {
+	Body *TakeScreenshotJSONRequestBody
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type TakeScreenshotResponseObject interface {
+	VisitTakeScreenshotResponse(w http.ResponseWriter) error
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type TakeScreenshot200ImagepngResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+func (response TakeScreenshot200ImagepngResponse) VisitTakeScreenshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type TakeScreenshot400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response TakeScreenshot400JSONResponse) VisitTakeScreenshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/chromium/flags", wrapper.PatchChromiumFlags)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/chromium/policies", wrapper.PatchChromiumPolicies)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/chromium/upload-extensions-and-restart", wrapper.UploadExtensionsAndRestart)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/batch", wrapper.BatchComputerAction)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/click_mouse", wrapper.ClickMouse)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/clipboard/read", wrapper.ReadClipboard)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/clipboard/write", wrapper.WriteClipboard)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/cursor", wrapper.SetCursor)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/drag_mouse", wrapper.DragMouse)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/get_mouse_position", wrapper.GetMousePosition)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/move_mouse", wrapper.MoveMouse)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/press_key", wrapper.PressKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/screenshot", wrapper.TakeScreenshot)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/scroll", wrapper.Scroll)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/computer/type", wrapper.TypeText)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/display", wrapper.PatchDisplay)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/fs/create_directory", wrapper.CreateDirectory)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/fs/delete_directory", wrapper.DeleteDirectory)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/fs/delete_file", wrapper.DeleteFile)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/fs/download_dir_zip", wrapper.DownloadDirZip)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/fs/download_dir_zstd", wrapper.DownloadDirZstd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/fs/file_info", wrapper.FileInfo)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/fs/list_files", wrapper.ListFiles)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/fs/move", wrapper.MovePath)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/fs/read_file", wrapper.ReadFile)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/fs/set_file_permissions", wrapper.SetFilePermissions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/fs/upload", wrapper.UploadFiles)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/fs/upload_zip", wrapper.UploadZip)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/fs/upload_zstd", wrapper.UploadZstd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/fs/watch", wrapper.StartFsWatch)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/fs/watch/{watch_id}", wrapper.StopFsWatch)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/fs/watch/{watch_id}/events", wrapper.StreamFsEvents)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/fs/write_file", wrapper.WriteFile)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/logs/stream", wrapper.LogsStream)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/playwright/execute", wrapper.ExecutePlaywrightCode)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/process/exec", wrapper.ProcessExec)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/process/spawn", wrapper.ProcessSpawn)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/process/{process_id}/kill", wrapper.ProcessKill)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/process/{process_id}/resize", wrapper.ProcessResize)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/process/{process_id}/status", wrapper.ProcessStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/process/{process_id}/stdin", wrapper.ProcessStdin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/process/{process_id}/stdout/stream", wrapper.ProcessStdoutStream)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/reclaim/prove", wrapper.ReclaimProve)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/recording/delete", wrapper.DeleteRecording)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/recording/download", wrapper.DownloadRecording)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/recording/list", wrapper.ListRecorders)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/recording/start", wrapper.StartRecording)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/recording/stop", wrapper.StopRecording)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeScreenshot500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response TakeScreenshot500JSONResponse) VisitTakeScreenshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return r
+	return json.NewEncoder(w).Encode(response)
 }
 
-type BadRequestErrorJSONResponse Error
+type ScrollRequestObject struct {
+	Body *ScrollJSONRequestBody
+}
 
-type ConflictErrorJSONResponse Error
+type ScrollResponseObject interface {
+	VisitScrollResponse(w http.ResponseWriter) error
+}
 
-type InternalErrorJSONResponse Error
+type Scroll200Response struct {
+}
 
-type NotFoundErrorJSONResponse Error
+func (response Scroll200Response) VisitScrollResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
 
-type PatchChromiumFlagsRequestObject struct {
-	Body *PatchChromiumFlagsJSONRequestBody
+type Scroll400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response Scroll400JSONResponse) VisitScrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchChromiumFlagsResponseObject interface {
-	VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error
+type Scroll500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response Scroll500JSONResponse) VisitScrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchChromiumFlags200Response struct {
+type TypeTextRequestObject struct {
+	Body *TypeTextJSONRequestBody
 }
 
-func (response PatchChromiumFlags200Response) VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error {
+type TypeTextResponseObject interface {
+	VisitTypeTextResponse(w http.ResponseWriter) error
+}
+
+type TypeText200Response struct {
+}
+
+func (response TypeText200Response) VisitTypeTextResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchChromiumFlags400JSONResponse struct{ BadRequestErrorJSONResponse }
+type TypeText400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response PatchChromiumFlags400JSONResponse) VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error {
+func (response TypeText400JSONResponse) VisitTypeTextResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchChromiumFlags500JSONResponse struct{ InternalErrorJSONResponse }
+type TypeText500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response PatchChromiumFlags500JSONResponse) VisitPatchChromiumFlagsResponse(w http.ResponseWriter) error {
+func (response TypeText500JSONResponse) VisitTypeTextResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchChromiumPoliciesRequestObject struct {
-	Body *PatchChromiumPoliciesJSONRequestBody
+type GetDisplayInfoRequestObject struct {
 }
 
-type PatchChromiumPoliciesResponseObject interface {
-	VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error
+type GetDisplayInfoResponseObject interface {
+	VisitGetDisplayInfoResponse(w http.ResponseWriter) error
 }
 
-type PatchChromiumPolicies200Response struct {
+type GetDisplayInfo200JSONResponse DisplayInfo
+
+func (response GetDisplayInfo200JSONResponse) VisitGetDisplayInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response PatchChromiumPolicies200Response) VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error {
+type GetDisplayInfo500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetDisplayInfo500JSONResponse) VisitGetDisplayInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDisplayRequestObject struct {
+	Body *PatchDisplayJSONRequestBody
+}
+
+type PatchDisplayResponseObject interface {
+	VisitPatchDisplayResponse(w http.ResponseWriter) error
+}
+
+type PatchDisplay200JSONResponse DisplayConfig
+
+func (response PatchDisplay200JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDisplay400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response PatchDisplay400JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDisplay409JSONResponse struct{ ConflictErrorJSONResponse }
+
+func (response PatchDisplay409JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDisplay500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PatchDisplay500JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryRequestObject struct {
+	Body *CreateDirectoryJSONRequestBody
+}
+
+type CreateDirectoryResponseObject interface {
+	VisitCreateDirectoryResponse(w http.ResponseWriter) error
+}
+
+type CreateDirectory201Response struct {
+}
+
+func (response CreateDirectory201Response) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateDirectory400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response CreateDirectory400JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateDirectory500JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDirectoryRequestObject struct {
+	Body *DeleteDirectoryJSONRequestBody
+}
+
+type DeleteDirectoryResponseObject interface {
+	VisitDeleteDirectoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteDirectory200Response struct {
+}
+
+func (response DeleteDirectory200Response) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type PatchChromiumPolicies400JSONResponse struct{ BadRequestErrorJSONResponse }
+type DeleteDirectory400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response PatchChromiumPolicies400JSONResponse) VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error {
+func (response DeleteDirectory400JSONResponse) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchChromiumPolicies500JSONResponse struct{ InternalErrorJSONResponse }
+type DeleteDirectory404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response PatchChromiumPolicies500JSONResponse) VisitPatchChromiumPoliciesResponse(w http.ResponseWriter) error {
+func (response DeleteDirectory404JSONResponse) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteDirectory500JSONResponse) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadExtensionsAndRestartRequestObject struct {
-	Body *multipart.Reader
+type DeleteFileRequestObject struct {
+	Body *DeleteFileJSONRequestBody
 }
 
-type UploadExtensionsAndRestartResponseObject interface {
-	VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error
+type DeleteFileResponseObject interface {
+	VisitDeleteFileResponse(w http.ResponseWriter) error
 }
 
-type UploadExtensionsAndRestart201Response struct {
+type DeleteFile200Response struct {
 }
 
-func (response UploadExtensionsAndRestart201Response) VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+func (response DeleteFile200Response) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteFile400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response DeleteFile400JSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile404JSONResponse struct{ NotFoundErrorJSONResponse }
+
+func (response DeleteFile404JSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFile500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteFile500JSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadDirZipRequestObject struct {
+	Params DownloadDirZipParams
+}
+
+type DownloadDirZipResponseObject interface {
+	VisitDownloadDirZipResponse(w http.ResponseWriter) error
+}
+
+type DownloadDirZip200ApplicationzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response DownloadDirZip200ApplicationzipResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadDirZip400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response DownloadDirZip400JSONResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadExtensionsAndRestart400JSONResponse struct{ BadRequestErrorJSONResponse }
+type DownloadDirZip404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response UploadExtensionsAndRestart400JSONResponse) VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error {
+func (response DownloadDirZip404JSONResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadExtensionsAndRestart500JSONResponse struct{ InternalErrorJSONResponse }
+type DownloadDirZip500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UploadExtensionsAndRestart500JSONResponse) VisitUploadExtensionsAndRestartResponse(w http.ResponseWriter) error {
+func (response DownloadDirZip500JSONResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type BatchComputerActionRequestObject struct {
-	Body *BatchComputerActionJSONRequestBody
+type DownloadDirZstdRequestObject struct {
+	Params DownloadDirZstdParams
 }
 
-type BatchComputerActionResponseObject interface {
-	VisitBatchComputerActionResponse(w http.ResponseWriter) error
+type DownloadDirZstdResponseObject interface {
+	VisitDownloadDirZstdResponse(w http.ResponseWriter) error
 }
 
-type BatchComputerAction200Response struct {
+type DownloadDirZstd200ApplicationzstdResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func (response BatchComputerAction200Response) VisitBatchComputerActionResponse(w http.ResponseWriter) error {
+func (response DownloadDirZstd200ApplicationzstdResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zstd")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
-	return nil
-}
-
-type BatchComputerAction400JSONResponse struct{ BadRequestErrorJSONResponse }
-
-func (response BatchComputerAction400JSONResponse) VisitBatchComputerActionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type BatchComputerAction500JSONResponse struct{ InternalErrorJSONResponse }
+type DownloadDirZstd400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response BatchComputerAction500JSONResponse) VisitBatchComputerActionResponse(w http.ResponseWriter) error {
+func (response DownloadDirZstd400JSONResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ClickMouseRequestObject struct {
-	Body *ClickMouseJSONRequestBody
-}
-
-type ClickMouseResponseObject interface {
-	VisitClickMouseResponse(w http.ResponseWriter) error
-}
-
-type ClickMouse200Response struct {
-}
-
-func (response ClickMouse200Response) VisitClickMouseResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type ClickMouse400JSONResponse struct{ BadRequestErrorJSONResponse }
+type DownloadDirZstd404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response ClickMouse400JSONResponse) VisitClickMouseResponse(w http.ResponseWriter) error {
+func (response DownloadDirZstd404JSONResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ClickMouse500JSONResponse struct{ InternalErrorJSONResponse }
+type DownloadDirZstd500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ClickMouse500JSONResponse) VisitClickMouseResponse(w http.ResponseWriter) error {
+func (response DownloadDirZstd500JSONResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadClipboardRequestObject struct {
+type FileInfoRequestObject struct {
+	Params FileInfoParams
 }
 
-type ReadClipboardResponseObject interface {
-	VisitReadClipboardResponse(w http.ResponseWriter) error
+type FileInfoResponseObject interface {
+	VisitFileInfoResponse(w http.ResponseWriter) error
 }
 
-type ReadClipboard200JSONResponse ClipboardContent
+type FileInfo200JSONResponse FileInfo
 
-func (response ReadClipboard200JSONResponse) VisitReadClipboardResponse(w http.ResponseWriter) error {
+func (response FileInfo200JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadClipboard500JSONResponse struct{ InternalErrorJSONResponse }
+type FileInfo400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ReadClipboard500JSONResponse) VisitReadClipboardResponse(w http.ResponseWriter) error {
+func (response FileInfo400JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WriteClipboardRequestObject struct {
-	Body *WriteClipboardJSONRequestBody
-}
-
-type WriteClipboardResponseObject interface {
-	VisitWriteClipboardResponse(w http.ResponseWriter) error
-}
-
-type WriteClipboard200Response struct {
-}
-
-func (response WriteClipboard200Response) VisitWriteClipboardResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type WriteClipboard400JSONResponse struct{ BadRequestErrorJSONResponse }
+type FileInfo404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response WriteClipboard400JSONResponse) VisitWriteClipboardResponse(w http.ResponseWriter) error {
+func (response FileInfo404JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WriteClipboard500JSONResponse struct{ InternalErrorJSONResponse }
+type FileInfo500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response WriteClipboard500JSONResponse) VisitWriteClipboardResponse(w http.ResponseWriter) error {
+func (response FileInfo500JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetCursorRequestObject struct {
-	Body *SetCursorJSONRequestBody
+type ListFilesRequestObject struct {
+	Params ListFilesParams
 }
 
-type SetCursorResponseObject interface {
-	VisitSetCursorResponse(w http.ResponseWriter) error
+type ListFilesResponseObject interface {
+	VisitListFilesResponse(w http.ResponseWriter) error
 }
 
-type SetCursor200JSONResponse OkResponse
+type ListFiles200JSONResponse ListFiles
 
-func (response SetCursor200JSONResponse) VisitSetCursorResponse(w http.ResponseWriter) error {
+func (response ListFiles200JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetCursor400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ListFiles400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response SetCursor400JSONResponse) VisitSetCursorResponse(w http.ResponseWriter) error {
+func (response ListFiles400JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetCursor500JSONResponse struct{ InternalErrorJSONResponse }
+type ListFiles404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response SetCursor500JSONResponse) VisitSetCursorResponse(w http.ResponseWriter) error {
+func (response ListFiles404JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFiles500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListFiles500JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DragMouseRequestObject struct {
-	Body *DragMouseJSONRequestBody
+type MovePathRequestObject struct {
+	Body *MovePathJSONRequestBody
 }
 
-type DragMouseResponseObject interface {
-	VisitDragMouseResponse(w http.ResponseWriter) error
+type MovePathResponseObject interface {
+	VisitMovePathResponse(w http.ResponseWriter) error
 }
 
-type DragMouse200Response struct {
+type MovePath200Response struct {
 }
 
-func (response DragMouse200Response) VisitDragMouseResponse(w http.ResponseWriter) error {
+func (response MovePath200Response) VisitMovePathResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
 	return nil
 }
 
-type DragMouse400JSONResponse struct{ BadRequestErrorJSONResponse }
+type MovePath400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DragMouse400JSONResponse) VisitDragMouseResponse(w http.ResponseWriter) error {
+func (response MovePath400JSONResponse) VisitMovePathResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DragMouse500JSONResponse struct{ InternalErrorJSONResponse }
-
-func (response DragMouse500JSONResponse) VisitDragMouseResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetMousePositionRequestObject struct {
-}
-
-type GetMousePositionResponseObject interface {
-	VisitGetMousePositionResponse(w http.ResponseWriter) error
-}
-
-type GetMousePosition200JSONResponse MousePositionResponse
+type MovePath404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response GetMousePosition200JSONResponse) VisitGetMousePositionResponse(w http.ResponseWriter) error {
+func (response MovePath404JSONResponse) VisitMovePathResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMousePosition500JSONResponse struct{ InternalErrorJSONResponse }
+type MovePath500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response GetMousePosition500JSONResponse) VisitGetMousePositionResponse(w http.ResponseWriter) error {
+func (response MovePath500JSONResponse) VisitMovePathResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MoveMouseRequestObject struct {
-	Body *MoveMouseJSONRequestBody
+type ReadFileRequestObject struct {
+	Params ReadFileParams
 }
 
-type MoveMouseResponseObject interface {
-	VisitMoveMouseResponse(w http.ResponseWriter) error
+type ReadFileResponseObject interface {
+	VisitReadFileResponse(w http.ResponseWriter) error
 }
 
-type MoveMouse200Response struct {
+type ReadFile200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func (response MoveMouse200Response) VisitMoveMouseResponse(w http.ResponseWriter) error {
+func (response ReadFile200ApplicationoctetStreamResponse) VisitReadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
-	return nil
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type MoveMouse400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ReadFile400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response MoveMouse400JSONResponse) VisitMoveMouseResponse(w http.ResponseWriter) error {
+func (response ReadFile400JSONResponse) VisitReadFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MoveMouse500JSONResponse struct{ InternalErrorJSONResponse }
+type ReadFile404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response MoveMouse500JSONResponse) VisitMoveMouseResponse(w http.ResponseWriter) error {
+func (response ReadFile404JSONResponse) VisitReadFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadFile500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ReadFile500JSONResponse) VisitReadFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PressKeyRequestObject struct {
-	Body *PressKeyJSONRequestBody
+type SetFilePermissionsRequestObject struct {
+	Body *SetFilePermissionsJSONRequestBody
 }
 
-type PressKeyResponseObject interface {
-	VisitPressKeyResponse(w http.ResponseWriter) error
+type SetFilePermissionsResponseObject interface {
+	VisitSetFilePermissionsResponse(w http.ResponseWriter) error
 }
 
-type PressKey200Response struct {
+type SetFilePermissions200Response struct {
+}
+
+func (response SetFilePermissions200Response) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
 }
 
-func (response PressKey200Response) VisitPressKeyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type SetFilePermissions400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response SetFilePermissions400JSONResponse) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PressKey400JSONResponse struct{ BadRequestErrorJSONResponse }
+type SetFilePermissions404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response PressKey400JSONResponse) VisitPressKeyResponse(w http.ResponseWriter) error {
+func (response SetFilePermissions404JSONResponse) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PressKey500JSONResponse struct{ InternalErrorJSONResponse }
+type SetFilePermissions500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response PressKey500JSONResponse) VisitPressKeyResponse(w http.ResponseWriter) error {
+func (response SetFilePermissions500JSONResponse) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TakeScreenshotRequestObject struct {
-	Body *TakeScreenshotJSONRequestBody
+type UploadFilesRequestObject struct {
+	Body *multipart.Reader
 }
 
-type TakeScreenshotResponseObject interface {
-	VisitTakeScreenshotResponse(w http.ResponseWriter) error
+type UploadFilesResponseObject interface {
+	VisitUploadFilesResponse(w http.ResponseWriter) error
 }
 
-type TakeScreenshot200ImagepngResponse struct {
-	Body          io.Reader
-	ContentLength int64
+type UploadFiles201Response struct {
 }
 
-func (response TakeScreenshot200ImagepngResponse) VisitTakeScreenshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "image/png")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.WriteHeader(200)
-
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+func (response UploadFiles201Response) VisitUploadFilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
 }
 
-type TakeScreenshot400JSONResponse struct{ BadRequestErrorJSONResponse }
+type UploadFiles400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response TakeScreenshot400JSONResponse) VisitTakeScreenshotResponse(w http.ResponseWriter) error {
+func (response UploadFiles400JSONResponse) VisitUploadFilesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TakeScreenshot500JSONResponse struct{ InternalErrorJSONResponse }
+type UploadFiles404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response TakeScreenshot500JSONResponse) VisitTakeScreenshotResponse(w http.ResponseWriter) error {
+func (response UploadFiles404JSONResponse) VisitUploadFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadFiles500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UploadFiles500JSONResponse) VisitUploadFilesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ScrollRequestObject struct {
-	Body *ScrollJSONRequestBody
+type UploadZipRequestObject struct {
+	Body *multipart.Reader
 }
 
-type ScrollResponseObject interface {
-	VisitScrollResponse(w http.ResponseWriter) error
+type UploadZipResponseObject interface {
+	VisitUploadZipResponse(w http.ResponseWriter) error
 }
 
-type Scroll200Response struct {
+type UploadZip201Response struct {
 }
 
-func (response Scroll200Response) VisitScrollResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response UploadZip201Response) VisitUploadZipResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
 	return nil
 }
 
-type Scroll400JSONResponse struct{ BadRequestErrorJSONResponse }
+type UploadZip400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response Scroll400JSONResponse) VisitScrollResponse(w http.ResponseWriter) error {
+func (response UploadZip400JSONResponse) VisitUploadZipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Scroll500JSONResponse struct{ InternalErrorJSONResponse }
+type UploadZip404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response Scroll500JSONResponse) VisitScrollResponse(w http.ResponseWriter) error {
+func (response UploadZip404JSONResponse) VisitUploadZipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadZip500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UploadZip500JSONResponse) VisitUploadZipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TypeTextRequestObject struct {
-	Body *TypeTextJSONRequestBody
+type UploadZstdRequestObject struct {
+	Body *multipart.Reader
 }
 
-type TypeTextResponseObject interface {
-	VisitTypeTextResponse(w http.ResponseWriter) error
+type UploadZstdResponseObject interface {
+	VisitUploadZstdResponse(w http.ResponseWriter) error
 }
 
-type TypeText200Response struct {
+type UploadZstd201Response struct {
 }
 
-func (response TypeText200Response) VisitTypeTextResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response UploadZstd201Response) VisitUploadZstdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
 	return nil
 }
 
-type TypeText400JSONResponse struct{ BadRequestErrorJSONResponse }
+type UploadZstd400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response TypeText400JSONResponse) VisitTypeTextResponse(w http.ResponseWriter) error {
+func (response UploadZstd400JSONResponse) VisitUploadZstdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TypeText500JSONResponse struct{ InternalErrorJSONResponse }
+type UploadZstd404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response TypeText500JSONResponse) VisitTypeTextResponse(w http.ResponseWriter) error {
+func (response UploadZstd404JSONResponse) VisitUploadZstdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadZstd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UploadZstd500JSONResponse) VisitUploadZstdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDisplayRequestObject struct {
-	Body *PatchDisplayJSONRequestBody
+type StartFsWatchRequestObject struct {
+	Body *StartFsWatchJSONRequestBody
 }
 
-type PatchDisplayResponseObject interface {
-	VisitPatchDisplayResponse(w http.ResponseWriter) error
+type StartFsWatchResponseObject interface {
+	VisitStartFsWatchResponse(w http.ResponseWriter) error
 }
 
-type PatchDisplay200JSONResponse DisplayConfig
+type StartFsWatch201JSONResponse struct {
+	// WatchId Unique identifier for the directory watch
+	WatchId *string `json:"watch_id,omitempty"`
+}
 
-func (response PatchDisplay200JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+func (response StartFsWatch201JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDisplay400JSONResponse struct{ BadRequestErrorJSONResponse }
+type StartFsWatch400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response PatchDisplay400JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+func (response StartFsWatch400JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDisplay409JSONResponse struct{ ConflictErrorJSONResponse }
+type StartFsWatch404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response PatchDisplay409JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+func (response StartFsWatch404JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDisplay500JSONResponse struct{ InternalErrorJSONResponse }
+type StartFsWatch500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response PatchDisplay500JSONResponse) VisitPatchDisplayResponse(w http.ResponseWriter) error {
+func (response StartFsWatch500JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDirectoryRequestObject struct {
-	Body *CreateDirectoryJSONRequestBody
+type StopFsWatchRequestObject struct {
+	WatchId string `json:"watch_id"`
 }
 
-type CreateDirectoryResponseObject interface {
-	VisitCreateDirectoryResponse(w http.ResponseWriter) error
+type StopFsWatchResponseObject interface {
+	VisitStopFsWatchResponse(w http.ResponseWriter) error
 }
 
-type CreateDirectory201Response struct {
+type StopFsWatch204Response struct {
 }
 
-func (response CreateDirectory201Response) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+func (response StopFsWatch204Response) VisitStopFsWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type CreateDirectory400JSONResponse struct{ BadRequestErrorJSONResponse }
+type StopFsWatch400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response CreateDirectory400JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+func (response StopFsWatch400JSONResponse) VisitStopFsWatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+type StopFsWatch404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response CreateDirectory500JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+func (response StopFsWatch404JSONResponse) VisitStopFsWatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopFsWatch500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response StopFsWatch500JSONResponse) VisitStopFsWatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDirectoryRequestObject struct {
-	Body *DeleteDirectoryJSONRequestBody
+type StreamFsEventsRequestObject struct {
+	WatchId string `json:"watch_id"`
 }
 
-type DeleteDirectoryResponseObject interface {
-	VisitDeleteDirectoryResponse(w http.ResponseWriter) error
+type StreamFsEventsResponseObject interface {
+	VisitStreamFsEventsResponse(w http.ResponseWriter) error
 }
 
-type DeleteDirectory200Response struct {
+type StreamFsEvents200ResponseHeaders struct {
+	XSSEContentType string
 }
 
-func (response DeleteDirectory200Response) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
+type StreamFsEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	Headers       StreamFsEvents200ResponseHeaders
+	ContentLength int64
+}
+
+func (response StreamFsEvents200TexteventStreamResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
 	w.WriteHeader(200)
-	return nil
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, might as well use io.Copy
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+
+	// Use a buffer for efficient copying and flushing
+	buf := make([]byte, 4096) // text/event-stream are usually very small messages
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			flusher.Flush() // Flush after each write
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil // End of file, no error
+			}
+			return err
+		}
+	}
 }
 
-type DeleteDirectory400JSONResponse struct{ BadRequestErrorJSONResponse }
+type StreamFsEvents400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DeleteDirectory400JSONResponse) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
+func (response StreamFsEvents400JSONResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDirectory404JSONResponse struct{ NotFoundErrorJSONResponse }
+type StreamFsEvents404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response DeleteDirectory404JSONResponse) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
+func (response StreamFsEvents404JSONResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+type StreamFsEvents500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response DeleteDirectory500JSONResponse) VisitDeleteDirectoryResponse(w http.ResponseWriter) error {
+func (response StreamFsEvents500JSONResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFileRequestObject struct {
-	Body *DeleteFileJSONRequestBody
+type WriteFileRequestObject struct {
+	Params WriteFileParams
+	Body   io.Reader
 }
 
-type DeleteFileResponseObject interface {
-	VisitDeleteFileResponse(w http.ResponseWriter) error
+type WriteFileResponseObject interface {
+	VisitWriteFileResponse(w http.ResponseWriter) error
 }
 
-type DeleteFile200Response struct {
+type WriteFile201Response struct {
 }
 
-func (response DeleteFile200Response) VisitDeleteFileResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response WriteFile201Response) VisitWriteFileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
 	return nil
 }
 
-type DeleteFile400JSONResponse struct{ BadRequestErrorJSONResponse }
+type WriteFile400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DeleteFile400JSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+func (response WriteFile400JSONResponse) VisitWriteFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFile404JSONResponse struct{ NotFoundErrorJSONResponse }
+type WriteFile404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response DeleteFile404JSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+func (response WriteFile404JSONResponse) VisitWriteFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFile500JSONResponse struct{ InternalErrorJSONResponse }
+type WriteFile500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response DeleteFile500JSONResponse) VisitDeleteFileResponse(w http.ResponseWriter) error {
+func (response WriteFile500JSONResponse) VisitWriteFileResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadDirZipRequestObject struct {
-	Params DownloadDirZipParams
+type GetLogsRequestObject struct {
+	Params GetLogsParams
 }
 
-type DownloadDirZipResponseObject interface {
-	VisitDownloadDirZipResponse(w http.ResponseWriter) error
+type GetLogsResponseObject interface {
+	VisitGetLogsResponse(w http.ResponseWriter) error
 }
 
-type DownloadDirZip200ApplicationzipResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+type GetLogs200JSONResponse []ServerLogEntry
 
-func (response DownloadDirZip200ApplicationzipResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/zip")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
+func (response GetLogs200JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadDirZip400JSONResponse struct{ BadRequestErrorJSONResponse }
+type GetLogs400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DownloadDirZip400JSONResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
+func (response GetLogs400JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadDirZip404JSONResponse struct{ NotFoundErrorJSONResponse }
+type GetLogs404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response DownloadDirZip404JSONResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
+func (response GetLogs404JSONResponse) VisitGetLogsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadDirZip500JSONResponse struct{ InternalErrorJSONResponse }
-
-func (response DownloadDirZip500JSONResponse) VisitDownloadDirZipResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type LogsStreamRequestObject struct {
+	Params LogsStreamParams
 }
 
-type DownloadDirZstdRequestObject struct {
-	Params DownloadDirZstdParams
+type LogsStreamResponseObject interface {
+	VisitLogsStreamResponse(w http.ResponseWriter) error
 }
 
-type DownloadDirZstdResponseObject interface {
-	VisitDownloadDirZstdResponse(w http.ResponseWriter) error
+type LogsStream200ResponseHeaders struct {
+	XSSEContentType string
 }
 
-type DownloadDirZstd200ApplicationzstdResponse struct {
+type LogsStream200TexteventStreamResponse struct {
 	Body          io.Reader
+	Headers       LogsStream200ResponseHeaders
 	ContentLength int64
 }
 
-func (response DownloadDirZstd200ApplicationzstdResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/zstd")
+func (response LogsStream200TexteventStreamResponse) VisitLogsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
 	if response.ContentLength != 0 {
 		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
 	w.WriteHeader(200)
 
 	if closer, ok := response.Body.(io.ReadCloser); ok {
 		defer closer.Close()
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, might as well use io.Copy
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+
+	// Use a buffer for efficient copying and flushing
+	buf := make([]byte, 4096) // text/event-stream are usually very small messages
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			flusher.Flush() // Flush after each write
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil // End of file, no error
+			}
+			return err
+		}
+	}
 }
 
-type DownloadDirZstd400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ExecutePlaywrightCodeRequestObject struct {
+	Body *ExecutePlaywrightCodeJSONRequestBody
+}
 
-func (response DownloadDirZstd400JSONResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
+type ExecutePlaywrightCodeResponseObject interface {
+	VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error
+}
+
+type ExecutePlaywrightCode200JSONResponse ExecutePlaywrightResult
+
+func (response ExecutePlaywrightCode200JSONResponse) VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadDirZstd404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ExecutePlaywrightCode400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DownloadDirZstd404JSONResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
+func (response ExecutePlaywrightCode400JSONResponse) VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadDirZstd500JSONResponse struct{ InternalErrorJSONResponse }
+type ExecutePlaywrightCode500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response DownloadDirZstd500JSONResponse) VisitDownloadDirZstdResponse(w http.ResponseWriter) error {
+func (response ExecutePlaywrightCode500JSONResponse) VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FileInfoRequestObject struct {
-	Params FileInfoParams
+type ProcessExecRequestObject struct {
+	Body *ProcessExecJSONRequestBody
 }
 
-type FileInfoResponseObject interface {
-	VisitFileInfoResponse(w http.ResponseWriter) error
+type ProcessExecResponseObject interface {
+	VisitProcessExecResponse(w http.ResponseWriter) error
 }
 
-type FileInfo200JSONResponse FileInfo
+type ProcessExec200JSONResponse ProcessExecResult
 
-func (response FileInfo200JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
+func (response ProcessExec200JSONResponse) VisitProcessExecResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FileInfo400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessExec400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response FileInfo400JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
+func (response ProcessExec400JSONResponse) VisitProcessExecResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FileInfo404JSONResponse struct{ NotFoundErrorJSONResponse }
-
-func (response FileInfo404JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type FileInfo500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessExec500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response FileInfo500JSONResponse) VisitFileInfoResponse(w http.ResponseWriter) error {
+func (response ProcessExec500JSONResponse) VisitProcessExecResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListFilesRequestObject struct {
-	Params ListFilesParams
+type ProcessSpawnRequestObject struct {
+	Body *ProcessSpawnJSONRequestBody
 }
 
-type ListFilesResponseObject interface {
-	VisitListFilesResponse(w http.ResponseWriter) error
+type ProcessSpawnResponseObject interface {
+	VisitProcessSpawnResponse(w http.ResponseWriter) error
 }
 
-type ListFiles200JSONResponse ListFiles
+type ProcessSpawn200JSONResponse ProcessSpawnResult
 
-func (response ListFiles200JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+func (response ProcessSpawn200JSONResponse) VisitProcessSpawnResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListFiles400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessSpawn400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ListFiles400JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+func (response ProcessSpawn400JSONResponse) VisitProcessSpawnResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListFiles404JSONResponse struct{ NotFoundErrorJSONResponse }
-
-func (response ListFiles404JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListFiles500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessSpawn500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ListFiles500JSONResponse) VisitListFilesResponse(w http.ResponseWriter) error {
+func (response ProcessSpawn500JSONResponse) VisitProcessSpawnResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MovePathRequestObject struct {
-	Body *MovePathJSONRequestBody
+type ProcessKillRequestObject struct {
+	ProcessId openapi_types.UUID `json:"process_id"`
+	Body      *ProcessKillJSONRequestBody
 }
 
-type MovePathResponseObject interface {
-	VisitMovePathResponse(w http.ResponseWriter) error
+type ProcessKillResponseObject interface {
+	VisitProcessKillResponse(w http.ResponseWriter) error
 }
 
-type MovePath200Response struct {
-}
+type ProcessKill200JSONResponse OkResponse
 
-func (response MovePath200Response) VisitMovePathResponse(w http.ResponseWriter) error {
+func (response ProcessKill200JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MovePath400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessKill400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response MovePath400JSONResponse) VisitMovePathResponse(w http.ResponseWriter) error {
+func (response ProcessKill400JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MovePath404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ProcessKill404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response MovePath404JSONResponse) VisitMovePathResponse(w http.ResponseWriter) error {
+func (response ProcessKill404JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MovePath500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessKill500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response MovePath500JSONResponse) VisitMovePathResponse(w http.ResponseWriter) error {
+func (response ProcessKill500JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFileRequestObject struct {
-	Params ReadFileParams
+type ProcessResizeRequestObject struct {
+	ProcessId openapi_types.UUID `json:"process_id"`
+	Body      *ProcessResizeJSONRequestBody
 }
 
-type ReadFileResponseObject interface {
-	VisitReadFileResponse(w http.ResponseWriter) error
+type ProcessResizeResponseObject interface {
+	VisitProcessResizeResponse(w http.ResponseWriter) error
 }
 
-type ReadFile200ApplicationoctetStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
-}
+type ProcessResize200JSONResponse OkResponse
 
-func (response ReadFile200ApplicationoctetStreamResponse) VisitReadFileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
+func (response ProcessResize200JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFile400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessResize400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ReadFile400JSONResponse) VisitReadFileResponse(w http.ResponseWriter) error {
+func (response ProcessResize400JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFile404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ProcessResize404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response ReadFile404JSONResponse) VisitReadFileResponse(w http.ResponseWriter) error {
+func (response ProcessResize404JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFile500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessResize500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ReadFile500JSONResponse) VisitReadFileResponse(w http.ResponseWriter) error {
+func (response ProcessResize500JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetFilePermissionsRequestObject struct {
-	Body *SetFilePermissionsJSONRequestBody
+type ProcessSignalRequestObject struct {
+	ProcessId openapi_types.UUID `json:"process_id"`
+	Body      *ProcessSignalJSONRequestBody
 }
 
-type SetFilePermissionsResponseObject interface {
-	VisitSetFilePermissionsResponse(w http.ResponseWriter) error
+type ProcessSignalResponseObject interface {
+	VisitProcessSignalResponse(w http.ResponseWriter) error
 }
 
-type SetFilePermissions200Response struct {
-}
+type ProcessSignal200JSONResponse OkResponse
 
-func (response SetFilePermissions200Response) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
+func (response ProcessSignal200JSONResponse) VisitProcessSignalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SetFilePermissions400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessSignal400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response SetFilePermissions400JSONResponse) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
+func (response ProcessSignal400JSONResponse) VisitProcessSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetFilePermissions404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ProcessSignal404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response SetFilePermissions404JSONResponse) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
+func (response ProcessSignal404JSONResponse) VisitProcessSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetFilePermissions500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessSignal500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response SetFilePermissions500JSONResponse) VisitSetFilePermissionsResponse(w http.ResponseWriter) error {
+func (response ProcessSignal500JSONResponse) VisitProcessSignalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadFilesRequestObject struct {
-	Body *multipart.Reader
+type ProcessStatusRequestObject struct {
+	ProcessId openapi_types.UUID `json:"process_id"`
 }
 
-type UploadFilesResponseObject interface {
-	VisitUploadFilesResponse(w http.ResponseWriter) error
+type ProcessStatusResponseObject interface {
+	VisitProcessStatusResponse(w http.ResponseWriter) error
 }
 
-type UploadFiles201Response struct {
-}
+type ProcessStatus200JSONResponse ProcessStatus
 
-func (response UploadFiles201Response) VisitUploadFilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+func (response ProcessStatus200JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadFiles400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessStatus400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response UploadFiles400JSONResponse) VisitUploadFilesResponse(w http.ResponseWriter) error {
+func (response ProcessStatus400JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadFiles404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ProcessStatus404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response UploadFiles404JSONResponse) VisitUploadFilesResponse(w http.ResponseWriter) error {
+func (response ProcessStatus404JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadFiles500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessStatus500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UploadFiles500JSONResponse) VisitUploadFilesResponse(w http.ResponseWriter) error {
+func (response ProcessStatus500JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZipRequestObject struct {
-	Body *multipart.Reader
+type ProcessStdinRequestObject struct {
+	ProcessId openapi_types.UUID `json:"process_id"`
+	Body      *ProcessStdinJSONRequestBody
 }
 
-type UploadZipResponseObject interface {
-	VisitUploadZipResponse(w http.ResponseWriter) error
+type ProcessStdinResponseObject interface {
+	VisitProcessStdinResponse(w http.ResponseWriter) error
 }
 
-type UploadZip201Response struct {
-}
+type ProcessStdin200JSONResponse ProcessStdinResult
 
-func (response UploadZip201Response) VisitUploadZipResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+func (response ProcessStdin200JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZip400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ProcessStdin400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response UploadZip400JSONResponse) VisitUploadZipResponse(w http.ResponseWriter) error {
+func (response ProcessStdin400JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZip404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ProcessStdin404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response UploadZip404JSONResponse) VisitUploadZipResponse(w http.ResponseWriter) error {
+func (response ProcessStdin404JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZip500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessStdin500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UploadZip500JSONResponse) VisitUploadZipResponse(w http.ResponseWriter) error {
+func (response ProcessStdin500JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZstdRequestObject struct {
-	Body *multipart.Reader
+type ProcessStdoutStreamRequestObject struct {
+	ProcessId openapi_types.UUID `json:"process_id"`
 }
 
-type UploadZstdResponseObject interface {
-	VisitUploadZstdResponse(w http.ResponseWriter) error
+type ProcessStdoutStreamResponseObject interface {
+	VisitProcessStdoutStreamResponse(w http.ResponseWriter) error
 }
 
-type UploadZstd201Response struct {
+type ProcessStdoutStream200ResponseHeaders struct {
+	XSSEContentType string
 }
 
-func (response UploadZstd201Response) VisitUploadZstdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+type ProcessStdoutStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	Headers       ProcessStdoutStream200ResponseHeaders
+	ContentLength int64
 }
 
-type UploadZstd400JSONResponse struct{ BadRequestErrorJSONResponse }
+func (response ProcessStdoutStream200TexteventStreamResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
+	w.WriteHeader(200)
 
-func (response UploadZstd400JSONResponse) VisitUploadZstdResponse(w http.ResponseWriter) error {
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, might as well use io.Copy
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+
+	// Use a buffer for efficient copying and flushing
+	buf := make([]byte, 4096) // text/event-stream are usually very small messages
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			flusher.Flush() // Flush after each write
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil // End of file, no error
+			}
+			return err
+		}
+	}
+}
+
+type ProcessStdoutStream400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response ProcessStdoutStream400JSONResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZstd404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ProcessStdoutStream404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response UploadZstd404JSONResponse) VisitUploadZstdResponse(w http.ResponseWriter) error {
+func (response ProcessStdoutStream404JSONResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UploadZstd500JSONResponse struct{ InternalErrorJSONResponse }
+type ProcessStdoutStream500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response UploadZstd500JSONResponse) VisitUploadZstdResponse(w http.ResponseWriter) error {
+func (response ProcessStdoutStream500JSONResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartFsWatchRequestObject struct {
-	Body *StartFsWatchJSONRequestBody
+type GetConfigRequestObject struct {
 }
 
-type StartFsWatchResponseObject interface {
-	VisitStartFsWatchResponse(w http.ResponseWriter) error
+type GetConfigResponseObject interface {
+	VisitGetConfigResponse(w http.ResponseWriter) error
 }
 
-type StartFsWatch201JSONResponse struct {
-	// WatchId Unique identifier for the directory watch
-	WatchId *string `json:"watch_id,omitempty"`
-}
+type GetConfig200JSONResponse ServerConfig
 
-func (response StartFsWatch201JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
+func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartFsWatch400JSONResponse struct{ BadRequestErrorJSONResponse }
+type GetConfig500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response StartFsWatch400JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
+func (response GetConfig500JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartFsWatch404JSONResponse struct{ NotFoundErrorJSONResponse }
+type GetCircuitStatusRequestObject struct {
+}
 
-func (response StartFsWatch404JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
+type GetCircuitStatusResponseObject interface {
+	VisitGetCircuitStatusResponse(w http.ResponseWriter) error
+}
+
+type GetCircuitStatus200JSONResponse CircuitStatus
+
+func (response GetCircuitStatus200JSONResponse) VisitGetCircuitStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartFsWatch500JSONResponse struct{ InternalErrorJSONResponse }
+type GetCircuitStatus500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response StartFsWatch500JSONResponse) VisitStartFsWatchResponse(w http.ResponseWriter) error {
+func (response GetCircuitStatus500JSONResponse) VisitGetCircuitStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopFsWatchRequestObject struct {
-	WatchId string `json:"watch_id"`
+type ReclaimProveRequestObject struct {
+	Body *ReclaimProveJSONRequestBody
 }
 
-type StopFsWatchResponseObject interface {
-	VisitStopFsWatchResponse(w http.ResponseWriter) error
+type ReclaimProveResponseObject interface {
+	VisitReclaimProveResponse(w http.ResponseWriter) error
 }
 
-type StopFsWatch204Response struct {
-}
+type ReclaimProve200JSONResponse ReclaimProveResult
 
-func (response StopFsWatch204Response) VisitStopFsWatchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response ReclaimProve200JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StopFsWatch400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ReclaimProve400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response StopFsWatch400JSONResponse) VisitStopFsWatchResponse(w http.ResponseWriter) error {
+func (response ReclaimProve400JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopFsWatch404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ReclaimProve429ResponseHeaders struct {
+	RetryAfter int
+}
 
-func (response StopFsWatch404JSONResponse) VisitStopFsWatchResponse(w http.ResponseWriter) error {
+type ReclaimProve429JSONResponse struct {
+	Body    Error
+	Headers ReclaimProve429ResponseHeaders
+}
+
+func (response ReclaimProve429JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type StopFsWatch500JSONResponse struct{ InternalErrorJSONResponse }
+type ReclaimProve500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response StopFsWatch500JSONResponse) VisitStopFsWatchResponse(w http.ResponseWriter) error {
+func (response ReclaimProve500JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StreamFsEventsRequestObject struct {
-	WatchId string `json:"watch_id"`
+type ReclaimProve502JSONResponse Error
+
+func (response ReclaimProve502JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StreamFsEventsResponseObject interface {
-	VisitStreamFsEventsResponse(w http.ResponseWriter) error
+type ReclaimProve503ResponseHeaders struct {
+	RetryAfter int
 }
 
-type StreamFsEvents200ResponseHeaders struct {
-	XSSEContentType string
+type ReclaimProve503JSONResponse struct {
+	Body    Error
+	Headers ReclaimProve503ResponseHeaders
 }
 
-type StreamFsEvents200TexteventStreamResponse struct {
-	Body          io.Reader
-	Headers       StreamFsEvents200ResponseHeaders
-	ContentLength int64
+func (response ReclaimProve503JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (response StreamFsEvents200TexteventStreamResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
-	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
-	w.WriteHeader(200)
+type GetProofStatsRequestObject struct {
+}
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		// If w doesn't support flushing, might as well use io.Copy
-		_, err := io.Copy(w, response.Body)
-		return err
-	}
+type GetProofStatsResponseObject interface {
+	VisitGetProofStatsResponse(w http.ResponseWriter) error
+}
 
-	// Use a buffer for efficient copying and flushing
-	buf := make([]byte, 4096) // text/event-stream are usually very small messages
-	for {
-		n, err := response.Body.Read(buf)
-		if n > 0 {
-			if _, werr := w.Write(buf[:n]); werr != nil {
-				return werr
-			}
-			flusher.Flush() // Flush after each write
-		}
-		if err != nil {
-			if err == io.EOF {
-				return nil // End of file, no error
-			}
-			return err
-		}
-	}
+type GetProofStats200JSONResponse ProofStats
+
+func (response GetProofStats200JSONResponse) VisitGetProofStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProofStats500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetProofStats500JSONResponse) VisitGetProofStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StreamFsEvents400JSONResponse struct{ BadRequestErrorJSONResponse }
+type CleanupRequestObject struct {
+	Params CleanupParams
+}
 
-func (response StreamFsEvents400JSONResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
+type CleanupResponseObject interface {
+	VisitCleanupResponse(w http.ResponseWriter) error
+}
+
+type Cleanup200JSONResponse CleanupResult
+
+func (response Cleanup200JSONResponse) VisitCleanupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StreamFsEvents404JSONResponse struct{ NotFoundErrorJSONResponse }
+type Cleanup400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response StreamFsEvents404JSONResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
+func (response Cleanup400JSONResponse) VisitCleanupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StreamFsEvents500JSONResponse struct{ InternalErrorJSONResponse }
+type Cleanup500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response StreamFsEvents500JSONResponse) VisitStreamFsEventsResponse(w http.ResponseWriter) error {
+func (response Cleanup500JSONResponse) VisitCleanupResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WriteFileRequestObject struct {
-	Params WriteFileParams
-	Body   io.Reader
+type DeleteRecordingRequestObject struct {
+	Body *DeleteRecordingJSONRequestBody
 }
 
-type WriteFileResponseObject interface {
-	VisitWriteFileResponse(w http.ResponseWriter) error
+type DeleteRecordingResponseObject interface {
+	VisitDeleteRecordingResponse(w http.ResponseWriter) error
 }
 
-type WriteFile201Response struct {
+type DeleteRecording200Response struct {
 }
 
-func (response WriteFile201Response) VisitWriteFileResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
+func (response DeleteRecording200Response) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
 	return nil
 }
 
-type WriteFile400JSONResponse struct{ BadRequestErrorJSONResponse }
+type DeleteRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response WriteFile400JSONResponse) VisitWriteFileResponse(w http.ResponseWriter) error {
+func (response DeleteRecording400JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WriteFile404JSONResponse struct{ NotFoundErrorJSONResponse }
+type DeleteRecording404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response WriteFile404JSONResponse) VisitWriteFileResponse(w http.ResponseWriter) error {
+func (response DeleteRecording404JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type WriteFile500JSONResponse struct{ InternalErrorJSONResponse }
+type DeleteRecording409ResponseHeaders struct {
+	RetryAfter int
+}
 
-func (response WriteFile500JSONResponse) VisitWriteFileResponse(w http.ResponseWriter) error {
+type DeleteRecording409JSONResponse struct {
+	Body    Error
+	Headers DeleteRecording409ResponseHeaders
+}
+
+func (response DeleteRecording409JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteRecording500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteRecording500JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type LogsStreamRequestObject struct {
-	Params LogsStreamParams
+type DownloadRecordingRequestObject struct {
+	Params DownloadRecordingParams
 }
 
-type LogsStreamResponseObject interface {
-	VisitLogsStreamResponse(w http.ResponseWriter) error
+type DownloadRecordingResponseObject interface {
+	VisitDownloadRecordingResponse(w http.ResponseWriter) error
 }
 
-type LogsStream200ResponseHeaders struct {
-	XSSEContentType string
+type DownloadRecording200ResponseHeaders struct {
+	AcceptRanges         string
+	ETag                 string
+	LastModified         string
+	XRecordingFinishedAt string
+	XRecordingPartial    string
+	XRecordingStartedAt  string
 }
 
-type LogsStream200TexteventStreamResponse struct {
+type DownloadRecording200Videomp4Response struct {
 	Body          io.Reader
-	Headers       LogsStream200ResponseHeaders
+	Headers       DownloadRecording200ResponseHeaders
 	ContentLength int64
 }
 
-func (response LogsStream200TexteventStreamResponse) VisitLogsStreamResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
+func (response DownloadRecording200Videomp4Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "video/mp4")
 	if response.ContentLength != 0 {
 		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("X-Recording-Finished-At", fmt.Sprint(response.Headers.XRecordingFinishedAt))
+	w.Header().Set("X-Recording-Partial", fmt.Sprint(response.Headers.XRecordingPartial))
+	w.Header().Set("X-Recording-Started-At", fmt.Sprint(response.Headers.XRecordingStartedAt))
 	w.WriteHeader(200)
 
 	if closer, ok := response.Body.(io.ReadCloser); ok {
 		defer closer.Close()
 	}
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		// If w doesn't support flushing, might as well use io.Copy
-		_, err := io.Copy(w, response.Body)
-		return err
-	}
-
-	// Use a buffer for efficient copying and flushing
-	buf := make([]byte, 4096) // text/event-stream are usually very small messages
-	for {
-		n, err := response.Body.Read(buf)
-		if n > 0 {
-			if _, werr := w.Write(buf[:n]); werr != nil {
-				return werr
-			}
-			flusher.Flush() // Flush after each write
-		}
-		if err != nil {
-			if err == io.EOF {
-				return nil // End of file, no error
-			}
-			return err
-		}
-	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type ExecutePlaywrightCodeRequestObject struct {
-	Body *ExecutePlaywrightCodeJSONRequestBody
+type DownloadRecording202ResponseHeaders struct {
+	RetryAfter int
 }
 
-type ExecutePlaywrightCodeResponseObject interface {
-	VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error
+type DownloadRecording202Response struct {
+	Headers DownloadRecording202ResponseHeaders
 }
 
-type ExecutePlaywrightCode200JSONResponse ExecutePlaywrightResult
-
-func (response ExecutePlaywrightCode200JSONResponse) VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response DownloadRecording202Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(202)
+	return nil
 }
 
-type ExecutePlaywrightCode400JSONResponse struct{ BadRequestErrorJSONResponse }
-
-func (response ExecutePlaywrightCode400JSONResponse) VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type DownloadRecording206ResponseHeaders struct {
+	AcceptRanges         string
+	ContentRange         string
+	ETag                 string
+	LastModified         string
+	XRecordingFinishedAt string
+	XRecordingStartedAt  string
 }
 
-type ExecutePlaywrightCode500JSONResponse struct{ InternalErrorJSONResponse }
+type DownloadRecording206Videomp4Response struct {
+	Body          io.Reader
+	Headers       DownloadRecording206ResponseHeaders
+	ContentLength int64
+}
 
-func (response ExecutePlaywrightCode500JSONResponse) VisitExecutePlaywrightCodeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (response DownloadRecording206Videomp4Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "video/mp4")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Accept-Ranges", fmt.Sprint(response.Headers.AcceptRanges))
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.Header().Set("X-Recording-Finished-At", fmt.Sprint(response.Headers.XRecordingFinishedAt))
+	w.Header().Set("X-Recording-Started-At", fmt.Sprint(response.Headers.XRecordingStartedAt))
+	w.WriteHeader(206)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type ProcessExecRequestObject struct {
-	Body *ProcessExecJSONRequestBody
+type DownloadRecording304ResponseHeaders struct {
+	ETag         string
+	LastModified string
 }
 
-type ProcessExecResponseObject interface {
-	VisitProcessExecResponse(w http.ResponseWriter) error
+type DownloadRecording304Response struct {
+	Headers DownloadRecording304ResponseHeaders
 }
 
-type ProcessExec200JSONResponse ProcessExecResult
-
-func (response ProcessExec200JSONResponse) VisitProcessExecResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response DownloadRecording304Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
 }
 
-type ProcessExec400JSONResponse struct{ BadRequestErrorJSONResponse }
+type DownloadRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ProcessExec400JSONResponse) VisitProcessExecResponse(w http.ResponseWriter) error {
+func (response DownloadRecording400JSONResponse) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessExec500JSONResponse struct{ InternalErrorJSONResponse }
+type DownloadRecording404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response ProcessExec500JSONResponse) VisitProcessExecResponse(w http.ResponseWriter) error {
+func (response DownloadRecording404JSONResponse) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessSpawnRequestObject struct {
-	Body *ProcessSpawnJSONRequestBody
-}
-
-type ProcessSpawnResponseObject interface {
-	VisitProcessSpawnResponse(w http.ResponseWriter) error
+type DownloadRecording416ResponseHeaders struct {
+	ContentRange string
 }
 
-type ProcessSpawn200JSONResponse ProcessSpawnResult
-
-func (response ProcessSpawn200JSONResponse) VisitProcessSpawnResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type DownloadRecording416Response struct {
+	Headers DownloadRecording416ResponseHeaders
 }
 
-type ProcessSpawn400JSONResponse struct{ BadRequestErrorJSONResponse }
-
-func (response ProcessSpawn400JSONResponse) VisitProcessSpawnResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (response DownloadRecording416Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Range", fmt.Sprint(response.Headers.ContentRange))
+	w.WriteHeader(416)
+	return nil
 }
 
-type ProcessSpawn500JSONResponse struct{ InternalErrorJSONResponse }
+type DownloadRecording500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ProcessSpawn500JSONResponse) VisitProcessSpawnResponse(w http.ResponseWriter) error {
+func (response DownloadRecording500JSONResponse) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessKillRequestObject struct {
-	ProcessId openapi_types.UUID `json:"process_id"`
-	Body      *ProcessKillJSONRequestBody
+type ListRecordersRequestObject struct {
+	Params ListRecordersParams
 }
 
-type ProcessKillResponseObject interface {
-	VisitProcessKillResponse(w http.ResponseWriter) error
+type ListRecordersResponseObject interface {
+	VisitListRecordersResponse(w http.ResponseWriter) error
 }
 
-type ProcessKill200JSONResponse OkResponse
+type ListRecorders200JSONResponse []RecorderInfo
 
-func (response ProcessKill200JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
+func (response ListRecorders200JSONResponse) VisitListRecordersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessKill400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ListRecorders500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ProcessKill400JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
+func (response ListRecorders500JSONResponse) VisitListRecordersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessKill404JSONResponse struct{ NotFoundErrorJSONResponse }
+type GetRecordingQueueRequestObject struct {
+}
 
-func (response ProcessKill404JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
+type GetRecordingQueueResponseObject interface {
+	VisitGetRecordingQueueResponse(w http.ResponseWriter) error
+}
+
+type GetRecordingQueue200JSONResponse RecordingQueueStats
+
+func (response GetRecordingQueue200JSONResponse) VisitGetRecordingQueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessKill500JSONResponse struct{ InternalErrorJSONResponse }
+type GetRecordingQueue500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ProcessKill500JSONResponse) VisitProcessKillResponse(w http.ResponseWriter) error {
+func (response GetRecordingQueue500JSONResponse) VisitGetRecordingQueueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessResizeRequestObject struct {
-	ProcessId openapi_types.UUID `json:"process_id"`
-	Body      *ProcessResizeJSONRequestBody
+type StartRecordingRequestObject struct {
+	Params StartRecordingParams
+	Body   *StartRecordingJSONRequestBody
 }
 
-type ProcessResizeResponseObject interface {
-	VisitProcessResizeResponse(w http.ResponseWriter) error
+type StartRecordingResponseObject interface {
+	VisitStartRecordingResponse(w http.ResponseWriter) error
 }
 
-type ProcessResize200JSONResponse OkResponse
+type StartRecording200JSONResponse StartRecordingDryRun
 
-func (response ProcessResize200JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
+func (response StartRecording200JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessResize400JSONResponse struct{ BadRequestErrorJSONResponse }
-
-func (response ProcessResize400JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type StartRecording201Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response StartRecording201Response) VisitStartRecordingResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
 }
 
-type ProcessResize404JSONResponse struct{ NotFoundErrorJSONResponse }
+type StartRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ProcessResize404JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
+func (response StartRecording400JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessResize500JSONResponse struct{ InternalErrorJSONResponse }
+type StartRecording409JSONResponse struct{ ConflictErrorJSONResponse }
 
-func (response ProcessResize500JSONResponse) VisitProcessResizeResponse(w http.ResponseWriter) error {
+func (response StartRecording409JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStatusRequestObject struct {
-	ProcessId openapi_types.UUID `json:"process_id"`
+type StartRecording429ResponseHeaders struct {
+	RetryAfter int
 }
 
-type ProcessStatusResponseObject interface {
-	VisitProcessStatusResponse(w http.ResponseWriter) error
+type StartRecording429JSONResponse struct {
+	Body    Error
+	Headers StartRecording429ResponseHeaders
 }
 
-type ProcessStatus200JSONResponse ProcessStatus
-
-func (response ProcessStatus200JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
+func (response StartRecording429JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ProcessStatus400JSONResponse struct{ BadRequestErrorJSONResponse }
+type StartRecording500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ProcessStatus400JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
+func (response StartRecording500JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStatus404JSONResponse struct{ NotFoundErrorJSONResponse }
+type StartRecording503JSONResponse Error
 
-func (response ProcessStatus404JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
+func (response StartRecording503JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(503)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStatus500JSONResponse struct{ InternalErrorJSONResponse }
+type StartRecording507JSONResponse Error
 
-func (response ProcessStatus500JSONResponse) VisitProcessStatusResponse(w http.ResponseWriter) error {
+func (response StartRecording507JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(507)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStdinRequestObject struct {
-	ProcessId openapi_types.UUID `json:"process_id"`
-	Body      *ProcessStdinJSONRequestBody
+type StopRecordingRequestObject struct {
+	Body *StopRecordingJSONRequestBody
 }
 
-type ProcessStdinResponseObject interface {
-	VisitProcessStdinResponse(w http.ResponseWriter) error
+type StopRecordingResponseObject interface {
+	VisitStopRecordingResponse(w http.ResponseWriter) error
 }
 
-type ProcessStdin200JSONResponse ProcessStdinResult
+type StopRecording200Response struct {
+}
 
-func (response ProcessStdin200JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response StopRecording200Response) VisitStopRecordingResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type ProcessStdin400JSONResponse struct{ BadRequestErrorJSONResponse }
+type StopRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ProcessStdin400JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
+func (response StopRecording400JSONResponse) VisitStopRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStdin404JSONResponse struct{ NotFoundErrorJSONResponse }
-
-func (response ProcessStdin404JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ProcessStdin500JSONResponse struct{ InternalErrorJSONResponse }
+type StopRecording500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ProcessStdin500JSONResponse) VisitProcessStdinResponse(w http.ResponseWriter) error {
+func (response StopRecording500JSONResponse) VisitStopRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStdoutStreamRequestObject struct {
-	ProcessId openapi_types.UUID `json:"process_id"`
-}
-
-type ProcessStdoutStreamResponseObject interface {
-	VisitProcessStdoutStreamResponse(w http.ResponseWriter) error
+type ConvertRecordingRequestObject struct {
+	Id     string `json:"id"`
+	Params ConvertRecordingParams
 }
 
-type ProcessStdoutStream200ResponseHeaders struct {
-	XSSEContentType string
+type ConvertRecordingResponseObject interface {
+	VisitConvertRecordingResponse(w http.ResponseWriter) error
 }
 
-type ProcessStdoutStream200TexteventStreamResponse struct {
+type ConvertRecording200ImagegifResponse struct {
 	Body          io.Reader
-	Headers       ProcessStdoutStream200ResponseHeaders
 	ContentLength int64
 }
 
-func (response ProcessStdoutStream200TexteventStreamResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
+func (response ConvertRecording200ImagegifResponse) VisitConvertRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/gif")
 	if response.ContentLength != 0 {
 		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
 	w.WriteHeader(200)
 
 	if closer, ok := response.Body.(io.ReadCloser); ok {
 		defer closer.Close()
 	}
-	flusher, ok := w.(http.Flusher)
-	if !ok {
-		// If w doesn't support flushing, might as well use io.Copy
-		_, err := io.Copy(w, response.Body)
-		return err
-	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
 
-	// Use a buffer for efficient copying and flushing
-	buf := make([]byte, 4096) // text/event-stream are usually very small messages
-	for {
-		n, err := response.Body.Read(buf)
-		if n > 0 {
-			if _, werr := w.Write(buf[:n]); werr != nil {
-				return werr
-			}
-			flusher.Flush() // Flush after each write
-		}
-		if err != nil {
-			if err == io.EOF {
-				return nil // End of file, no error
-			}
-			return err
-		}
-	}
+type ConvertRecording202ResponseHeaders struct {
+	RetryAfter int
 }
 
-type ProcessStdoutStream400JSONResponse struct{ BadRequestErrorJSONResponse }
+type ConvertRecording202Response struct {
+	Headers ConvertRecording202ResponseHeaders
+}
 
-func (response ProcessStdoutStream400JSONResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
+func (response ConvertRecording202Response) VisitConvertRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(202)
+	return nil
+}
+
+type ConvertRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
+
+func (response ConvertRecording400JSONResponse) VisitConvertRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStdoutStream404JSONResponse struct{ NotFoundErrorJSONResponse }
+type ConvertRecording404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response ProcessStdoutStream404JSONResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
+func (response ConvertRecording404JSONResponse) VisitConvertRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProcessStdoutStream500JSONResponse struct{ InternalErrorJSONResponse }
+type ConvertRecording409JSONResponse struct{ ConflictErrorJSONResponse }
 
-func (response ProcessStdoutStream500JSONResponse) VisitProcessStdoutStreamResponse(w http.ResponseWriter) error {
+func (response ConvertRecording409JSONResponse) VisitConvertRecordingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConvertRecording500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ConvertRecording500JSONResponse) VisitConvertRecordingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReclaimProveRequestObject struct {
-	Body *ReclaimProveJSONRequestBody
+type GetRecordingLocationRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ReclaimProveResponseObject interface {
-	VisitReclaimProveResponse(w http.ResponseWriter) error
+type GetRecordingLocationResponseObject interface {
+	VisitGetRecordingLocationResponse(w http.ResponseWriter) error
 }
 
-type ReclaimProve200JSONResponse ReclaimProveResult
+type GetRecordingLocation200JSONResponse RecordingLocation
 
-func (response ReclaimProve200JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
+func (response GetRecordingLocation200JSONResponse) VisitGetRecordingLocationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReclaimProve400JSONResponse struct{ BadRequestErrorJSONResponse }
+type GetRecordingLocation400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ReclaimProve400JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
+func (response GetRecordingLocation400JSONResponse) VisitGetRecordingLocationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReclaimProve500JSONResponse struct{ InternalErrorJSONResponse }
+type GetRecordingLocation404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response ReclaimProve500JSONResponse) VisitReclaimProveResponse(w http.ResponseWriter) error {
+func (response GetRecordingLocation404JSONResponse) VisitGetRecordingLocationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRecordingRequestObject struct {
-	Body *DeleteRecordingJSONRequestBody
+type GetRecordingLocation500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetRecordingLocation500JSONResponse) VisitGetRecordingLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRecordingResponseObject interface {
-	VisitDeleteRecordingResponse(w http.ResponseWriter) error
+type GetRecordingManifestRequestObject struct {
+	Id string `json:"id"`
 }
 
-type DeleteRecording200Response struct {
+type GetRecordingManifestResponseObject interface {
+	VisitGetRecordingManifestResponse(w http.ResponseWriter) error
 }
 
-func (response DeleteRecording200Response) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+type GetRecordingManifest200JSONResponse RecordingManifest
+
+func (response GetRecordingManifest200JSONResponse) VisitGetRecordingManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
+type GetRecordingManifest400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DeleteRecording400JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+func (response GetRecordingManifest400JSONResponse) VisitGetRecordingManifestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRecording404JSONResponse struct{ NotFoundErrorJSONResponse }
+type GetRecordingManifest404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response DeleteRecording404JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+func (response GetRecordingManifest404JSONResponse) VisitGetRecordingManifestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRecording409JSONResponse struct{ ConflictErrorJSONResponse }
+type GetRecordingManifest409JSONResponse struct{ ConflictErrorJSONResponse }
 
-func (response DeleteRecording409JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+func (response GetRecordingManifest409JSONResponse) VisitGetRecordingManifestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRecording500JSONResponse struct{ InternalErrorJSONResponse }
+type GetRecordingManifest500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response DeleteRecording500JSONResponse) VisitDeleteRecordingResponse(w http.ResponseWriter) error {
+func (response GetRecordingManifest500JSONResponse) VisitGetRecordingManifestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadRecordingRequestObject struct {
-	Params DownloadRecordingParams
+type StreamRecordingProgressRequestObject struct {
+	Id string `json:"id"`
 }
 
-type DownloadRecordingResponseObject interface {
-	VisitDownloadRecordingResponse(w http.ResponseWriter) error
+type StreamRecordingProgressResponseObject interface {
+	VisitStreamRecordingProgressResponse(w http.ResponseWriter) error
 }
 
-type DownloadRecording200ResponseHeaders struct {
-	XRecordingFinishedAt string
-	XRecordingStartedAt  string
+type StreamRecordingProgress200ResponseHeaders struct {
+	XSSEContentType string
 }
 
-type DownloadRecording200Videomp4Response struct {
+type StreamRecordingProgress200TexteventStreamResponse struct {
 	Body          io.Reader
-	Headers       DownloadRecording200ResponseHeaders
+	Headers       StreamRecordingProgress200ResponseHeaders
 	ContentLength int64
 }
 
-func (response DownloadRecording200Videomp4Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "video/mp4")
+func (response StreamRecordingProgress200TexteventStreamResponse) VisitStreamRecordingProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
 	if response.ContentLength != 0 {
 		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
-	w.Header().Set("X-Recording-Finished-At", fmt.Sprint(response.Headers.XRecordingFinishedAt))
-	w.Header().Set("X-Recording-Started-At", fmt.Sprint(response.Headers.XRecordingStartedAt))
+	w.Header().Set("X-SSE-Content-Type", fmt.Sprint(response.Headers.XSSEContentType))
 	w.WriteHeader(200)
 
 	if closer, ok := response.Body.(io.ReadCloser); ok {
 		defer closer.Close()
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
-
-type DownloadRecording202ResponseHeaders struct {
-	RetryAfter int
-}
-
-type DownloadRecording202Response struct {
-	Headers DownloadRecording202ResponseHeaders
-}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, might as well use io.Copy
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
 
-func (response DownloadRecording202Response) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
-	w.WriteHeader(202)
-	return nil
+	// Use a buffer for efficient copying and flushing
+	buf := make([]byte, 4096) // text/event-stream are usually very small messages
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+			flusher.Flush() // Flush after each write
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil // End of file, no error
+			}
+			return err
+		}
+	}
 }
 
-type DownloadRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
+type StreamRecordingProgress400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response DownloadRecording400JSONResponse) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+func (response StreamRecordingProgress400JSONResponse) VisitStreamRecordingProgressResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadRecording404JSONResponse struct{ NotFoundErrorJSONResponse }
+type StreamRecordingProgress404JSONResponse struct{ NotFoundErrorJSONResponse }
 
-func (response DownloadRecording404JSONResponse) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+func (response StreamRecordingProgress404JSONResponse) VisitStreamRecordingProgressResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DownloadRecording500JSONResponse struct{ InternalErrorJSONResponse }
+type StreamRecordingProgress500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response DownloadRecording500JSONResponse) VisitDownloadRecordingResponse(w http.ResponseWriter) error {
+func (response StreamRecordingProgress500JSONResponse) VisitStreamRecordingProgressResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRecordersRequestObject struct {
+type GetRecordingStatusRequestObject struct {
+	Id string `json:"id"`
 }
 
-type ListRecordersResponseObject interface {
-	VisitListRecordersResponse(w http.ResponseWriter) error
+type GetRecordingStatusResponseObject interface {
+	VisitGetRecordingStatusResponse(w http.ResponseWriter) error
 }
 
-type ListRecorders200JSONResponse []RecorderInfo
+type GetRecordingStatus200JSONResponse RecordingStatus
 
-func (response ListRecorders200JSONResponse) VisitListRecordersResponse(w http.ResponseWriter) error {
+func (response GetRecordingStatus200JSONResponse) VisitGetRecordingStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRecorders500JSONResponse struct{ InternalErrorJSONResponse }
+type GetRecordingStatus400JSONResponse struct{ BadRequestErrorJSONResponse }
 
-func (response ListRecorders500JSONResponse) VisitListRecordersResponse(w http.ResponseWriter) error {
+func (response GetRecordingStatus400JSONResponse) VisitGetRecordingStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartRecordingRequestObject struct {
-	Body *StartRecordingJSONRequestBody
+type GetRecordingStatus404JSONResponse struct{ NotFoundErrorJSONResponse }
+
+func (response GetRecordingStatus404JSONResponse) VisitGetRecordingStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StartRecordingResponseObject interface {
-	VisitStartRecordingResponse(w http.ResponseWriter) error
+type GetRecordingStatus500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetRecordingStatus500JSONResponse) VisitGetRecordingStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StartRecording201Response struct {
+type GetScaleToZeroStatusRequestObject struct {
 }
 
-func (response StartRecording201Response) VisitStartRecordingResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+type GetScaleToZeroStatusResponseObject interface {
+	VisitGetScaleToZeroStatusResponse(w http.ResponseWriter) error
 }
 
-type StartRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
+type GetScaleToZeroStatus200JSONResponse ScaleToZeroStatus
 
-func (response StartRecording400JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
+func (response GetScaleToZeroStatus200JSONResponse) VisitGetScaleToZeroStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScaleToZeroStatus500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetScaleToZeroStatus500JSONResponse) VisitGetScaleToZeroStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartRecording409JSONResponse struct{ ConflictErrorJSONResponse }
+type ResumeScaleToZeroRequestObject struct {
+}
 
-func (response StartRecording409JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
+type ResumeScaleToZeroResponseObject interface {
+	VisitResumeScaleToZeroResponse(w http.ResponseWriter) error
+}
+
+type ResumeScaleToZero200JSONResponse ScaleToZeroStatus
+
+func (response ResumeScaleToZero200JSONResponse) VisitResumeScaleToZeroResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StartRecording500JSONResponse struct{ InternalErrorJSONResponse }
+type ResumeScaleToZero500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response StartRecording500JSONResponse) VisitStartRecordingResponse(w http.ResponseWriter) error {
+func (response ResumeScaleToZero500JSONResponse) VisitResumeScaleToZeroResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRecordingRequestObject struct {
-	Body *StopRecordingJSONRequestBody
-}
-
-type StopRecordingResponseObject interface {
-	VisitStopRecordingResponse(w http.ResponseWriter) error
-}
-
-type StopRecording200Response struct {
+type PauseScaleToZeroRequestObject struct {
 }
 
-func (response StopRecording200Response) VisitStopRecordingResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type PauseScaleToZeroResponseObject interface {
+	VisitPauseScaleToZeroResponse(w http.ResponseWriter) error
 }
 
-type StopRecording400JSONResponse struct{ BadRequestErrorJSONResponse }
+type PauseScaleToZero200JSONResponse ScaleToZeroStatus
 
-func (response StopRecording400JSONResponse) VisitStopRecordingResponse(w http.ResponseWriter) error {
+func (response PauseScaleToZero200JSONResponse) VisitPauseScaleToZeroResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRecording500JSONResponse struct{ InternalErrorJSONResponse }
+type PauseScaleToZero500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response StopRecording500JSONResponse) VisitStopRecordingResponse(w http.ResponseWriter) error {
+func (response PauseScaleToZero500JSONResponse) VisitPauseScaleToZeroResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
@@ -11902,15 +18390,39 @@ func (response StopRecording500JSONResponse) VisitStopRecordingResponse(w http.R
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Send a single CDP command to Chromium
+	// (POST /chromium/cdp)
+	SendCDPCommand(ctx context.Context, request SendCDPCommandRequestObject) (SendCDPCommandResponseObject, error)
+	// Export all browser cookies
+	// (GET /chromium/cookies)
+	GetChromiumCookies(ctx context.Context, request GetChromiumCookiesRequestObject) (GetChromiumCookiesResponseObject, error)
+	// Import cookies into the browser
+	// (POST /chromium/cookies)
+	SetChromiumCookies(ctx context.Context, request SetChromiumCookiesRequestObject) (SetChromiumCookiesResponseObject, error)
+	// Override Chromium's geolocation, timezone and locale
+	// (POST /chromium/emulation)
+	SetChromiumEmulation(ctx context.Context, request SetChromiumEmulationRequestObject) (SetChromiumEmulationResponseObject, error)
+	// List installed extensions with integrity metadata
+	// (GET /chromium/extensions)
+	GetExtensions(ctx context.Context, request GetExtensionsRequestObject) (GetExtensionsResponseObject, error)
 	// Update Chromium launch flags and restart
 	// (PATCH /chromium/flags)
 	PatchChromiumFlags(ctx context.Context, request PatchChromiumFlagsRequestObject) (PatchChromiumFlagsResponseObject, error)
 	// Update Chromium enterprise policies and restart
 	// (PATCH /chromium/policies)
 	PatchChromiumPolicies(ctx context.Context, request PatchChromiumPoliciesRequestObject) (PatchChromiumPoliciesResponseObject, error)
+	// List Chromium's CDP targets
+	// (GET /chromium/targets)
+	GetChromiumTargets(ctx context.Context, request GetChromiumTargetsRequestObject) (GetChromiumTargetsResponseObject, error)
 	// Upload one or more unpacked extensions (as zips) and restart Chromium
 	// (POST /chromium/upload-extensions-and-restart)
 	UploadExtensionsAndRestart(ctx context.Context, request UploadExtensionsAndRestartRequestObject) (UploadExtensionsAndRestartResponseObject, error)
+	// Set Chromium's viewport size and scale at runtime
+	// (POST /chromium/viewport)
+	SetChromiumViewport(ctx context.Context, request SetChromiumViewportRequestObject) (SetChromiumViewportResponseObject, error)
+	// Warm up Chromium so the first CDP client connects quickly
+	// (POST /chromium/warmup)
+	WarmupChromium(ctx context.Context, request WarmupChromiumRequestObject) (WarmupChromiumResponseObject, error)
 	// Execute a batch of computer actions sequentially
 	// (POST /computer/batch)
 	BatchComputerAction(ctx context.Context, request BatchComputerActionRequestObject) (BatchComputerActionResponseObject, error)
@@ -11947,6 +18459,12 @@ type StrictServerInterface interface {
 	// Type text on the host computer
 	// (POST /computer/type)
 	TypeText(ctx context.Context, request TypeTextRequestObject) (TypeTextResponseObject, error)
+	// Get the server's non-sensitive configuration
+	// (GET /config)
+	GetConfig(ctx context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error)
+	// Get the display resolution and color depth
+	// (GET /display)
+	GetDisplayInfo(ctx context.Context, request GetDisplayInfoRequestObject) (GetDisplayInfoResponseObject, error)
 	// Update display configuration
 	// (PATCH /display)
 	PatchDisplay(ctx context.Context, request PatchDisplayRequestObject) (PatchDisplayResponseObject, error)
@@ -12001,6 +18519,9 @@ type StrictServerInterface interface {
 	// Write or create a file
 	// (PUT /fs/write_file)
 	WriteFile(ctx context.Context, request WriteFileRequestObject) (WriteFileResponseObject, error)
+	// Get recent server log entries
+	// (GET /logs)
+	GetLogs(ctx context.Context, request GetLogsRequestObject) (GetLogsResponseObject, error)
 	// Stream logs over SSE
 	// (GET /logs/stream)
 	LogsStream(ctx context.Context, request LogsStreamRequestObject) (LogsStreamResponseObject, error)
@@ -12019,6 +18540,9 @@ type StrictServerInterface interface {
 	// Resize a PTY-backed process
 	// (POST /process/{process_id}/resize)
 	ProcessResize(ctx context.Context, request ProcessResizeRequestObject) (ProcessResizeResponseObject, error)
+	// Send a signal to a process's job
+	// (POST /process/{process_id}/signal)
+	ProcessSignal(ctx context.Context, request ProcessSignalRequestObject) (ProcessSignalResponseObject, error)
 	// Get process status
 	// (GET /process/{process_id}/status)
 	ProcessStatus(ctx context.Context, request ProcessStatusRequestObject) (ProcessStatusResponseObject, error)
@@ -12028,9 +18552,18 @@ type StrictServerInterface interface {
 	// Stream process stdout over SSE
 	// (GET /process/{process_id}/stdout/stream)
 	ProcessStdoutStream(ctx context.Context, request ProcessStdoutStreamRequestObject) (ProcessStdoutStreamResponseObject, error)
+	// Get ZK circuit initialization state and memory footprint
+	// (GET /reclaim/circuits)
+	GetCircuitStatus(ctx context.Context, request GetCircuitStatusRequestObject) (GetCircuitStatusResponseObject, error)
 	// Execute TEE+MPC proof protocol to generate a verifiable claim
 	// (POST /reclaim/prove)
 	ReclaimProve(ctx context.Context, request ReclaimProveRequestObject) (ReclaimProveResponseObject, error)
+	// Get aggregate proof statistics
+	// (GET /reclaim/stats)
+	GetProofStats(ctx context.Context, request GetProofStatsRequestObject) (GetProofStatsResponseObject, error)
+	// Remove orphaned recording and temp files
+	// (POST /recording/cleanup)
+	Cleanup(ctx context.Context, request CleanupRequestObject) (CleanupResponseObject, error)
 	// Delete a previously recorded video file
 	// (POST /recording/delete)
 	DeleteRecording(ctx context.Context, request DeleteRecordingRequestObject) (DeleteRecordingResponseObject, error)
@@ -12040,12 +18573,39 @@ type StrictServerInterface interface {
 	// List all recorders
 	// (GET /recording/list)
 	ListRecorders(ctx context.Context, request ListRecordersRequestObject) (ListRecordersResponseObject, error)
+	// Get recording concurrency and start queue depth
+	// (GET /recording/queue)
+	GetRecordingQueue(ctx context.Context, request GetRecordingQueueRequestObject) (GetRecordingQueueResponseObject, error)
 	// Start a screen recording. Only one recording per ID can be registered at a time.
 	// (POST /recording/start)
 	StartRecording(ctx context.Context, request StartRecordingRequestObject) (StartRecordingResponseObject, error)
 	// Stop the recording
 	// (POST /recording/stop)
 	StopRecording(ctx context.Context, request StopRecordingRequestObject) (StopRecordingResponseObject, error)
+	// Convert a recording to an animated GIF
+	// (GET /recordings/{id}/gif)
+	ConvertRecording(ctx context.Context, request ConvertRecordingRequestObject) (ConvertRecordingResponseObject, error)
+	// Get where a recording is stored
+	// (GET /recordings/{id}/location)
+	GetRecordingLocation(ctx context.Context, request GetRecordingLocationRequestObject) (GetRecordingLocationResponseObject, error)
+	// Get a recording's manifest
+	// (GET /recordings/{id}/manifest)
+	GetRecordingManifest(ctx context.Context, request GetRecordingManifestRequestObject) (GetRecordingManifestResponseObject, error)
+	// Stream recording progress
+	// (GET /recordings/{id}/progress)
+	StreamRecordingProgress(ctx context.Context, request StreamRecordingProgressRequestObject) (StreamRecordingProgressResponseObject, error)
+	// Get recording status
+	// (GET /recordings/{id}/status)
+	GetRecordingStatus(ctx context.Context, request GetRecordingStatusRequestObject) (GetRecordingStatusResponseObject, error)
+	// Get whether scale-to-zero is suppressed and why
+	// (GET /scale-to-zero)
+	GetScaleToZeroStatus(ctx context.Context, request GetScaleToZeroStatusRequestObject) (GetScaleToZeroStatusResponseObject, error)
+	// Resume scale-to-zero
+	// (DELETE /scale-to-zero/pause)
+	ResumeScaleToZero(ctx context.Context, request ResumeScaleToZeroRequestObject) (ResumeScaleToZeroResponseObject, error)
+	// Pause scale-to-zero
+	// (POST /scale-to-zero/pause)
+	PauseScaleToZero(ctx context.Context, request PauseScaleToZeroRequestObject) (PauseScaleToZeroResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -12077,6 +18637,149 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// SendCDPCommand operation middleware
+func (sh *strictHandler) SendCDPCommand(w http.ResponseWriter, r *http.Request) {
+	var request SendCDPCommandRequestObject
+
+	var body SendCDPCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SendCDPCommand(ctx, request.(SendCDPCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SendCDPCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SendCDPCommandResponseObject); ok {
+		if err := validResponse.VisitSendCDPCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetChromiumCookies operation middleware
+func (sh *strictHandler) GetChromiumCookies(w http.ResponseWriter, r *http.Request) {
+	var request GetChromiumCookiesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChromiumCookies(ctx, request.(GetChromiumCookiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChromiumCookies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetChromiumCookiesResponseObject); ok {
+		if err := validResponse.VisitGetChromiumCookiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetChromiumCookies operation middleware
+func (sh *strictHandler) SetChromiumCookies(w http.ResponseWriter, r *http.Request) {
+	var request SetChromiumCookiesRequestObject
+
+	var body SetChromiumCookiesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetChromiumCookies(ctx, request.(SetChromiumCookiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetChromiumCookies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetChromiumCookiesResponseObject); ok {
+		if err := validResponse.VisitSetChromiumCookiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetChromiumEmulation operation middleware
+func (sh *strictHandler) SetChromiumEmulation(w http.ResponseWriter, r *http.Request) {
+	var request SetChromiumEmulationRequestObject
+
+	var body SetChromiumEmulationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetChromiumEmulation(ctx, request.(SetChromiumEmulationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetChromiumEmulation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetChromiumEmulationResponseObject); ok {
+		if err := validResponse.VisitSetChromiumEmulationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExtensions operation middleware
+func (sh *strictHandler) GetExtensions(w http.ResponseWriter, r *http.Request, params GetExtensionsParams) {
+	var request GetExtensionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExtensions(ctx, request.(GetExtensionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExtensions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExtensionsResponseObject); ok {
+		if err := validResponse.VisitGetExtensionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PatchChromiumFlags operation middleware
 func (sh *strictHandler) PatchChromiumFlags(w http.ResponseWriter, r *http.Request) {
 	var request PatchChromiumFlagsRequestObject
@@ -12139,6 +18842,30 @@ func (sh *strictHandler) PatchChromiumPolicies(w http.ResponseWriter, r *http.Re
 	}
 }
 
+// GetChromiumTargets operation middleware
+func (sh *strictHandler) GetChromiumTargets(w http.ResponseWriter, r *http.Request) {
+	var request GetChromiumTargetsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetChromiumTargets(ctx, request.(GetChromiumTargetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetChromiumTargets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetChromiumTargetsResponseObject); ok {
+		if err := validResponse.VisitGetChromiumTargetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UploadExtensionsAndRestart operation middleware
 func (sh *strictHandler) UploadExtensionsAndRestart(w http.ResponseWriter, r *http.Request) {
 	var request UploadExtensionsAndRestartRequestObject
@@ -12170,6 +18897,61 @@ func (sh *strictHandler) UploadExtensionsAndRestart(w http.ResponseWriter, r *ht
 	}
 }
 
+// SetChromiumViewport operation middleware
+func (sh *strictHandler) SetChromiumViewport(w http.ResponseWriter, r *http.Request) {
+	var request SetChromiumViewportRequestObject
+
+	var body SetChromiumViewportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetChromiumViewport(ctx, request.(SetChromiumViewportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetChromiumViewport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetChromiumViewportResponseObject); ok {
+		if err := validResponse.VisitSetChromiumViewportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// WarmupChromium operation middleware
+func (sh *strictHandler) WarmupChromium(w http.ResponseWriter, r *http.Request) {
+	var request WarmupChromiumRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.WarmupChromium(ctx, request.(WarmupChromiumRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WarmupChromium")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(WarmupChromiumResponseObject); ok {
+		if err := validResponse.VisitWarmupChromiumResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // BatchComputerAction operation middleware
 func (sh *strictHandler) BatchComputerAction(w http.ResponseWriter, r *http.Request) {
 	var request BatchComputerActionRequestObject
@@ -12531,6 +19313,30 @@ func (sh *strictHandler) TypeText(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetDisplayInfo operation middleware
+func (sh *strictHandler) GetDisplayInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetDisplayInfoRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDisplayInfo(ctx, request.(GetDisplayInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDisplayInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDisplayInfoResponseObject); ok {
+		if err := validResponse.VisitGetDisplayInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // PatchDisplay operation middleware
 func (sh *strictHandler) PatchDisplay(w http.ResponseWriter, r *http.Request) {
 	var request PatchDisplayRequestObject
@@ -13051,6 +19857,32 @@ func (sh *strictHandler) WriteFile(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
+// GetLogs operation middleware
+func (sh *strictHandler) GetLogs(w http.ResponseWriter, r *http.Request, params GetLogsParams) {
+	var request GetLogsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLogs(ctx, request.(GetLogsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLogs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLogsResponseObject); ok {
+		if err := validResponse.VisitGetLogsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // LogsStream operation middleware
 func (sh *strictHandler) LogsStream(w http.ResponseWriter, r *http.Request, params LogsStreamParams) {
 	var request LogsStreamRequestObject
@@ -13176,7 +20008,40 @@ func (sh *strictHandler) ProcessKill(w http.ResponseWriter, r *http.Request, pro
 
 	request.ProcessId = processId
 
-	var body ProcessKillJSONRequestBody
+	var body ProcessKillJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ProcessKill(ctx, request.(ProcessKillRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ProcessKill")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ProcessKillResponseObject); ok {
+		if err := validResponse.VisitProcessKillResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ProcessResize operation middleware
+func (sh *strictHandler) ProcessResize(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	var request ProcessResizeRequestObject
+
+	request.ProcessId = processId
+
+	var body ProcessResizeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -13184,18 +20049,18 @@ func (sh *strictHandler) ProcessKill(w http.ResponseWriter, r *http.Request, pro
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ProcessKill(ctx, request.(ProcessKillRequestObject))
+		return sh.ssi.ProcessResize(ctx, request.(ProcessResizeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ProcessKill")
+		handler = middleware(handler, "ProcessResize")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ProcessKillResponseObject); ok {
-		if err := validResponse.VisitProcessKillResponse(w); err != nil {
+	} else if validResponse, ok := response.(ProcessResizeResponseObject); ok {
+		if err := validResponse.VisitProcessResizeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -13203,13 +20068,13 @@ func (sh *strictHandler) ProcessKill(w http.ResponseWriter, r *http.Request, pro
 	}
 }
 
-// ProcessResize operation middleware
-func (sh *strictHandler) ProcessResize(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
-	var request ProcessResizeRequestObject
+// ProcessSignal operation middleware
+func (sh *strictHandler) ProcessSignal(w http.ResponseWriter, r *http.Request, processId openapi_types.UUID) {
+	var request ProcessSignalRequestObject
 
 	request.ProcessId = processId
 
-	var body ProcessResizeJSONRequestBody
+	var body ProcessSignalJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -13217,18 +20082,18 @@ func (sh *strictHandler) ProcessResize(w http.ResponseWriter, r *http.Request, p
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ProcessResize(ctx, request.(ProcessResizeRequestObject))
+		return sh.ssi.ProcessSignal(ctx, request.(ProcessSignalRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ProcessResize")
+		handler = middleware(handler, "ProcessSignal")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ProcessResizeResponseObject); ok {
-		if err := validResponse.VisitProcessResizeResponse(w); err != nil {
+	} else if validResponse, ok := response.(ProcessSignalResponseObject); ok {
+		if err := validResponse.VisitProcessSignalResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -13321,6 +20186,54 @@ func (sh *strictHandler) ProcessStdoutStream(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// GetConfig operation middleware
+func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
+		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCircuitStatus operation middleware
+func (sh *strictHandler) GetCircuitStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetCircuitStatusRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCircuitStatus(ctx, request.(GetCircuitStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCircuitStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCircuitStatusResponseObject); ok {
+		if err := validResponse.VisitGetCircuitStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ReclaimProve operation middleware
 func (sh *strictHandler) ReclaimProve(w http.ResponseWriter, r *http.Request) {
 	var request ReclaimProveRequestObject
@@ -13352,6 +20265,56 @@ func (sh *strictHandler) ReclaimProve(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetProofStats operation middleware
+func (sh *strictHandler) GetProofStats(w http.ResponseWriter, r *http.Request) {
+	var request GetProofStatsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProofStats(ctx, request.(GetProofStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProofStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProofStatsResponseObject); ok {
+		if err := validResponse.VisitGetProofStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Cleanup operation middleware
+func (sh *strictHandler) Cleanup(w http.ResponseWriter, r *http.Request, params CleanupParams) {
+	var request CleanupRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Cleanup(ctx, request.(CleanupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Cleanup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CleanupResponseObject); ok {
+		if err := validResponse.VisitCleanupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeleteRecording operation middleware
 func (sh *strictHandler) DeleteRecording(w http.ResponseWriter, r *http.Request) {
 	var request DeleteRecordingRequestObject
@@ -13413,9 +20376,11 @@ func (sh *strictHandler) DownloadRecording(w http.ResponseWriter, r *http.Reques
 }
 
 // ListRecorders operation middleware
-func (sh *strictHandler) ListRecorders(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) ListRecorders(w http.ResponseWriter, r *http.Request, params ListRecordersParams) {
 	var request ListRecordersRequestObject
 
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.ListRecorders(ctx, request.(ListRecordersRequestObject))
 	}
@@ -13436,10 +20401,36 @@ func (sh *strictHandler) ListRecorders(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetRecordingQueue operation middleware
+func (sh *strictHandler) GetRecordingQueue(w http.ResponseWriter, r *http.Request) {
+	var request GetRecordingQueueRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecordingQueue(ctx, request.(GetRecordingQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecordingQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecordingQueueResponseObject); ok {
+		if err := validResponse.VisitGetRecordingQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // StartRecording operation middleware
-func (sh *strictHandler) StartRecording(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) StartRecording(w http.ResponseWriter, r *http.Request, params StartRecordingParams) {
 	var request StartRecordingRequestObject
 
+	request.Params = params
+
 	var body StartRecordingJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		if !errors.Is(err, io.EOF) {
@@ -13504,170 +20495,600 @@ func (sh *strictHandler) StopRecording(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ConvertRecording operation middleware
+func (sh *strictHandler) ConvertRecording(w http.ResponseWriter, r *http.Request, id string, params ConvertRecordingParams) {
+	var request ConvertRecordingRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConvertRecording(ctx, request.(ConvertRecordingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConvertRecording")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConvertRecordingResponseObject); ok {
+		if err := validResponse.VisitConvertRecordingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRecordingLocation operation middleware
+func (sh *strictHandler) GetRecordingLocation(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetRecordingLocationRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecordingLocation(ctx, request.(GetRecordingLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecordingLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecordingLocationResponseObject); ok {
+		if err := validResponse.VisitGetRecordingLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRecordingManifest operation middleware
+func (sh *strictHandler) GetRecordingManifest(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetRecordingManifestRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecordingManifest(ctx, request.(GetRecordingManifestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecordingManifest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecordingManifestResponseObject); ok {
+		if err := validResponse.VisitGetRecordingManifestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamRecordingProgress operation middleware
+func (sh *strictHandler) StreamRecordingProgress(w http.ResponseWriter, r *http.Request, id string) {
+	var request StreamRecordingProgressRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamRecordingProgress(ctx, request.(StreamRecordingProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamRecordingProgress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamRecordingProgressResponseObject); ok {
+		if err := validResponse.VisitStreamRecordingProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRecordingStatus operation middleware
+func (sh *strictHandler) GetRecordingStatus(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetRecordingStatusRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecordingStatus(ctx, request.(GetRecordingStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecordingStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecordingStatusResponseObject); ok {
+		if err := validResponse.VisitGetRecordingStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetScaleToZeroStatus operation middleware
+func (sh *strictHandler) GetScaleToZeroStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetScaleToZeroStatusRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetScaleToZeroStatus(ctx, request.(GetScaleToZeroStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetScaleToZeroStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetScaleToZeroStatusResponseObject); ok {
+		if err := validResponse.VisitGetScaleToZeroStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeScaleToZero operation middleware
+func (sh *strictHandler) ResumeScaleToZero(w http.ResponseWriter, r *http.Request) {
+	var request ResumeScaleToZeroRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeScaleToZero(ctx, request.(ResumeScaleToZeroRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeScaleToZero")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeScaleToZeroResponseObject); ok {
+		if err := validResponse.VisitResumeScaleToZeroResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseScaleToZero operation middleware
+func (sh *strictHandler) PauseScaleToZero(w http.ResponseWriter, r *http.Request) {
+	var request PauseScaleToZeroRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseScaleToZero(ctx, request.(PauseScaleToZeroRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseScaleToZero")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseScaleToZeroResponseObject); ok {
+		if err := validResponse.VisitPauseScaleToZeroResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+XMbN9bgv4Lq/aos7fCQr8xGU/uDIsuJvtixSpI3Mwm9HLD7kcSnbqAHQFOiXZ6/",
-	"fQsPQB9sNC9JsZ39qlIxReJ4wDvw8PCOT1Esslxw4FpFx58iCSoXXAH+8QNNLuFfBSh9JqWQ5qtYcA1c",
-	"m480z1MWU80EH/6XEtx8p+I5ZNR8+g8J0+g4+h/Davyh/VUN7WifP3/uRQmoWLLcDBIdmwmJmzH63ItO",
-	"BZ+mLP6jZvfTmanPuQbJafoHTe2nI1cgFyCJa9iLfhH6tSh48gfB8YvQBOeLzG+uuSUFHc9PRZYXGuRJ",
-	"bJp7RBlIkoSZr2h6IUUOUjNDQFOaKlid4YRMzFBETEnshiMUx1NECwJ3EBcaiDKDc81omi4HUS/Ka+N+",
-	"ilwH87E5+juZgISEpExpM0V75AE5ww9McKK0yBURnOg5kCmTShMwO2MmZBoytWkfmxti8JUxfm57Pu1F",
-	"eplDdBxRKekSN1TCvwomIYmOfy/X8KFsJyb/BZb6TlMW37wVhYJtN7m5P5NCa0sPze3BIYn91ewJM2RH",
-	"Y01umZ5HvQh4kRnYUpjqqBdJNpubfzOWJClEvWhC45uoF02FvKUyqYGutGR8ZkCPDehj+/Xq9NfLHBDx",
-	"po3DTW3WRNyaP4s8csMEJ5iLNBnfwFKFlpewKQNJzM9mfaYtSQrTFXFsR60htzV6E2W9iBfZGHu56aa0",
-	"SDUid4VximwC0ixOswxwcgk5UN2Y141utn0GyN937VX8ncRCyIRxqnG3ygFILhRze9Yeadke6R/7jLRC",
-	"pneRGbqDSPOJoDI5rYmk7WlUw51ug3xaSAlcGzDt4MS0I17qtehhBVocNAhsk1N3lVmK8VkKqxKrLrCo",
-	"IjmVVuhYETcg13Mg/zSg/JNMGaQJUZBCrBW5nbN4PuLVKDnIqZBZj1CeWDQJaY/ixNCu7W02gTIjzebg",
-	"IcippBlokGow4md3NNbpkghe/m57ZgYezwQGIJIVSpMJkFyKBUsgGYx4S8paVs6MzNgoCFsCyxwtks62",
-	"6/5K0tlq70wsYLveb8UCVnvnEpQyYmJT5wvT8GdY1vqqWIo03dTxClvVu4Eex4VU9pxe2xX0KTas904B",
-	"8o0dTaPqsOmQsh7H5flXo7BBTd7W8dvYbzvyGJmpvpXl1jRw21i5X0hIcleDblimOSeu4U6X27PK5Wbk",
-	"IJdLoBpeMQmxFnK53+GZiSSwq+9y250kfnRiGpIDEWuaErvKHoHBbED++vLl4YC8socFngV/ffkStRiq",
-	"jZ4XHUf/9/ej/l8/fHree/H5P6LAXuVUz9tAnEyUSI20qYAwDc0MMS59ZZLh4H9uFJk4U2gzX0EKGi6o",
-	"nu+3jxuW4AFPcJqHB/wSYjz7ZvtBz5I27OeJUUlRw3CnqfST1FZCTtJ8TnmRgWQxEZLMl/kc+Cr+af/j",
-	"Sf+3o/73/Q9/+Y/gYtsLYypP6dLcU9hsx/XMAZW5zgM3sWMT244wTnJ2B6kK6hoSphLUfCyphs1DutbE",
-	"tDYD//SRHGR0aY4fXqQpYVPChSYJaIg1naRwGJz0liUhglqdDZuthT+4tasn0OMo3EZsdijbpZJtte6Q",
-	"AE0gpcuGHnq0qqq8Mk3M6jOWpkxBLHiiyAT0LQD3gBhFGzUNpanUjnqN/Cc0FU5LMNw1QLA4ywygRyGc",
-	"JIXE++c4C6jj11TOQBMtjID0LVuwTYXECQ1rSbA7ZGDJDFJv58CJyoTQ8/+tZQED8i5jGvvQQouMahYb",
-	"jdusYUIVJHibwwlRvqTAZ24d9M6u4+nR0dFRbV0vgwu7zy3DLGGnS0ZYUq7eZX+/65Hlh7pKn1MmVYk7",
-	"PZeimM2NcplaIGaMzwbkrVH1nO5IqCYpUKXJM5ILxrVq3HVXQa5tSEbv3MX2Wf2W+6y9mrU/Wlw2aNjg",
-	"dZWM3ysg8yKjvJ+yGyA/wEez4XEhF1BRM2L4li7tQgjjSgNNzFaljAOV9nqbixQJb0B+NcSEsxGlIVfj",
-	"HORYwQwpzbID5GNksnGmCJVA2IwLCcmgkiITIVKgqH41mjeW9HJHvpRgYFyAhauFwXMLRZsbNvJna53N",
-	"W+xR9zW2BAlpy8KVgyR+vxivxEQ3gOStBY88bcD6dOO1s/NwLw1hK0obKEVnEGC3lYF9w+DY9i53kdLl",
-	"LUrh/Uxcrlf9dlgNSWKjXrauWkGd0+jBV/j38D/pgtqPOEDDoHWN98UEyJwqQuMYFAqFJzmdwZMeeYKX",
-	"5zv9xN4un0ykuFUgn5AFlcwct+7qmOUpHJNRRG8p08R0HsyEFgdP5lrn6ng4BNtmEIvsyeHfiARdSE5q",
-	"zTXTKRwc/m0UjXhIqdUsA1HosYK4QYfftejwrZXWbo14hWEZKg+OdUr1mjBFvjtqSPjnDfm+mdZw87ek",
-	"B4UA70gOppPhqRUqqFbXogfwVN4cComfOBI2elO1P1PKUkhCuy5LoNv3xAVNC3CYhIRMls74YC42bEoo",
-	"Xx5aMZKADMBzpSlPqEys2ZRMpcjsWV5bWAsepRNR6DWDiULnhd52tAIJvj3cr3PQc5DVghy/JMR1mRZp",
-	"ugwI9hXq8BOECOQ1S+GcT0VbHjE1TphcDxWeX0wRWl3nwgdNJpKxof/2cG/MUZ6hQmIfApBPBtZGm1Ed",
-	"HUcJ1dDH3oHdC991zbLs7XbCtCIH5lLbI6Mokbd3sm/+G0XmYjOK+vK2L/vmv1F0OAjNwGkI7h+oAmJ+",
-	"8hepqZlSyOBObH0r9jprm0jYRxhPlhoCdHLFPqJgwZ8H5AiVSw8GAzXYbCPFNTroGpP1PB3UcOg2vYuc",
-	"rpZKQ3a2KM/qVcQobEDiOeUzIGAath9ItiE/Op1CbPhhazrcF5flVPsidTcqCZvFcEvRMFa3gZ1enp1c",
-	"n0W96NfLc/z31dmbM/xwefbLyduzwD0sZIzqdSssb5jSiLfAGo1WbNbW3jHGLQMblgauPSFu9SpVSqXA",
-	"VeONmHXQ1glJxQznWlait/bE2Cayms61IpXErDykjOYx6FIGlKZZHjiZzFlvpq8guqWK5FIkRWypaBvx",
-	"1qH51acOIQzv7BfugeTSvYe3Jfy2LzfeLrr/i03XCFu/1LQM5LsZNx7wko8W43te7xOmNOUxNHS+l499",
-	"qTcw73Spv/9N1wnm6lprPlKuV3YxLKs3kWdlNfAURrTYi0y3HWknct3f7JyA0uNN5nNQ2gBvX9Cs0rDJ",
-	"+tyLlIw3DaxEIWPYesxVVdNP0KutIrRD727qcmmHu8iPwNEq/e5n4j192nJd3Gyk2nOemGMBlFemB5sV",
-	"aXETXMsF1fHcWbb3w3iXaftVt0m7FBTPXhztbuB+1WnYHpDzKREZ0xqSHikU2MfaOZvNQWlCF5Sl5spt",
-	"u3ipKAHJxx2yTjX57qj3/Kj37GXv6dGHMIi4tWOWpLAZX1Nn+JIwNbID3ROMompFcMoWQBYMbo0SUr5p",
-	"DCXgMo1qGGu2gLCkkYBm5HE8lyJjBvZP3bNjU3LqmhI61SBr6/dqrRYEuCokEKYJTWhun9E43BIDdeP2",
-	"jzSBezkHmkyLtIezld+kHeTZ+aLwqvMloSSb58+OtntXWH1e3u/k3WDz96euP7YMTeE5hob+lbO4TqIG",
-	"3Uc925ZKIJrmudWv1psV1xyk5TtptulEvYElwbdl5+xlT/TtD9jw/G+ctdyMrpbZRKQ4OU40IGc0nhMz",
-	"BVFzUaQJmQChtbZEFXkupLa2kLtEaCHSET9QAOTvT5/iWpYZSWDKOCJRHQ6Is50pwnicFgmQUXSJFpVR",
-	"ZG7NV3M21fbjqZap/XSSuq9evxxFg5G1mFujKlPW5B8jgDRVwkAZi2zijizlnpnteH/R/jKOf+Fsf7mm",
-	"Exx2hw1dkda4u0F5LYUR+Gd3ED+YeZSa5WVogl9yI0e4KFTQ8U/Ompb23z+0vTjtSFTOCqMeqd2oiqqx",
-	"FKJpJw8vo3AWcLsf+KpHTFeSS7ZgKcygQ+xQNS4UBG7nq0NSZcnBtDZD8SLF08PL+LbznV174PKLG40n",
-	"j5BEzSFNyy03Z0HBg3e0+DYw1q9C3hgeri6rB7R+WT90IzrLm52E8dACNutcwBfd5PUp9ETqcPap5dt6",
-	"xhdMCo4Xj9L0bWBVoMuj2G19bTcqym+Zr3ezWHcjsNswbdG5kQ3vZZWmdaYrEVauo82Ea++DlXdt12Vw",
-	"ELxlwB3T4/AziFsqMU3QlBsewRqpx5PvXoRtVN+96AM33RNim5JJMZ1azuowUm87mCh092Cfu7H3M6s8",
-	"yHZD3xWbmUMWqdfy8Ar1NlGmsHlDqEXXZ5dvo/Xj1i1lrvnP52/eRL3o/JfrqBf99P5is4HMzb2GiC9R",
-	"Fd33NEE1lpKL63/0JzS+gaR7G2KRBkj2F7glGmTGzMpjkRYZV5ueK3uRFLebxjJNdnz3xFF7FtA1O3aV",
-	"09uGA36avptGx79v8nVsHd2fe6t2LZqmwlztxlovN5+CJ641oSRXUCSiX67+4OL6H4ergtVq9ngQeedz",
-	"fPc2J1LHcRlG2rnRvwylriDOXmjqizB3hNZr+Q4obc1kmu0/TVscfGjhdQ95fl4zGNOJEUiUKDPaOn7I",
-	"Q15u765KZJ2/Cota9/s41N1GsPSpMnwPCWGV01zgkC3tuEXBkrAgpkYdH1MdthOjHddio05mrtsOpuJO",
-	"VtNUF2pHbHinNIWd7SnbLZXyYpzHgfWdKc0yai4jpxfvSYH29BxkDFzTWf0U5Oi2seEYPfPHJ2HTxl7N",
-	"qT1b7XZt0lF6UQZZ12NaBbEEhZgnGWRGR7TQl+9sHSd40NxyUeFUNx5vZMG5QZ9dNiThs6gbsQnbM4jp",
-	"FdXUSLJbyawBdIX07Ds243kReJtLqKZbKRZJfZbBRuthOe6HjWu+l75owHE+g8oM116haaGBdxFJ5WSE",
-	"DYhrPoi2Nam4pUig1UPpLrrT1RnJ6TIV1JBpLkEZCcVnJQadA4KQJGVTiJdx6h5a1X2xWT6sVcRiVhFU",
-	"QSH8TvemCVLrRdOwQtB7dCvRUApSOzhTZIQdR1EXyxr4A6eANYTbn/1LFm5BPC/4TR1g5w9Seplsx8SX",
-	"EKeUZafmfzviHx1fQJozKSE4ygpyzK1UaXxzW9UfeThs6aScnbg2dkgzCiTWNmCjr8xsB/959e4XFzJw",
-	"GER9LuKAYfIHoLHgBH8lVuaTgxRmNF6GXaars7c92HvO/lVA/XguI8QQxjlV+O7uIoRkrxZr1POrDEIv",
-	"bnlownfma0KTRIJSw7yYpCxG01t93rCDgJ834P1NueAspmm6JLVdtbitOm6ew60yIK1qng2+VeUSM9c6",
-	"H0WHax+4xyq4+3ekbFEzE1QcaPFwSxXJaAJbCkfHFhdSLODBzHPXZ2d/eXtxapZvCUKLWKQh7piy2diH",
-	"InfYhRFLtqmZQyxASpYAcfcMMxlRIBcsBvL+8k3DOfHTKNIAN+9lOoqOR9GtUsfDYVwoLbK+BujfDGo+",
-	"isNbNYo+hz0RPSLHSCKqA2YDaim/S9zXqMp5VZeRdfYt/P3lmx756fr6gmSg5yLpjbh/bKsi8WSRgrIe",
-	"mRISF6elcohLV67VlXOaAS7b0lxvZBlDjaLjT6OocHsScNbEthYUbPLj2fUo+hzcmVUv3NA2fdhIdvdS",
-	"L8LEtsZXMvZHwLqrb+O4wGA9pZjgwRuMk4yuyVA6hmhdZJhyQNZhy+jdGww6wEiDoC/ajFNdSNgS5Kuy",
-	"fcuwUq2hF3nJVg2/Bk9XdRh2udbIZa7FTNJ8zmJSTqW2ODr9D2N3AASUED0HCUXmjwgvdH1PoudUE3er",
-	"XCvM8YdxY6PXW/B8y+YRaE7wbofae40vDG9qKB/ho211HiETkGGX0ynjTM23uypXcWu+V9dFeeObg+Wg",
-	"9teqDMCr/d6Intj6Yl9B6zrtCewK/yDf1OEMccxVLAG4mgt9CbNtQse38034yfoklGGEM2coXxN01/Fa",
-	"/Su+Uu8y0Jaea3asJ4pokfdTmJobgjSa3T182XYYM+gu5Heh5zd2E8r2eXWXJaI3xH83CSPIss0o8V09",
-	"mVJNx3frH/9/EpJ9NGpISmxwNqGZKLgeEOvCuAD3vSIYedAjHGa08b3BQ/h2ZyHYEHL4fwzE8RbzJ+KW",
-	"B6Yv8vDk9/HWK+PUt3/43cQVVNu0DbVg+uZUuzPFzkNu7ULXyjCwo9RiSQJ8Q0yFdfWr/Chcp41+YK5d",
-	"B9ivWQoXIDOGuo3aD/6ZFEUefpzBn5y7uiQ/Nizcu8ZFBEL/v3vx4nC3SP+O27KBFX/C138P7/sOeLfx",
-	"ob+dC4X2Y7+31uXHepeg21WybxT+mpiGesqK3ZTNC1ooqEc4CYlvGnhFgqR8X97xgbruLYW5KkLv0/VY",
-	"soZj8dFGpqxPHtwQo8K8Vr+ai+BDJlYos16gyRgT0ISVV8O4bAGb3/ZKbnfjkbJvutzC37PTexV34J7p",
-	"GabmDh72zrysdFvfyKB4mhuOddYGhSYGkN7qcFjH+bOjTQ+FwWczb+AIPHjVFFh7Z3ugJBEItCfoc35l",
-	"CbjbOaWCo+6c4S1P63dn7YZk9A6Dl9hHOOdvf+iGACNdlAu5evvDlhhZjdl/uqX35ZUW+X0JTcgYzDib",
-	"+eU8yyBhVEO6xNRyaPYVhSYzSWOYFilR80IbLWhArudMkQx9iNHwwTg6wUlZ5BoSsmAJCNys8Jv4LtlJ",
-	"LAcbgB4xNclqyp6dNd37JbYweqCW4gbURt/VsAHfwI4PeZg4yRpg5wK9MG3Kr/1Tj/0qmYYyWdp+G7Qe",
-	"6MYzpI8P9BPuC7hpxpyhAQO7o+PoZ5AcUnKe0RkocnJxHvWiBUhlwTkaPB0coUqTA6c5i46j54OjwXMX",
-	"HYcLGXov8eE0pTN/nIUeO96CnAF6fGNL+4YCd0zhU53goHqkyM3tn6wMGvAzXzBKVJGDXDAlZNIbccoT",
-	"gpHrBdcsxZ0rW7+CxbUQqSKjKGVKA2d8NoowGi1lHAhTRExQXBnFdyqkD6FGCe8CItC8anBohXOCGo2O",
-	"536W17h+iwpQ+geRLHfK77kipvxurhjs/JLsHmpBMtxW937x+yjq92+YUDfWGbnfT5iikxT6s7wYRR8O",
-	"9/cftgCFyapqp2UB1qJWZZ19dnQUUL0RfovvBK3m5dIcslcDuz/3ohd2pNAtvpxxuJrk9nMverlNv2aG",
-	"WEyXWmQZlcvoOHpv6bIEMaUFj+cOCdbkjzBjt4p6c5GyuFLyurnCXAn6PnVfNQ1gvhPJjJpvhlqS6nRl",
-	"3MmHCS1/Hhiqsq8T69mF7M4tI74ru5yCxBQ1fhdIRjmdWU/8Gyt4GJ9KqrQsYrSmIhWTszsN3IigK9BG",
-	"NqjeiOdS3C37mMMEknJEu45yfE+GqKadvroY+phDwQ/xbjRJRXwDyYijedvv5UbOvvBo3J+5w0dDKLJn",
-	"G+QPyM8+wsP9ZO6TasQPXByBi6Y5FeKGgXL7OIoOcb8wR4S7LM7LEey3gxG/AiD+0QkpGSpIBjMhZimU",
-	"hD20l7gyCsp/b7fUPVnZdMOKxSeFnr9bgPxJ6/wMfQYTvwdBgFE3NY3V+3wmaQKq7OUO1bf07lRwDjb1",
-	"7gXIC0Mn0fHzZ73oQuRFrk7SVNxC8lrI9zJVaK5oP6hFHz4/lFzztPLNirZVsjNr6ZZwRZ4KmvTBs6zq",
-	"U570fVsj9oQKKDrvsRvmNRWSZEaClEOQjywnVMZztjAcDncaMxrrOWSk4Eb7Hc5FBkMrQobV1MNRcXT0",
-	"PMZnVfMJeiOuQBNpZFxWn8HKbcb3UDRKyTnif6CiYferFIzqhCeXbo/XyaSsSDXLqdTDqZBZH9+/1ugc",
-	"1VZ2h2FVbYzyYdGPe4KOv+b+XtMwmsOHs028FqnBKRrEtCB5SmNwWWI8unbD+srd56T/G+1/POp/Pxj3",
-	"P3x62nv28mXYbveR5WNzQWuD+FtFkHUPAWogy62HesU+JdQHmJLXh5BllLMpKI1H9GH9vWvCuOHETVp9",
-	"CZ5L2xG6maxV4GrY3U+LexryMyupwZICJL2AtLNcUzIHM0c1Tb603GuJoBKbNSI/oMoIJHVYF4LlEp00",
-	"dFfK4cTreGGpd+aj4zgRK7kAW6nz0X7gslSfXJyTmKbpgJy4X/Hktw8MRp2pJ9d3yebmIk0ckcJdnBbK",
-	"EK9Rf3pECcIFEWgKRJdWUgobRWLKrSN/CnQB6LGyKbt+meO6dIphZTS5fQ7xuasxpdVgxNFYYuPgpkWK",
-	"OkQ8d1yVgPXLN/fCuIwkRecBmybBzHYDS5tM3G3XiHvTTE6XZhQO+lbIGyJFwZO+liwnRnXk8RJnAwwb",
-	"5QlbsKSgqRsmJHkDdRLuoQaue39cU5FhX2UEh+zIk/Ulea9khDW1I+o0vcJmK3nMPbM1EVdlMH8kfAVS",
-	"pO+JJptU1ieA92z9RTF0xbIitWFAluvqJR7C9rQWjqy5amhEfTeaLoEmpzXTVmi3HgpdzeoGoYIxZZEC",
-	"NyWeUy2+uffumkXbOgiln1XLyte1nWgb7N7PpnHykUg/bAHdl/zR6uliBjDzeYmFr0Zg/WoNst6mvAW+",
-	"yroBYTSV7/mPhKF2RYKtkfMg89cS2oT4zLoaLJhiE5YyvSxvy18Nxn9iiQutF7f1rF1NNDcrYoS1PswY",
-	"gloLOrV4gWpTd/eIcM+MRnOjPleWmVZqgs8oPTM9X03nPWMLnzHZKqYpUAWoW9UTUW7INR3SeMrM6Y9E",
-	"mu3aIHvKDTPQV3JcIihVPjSLJop4WKGYGWhLMOOyZE+nkPgRdCN33WMej+EkeWHexUhIu9JyEQ+xiz+C",
-	"9qxWm8L5JfmZtlE+mqVmwptb5tB7JDJvF7G5l3bodsGs7MuS+lufGq6BHX8qls48laRR22CsUd5njRx1",
-	"+beqedBhEGUmL0Vp5Ulk7eSVS1stidCIh1IDDchrlL8GMAlz4Pbe3M5B1CMKYMQNMOE8QoTqyow+Y3ow",
-	"lQAJqBst8oGQs+Gd+R+GQwzvnj61H/KUMj60gyUwHcytPHeeFHPBhVT1B/N+Cguo1mtu1M5PJnZbgR5R",
-	"ypnQLBZEEnzxcImtHokdWmWZ9uQGRChSy9ekLdgzvm5LQrrcgvBV6XXcLaqu6Q1U3smPpTG2nKw/Oxyt",
-	"PXFYRmcwzG1QQDXTZutm62CpACA46BdF6CnN8UWSkgpB3gtnAzpdqbGwELPu42ThXKzTpdHehsLwtnf7",
-	"Nt/pmo5Xk6RNbbFh52tkZ3NqYMN/29W/4CQVM/Tu1iy+UeSAC+1iC6yJs0ZBZAJzumCGpOmSLKhc/o3o",
-	"Aq10rtyPZ+DBiGMxjonQ89pS7HOjdydH53Nnu3RP3T0rza14w5mtgM8a5h9yUI6BqnA1waH1+0ArElob",
-	"AVIX2e1E4T+dYHcGjH7fVXH8hfT7qF6TI2JfEKxCbt8Q/hmSkFfei/uR2K9efW5P6ejI6yuxIVlgKl3B",
-	"oodqoxnvoM359N8dwtE5qj0SXtql6+5h5DAr+YpOLSzfikaNbiy4KlwND5aAq4RLsflYykMgpewfbNBo",
-	"lmoLHF/vnQXDly2zEdE+3+c90Pzi6PvN/ZqVtR/QL6BjOYY0pmpoixSOy8yBSCZFyBrfLOT4WCb5cLnI",
-	"fV83Kw98u86viHXtSglFf8pq+z1ebOXCLfBiSys+Nl7alSf3tvmUKLFLTO7HWS8292sWbH8QYxFCXq/O",
-	"sYo374awBmWvrSvA140tjK/6EyAK8VHiSNzyVNDEcNf4I8M4ghnoUNyKLiRXhJLfzi9soMRKfgmHrjIy",
-	"vhYLVS+IsoJ/N/8rJn9jedRMp/L7DhVmvUuL0aD9ojC3run3rwJQHFinHR8V1qSBXt2TaFOU2YedDme3",
-	"r/e6UJpd92ssAyiQsOob/C3SpUNWXYQQ6gnNLbmDXpVOtiBYTeXgo9LkQFNZc33KvOEFfffNWIdr6XrE",
-	"1xA2+U3phIjpFKTCjAqYKIXrdEmmVGmQ5YSYApEnI55A/SvzmUrAZKkfWe4uxDSeM1hggRHQq6MgG4Vf",
-	"PWpcZfboW2Gr3qd2uuxyuWgdHJCf2GwO0v5VVt0hKqNpCiV6FZkUmmh6AyQVfAZyMOJ9iwmlj8m/Dbbt",
-	"EORpj7hILoNYSMjBv58fHfVfHh2Rtz8M1aHp6AJ/mh2f98iEppTHRpUyPYeIAXLw76cva30t4ppd/9rz",
-	"+PRdXh71/1ejUwvMpz38tuzx7Kj/ouzRgZEatYxxmKiOjirZrv9UpT1zWxX1ar9ZkPGDCiVx21UqOu69",
-	"l1i8drz9/5lo1M1ll+LRyK+xj4tyYrEpGsryW9vKhI0Vzr6GE3Y3nbAqQdYmKNTyavXNvkGy+RF0o0Kb",
-	"T7jbwl5JNilTGvV01Uk3VaG4/Q6Tb5NSqlUHSKW6vqU27u8bpBX0hEfMWyfdNm1gabGu65svhvWIz84P",
-	"cXXDZ97K3PEN4glXgOWPMLZgHTNLoEl56Q7y8iXQxF25t2NlnMyrhGb8r4WbRaxB96s0r/fSJVD0B30k",
-	"vzFiQY/M8ipjOpbEocAK+nEt00ond7cT3jyeg19HZp29I9dqiWScO943iMgr0IHqqzXUDTEJj5qzvMSw",
-	"DV3pfrTFGEIf4YKRWjYuQ0hiI6xScAeCc4ORkAknA6yf6KAjosurBw8WwlVqJB0xWPsUU6xlJHAK7Xbl",
-	"Fb1A3TXSyUU5ra+YuD5WHXfhwaKcEEtlgNO3LuoCgU9Tp6/V2cGbNtcGcFI0vCC/2RpDNlaTaVXZNluu",
-	"YaFinSHmsNbNB2ONXUk/qedfqkWhlhdnLbbjg3pg4T2i/tbxw56E/RvLK7KuIfBPQ+S0Hky8QqItenfG",
-	"lQ0Ev6tptIsvRnwzY2w2kTYsoiO+YhLtDiV2Ns4HYy5vVWn7Pdg02g2Lkz9CNjJD78sxrfmUjyu6W5/M",
-	"qKqAkYJVEfDgrLrbjE2S5T6ppYMNA4WxULQhp34f2/SrfoebaneuyAuPh0cRFyduD//kImOVXDvExu1q",
-	"sO/KTaCWFvCx7gCBzIPb43bPxES47HVp1gPp8iquvHXbsTEDWfuuicskD50/4wsRm11M3UjtgqD5rKaJ",
-	"4W4NP/kt/+xSq4ENAFylN5FX5LZipEDDg7M0OLtDicd1tofNpoZAnRyPKJHn3z6irjDvny/yELL2rSJp",
-	"aP1PO01Jts7Ra3Vmm/2BuFo1C2m40xbaoD1o03vAFV5tbcGmkD/31VmtXFB1F3b+uZjymyauCMzf+1dX",
-	"Z30Xmtu/dh6fq6myEkZdQr8pMcNjSR7n7nuwKsQOGy93/pWuJeoCj3Kfv0UyxY1u7bILJ7Rit6RYc5lf",
-	"72SEAa/bGDxf1ZQv2jJ+/oHv3mW61mmZ1Lkzn7OvNo9q2XcvXnSBiUmQO8BamwXaMt82J/49zbF7WjPK",
-	"cOtv/RhFs5Q5Ob0/ZOWqlYqZGlYbG36iEzNXd65DDq8QhMIqaGsp1wsaR+JV7qhgHbTwNFORpuI27HnQ",
-	"KIRRS9W8imbB02WVEY9NiYWdMEUcaGsYs/tU2WWe2trDs1UNxq5+XvTFTrQ3YrblUWYI66s+vUIngwEa",
-	"EwiaqS2D5Cld3mINiaFLEbNF6iI5YVpSuSQXZW9Xg5Qb7pOg5rUU7752Hp1RxpW9iU+kuFUgiSv2OeKC",
-	"k1TENJ0LpY+/f/bs2YBcoxNZAljKlMa+0PCTnM7gSY88ceM+sYmlnrghn1Rl4F0ElCyrUGk/YgUcpqHS",
-	"hcSitryRwShkOHFbUK371J4Oj3Gza831haIeAnBgLbBQXHi1uV9jqqFqCRjSc4WQW4oIEKdjECuTkDu6",
-	"L/q1ItyPFjvbLvP9x9JBA4IuCqgyhUnX5qtIMRWLLDNSQi15PJeCi0L5jFIewVhXeyOGsZb346K4UQb+",
-	"y+C4XrE8dBTaEuRfGW7pGuR+qoqbfx7esGZ0bhDRPzMM89x8L6+VTV+nEm6oib79ZWEvhJrVfJVZgN79",
-	"/E36F3BX/y/FdKVObe2mOAmKfYSNNHdpm/1pqM6u57/p7uEclLDICiUX1//oT2ya0s3EpzTVRbcpsqy8",
-	"jq3+aNp75HPMLip0hLlfvkkv5arOvFteN+oTtoVOg63+NFIHl/OF9ScLQpf+9MMS0+Ja89s3a3GrTj5i",
-	"6WwtHYpCbzLEVZsnCr3WIveF5NE9LEvl2ky3LW1MfndFofNCo5UjZVOIl3EK//2A8ngPKDWqFoVeMZhJ",
-	"W2zb0Plis61MNStDu0rd9XLothC65aUFWGRgQljKsdz8FQGe5IJx7fM6+j6xLXqBRrHrs7Pxz0gh5tO1",
-	"r7Wvej4NjCKUXL+5InPKEzWnN2B9lKoC96560gw4Ftcz7eN6YXDMwePqdNtFYOXumHIyAbIAad2XBO9j",
-	"Fu+Q8axeT/6RrtDNkvVf5AgIVM0PcPoF1pj3hPEV2so6KvZrUZIIoQ7tWPXF1m33LGKL8w0rP4WwAmKD",
-	"66ta3Y+Zy6BVMrA7tVlX6ckvlsXgC6V/KXMf5BIWDM0qvvxgvZphC+su/rLzoPcBmnXEr31gLt91y+KH",
-	"lYPRgGDWMZEZbaqZTKzwqSLdw1nZveutF/WC8EvvpvKJm7UH3LBhlr+4d8RNrRiqfZ1v6ADlr/3XjDM1",
-	"h6R/srYcv5jaivzNoqZT13lAfiyopFyDdSmdALl8ffr8+fPvB+sfCRugXFmXrb0gce5e+wJiQHl29Gwd",
-	"YzNz2LM0xRr7UswkKHN0YjplouXSPg9g9QjZ3O5L0HLZP5nqULnnq2I2s+HUmNUZCxDVSrNWxX/k0jJB",
-	"tYh1lVm/RdWqjMm2meAU8iKgF/MWEiVl9vToDLG9dIyt7pseuQyZ2XDE42w2GLoVh9LiV183SZZQPlgM",
-	"Kk3T+rDNbWsV4Ap4pz724RuuCx08e5+uY1EnBL7BJGq4A2US0UquDcg7ni4xBqeSdTlIcv7Kq9USZkxp",
-	"LBKEGRONBBm0sSzydUiuVUt+NBwHKjLvrl45b9Evm69Si7x5/OBC/l8AAAD//50vtmZSywAA",
+	"H4sIAAAAAAACA+29eXPbRrY3/FVQfG+VpWdISvI2N3HNH7JEObrRNiSdZDL0ywuSTRIjEMBgkcSk/Hz2",
+	"5yzdjQbQ4CbJjmfm1q2MTAC9nj591t/5vTEOF1EYiCBNGt//3ohFAv9KBP3jvTvpin9mIkk7cRzG+NM4",
+	"DFJ4F/90o8j3xm7qhcHBP5IwwN+S8VwsXPzrv2IxbXzf+P8O8vYP+GlywK19/vy52ZiIZBx7ETYCb0OH",
+	"juyxAQ9PwmAKXXyp3lV32PU5dBQHrv+FulbdOT0R34nYkS82G1dhehZmweQLjQO6c6i/Bj6TrzMppOP5",
+	"CbSUwUCPx/i62igcyWTi4U+ufxOHkYhTDwlo6vqJKPdw7IywKSecOmPZnONSe4mTho54EGP4zUmw8SD1",
+	"XN9fthvNRmS0C/3xB/hnsfXreCJiMXF8L0mxi2rLbadDf8DPTpKGUeLAH+lcOFMvhm8Ergx26KVikaxb",
+	"x+KC4H4tvOCcvzxqNtJlJOBTN47dJS1oDLPyYHyN7/+u5/BJvxeO/iGY+k5Ob6DphRtMNl3k4vosRDoP",
+	"J9XlgXYdftZ0RHvWdm7cmWjHwg/dSUOPI0ljL5jhOCI3dnkV7J2ncVbZ4D6sJffxInGoAQErlDQs0wQ2",
+	"k8BHQ88y1J4IJrQvY14I3iYvceRH7+AHf6n+lTjjWLipmKjdTKBb+DQIBC0z7D5QRNNJQieC/QSaS914",
+	"JlLs2jLv/GFlXMdp6gL5AkHRaPhNBweYWEbspXrA1n68hQizdJiIMfc0dTMfNvvosLyql+6Dt8gWDn6B",
+	"nd+7XupMw5g6HMXhfSJiWO9YRP4Selrw643v3x4STfI/cpL0gInMRFwhSkk462gyoVFuRZJCMbCV5+n0",
+	"RnO+eE0vdbQnV58WA1toOvdzgTvhJNl4LMRETKq0+Nk+Yc11t2Bw9I25LTCONIsDoE3cL9fBQygHWeVs",
+	"43Ai8H/L+wS7CGQEp9V4qOiotIfURP6+dS/ncbjwssVJGN56YnsOLic2ps+bjsdnDid2JdL7ML5tc8tO",
+	"MncjUZ3lJFy4XmCZSrMhHiKYiIW1d/DBEvuC0xIGEzhYXjAW1PPHwHtwRBSO5++c1hGtszx1cozIfuDX",
+	"hQsEBb1nI1/kRBBkixGv8TxNo2tgK8bIRmHoC5d4ewA8xTrmyE3n1gfIhXpwk1jYG7wzBtq8cB8cGO0V",
+	"HATgaAsvRR5GBMuchFZxEorECeBqToDVeKmNk8CaZLGwj1sxIOvDO9fPNiAqmrt6u6k2UE493zVjCfWY",
+	"8gGsJ8Uz1/PlPHbhLZVl8YKJeKiu/k2YUNsoIhjrLOk4lndu03IKa2igtFrcbVOtGo9v/exv8Lbc+jTK",
+	"wcOlAOSx4jBS6/JEOh0PXoqdLPaR/Hg7HS9x1Cy+7JlFwpfcsXhuv4Fju+1phCWvtvuxe2ESIkn2AsXW",
+	"d3Jvmg6OVsoZ2LgjhQVnChRUwxR2OdvrqTTZ8nSO8682E6qLl9PnNXK0an7VwDuLzHdTyQO3OFzXoIvF",
+	"Hvwmd2TCcp+AzZghv9aP07mbOvegehCblgwEXnfhF3cEkmFaPVAzEfrhWA9rkyX5YHwC08O/fQuVvj+5",
+	"cV7/2fHdYJbBQEFInRFfGLtBGIDG6ONZW9QJpL8hUVfaPD++OnbUY+f8tPr15002YDd95gsvFalGE9E6",
+	"7TxujWRLnQwnc/BexL4XbLduH4oT34J09RUHKkEYp0y6SLaJM1oSDRttO8c35zYtGxiNO14WVJOKZnIs",
+	"31J3aaQ6BoLTql+ViWul5NDC0JFW0owlYcunSrn5zlRuWt9ZWwqD2SZNHf13oS36Z6mxEt/RYzQ7WcWE",
+	"+qQqbn2940UutUy5wlL8tmwY6abCorX+PBd037vOqbjrw2UECjPwsyDFO199ppgb95YTqnF5QW+BiK2K",
+	"8fmpGp8cLfFE+mDCujIcCmBDU7jElmuVbsupS337Nc4/lIfTl4OAh/IYIvFj/2iRaMItGt95YzFEAQkW",
+	"Bi5zuay2ock7e/U1WrEoqEHz9818e9ZTybZ3bJp/tdUdK2ly3R2rml818J88cY+cZksCV58hrwDZynrT",
+	"WoRRQZuXIFMfTt1xWpD/DclQeLN5WqNQhyPPrxHS7r1JQTY0PrsH4T68H4K86/226qiZFgD+xrl3ySiB",
+	"36np3alVq5620h7wkPSUmtY10LOqjHOTrdvtcq7Zi9yYVd7yLt45yCz4SyfyHoRPNtqTXk/+q8CbTdZ8",
+	"2D5qrtrnGuriF/BOsvfx+tXLNZYyk2D03OwWIBJ2QPBz+As1zz0gcVfvuDMHYR4EApA3UI703aUDjYS+",
+	"P3LjZN/KfXkvh7yz68dx7CehpDcbNZYo0MH3rN3qw1CztvS8fmn//Pa/tzNClijdSrlePM689DyYhltf",
+	"qL/+6Iz587aDBsNpGKYRcHRQpTzhg46KQjtaWkKlqsrXYcdAeBJoTAw8dE3gwWoPggp3gjPkLdAaPRwt",
+	"U5tefBzBBw/0DvC9RRgvi/2EMAzQ+OLwDuhjeCuW3JDzJyc+Gif6HziM4UT4qSs7MgQtmM/b11YTRuWr",
+	"yvA+AKGkc3WdJ+SSYnsqKDtBqoZ8P0fiJiYdIwmby2LO590gYB0IDVuxqLQDasmLFBYWHrgTWs4NZyH7",
+	"Ws2D5dhw72rGZyV6ZTUoOVDkDgUkQ5BYMZ7Dpe6+PLT6T8o7aLEo4OlUojO/7sDrBXq4L40dVf7NVikn",
+	"l9U9d49OeugugaG7eBKSJaiviycZhN3YYG7figPeS900S7Y84ueqbVd6+YgZBxNFb/mBl7PPOUJSNcir",
+	"B1XjFpDVEt1J6D2DBZFvkqbtFYcQoltyY6eiwdsqgllzPXPpZQucWOk9umTMDS3uJtwUUzfeYT+NhSuP",
+	"zLqveL6yaCcX0iReDuMsWH3cp8CTEjbFkIMQPcFi0pR2mQWswsR63um7jeXn6ziC61tMzlAgsGzSNBb1",
+	"G9QPU9en61YRIHe+/eKrFVHDL3ZsX39vfHsZZonYTdgbZWkaWraAmnT4KUoWOOIYZEEQDthBEODdD1qz",
+	"mCK/jaUMu/AmE5JWR+74lhfg3o1NlpAz0zEOfVij7cGvFFKA70ivv9EriDv4zyxqyGasHeC1i6w6sU1v",
+	"4oF0ECNrJkkV33UmGX7Kh4paNU54jZqakwgMbUhfJaul5SsScolSvAXZhNGkI9y00G+V9VvcHr+gfTme",
+	"eAEyROX6oBVTRhtrS8tqS3/bpaUS8aKDZFlHpNEoBDo4MYJdtlCGxYNFFTjJ4hiFjrFq3MH3HBVP01yn",
+	"3WOj1sEWY0C2lUYT6MwX5VgYMxTGpTAKDmfh4BmWW/8Xh/K/LLSCkObDeBKUycZzkLd0K9A/cpUmXYDs",
+	"Yog5yGuCtMtf4yLAvZ/QC/LbPHQDRNzOA/wK3BTtmvI5f7nA8ahDgANyFllCwhwJMxO7gMxHeYE8Y+1t",
+	"WGFYGLQUu7PNPj+FN8tf4yWw2deX8Gb5a7jwkwTZxLqPb/DFH8XS+JYVvHUf9ugt8zORDsdZnKwPoOiJ",
+	"9IReNL/2hYjWfogv5WFMNVxW7bGOrDIorG3wW3N/C+vNLQ/pMJlLqZemsLeFmauJ2Dh33uiaaeI90Yf3",
+	"9PKUTzm2bD3lFF50Cu+hfWO5Y1iWDO4oOZki/tyZqNYdfNHZC8ckJ9AspbLx5zdv9tvOKV8WdBfAL232",
+	"w2MEITT3///9sPXnT7+/ar7+/F/2mC6bMn88SkIfuU0+CHI4Qg8cWVXq5KD9f9ayTOrJtpinwKxScQOP",
+	"d1vHNVNQA59QN08/8K4Y09032230VuM56sMsYcjbNFadGDNxLgTOI2nCLs1A5m4682U0F8C3w9jJAtAx",
+	"EvhIwO9ZhJ+9fY3aKYphyMVLVOK2fjtu/XrY+m7Y+vT7UfOtlVxsvqlTL4l8d4nRst5sy7nX2enU5Tzh",
+	"tg1znbYnWZRbAdJuMh/Grs1VX25Svu3g29jwD785ewv4Ha6qIPN9dEygjjCBhYZzN/LFvrXTGmNYuTdt",
+	"E6sd/4ql3cGsBQoVEj+yZLzox6GPYR0iys04v6ix2azpkXVORiMwkxHqjPAVT6mJNHeIq+ahEJWBLIDL",
+	"NxK0gvHCC0xFy1jAOhI43Wbr7ZxUNcEWq6YzaDyE8WzQcPbmwp1MM38fBw2/3k1H6ldQm0xra84nazb6",
+	"dJsNXmO/j+gHmouV25Rll+dR1fDCrVHTtHoWlyyx+TIBa3LXeIhP8RVyB3s+qOMyEmgk0nu0pcqBoIrG",
+	"4S0pCLuS76Hk4LjoXpW+oHTetvuODdoArYysLsNFUu8WJBVcvVkZmwqsRaaMoTa4QjiWhTRiBk6yCMN0",
+	"/hcMQm071zp8KUtDkIi9MepqOIeRm8iYZOqQbiZfBDM5j9zDAf9nzOuNdWKP0U9xClupp/Y7thxf//eH",
+	"prP8ZCqDkevFid67dB6H2WwuTcU4iBn013YuUUmQWocDqqwvXPjhJWiPMN+kEH9fHrLJBdwHGWz/0oy8",
+	"f1mdzcqHvJcFGrYFF3+EUzPPFm7Q8r1b4bwXv+GCg3R6J3Jqph2+d5c8ESCsJAUmg0vlA0d0YzaMRCFH",
+	"w7SdnynUE3sDwhdRMoQDPEzEjCiNj4OIhnTIgJ7JNeHNglBG6FliPc3XC1N6s+W5jAWOEfaQxlXZwXMe",
+	"RfU0rD2flXmuCX3PDSB6SERbPC68kNR6ydBHYhP1A3QueXjOUWGs6/1StWJhJ8CY6xiN1dvaqqfTRSRm",
+	"LxIHPYZwBICFz1ArkmE7MihSC4Ntp6vCeXSQMN92TpyBHMjNDQJk587Z2eVN58Pwpnv9odvp9YCboVhj",
+	"Vcjhbke5aHg7imxZNVkaZakjX8JlvoW/D5J3IABkwCJ92S96cpQlA8SCti1WcwL9RmIypDAMS19n9Lsj",
+	"X0NGcgtaH0005GHQlyTGtTdzgkwyzpNa3ysb1p6o26ltKZWcKJBkkDmrFeWRSXLGk2hdvbrx52dEtkPt",
+	"a7v+hiPGVBQ0Mg4lL7Bcn2iCTN1FpKRKSbaqO16kPN7XOokkEjanXUctCT3PDzsZPOHyxJG9A/bkh/fO",
+	"kbMAhqfoHYOY4HT5dOOKuWddvNJhlivJ29QsHgA1RMuKVAjYRl5WHqFi1O35Hmuz9U7wxW3SQFblf+Qt",
+	"ViUJF+15ooW+WGQXuPYJmvuUSEQJcs4JRY8lTjIn0X8UuwEm9ckcLVhK9scAPwiDQZBSThiNh6yu71Bp",
+	"wIiwPOGBQmeBsaPvPRJjkGbGqmtqhjgdeQNVdDTzMSWxMouESwW0kOGUUhibDc03h14wVKy18DsuN+rW",
+	"xbexDUwOjEq/T0HG8b3fcLnNn1k9x1e9iYDtS+E4LMnp6wV38IHtSSxACscHUisbjrIE7WDKnzbU3jnu",
+	"LQ3DIcgeS5xGOE3IhUptD/NxyHS9/JG0wcb5k9IvQ2zWR5mYn4XT4dSFi36i/ylT1Mhx4nqLYQLiB+xC",
+	"LIy5TdBpLIcJl0yQDv+Zgbw7FA8630qGRBf6K06AwwrpyAG7HU68eAgam1/8JQvuY4+0cz1AY3fUyPVa",
+	"6WfFTYehZEK1Xv5ZTdim7HDqqLiB/bonlWi3HFj5lWnkz5t0ZP6WnU9U3V49+vfB/7h3Lv9JDRQyXjkt",
+	"Dn7EIAh3PEb5Ap6/wNi6F03nBflAHtIX7CR4odIJnTs39nCtpQcAT8r3oD67lHxIgXmzMA33XmD+RfL9",
+	"wYHgd9pwqF7sv5N5b47xOsVD7u2/GzQGW+VDvq3NhxQ6mZcyI42bR1lJkc+8PSyoW68OtwtIGtdp6BZ6",
+	"2MivXbHd4DjhRi1RQT67Rm3Kky35ULFab2qsT346yqueZ1pWzf2UFJJnMMrQcRrcHofy7rNMD+c3tuXL",
+	"AEWhr43Gy7kqdIsYE6tmz6QTPID1jWmJbKPWMiL41VEDxmqj0MSfIINYrg/MVB3YCQQYIuYz7RKpFpD6",
+	"CHINDEmohrQfj4gVmCEF3pNpzh3fwpvtcfxQZR+xxR2MwQsUyaTjj7gF3ZdtOWsI7+c5Uwb2jkY2YL/e",
+	"VCQl0yAKFWQ4pLxodZOAtO/xK6DHeFNrcDhwrmEWTVBEe1j4qzczd2Ekcw9UROwMrUv8fRu+N/Vy15lh",
+	"TLtMHrdHQNpM9hQ8K4yNOT91JgIuyTg/J3IvKrOxB5dp7xJvChn0nc4v/c5V7/z6qjc8Pe82HZQalKKr",
+	"+wb98WP3IrGS/9x9+eZttbMfxIPT++G4BQ/RmyASHQ1VN+j85ud7f+UeEB0YO8w7q5P2pRWNfpUQFjI6",
+	"WmZO2Y0bGKG6Mo6NukVb9ebhkDAGlR1bim/lBzmbwcaJevEfWSBPi6L0NkJwkB0V2JNjTQy0h8KVSNvG",
+	"RvCkKg5SciYlKBetiYBEmxTmeeQnw248WoQTEn+qzV2geW5BRka5W/heQa3ECbToawvt2O31xIDI10m+",
+	"hT10caLVfhLfP8Qt/P9Bgy32rfi+Fbfw/weN/fbmR+q9mxRZHMZJUcarZSU29pEqO7TliPwmVoZbKtJs",
+	"O4ekUalhoDqzceykzLk2OmsqOjD2cIVjAde9RxGenTttfytvjAwBHYPyBaKEuLPmMG5Cfu50Cv3CSdmY",
+	"DnfdS93Vrpu6HZXYgyRoSSlMwoyIOOl2jvuYTvhz95z+97Rz0aE/up2r48uORd2whSY0642QF16Snqkg",
+	"xtIc0dJNxqHKinkBH2DCSAlSRYgbBUFqrmRxH1yEsxraOnb8cEZ9LXPWakAZVYnMMHmUuBI0ZJoV2nU6",
+	"BZms7NYstmLpEeElBL1PsjFT0SbsrcbwYnZt2zDyw6k0za7E3apy+E3j+FSUzO7xe3UtbBy3VwmX2jK+",
+	"9+kcdxQ/9EiX3QQOlBuMRUF1fPPcjjoc81aOusd7ryRjzkViUjswP6OwinZevY48c0+gojD4aScy3bSl",
+	"rch19yCkCZqz1gVTYUh84Mp4ShYa1sUiwY7G43UNJ2EWj8XGbZY1VtVB05iFbYWub02+tIXu+gFVKjhT",
+	"1z86ClGwytfD27VUex5MyLSeKJ28vV4fD2/tcwlO5q4X/GSoHFZP1zhkCQMuufEtnkrXIcun484wRJbD",
+	"rruCf2MFhqCQ4Ppwx6nFcCcfVDdzMiFPn2S/laacMan+VnG67kbsU+QYNJUq4zmcElLZ3zl5DpZSvOyN",
+	"Jzrnpti2+gbNSmwzkeNEi2KUqpwqXJcm9P4PFvo4QIjHBP/cYwY9CHj9yDogs7+0D4nsA/ug6gbunev5",
+	"5IVQfeIWFr6CaWJOdcEXYMxOjQOj//Pm1ktbchGa+f5ZacpMA9k28juP8AtlWMBCTDzkdFPOq3NTdInE",
+	"YoYpLDG5xNhQjtaMaqYSq2diMnTTItjBKsWsHgNH6trbpqQYygmrIHpMtgW8wfBxGVK1GxveIZxM394v",
+	"Xx9uH1d4WhtP2HbOp8qlRYYajqefwwDQvJITM32iRJVYR+4Z+sLbw+arw+bLN82jw0/2IdKKD71JKSHZ",
+	"ykSnMsIEhowXeqqzgokX+OhkxSxgpENNlAcIJYjhIQmFet+Jdl1KMsZrDccyldwS2pr3Tq86KusctLWU",
+	"0PF05KLUNdF1ESSIcQScw524EUc6BzBCHHXBsk80QWspQ/ya1Jv+xa+5M3aI79NkQ6nim4RzljMAdhOH",
+	"1wTXKVFYyZJIUyRcUkRdSUA2SZQCOJv8LsYVpS76O9fH76yQbnUo+2KdmIsprhT+L5FeWczeXOq1938h",
+	"w9Kw9WS5GIUMK0AdtZ0OAndiF9prjTn6+bsgY0QyuGa0dB4mYQrUMgj2EiGcX46OaC7LBQJvkW8WhJp9",
+	"RJMlnxeGeo39bCKcQaNL3pJBA01Zvbk3TfnPkzT2+a9jX/509mbQaA84NI2NukDSFFvHZnMXM/hHlB00",
+	"knJkIjMBuL0/pcpCRv+i3v7Ud0fU7BYLWmLitLpWfh2HKIWh3+vJXJ+uBkxNlgHykQDUDivqbzwrhrT9",
+	"/VMVwplbglcz1FmS7ajKTYYxaFProRW6WaAytsmbSvFW+Cn84t3B9T0TNWwHjb2IMlOh3XKTbsLkkEmo",
+	"HwxSJ9lF8vhqfiTP3YbChwtNNw8CY86F7+slx7sgC6yGk/G9zcYfxiQU5xakPde0oO3LFgtAuF5gm8B6",
+	"RUgEd/Xk9bstFlnu2e8VYOtOcOcBZZE1QLu1JWaivorl0rdtWMUV1/R23uj6Dax3OvN2rj2Gj/I4u+ah",
+	"0xum52HJFVhlpMmhtessNG2r6i8ePBAhrD4COVUEcGanuL0FdkAPRyCiWg3Hb1+3dEgcveqMsumUT1aN",
+	"A3rTxtDdU9vY5/rd+9HLk/y2274e+tV8pt4gR0rKqbe4ZeSG8wtMrdHvdC8bq9s1zdfy9R/PLy7gf86v",
+	"+vDfHz7ebKBHcd8riLhLouiutwmJsa5z0/9ba8T+uNplGIM0YImcBKmS81Vc5Ip+tgiSdXHBMMXwfl1b",
+	"+MqWAcbUapMHumLFeJueinRctWIvEucf4Wg1+ViaYigUugDDWPs/kSB75x8Ibd17+N4BYmk6QDdN568f",
+	"z+G/SElN52Ove0T/fdkcBEhjTefkGl/q9a/h/X6vj//tn1/hf68/Ygc/n1+d/NAeBI3HU14vcu8LgJS+",
+	"fz2FT9el9VZEoM/NstEemkJoRTFM0+UmQE38Nu5FIrJJ2NJUtAeUvV++oFhDYruIxFmgQH282WvEDjvx",
+	"S6SUygFgxdCcBOpalfD+LY5GpSd8bfduqmz1U2Vfd7gXzw1vmDtCOob7EVtbxVciWyzEdU9v1vmp/cqS",
+	"z2uqH2BMf8tNkIrRipbnh1qEFW2jyTKvzqSHao20DNWFdOuMAjVy+dkWfrDao7YLjo+KlZdhvySt1HP3",
+	"KBtGNjNrR6HSOCc3H0GaRmchfDeGliW0YCVAfYU40lFiiLJIqrXC0Er8jpdrnayHJtxFXaRAPuIyUhaP",
+	"XgcR1EhCVrPVTb6nacEzDcK6jN3l4dvv9PqNnXg7VoI5dVOXSlnEHnt3SqTHsX5eEGWWwAMgQ3cjAW1i",
+	"9tJee2vodj+tnfOj5G4cjkxyTLC56gzxjVQEdUSSZ3wwvJN8vd3Y1DQlpxILN48C2UaQ6HVA4Vsi9hWm",
+	"zQClIoeCGakdlEGawK18byrGy7Evo0iSx+6mjhrIiQVnYRXlhT0I4aI4pEq4Bh4FawT4RqxBM1JuHO60",
+	"AX04aNQdWRy/5RZgLx8/Vn4iWoLxPAtuzQHLmFkdibvxIQ6nNyAl7JK6ZrJnHUTAcLoSEB3tAO5EWht1",
+	"3kI5SzlZrqJu1aBkU3wFhFPrOlIA/2RVa6XRUBkdgsGhNDvuy572z8NY1bZskmaOiXBxbtBJQxCsMM8V",
+	"ISU3SBWXnTUbMilETuxT3Rbusn3Hs1ksZrh1uIEe3DnjxAAixKngDPKCDTLPUAoFVW8uPHTXg9vk4+1g",
+	"wA0JQkCAG32WU+rnPJMlsQaKqwkRXoF8U6oqiTbxKizCTYKdLOOuRGLgUllj4QLLAqKsjZhNlPGmB7xb",
+	"yBH33NR7YK6OXN/VtMNz2vL8hxlmgKG9CLMAA4aAd51ZHGZRfiAqhCITETY8Sfx2kwxqFPnPXiqZj+Ci",
+	"gYnCkdnxvFnIrxzuMHpzaDVjXaIfNuBh1FqytEN9JKaYteYGS/kFioISlW+LsXxnH8t3h6BYS3kV3cKr",
+	"B7Vtn9/Z+/zu6ftUBGmVTPPjqVdV1nGTFM3ReW3nhimDaYQJHNZ/GXJOxCDgIn5Hh4dw2FC1hH0hcgRS",
+	"4XD6QSPEwFTlH7Fni1DO2ob0mZPiNhQoo1lqXMx6EM4BB7xJsJUVlFbRYei7DSaxIaGWg1GpdXO5mg2d",
+	"YFSYnI3vyOiZE1qv7ZhOP4+UkUe+JBOiUyFhKPRqII4VGPBY9+7Id7hJbAVzU9GypSNbnL3/6V1fSVAu",
+	"K24MlTSyCLLCHWOMLT51eJucPR8u4PHSDjSUq/yWckGBByKBaRXQGIw0RqCLuXlImgaaX1PN0jr68D6w",
+	"dXiNP4P0RBFLB1E28r0xeU7NfmsrSHIVkGoGjSpH4y8dY1V5b/MP1/dRy1quzOQmxWZ0mgGmLQ4a+yuD",
+	"hoeJdfUfHP2GWd0qL9xG+4DBxAt3IjbUyeSxQH4onsy72u90/nR5cyIZhpJFbadj6s2GqoxsjVufdolf",
+	"xT5U/SNdiwo6U4U1KCHJzBv9fdBIhbj9iE7w7weN+wQzRscZnLJFCx60bttG+ujBfTJofLaz6HIKs33M",
+	"OFStNuq9N6hKos9o7EqOL4ZRN50f+n1dJ3UQqADGHOsyznwEWUPJBziEREJUWevspW87F97CYyiJQdDt",
+	"nFwcn18OL49/QTiOn85PO93hzXH3+LI3/PH9O4cyomNOtkwcKijqvIZLbK82XXu/tLR4d9K6MlE3B7J2",
+	"K/wGTzO56JZEXXqX50qvfOj0YeVrlp6jgzZwj9N7LPSOY7yJC1jxCOWSYSQDqV1wTWdA4Q5Cj/uczMgp",
+	"yyrcbRCQyJyo+6+oVBFGThglusynwJOIRlKCywWJkXeKirXOZhgqGPNBG6qkdG/yl0F2ePhqnBekpX9j",
+	"nC3Bm8EYRxhTBDIE3wcHeZwUhWugou94U0Y64BnidYjxNilagLm0npQUvMAxgAkmPFn+iF6bUo4f9eMO",
+	"gjeSDiyp7vvKCJmHEmosfWW7NTfSLPtI+XhDmCuFVG7go6BgLT29JsfHGsxuo/BYPSvMBG1RMiDyf3mU",
+	"CPFGQq5QGK6H8BE8wqGZK9imKuFYczA/Wyc/HJ9fDbs3J0MspocNqic/dbrnZ+dw4tC31D0+6b8zyzzy",
+	"mstoVRzeIMCjZziyFrxDalABxrLKWLVEhfAxh2BLtX3Ny3GSNi72ae2t8Cijo/0uWJFlPlYS2irNuCDN",
+	"oQhh27G1gOqW6GxcMfd+yGfVzuX7mvzg2lFY9m6SkyUZmXkxK1RJd0CSwZbujYF9+iduAioERax5Qb48",
+	"DHRMnAhh5OF2uLxwYBRuhCIg1tBOMMdYY51lgYp6ba/ionz4V9XR0uG+UtGgf03MM6jlDUXLGM0imbS1",
+	"LGV9vW0pUspXDvThKDuevESSj0k1C/fhglDtCMpuVe7whsTU0+9XTB35HCQkScNsfsUJ6plj2MbOES+j",
+	"NJzFbjQHodfIaV6vc6gHQyk5W4zGqI0KDIYtZgOoL3nvpRdwpRRcgmdZH7mi3izqDqj6bND8cG6rq3v4",
+	"0GKHP7QPL6zqo4nHlIsz4GmTZt0XJixAPVbFo6YpEX80196km12nu76vGkWADr495RujT5P5Zt7cXDBQ",
+	"X9UZGNeGF86F66dzi63+jEKwdbEk3eUL7QOifAa0VUhMJbTJ3EuzO13g193T86sPw17/+OJi2D+/7Fx/",
+	"7A978PvVaU+KjjnSV5J6IDFIf0CTEe+dLMlIjyRYsEEAzJn2geUk2BgfuIy/bDu91F2qqHEpPCmIjXyt",
+	"UG+DoY1FSw64ToqqqVXoJRoi2l5eDlZa+LYse/i5JNDdu9oPTzKJNpBxYg2uKmjRov1It3/eYW7f341O",
+	"QMhOtozaLOZ5zpJHL4ERtkl4VJbp0++ljhBbLphx2WXZxdTMiSgOQ8I8rPcpM/6XQRP5Yfq04vQjpDP6",
+	"/z6qVK8t4aDx24TxQkZLDSJJlbzkYZL+06aTkDo4cVxdu1jjO1iiLNgwbDGkoa12JjjOAg6pl8gCTORC",
+	"kn3KFST27xqBGMgysboEAphbgzKw61pcwo9Y1y7ys0RVrsIxUAyuFPEm1lFYO4qT2pJZ3VI8hoILKCxn",
+	"IT5jA1swyI2gDK10LcpXFO5Lsb8NgCPMtWsWNtGcbj6UerIE8r0I67InfyYYPwOulNl1aK0nT+GatnD1",
+	"HnyABCFfoNQRZaUpAKHqU+mqYk/SZEvFnjmDQANa409Wj34ND7cn4Kq5V1H6kSPI7ZEDbztnGPyAHdPA",
+	"qPysy8nWBrgl2wx0kT15Zer4ep3u4Y4X4kDq6O1F9HrQkF5rZnFUn08tjl3vyCKuVLYariOfki53qD7k",
+	"zLFFSD5jmlDbOfbv8xt1Wp7wBum6xB0VMWhwEz3WlaR4KbF3tg6CnYixi9D2+OuIwwoktqMpCGApt4mI",
+	"YGAiLxand8oLWpIN6JiqKmbf2FY3cyJCChQZV8hI7vb85dvX9nCWBy+143ZqIOE1gfL4uEupwfUgXjkJ",
+	"4NQniPknBSEgOrhL4IRG3XzIg8athxIUP3RZdGKrXHMQDBoaYxNeIGFD8i+ONwOdAMiCagtppC4y0Tuy",
+	"xJXEXsj9jyg0Ytz3fpNTn1jIU42jBZAbZltTICFLCxnCObgnD52irnMkUOnKsrGLqeeLDaDUCjTEdINP",
+	"DaiaRE5Ookc1rH0xSXbsmGs9BaNVMgEWEdcW2QM5yskCeiuiFLuuRosVeiVN4XiLNOIaJvqF5FyJbrre",
+	"tMB93fDrGwGKmQqUL7a92uWEtlnJb1h8Vu4uZAB1AvT2gvOUy1lrBNt8TQukqlPOmesarLLA81ZeJzea",
+	"jra4TDqEOYV3eb4SpreVs5wR4GhJviMpXyvfWaIiX2V0kLzhbRHO8dRWo0VitXBOOVXf1mufBT5n2Kb2",
+	"u0BCD1/Z0rZ1uRi2QHmJNkDWtBW795eqplq9dMGQMhK+xUOcd/c+KGJTYO0RXBR9oymhx6qCIxz3qULg",
+	"PquBR1cjwAoMLY3XrbDSXSrxEarsZ0ttUBBlMHNwneiUv+dc3rzW/NZA1hkJpgFiyrWdLYQ9LqSbMyP1",
+	"EkHHRzWh4LdiSS+eY5LInev36tQnlb9YrgGhGkjsO8QlUqj2gX0AcHWr/PTzYG3v+fkxQ43K4VY0AqBs",
+	"dq/W9ksYd8ARzoPL9/VdElNPJDLf5fv2FsWGfgjvTQKSFqcJikPs81SZ2fyvsZsU439Nh7aAI1oDiE/x",
+	"i/h87RZYT0reyz+jFdzjnxn8F5ZhU95hA9Mnmi0wlepGyMUsHCn7GV7NqCWU+2MD1w0FQHpVZsoIbxbd",
+	"qOqudvOAjoh+MgEC1MQoEZN6w4c8VEaYbsWSZ09xYZpZW3rArGxSH04/aDBiPvUHdM9FQQo+LE8LIe/g",
+	"TKiVJqGd1oWvGDbqvkOtQd3teIxwblJUUSZkBYNcsDVoHY49tjJwfuyHCXqKXe3CLrTO0JYF9cCYDe+9",
+	"KhygrehrE195pZoNZVop7+RK+v4rxns/Jibf1AaCMf04XubltRwKKK8iCLkPJ+r1tJ5pBkZBHi3Y6fh8",
+	"DnLH0jBFTl3HqP9aE7Rf7Y2HLgUoI9Ce8OXrg+s3yQsoNV1MCyiUYmQrhSXtUaZRrejEslphrOudreey",
+	"xe1pGplbcoLmeq6krw3T8UqQ87uxjK/krtrVNRMry/lm6mTZTP91vC7rHA42YuiNXV/0w19FHO5yjfZJ",
+	"mE+I1SDHYewh91YETQkYhcQNjLQcJ8/4unGSWrzlK5InqH3UMKmPjYGn41pTF1qXwvBWN75WG5VNNRs1",
+	"WGbGgv6A7W13usaYTbEydYkXFYeaqKBk0srkqGwgYSvmDp8qw3qCA2+lYQvkvxC6mm6+FDzqNauxU/Kv",
+	"0quKg8NRUzTOdEq32f18WSEjWiGLd8pcv9FSLpyZDqRntVFCUHm7LQlBPsj8QwSVQukBOMJouUWjfCjJ",
+	"ukWVgmo1z8oK8Qc6Hunmutd3DgpvHdArdox9PdwtepR3kr/Uu7NJ2QzdkZ5jU26enaBQjUrmYdoVM+l+",
+	"egKcwB8YH1BL6zOpdq4oPluDHPczIcZt09CG0M7c1gu0FEUtUvyArwfiUWDPW7RpxdNtluvZrtuyXRDw",
+	"Yr3Rq89MiTCsMTU9qi+/K9Svn7rDh9VAfD+EsfcbxpT7Dteyd9wFcse2wxjfd0L+DjwbV6zpBJh+af6O",
+	"+1BjXaMRrKmz+xOOeLxB/4gMaOk+i+ydPwbOmtveCoRt3alwUxnzg8h+IkY5oNjV9odi6yY3xphmII91",
+	"tdJtqJ09mS0q0pRUBlhhvqaEBcpM+g1/HzTOuseXnWH3uN8ZNL53jg4LkfzlcSFKv8r8LMPTwgZlYzJp",
+	"mfD47DdS9W2Ob86l3bpti32MH+PJOIbvvVGG2AaSZ9EQmvlCkL+NJCFOOQ24gqaMyBsEe4MGPWjDF1g2",
+	"xLnAQtIyyYIQL0GDosKNbfsi+aDE+/ayA/TI2TvtvP/4AYGNzq6bzs/H3SsUejvd7nXXXqXk8aUMVlQx",
+	"yCsYwIbNdq5fIF/iyTeNcga8o3YqTxWq6wnI1LDBuzHaMX9cKPu8ivUXOyX3TbHa89EaoEvV4aaT2ij8",
+	"vi7deYcpncHHFAVtyTYXK9UFOTO2ktxjXA5+sJaT8UsV/3dxVch7syNcszeZiGBNMTb2DuUgrfKjtSKl",
+	"fK9m2GiIvkH4KQog35FCiaHYkd9yJoRM4EMB9mnbSkjhmOQI+kry9revX++XsP7/ftj686ffXzVff/6v",
+	"LXI5caz0iKBF1Xg/1ox3k6o59/MwIVAltbbMXRm6lvJkJjtUKZCRQLVVjHq+ENHxON1EFyhlnKO2YZZG",
+	"JDOfTOATE+1+2hL90oRiTnBwNvBLswhloZTI4dqzaXZuXRC0IZ0lP2Oa4m7Ubd/svC4d4ihh6+2atBY4",
+	"uCBQrk8m06ddtufob/3lBiFjtfUqaAW02es0XnazYAe7Vm6Wc51ik9p9f0+8iax2TYYwvzPCgpVNVxZB",
+	"r4MxLtZokojFKjrqXnE/M3ZjOzjjWkTgfh5EirjSsXTy6y5VJcx2faxPKWkpTxMqxYSoJo0yBoTE8JSB",
+	"PDbzp5p7k9dbt72ebHYUXTaPzvDD+zzZFxMWuERAjF5YHwt6MqQKpQSj0xCrxbXeHHG2hO+NHl6+fd2U",
+	"THeSQ+G/fNV2LrOU8yDEw9jP8ECxgPzPiEXcvI7R0TqY9w2DQ0K52fKWeknM9PuXcIXpAJdiZAvI4RJj",
+	"HwR7XSaco2DVbBZZQtFreX5QIfIyzxg5vri4/rlzOjw9791cHP+tJ2e5emKPiFRBvM2wHIlMtaTg51tk",
+	"+eWoleYgYNUSv6c8QbggLrwge4APKWZZg84rZEeZMq1yWPACrEtA2Sj65RReUqEKdM5HcD1Dz6C6T0Vs",
+	"wqmJOw9hoDEOf0/yh0U0gWsR4Qj3myCSwUlF/G/KoVbOLJA20RMALWI5Cjl8INofMfhF9ktrA514sZ6X",
+	"RlDABIRwECBJYBxwnuCTcKo0woZiNoLTIcxTXihMwl2ajKaIsvQiMROLTrvXN8PTjzcX5yegGA9JR+6R",
+	"S1qkdUsLSljsHlt5dQcfKQaqoeabtG5peAsDQdgp5NNNzOtDb7V2PRvFZinSexCE1CwDKRk+EiN3CtcH",
+	"f1uSu1OjLmBqMofKDoK/DxqtNAsE1xVAu6wE+Rk0Pu1LStN1eu7LiFQiheWiszQ8O7u86XwYdn7pd4+H",
+	"x90PvbbTxylhaN+SokolcIIEkl+I1AUZD5O7RZyUqg4YeaEv37y1ScPug9TUgKVV7q+dQqFWcB6TAb48",
+	"XAdka4V1VUgYFkBWw5nHOapt50KkHAQ48WYeUsh8Gc1xLeETugUTTHeB30FxAAby9rVjLGVJ1ndbvx23",
+	"fj1sfTdsffr9qPm2RujfOObrDKO0uf4FfYBGMKB9yuD38FCngrwjDp5LSpZ2MRhL3BJoNEIQhV6Q5nkP",
+	"LpCyKpm3kvsrdgmd3Ym8e+hmzNX6SpFlRZZ+ZAe0ssYZnwEjapExj16gnQrjmRt4v7Esp1hYswL2Y4iA",
+	"GDmdJoVI7U3o+qkC39bQ88p12TUObsMzdFRSYY4OHx0+t5JyjMi6WeyOONBZyyIUNWQG28l1TXTFIbiV",
+	"T05vnPydvN4rwoZFlKFDl0tuYgXGqKR6F/F3EXRbdth23ocIlMblQvMgf4z9ZO5dSjqgfjFSWQ/AmmKw",
+	"WTwgFjpyZZIG/E58JxLydkSpOWYu9D3ooiRw8mdIyLcw0AUmvcaImIaFMGSqBQ1fSaEjmDLXf4wpp0/m",
+	"M7H8SXcdrB5sTOxOYSZNFGRETH8OAryc+Vf8LwIn8L+wwlkGtyIOqakGBq3i+/ivkjBViG4E9iLFwz1u",
+	"Zr8mz2mzSMemFqr1nOj0H8ogXT9MEqoeVSdUDwKQ+eWAR0gImqfRbUSQJ6Fv52abi+EY73YdgMQM7QXC",
+	"BnCN2ThlrSvHofKQo85C3ON7JNl+/iu7wks52yCHqShIGT6396HTNwBvkoPfvcnnA/XWvgNaUMDZnLhn",
+	"LpYTe1dsdRB4eeCeN0UQDdk2VhdLU6rgp26So0PN+VRgFhnBYJ/yR2Ywn08HGVNHKc6vTp5bl1ixlqcX",
+	"V/1HsTwgFQ5RhZInulpAZpVRRYkCHZIqEDpc3BnK1LJal18WLSZhvYAxCPYsEgYI9FIJ5YcwbeMpoya5",
+	"KUf5vHpJ89Tka67dq5c7ZI4ovRFXzoR7kpGcytFEBSqSbJQnTkmWraTn/AFaMk1Jm0dAsxhLqFIDokhn",
+	"TQIzvwWuEKYurFKuMvQ7V8dX/eFfP173j4eX7/f1zBWRoMBqlc9an/70X5vhPBTy6HYzO1CuHbaz3uh2",
+	"vpD1JTlRNtLWKrhLx2Ka+SDRZyn6hnE/PBb4GQiDETpgoHFGCsQdpTDi1dFegYtQayKq4NuENKCvIC/b",
+	"dqUPP/RB+9s5VsBd46c/FVRYsVS62si5gNGBypWstWTY8Sxx7HTrwPsKRnUeUk25BRwZM8ajzgOI7doM",
+	"ZT+78SKLdkShQmFFBtfrEpDINlEk4xspUclSzj11ZMuBEu6aNBwMR0JIMbyWFFoSeVUZ4G+PM5XphsRL",
+	"w/Ux5R3vdmK0+zVVLuGV+k7dQg94p+lSn6UJ1txM+N0aMCizBzbWEkgJItKhvCfXZYPAvQkG7+RdNvWa",
+	"Wjccqyac+F40Ct14stuJWE2lheoPErxhrDrclVLxNU+C56ReinGzcGHHAeiA5wsXYduOb84bhIKXSCif",
+	"9lH7kJxmQDZu5MFPr9qH7VcyI50mcqCKnB6MJ8RvozBJrYr1PVauQOqirZdF1VCxwctsHsZpC6WkiXMq",
+	"7vohFqmUwh3VnSEoPIQKJNmAGXCTjb/qmKjQdzTHUMQNnBgxSsLxLVAHzkBafIwifAnV2bnn0vYStA7W",
+	"lFDvYJSDQAQT1uj3CIbvu5cvX+6TpKHMRm2nxxqFc37KMghw40jIIlL5DNhaxVUA4U5Fwm1xuIRaiQgR",
+	"0zQJ6lQOXS4QVT+GgHaVLSUXE4lU0NggD4OGJZOuGb6okQBZ/Z5QLE0wgUmeaJ+AfPd9yMeaEHFlCHTE",
+	"1lQEQVOYc+x4WOu51x3oulBFcsUoH44wJywooqmXh4fPMgDi0NS/BTBPrjMlBmPui/MDaQLCy13w/MoL",
+	"RX+OtHFRKj2DXGABJ1QgNawl1+3G5YdeX/O8bMPV8z9476ql4gx3+O7NJt+RbQtYj/HVqydbRdmofen0",
+	"xaVPrj42XkLW0bzGOI3r9ZcZl9wNkJs4898NEtSslXHOqF35mWJ8YXcx6osOBh4y4K1+kVvBMVOTpW8M",
+	"5pcH6cxsZgoufysN9Pyy8uCoYd55LnV2BTJPGN+2oZ1j35dRNhoxgYdD3ydzNxLoIwK+d5OBHpkKZKYw",
+	"zhuQpe4poJKr5WYJm8tzziEVqcS9k2ljsVA4MXDvxTZ+8aES+tN4znNb6mr1Hr9IVGzPv9p5KVBm54Gu",
+	"IZTkFNUY07bfvAiIIbDIs6SaMpklsK30pO3IteZrDIW2HOjFXxIB4fUta0vAxcsNTtCOQsgafijBb5GY",
+	"3hVRYFFllxCkZhAWB1XZrycruT39FVUfp/eFr6ra2DoLHamtoiA2VPAWEWWRTjk2TppDCp5iDbf4n5uo",
+	"erLOF3SyFG1qj7Y8ZiVuLxaZr+G+7MfuGLEt6KB11Mt41D6I0JdwWdfSpt8svoGRq7/BpNVj5M6DoPAK",
+	"Am75+QtyqLm9niGiBal8zRx/yvvNVcL0AO08XmsEK4KQquSxxxFjoJdPaWp3UuixSePKRoLHnC2Rg0At",
+	"vSFk504LWQktp0SOF2I+E2A2nHKzEYNhsRUP3RrGoFfluaTXcj9fS4itzLfmABgAKjrE1eV9/c+xtxx7",
+	"fYYMb9gsP6JcYek3MokEEkJPlHnBA8yOAjxrhb8LfffplzXoFXMbp/NLv3PVO7++6g1Pz7u5Xfz8lHqW",
+	"OjnaPeguhxGRV4KrO7fH8YNWGNEACbPo/XDcQtv1xAPNPuXLm45RKM3oCqpeernyaeRnmUBaXMyCUWlz",
+	"UQj7t6SDC6vrjtMaQbGTL0qx3MnfK3wS5VMG3mdrRw4lrSaL0yNoTD0uEjnIIUzjowBAbAyoNkZLDtUw",
+	"+17i+ZNvUdFY2RpcCar89MhjvFE8u14eAjOuBClWqfw8UIC8+T5VirbteFALBwJJVS1roTcmSbKzxugI",
+	"xRAXgsUunoapLz1KEYaw2qqIxTOsFnDv0JvcKpkZKfM/YDs25mU4pUab6rrJyQBFWXKw3nkJRtvhrTZh",
+	"5IMcbKPKHAYNEowQJmDQoDwe3+NrJxyRe12HHLEaT15kLt9kI/cbnKnq5Yzmv/ttVHJkqNUsCX/aUkxr",
+	"CPf/gpZVFvHBsKfWrRcmtxz41GpNPPLRt2ZRhqFPW8TLljFtaEB22+JG12HJKkjj5/1mNVRPTW42Qiby",
+	"0k8z319+6UuscDY+Ml3qIfpuFgB74k1QOjSMuXQkiGfmseT1pwIzD1qyBIaxEggIFEexh9kEzH7zWz6X",
+	"U139uI1UxSV6Vh8XZ/vTMgi2PS4nQM0YGKdWAf26IKIS17pl67MXTGNXZ9ZJnEvNInsy169JTviHZYtg",
+	"YrF+kWyR56HbV2SovAwHbpaGXL6cTbekpmIqHMV4qrVce7Jv1Dbufrg3z3PcZPMNv7d8RLl+g2BPguOe",
+	"8l0nVUW5joPGPksURsbfXLfAv8Ja9IRwVGEkomSRj6Q9C8OZLzRhH3CuiHbvqN9loA+XVcL5v3cTb3yc",
+	"pXMUu36A1mXsqloD64ApigtfTj5Gs9iFieuv5B1+SRAwUjlJbkR8g3TCLvibMMqi5Jit/Gdh/DH2E8qK",
+	"qhZ9anz6/FR8TdHKN8vaymRHVqFaDsdOh9Xyr6lNv1COjsTZQ30VjrCs7tt0PBX/hrBMaHDaZxnhXrsV",
+	"FWdSnp9CvAyCEcFfTXKfR6jeYkgcyHa3zHIQy76lYZk0Z0jWGDz7coZfQMdTXa01eKpV/1fWz4hySpGK",
+	"et4FGmRs7FYusLaAglqKXmvNNB/pMw7cizmqL9fRfvMiYJDjuXeHJEqx72MufiULpxa1tgOul0ZV56hS",
+	"GlxZVHsIr9eF2TCLDHArbi/j6kt7EHxBGZeXKdfqjsmdRku76jpcADf3QP1LDzDguEX6wgpxt6hKW3gI",
+	"VVjSGgkccd51DspCKBtGW9TCbbF51gqrnmlf1dklpAOMuC7p6rzZB3O4BQ9YZjG0/squl0Jujlu/uq3f",
+	"DlvftTnm5uWbN/YgdaC2oR1I+9ecDs0KjS6OTJoAcs6tR71HCUMecMlsYmBq47neN5PgOdltbVSBHp5U",
+	"r22RESt1B2N3d1MgjqxJJ4oaFDh+03LR8qnRh4MRoiZf+8qtcB69mwaR77kJ8qFk37x/67yQd564Rzt2",
+	"Pb+7NvJkDIsxsFb1LV+3FcM1LJ43FpcCwyOS3HRNtuRQJoD4FBqHAfOmX/PeCybhPWmplNZK7b/nh9jy",
+	"z/T8PUbtJEUrNLS8nRk63/pQFiNUkCs6IH6NRfkntYLPa1BW3Xxle7Kebc3FveDt/o8xeSNhBT2thqyi",
+	"DxSdCJJn0XxM9YRYIiidXo7vqz+7UslR/p4VA8XOOKMho9ozZpwcGdswLy9i6QCUKThE3498N7jVMfKx",
+	"4MkGOs1OMotcZFYB89r9S5YEmfc1CNTph8FyLB0FbsHFlXqYukhjaTs9d0q3LgUnxiKioDt/+Y4qVCir",
+	"oDF6CpqPBeKi2Q4yh2Jq5viMJ6gQ9Gnzz2oTurxrKkGP/1InwVmKtHQacIUwJFm3UqCjfCUUR08c4H7j",
+	"W38pT4WMyz0YKZOZ/VB0ZElvzAHFuGm6OlhUVE04XIw64YBtGdaDKE1IdXDjyKdkSGGcK7QOJci2AqRW",
+	"uNYYj1lhQxJxqqwXtCbRIQlCmbrtBZijqimT3S1oOY8pE4aKVCuUDa7SLKMPeWk4nCyHcpYBFHBLUkJz",
+	"IlG5ZIVtHUBB0eke1TbDsPUp34AsKU7Q+4Jh03Cgxg7PbCxkkjKmM2Fvt2JJ8aVqufIcr8ilKg0BR4w4",
+	"MV7VLbgZIkfm03J+GPpqcJR33iSDM87N2I7pe7Kryd2RYCXPc99aetr+yi2X0kQhRoFB/HFMOPogOHRi",
+	"rAfApOnSMYPDOL4dLhQEgDpsxY07wZcYJuCZ5CPdwWO36ZLpmg+JPtZfdYd6HgnUuEUSRwFnq8ZoTUqo",
+	"7BGHgB/glVK/TZhWcGKEiz+fHKk6OZGt2W5C9Y4ju6T7sHJuHr26OGlCicuxGyqR83XLSfH29etZDPh/",
+	"JtK3ZxXsSv6USWDki+m5/nEY1s+c5KASczbYL4Idqd8mjcL2jIGCBZS3L6y1Xd92dQif5ZwxKMudl3gj",
+	"j3KVlfPhD7PjP6DhgUHs7g1Qu9I2T2J3Vr2ISg4WysV1ZSFMzVBHWZpi1I5hkNBaiUwycSgXrYndB/DV",
+	"HSY7hdJIMAN5LmD0Nja2YEyaINlKgrqhq0HLl39/aDrLTyZkauR6sdV+egpzes57U7f/WL6BDf1Brksa",
+	"Sg46xNvEpUJLFIMpM/TSMCJU32JcZsWpQwt1o958xgNb6GjN2aUKJTxTPYmnWMUPIlVHzehCIjipnjYR",
+	"PvCsrJMPL+Gd5yRz3f7TSIdyFXBmX5fUcV5VfC11K2oIxpzTJJvsGIFdIEb1Gj4qklI/hFtNPDPQrDTH",
+	"f+SwgxyIFN6Gm3sxIpdsDtw1WjoPkzANQ58xMlyJv4Gpy6Q3Sy5qfN5EKB0GPfvl6IiGsVwg1AmZjUhH",
+	"T/OohJmXtqexEDCjW0yUDuPZwQP+J4qh24OHoyP+I/JBCz7gxqCt9pz5uQRSmYcBRkQaWccyL0/NFzVq",
+	"Cak0lktBOJaJdAvxLoRWexQtLyzXMx0H1fxjTwNtqKw78MeRFviON/0jRJcbEH6iwe/rWVXfvRU5SP5z",
+	"SYwVrP/Pco9W3jgepuMeRFyNJ+9pvceucrHkA3Co0a+6oScS48518g1SmdxrthMB52uZGFcxwCBiQvr3",
+	"CSvyIMSzraoP4G+pIeMZnLQoLRbsfAsTyF+KgYUyAomMhEYHOxUZgAHcJs4e2ka5xAW77QwKAo45d+88",
+	"JGkX463i5TsnzchKhz+MhJn7QIV+CEYnn4qKB+eqBlQDQdouZeRg00SxY39NwkELBZPmnm6DROG8g30O",
+	"oyUrElkbBXBDqtymWOH/SsYuDRitFlvunSv4k8Rr59BhrzgL5OwX/1+r600VE3im42eUt9iVO0ry+oPY",
+	"kHgwuazA2+MiENc20hxzjlrmKNE+nmlfymAijzJy4Ez+QLcWzo2NGqt2QVW+WJErLIUyVenCONN5TUhy",
+	"gTfXVcFIQ0QEcmcBYuMuCDQLulegehqemZArXQ4Bcm7cJLlHnLgm37qE1cB1jgQwb0SAQ54TLtDCJOMr",
+	"4PcJsxd+P2WITGCPH7sXzKOAUWB8jQR+6nZOj0/6ndO64DtepmfNuDQKkdiuT17wwoI9qVam0XuDMGgl",
+	"KBmnMsjH7BBpRoYv1BLNXzMRS0afBztwkVDaG5eYufQoIXhBHj2mXiZXZcKVQq+DQTAX7oTq3e79cjcd",
+	"7av36EqQYcO/KJqUuBsjRMrDgahbCF3f+DVmK1Fk5ygjfN1y/XXunFWHGnKQAMmUMvOMNGF2YyGJU7VY",
+	"AYtjT00RajMIPj3T4Cfj0A8R9TFC40czzyOwBKzLET6XzmF08ZXsoLL3+mP7URo+1VpWzu+ut8Prw+/W",
+	"f4fjgpk9fXR2zXSQO0yTA46yGGroN7rdM5sTj17U9Quey5NX7GUrUjlaVW5BIjP9cW58ninIX5jVli+/",
+	"2hcQiMVG+3JKLz73vnAvcIrnjzYV6y3hKU4ed7Jer//uKkzPMPbgCW3MNHLYu9p9UxG5K7bsjKNi/9i7",
+	"RcV0/gU2ivZD71F4H2AULZ6u4W9etFakdp1fz28Y2NkIpGZUEtouXVfNKHyjSKNddevI/uEo/Aq9r0t1",
+	"VvWBcnhOciohVJiM7sarXk2qLqtZlgAq0oCZ47y2pNB2Oc5yXR9lh8JVV3PUQJdEWOYCf4t0KTfLZCGM",
+	"+2tMuYZek3SyAcGCkNyGV509+MPIAlgoey1Jz9jW/kq6HgQrCNv5Fb7HCrWIsYsZ+PACSPRYvYKhqk3s",
+	"bI75ngjzJ/zbjTn/CrNm2I4GOocn7nAkI8IwLbRCx8juLDVOFa7Rt3KsmtWA3Xy65FRoOz9wURv6V6JR",
+	"0iXwuE42IphxrOlN+WoEx9XinUjS753/i7stiy0eNR2Jmy2Bzvf+76vDwxbQtXP5/iDZxw8l5kHxw1dN",
+	"Z+T6WJdgwl8e0A7A90dvjG9544qf/rmp9lN98uaw9d+FjyrDPGrSr/qLl4et1/qLmh0xqGWoCi9akBz0",
+	"XzmovFyqRtN4xkOmP6wQ89tyRXl6H8UW+/Js/5uxxrQ4bc0ekX8NFUSpZItF1oBSjDQAbMYTiBPoggY+",
+	"+ZIKF/of4YbdTibUa2AhKJLySqaJb4xsPhDwnJ6BQ+kJUvAr7J4mG/Qkk5ye1NINZg+e0Ru7XSbfJqXk",
+	"s7YastQEfc6z+AZphZJCuVgHxfZXaQNjO2rVNwy7uMl38DmiVZ5CdaPokNzc8Q3uE80Azm4sKM121WHG",
+	"4GCtdFvPMgb6SpV7s6NMnSmRENv/o5zmcJyKtMVVPx4tSxDrt4ZWf2PEQoHcWpXhXClJHIlgRj80yurW",
+	"nu5qdePniwuuKaO8M36IUTVYRvF+gxuJ+ZCVg25WRD6gisvJ3Iv0DnMW9wpYTURyUcneBFrA6VwYT0Fg",
+	"A76QF4IuSbkIJQ/g8PJ2DbiBEg+eDM1ASyQ1cAQwsXS4ppL0hMA5WRBSHEwWB5AC7SY1pJsNxVC3TfqX",
+	"Cf/5ULfO+udVeLKEf9olnev/rbM6CwbAVMpr5nFQps2VECYuGV7ovKG5Q6GVYNattm1WIkrL9FV3ONi6",
+	"+WRHY1vSn5jFtg0cFq04p+Fm58DE2HgEAMaq87AjYSPGhyZrYwP/ZYjcNeF0SiRaoXdpXFlD8NuaRuvO",
+	"xSBYfzDWm0gLFlFERy+YROtRdaSN88kOl7KqWAucl0wv+gpZexiaX+/Q4l/RMKe71YWkrrLFiMtp+YJF",
+	"BLo488+5shZmL0+ymEpF8tgIM8f3bmmRnFaL3mnl3+2319SeKvELtQ/Pwi6O5Rr+i7OMMrnWsI37MkZA",
+	"SRPAQKOz5Gd665l0AKOL7WMddoSHpWlbS2R9DDwYg60gc34q7+VyrK3+VtU1aZrOU6MYfiVi48mYRuqp",
+	"Qg8yJDFarYPf1ZJ/lmXtBOcNl+ktjHJyKxkpyPAgLQ3S7qD3cZXtYb2p4bWlGJvcKK4y+o1vFNV0pbUi",
+	"EA6L8ai8SQcctl5rSuqR6eUs6dzJOnRfbK/KZiGMGObRWu1B6/wBPVJtaRrWONZeRxWXhXsx14VlWD/M",
+	"BWM9ada/N35pwestmdHf6stA8TJg8cRzZTHFqYPNo1SisgT2ykxsv+C5U166CquzOOU+f4tkSgtdWWWZ",
+	"hcxsV1MsKvOrg4woT34Tg+epIXy5FePnF/R7X6ucQeocS507e+EYc1f4m6ZDCMVvX7/ebzsS9Dfh2qWv",
+	"64ZJBdNrhvX3w9afP/3+qmmvZvpp0xv/kebYHa0ZGqXhW79GySyly24WQrX8cJZslO6AqEw6Jj68D7jO",
+	"MrB5zGjQEOETAjSFn2KC/74VEZWWWYgFOnUHAVWgybGpdOHvPH+iEHp+cf1h+P7j2VmnO7w4v+r0MOmi",
+	"Jgb9IpytdSFesoogIx+k71kOlj0QON86Ol8V6OCx51vxz4kYZbNGU/1878aEt01782mDY3opA0cCrTFV",
+	"RtnEoFaM28B64rVDxhK29iEfHR4ahd3hX/RvpUMdWXSoL1J/g/M6YDM7MOHlJgU4ukyCBbpD4FjyP8ZJ",
+	"+qUPbMVlrs4Ik7gxzvwEHuSsze4kh3f48qqRhEr7noRZPBYr7w5FqvKSyYGMawjU1s00RJu/nb64v0oR",
+	"lwqphwFhk8rKAN7U4bEjK5BDW3E11st12/RjzN3eW/7CENQ8vAoaX02mxKOxmTDpM0f848qPNtkMB80l",
+	"zqBrPiCRLpl5ILHdNsAcjEdeGkPLZsHNMYo7FI0whf1RSHEcJBlQYqA7c7HCTgEkVyb0DQIMFcQyU5g2",
+	"+D3WG8a684JbnWP0FAkJyKFfEHBv03kh233BWXgvFEK8zgBUqcuqau1UBoZOhDE4yr+SRWtLBQNtd6Fc",
+	"gnzeJyyfPYdtpdLXV8o7soyjvjyjXtw/IkZgPgXKxe3RyJkiLMQpDwjzJDod9aa2G34LO3o20Avdw1ei",
+	"g8II6iggh/iM5Tt/CGxIVck4WQaIOxyEWaKgINUGJ5F7H6zd4R699axbTF183T2WQ6jbZHr8lREBqnvr",
+	"rtjc3+UfZB279YqwGtaN/tEjfIb1lrG85ZUiodals4ze3Fld32lDcTZ/SPi+6x+/yQgfZCVYsdGn2hlS",
+	"bK2nOM4vX0tzXX7tX4bqeD7/obunCxFkTH3npv+31ohrZqwnPibUFThCQM0JIYQzQYMEdO8u0QtJ4Nnw",
+	"0z1inik4s2rfjpc6s1DHng0C9eELMv6KGSFn67fxn5G0hebcGzQBRrB1GZKDKp8y9iEXYhgE9KEzEvir",
+	"DuqRrb5AlGcKQXjHgB33WMSr8g7a1rgZUFKxmBA6ywltCwbkV74g9EXRdj4G5CDHiwO1jaXzj3DUQiqN",
+	"Q1+tm4QxwsLOdkw0vll5M/5ljjjP5z9H/EmvFte4XFyDeoHqVp3z1E2zeqef2jB+60sT4DPLqzwpm6gq",
+	"n3yT+UCKCyVqevVbP/E20F3orX8d1oPT+cp6Eg+hTk96v6S6Fezo+mZ9W7mE6zCdraTDMEvXGdzzxYOX",
+	"V1revxI/eoQFWc8NP9vQlqxWF1YDK6igNOR7UzFejn3xn1CF5wtVMKgacy2LhvFYjH3XWxyMvXiceWuq",
+	"vLIX99cfHfU2wsqqCMWU3a4o86pqrlwzRteFQuwxtmK7wSCARY/DB2+B3mT27YIUHaZRjBWIKEbLjdwx",
+	"It1Hvkvm8+816BjheHD/ISEQIMwwARd0j056MkUk8rPEQSz6RQbDKOCxoaI6Ibxs7ngWi3uJaiDFYnhv",
+	"EBjjht7azomaduEBhhDHmOHvO3sn592Tj+f93vD86rw/vDnuHl9cdC7Oe5dUnBrDhrMg5Y9ocUiGf0HK",
+	"wj1i/ekqSrMslvWbx6Eb2630CKLHI9LSzvPVAil0ZLOJ8wv6En8i2SAntnzRXVlbieIQsBZaiXqKlE2b",
+	"udbbk0ivCZaLhma7/LHT73T+dHlz4hDW9DhUdpA7wWyGNbnA+aHfv+np+lmqpID6RpfAgo+hweGPNGr8",
+	"q08k6Y3R3ywRSFFF7V/0nDm8k8wRJIKiGNK5KpLWZADXmQiQFpBInHG8jNJwFrvRXELkomCNkOI0Carv",
+	"h1WqQG8DuuYQ+DBoUQEpG2HJ2d/Qyj2PcGN28ZWEm+IQ6oQbeCxrLRG40tPF8bz87gvUeQuxLCco8hHO",
+	"gvmJ63PFOuRbMRANTAaIj2phOCliOKCLiEp/xcXruCvgcet4ig+q1pVsNmNQCyrJQRWRoRnGXE+MasQx",
+	"FRvb63ZOLo7PL4fdTr/7t+HxWb/THfY6J9dXp70mws9QBIDzhuFD8lVYGVzy+RFF915+maJ7GNWWpJQr",
+	"Lb2xrjyk93PEbyWFmGC0deFF4GNcJzWkG0+1AFxgMokJzfWawxJkg5Z4KAUpyOkqxAKWslvdYTII9Kb8",
+	"1Omen/1t2Dv/cHXc/9jt9PaRS3yp4oSmfIEEm6Se7+fcnyIM105SFYaBK1e1paf38zHczWfX3aG6rfeb",
+	"joyfN2LI5hlh8xKyEDHsIJR4PYOAJJ1EnirmoM9zUIxN0aKF7cgoFCDn6HDLI2P1NhnXHgvu+vJT1w7Q",
+	"LV8lFINHtFS8dvF6Xh8VSPIQVoZgrurE6k5XpQfhXsLIJxLoZGiD5GUpVkRUZR5cAk2GjfEQEtdLHV0a",
+	"Gs8Olh/FRqExhaMuS4LvYYP0F7NDejQkHS0ZksKgAg5lr3xM9YqYQLcsrJF8906B/KCoiWkWeUF2vIyB",
+	"w5FZmG5214ErpOnAfYBECCesSS3B0W87F5ZVGOuqyUb05CCQ48MwPxQsyfrbdiLEcWfk3SRfPAcLPWKm",
+	"myaum+719dnw5+vuj51ub59FaRKd8fJwJ4wDbd4iSLxUNwPGjLZju3hKl2ePKOF5rRSql9oLHMmR6mM+",
+	"nWjqzuDynFFhqEoX8iRQGYLZwRgD11bV/u0KRDJRAM7ys6Spy7oz4jYVGV2gwqtS3pFqrz/2bz72h6fn",
+	"XRYrL2/ob/YlBBjqOfMwg1FMZNMYDHofJNI1AdNOHF9MEd157gUENI4SpZuAOkIA0zCoJe05RkQi5aH2",
+	"BoRz3T09v/owPLnoHF99vBlenl8Njz90FEtqO2fq0FpGAL0rkHMgoCl6UUg7ZNzxQPCFB3obolVzQwww",
+	"Pg5nAaldMExGG/FVGWMkVQYxby+i18WlGQTk1AFaczGMMhvl6UAUGkVFgqDH0EbIJ3L7NowMxnrGBiaV",
+	"jLfFHpgbxR7lLa5fwZpIQtqGPuxCj++Mzbl906bUmwOlQuCq8o9albwQ+4KVbaw2UTe4SbzsZoEttDGP",
+	"3/z0rDUraa/q5fm+nq2cH939xIl57HXr8VXDQJhBwEgj2Hd9jrhaMdbFXEQmtEDOefIcNrvJnIFXu+r9",
+	"Z8W51b2sr5ZTic6Wk/1qCLcSGvz5FYR8YymPASXfkaCLVvPIL62ssQwqedXZ+dXxxfmv+OdKOfTLaG52",
+	"EOEoFnceRUep+2bioGAXGjkrxhGRQIa1dnyFdGiekpUXgU6Q0vdtnqnbdqjqj66sYSgdmSrVptZQfV7H",
+	"a8nsb0+Zclu/Hbd+PWx9N2x9+v2o+daeO1W5D37WJtACHRZMBQm6bu+RM6LUPRJYb4lLP03wFp66sbox",
+	"UE8Ko4gaSb/PQRqzSCmzmHeSG92msTvD4iZNWQOdy1Fg9HJKIQ1wN3cxbZeGNIMbHXpE+TMJ3AirTYEq",
+	"fBwk9yRoEEN/efhSFsJwZfV11QU0wRgO2DHbpFWLSuYpLoE+fu1aQFM1DHsuw9T1k42SGTp9d5Zwfe9I",
+	"lfwY+x4OWpluYOEVHQWgBshSTry+cpjG6ClWRRWMovVJnFeHKCABC0B8mintKW8TcxRjksxT8lmeT1tX",
+	"0GvrUuY/b5E+gVZSh2odqCB1mtYL1Gmi5TvapOrw0QYiS3VhNFrA+Rau3GguY4/zaTvncgfJOFAYJ2WC",
+	"APnqoJW6qV3Kjlo97Gi76R0rtKrREqYYE6XukYw9aOBPyV8OW0eHL18NGk39C/z7dWvQQBFE/YTvvB40",
+	"9gnIQgRqhi8P35o7RuFL81DiYbWdrtI36UAIUnp5DAno7Gn5/fpFoBO23cSRYnEG+f5ib3xUdYWy8F1u",
+	"tLFvs7auKIL20mZp3HQprt3E9VNYL4HSfXEAesSjkfty6UXCCxm39PF4LKKUB5zY4NDukQNKyhg02qv3",
+	"hTai2spPsNawK1RgJvZQotcl1HFEGFJG4XboSSHyl6XsUCxoO700xug0xRUHgZ0tMg+9F+6t9KOhw6Yo",
+	"wcgAgfYgWDONC7gV9Em0AWaWBpkDK5srTZF10jdDVLlu9X5p6a1qnYG0koDy2Tq2aOt96BOUfZC50ZSi",
+	"iNrsnT9uOx8ykBOAptgmCTpV9+zk1atX320zlBs3RsNndRiDBnpIgHnoIYzCyZKLWKu7CEfoGkNTtlTj",
+	"Ph806GoaNEBHVhGF6/fIHGGPDWA7rZU0nu26VDiUl2y8rzt1mgq/usNDitCrXR6vDg+3Fp3hbng29tUv",
+	"IJYbV5v10D0re1ORIMzfrQBYPLSEhCVUjIs8Tpq8ZIcOX8cHoNu9/Sq89T9s8NthMq9s+Dv9sgyb2/2q",
+	"Ag66qLhKe/GQ/JsQ1pePn3p99LaGSWh2JtkFe/NGYhlKnoEq7gb8bQOGVM99/s9GjOfzk9ZqKHmNNjOQ",
+	"IID8Suj9rjLqrzWMoCONKsNrPwAvM5wNNs3XmTr46XZqia03GUuD/bmzpiwXDXR8K5Z/uXP9TJA1mH9A",
+	"FrFg9LAAaznDU7RAcDFk8qWpubKFn+JWtO4F7Sf1tgJ4WpiMxrWowCOWoCu+CICG2lCuQ7EePoPcCOTo",
+	"UYTwZPD/6Lo1mi1SJixqJlb4mNntqf2YuWeNnUnSAaVyonMJ7fL4lyHIZScfu93OVZ+YrGpE+v5z/+4C",
+	"uAGTFcqBf0GRnFqnsU0kKlTih3WYM/qG+ytN5nnjnYyeav2lueRMU3gOz2nOU2FifCmOKX6TWbHsWJZD",
+	"LW45vbAGC3JjQ628ZIVZloeGQeVZ7yQM93QRiZlO4NIFlbEnVYZLjw82Ge6hubzvc8enjuzUJuHzU2wC",
+	"xN9pLEQ9o9jEn2Yx4wJXqyXn0jFQRkV5HJqsz0iQCJrlIOANkb5+Anwld7S2Rk1dz1cFqzGg4eV3beev",
+	"fAD0QeGt9TDyNwaJBlgkLUSTj0jK91I+6F7/uNsf/vVj52Nn2D+/7Fx/7GvHaO1q4dC3XKsTkt9aY4zr",
+	"CJjto2174d6Si5ARkxJ3KtrOsaaRmC4Btfv4ESwCLQ153XVEiEHpuDRyUcnqfXTogF5HkaexhshyNWPh",
+	"IGVQCDEKObfTKqJzWVfPXQQrDGITAUeU4khaP4plUbN0Hy5EMEPQ35dv3nyxzJbiKV3vDnyGTk/5XNkq",
+	"58R0FuTyN0uBddJHTHZIQn2wIr91y6rHly9L/EXiS3fkMc4ewZgRJAmic4dDvFqH+UcUkNcsXavNnCPJ",
+	"GwINkJnvD4I9/emQngzx5/3nD8yTk2cu9Qfwh36hCM3+ppGSSrUyyMHYejhMXMpyn+TqnGJK1IKTAVYp",
+	"jKL22Cd1p3x46Pvzk1Cf1kFAx9XVLNsmp00pYRtGi3cwCWmSTZvxTSsuIqdCd0g1IB3k8bJ//jK7wfrR",
+	"i8JKKwNYErljgnvCHaPAKfSnqsU1PoAr8k5wzFgYLmC1yXUN70685BaOXJi6he3jTof0YCgexkKAIqlj",
+	"aQeBgZE6D31ih0awmjy8zh7ng1HRVD625lvkOhgEWYDeA4o1JXFBX39uwuhWroNFMWl/aWxGo/m3tCul",
+	"FCnkJiCgj2N0ZOdyHAdV45WeX+ERi20yk8KIanOxCba8VMTVMFolrYbRc8feFPrYPfJGgkx/1SAoQogu",
+	"iNul5U4Ofsf0zJk33QiO1GalY5uWG1Be2sT5cH7GAmoyd6maAHAVuAXYWIIh+sELjL313WVbKQosOUoR",
+	"+j50IjdJML+HAxBk/DRDXEeuj2VLK6amFxSc4IexPsQBNMuRFFPUZzAqgkIoVKiEH4a3CcVeyNqtGKVZ",
+	"CCaBmcBAuUMGnZOAcWiXdDHQ0gkQvi4NS2YvpPppxCO59yaYpUYFdzkUS3qnMTvuGBk13AlUEJdk2ZSW",
+	"Ql4FNCLXmYp7facqxp5IdWMQIOKGxNGgmIzc0V+O6GD/4qQm9viERrK5Jti1ROoogbqYnbsmK/cJgnDO",
+	"cJwcCS8JA/auzjAGO1OHzWpAs75ah8taVSFxp43+yXvmPQg/eUc/zQXBId4KESVl4oVDFImxrAZcN3Ai",
+	"JfvQ374uwMp+97Iw+rc7wcrCeZ4JxRmewCFWzy8adS7JvlrJRDrEUST5utF8IMX81AHp5olF168Sm7ml",
+	"fvTo20iymIKSj0AmZWqw3VCIAcq7t8E1hdleGFNOaERkivQnFcMTs0sMqQuV5wZvqibnQGEyqfqE4mi4",
+	"Zg/NDY51JAjkue1cIDZpWXmjFoVMDEBmiDEgQKRc5KeYWC1z+/PoeuABc838DeB6BECVpZf6yPj1sOF0",
+	"3AakSIBmEYYB4yIZhjPxgJnoRqo0gtC7S4oTG+V46zjt/IJfipT5lsqo0EkxpCbQZbLOQHuhNu2Pe5d8",
+	"+hLmY70OK43HmsS/TUwalvPccjw2xhfaj7QirI2O9P/0rq9yUlQka5e/9uAADLLDw1djb0L/K9rqyzZl",
+	"qCkSHgRmQNb3jI+gCbUpLSdUYwmFSrgmmtIviRrTmAW8OWqH+AK6y3+G+wREUfZhRDJGVPZAm4txh5pX",
+	"kMiXJ2wqBXMOSiXq2Hq6S7HWF3KpFvPf/KjpdVh51DTp/btcvZR9V5A48yWwnU0V9lV7NjsLSjbW8WGM",
+	"H+OAIjKb+0v8F1xlLFAZMCH5GQVaRw2PoOzlTUm5qBgNPGioQJhBQ7ZbCjGXCayqjpMZuFQMPXcoPn8Q",
+	"uNakPmgDtNE885VlB4KASQj7cNCAO3Ion6vBJMqPw7AS7JOCkxk0izcvE9pSHm5qBE7xIDgLY1NgKJTe",
+	"wBldB6deIhEwmobsQuyUoYEicrxIRcIMMIVp31nVO0a20SfgRu3vt8wuHoHGVFmIDRGZDCW/QPj/wWF6",
+	"Dhym6mrbuVUF4LBejlBn/0WSw+E0JYeS5igvyT27rpO4aC5Xev3JzUdO9ZYIOmxTyRIygJKRn1+ncBnQ",
+	"PczYOraqexwA8Y485lgLI6HMGpnexIxODgRZHMju9HPMuKQy0VeF560RCuogHf+9RIJ6BKaC0/HbRYOM",
+	"K9PAQ5KAYipaadj6TcTh2pAfBUBW+MqIwIQ7fS58KvjL9yQWiL0TdD0leH1LxwIdjlLQHL3E5wHFX3yP",
+	"9eR5mkbA6UAUD1og8KB5TB0TifgbhEHLD8MINflBwJGj+818xk1GEmgqyDYCAMhAI9+7ue71neIiHERu",
+	"lgjCauC095rz08OP+uGv8Mnzw4RVO7NdQoVdeWLAsNqtT7JIVY6Wmo6FsnhRi9U/yyRG9jrmvzkpINHU",
+	"blLbuaYxMXkhrWSBO50SLgi5PmEK7FxAxo2GDPpsQjwTVCdB79qRuuBTYaz6H3JzHRctl+RwWMiwxqcA",
+	"CYeZF7cZG7YDbPxAK1+kCTj80pdw2rno9Ds1W3fj0oUoN0f6ptGVYu7QNIsZ8bB2p7CZb2WjIp7yk+wT",
+	"zbu8TfB//w8QfVmPhq0BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
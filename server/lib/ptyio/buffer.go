@@ -0,0 +1,123 @@
+package ptyio
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// OverflowPolicy selects what an OutputBuffer does when PTY output arrives while it is full.
+type OverflowPolicy string
+
+const (
+	// OverflowBlock makes Push wait for room, so the PTY isn't read until the client
+	// catches up. A process writing to the PTY stalls once the kernel's buffer fills too.
+	OverflowBlock OverflowPolicy = "block"
+	// OverflowDropOldest discards the oldest buffered output to make room, so the
+	// process never stalls but a slow client misses output.
+	OverflowDropOldest OverflowPolicy = "drop-oldest"
+)
+
+// ErrBufferClosed is returned by Push once the buffer has been closed.
+var ErrBufferClosed = errors.New("output buffer closed")
+
+// OutputBuffer queues PTY output for a client that reads it more slowly than the process
+// writes it, holding at most a fixed number of bytes. It is safe for one producer and one
+// consumer running concurrently.
+type OutputBuffer struct {
+	mu      sync.Mutex
+	room    *sync.Cond // signaled when output is taken or the buffer is closed
+	chunks  [][]byte
+	size    int
+	max     int
+	policy  OverflowPolicy
+	dropped int64
+	closed  bool
+	// ready has an element while there may be output to take.
+	ready chan struct{}
+}
+
+// NewOutputBuffer returns a buffer holding at most maxBytes of output, which must be at
+// least ReadBufferSize.
+func NewOutputBuffer(maxBytes int, policy OverflowPolicy) (*OutputBuffer, error) {
+	if maxBytes < ReadBufferSize {
+		return nil, fmt.Errorf("output buffer must hold at least %d bytes", ReadBufferSize)
+	}
+	switch policy {
+	case OverflowBlock, OverflowDropOldest:
+	default:
+		return nil, fmt.Errorf("unknown overflow policy %q", policy)
+	}
+	b := &OutputBuffer{max: maxBytes, policy: policy, ready: make(chan struct{}, 1)}
+	b.room = sync.NewCond(&b.mu)
+	return b, nil
+}
+
+// Push queues data, applying the buffer's policy when it doesn't fit. It returns
+// ErrBufferClosed if the buffer is closed, including while waiting for room.
+func (b *OutputBuffer) Push(data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(data) > b.max {
+		// only reachable with chunks larger than ReadPTYToWriter produces
+		b.dropped += int64(len(data) - b.max)
+		data = data[len(data)-b.max:]
+	}
+	for !b.closed && b.size+len(data) > b.max {
+		if b.policy == OverflowBlock {
+			b.room.Wait()
+			continue
+		}
+		oldest := b.chunks[0]
+		b.chunks[0] = nil
+		b.chunks = b.chunks[1:]
+		b.size -= len(oldest)
+		b.dropped += int64(len(oldest))
+	}
+	if b.closed {
+		return ErrBufferClosed
+	}
+	b.chunks = append(b.chunks, data)
+	b.size += len(data)
+	select {
+	case b.ready <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Pop takes the oldest queued output without waiting, reporting false if there is none.
+func (b *OutputBuffer) Pop() ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.chunks) == 0 {
+		return nil, false
+	}
+	data := b.chunks[0]
+	b.chunks[0] = nil
+	b.chunks = b.chunks[1:]
+	b.size -= len(data)
+	b.room.Signal()
+	return data, true
+}
+
+// Ready receives when output may have been queued since the last Pop that found none.
+func (b *OutputBuffer) Ready() <-chan struct{} {
+	return b.ready
+}
+
+// Close releases a Push waiting for room and makes further pushes fail. Queued output can
+// still be popped.
+func (b *OutputBuffer) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.room.Broadcast()
+}
+
+// Dropped returns the number of bytes of output discarded to make room.
+func (b *OutputBuffer) Dropped() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
@@ -0,0 +1,79 @@
+package ptyio
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputBuffer_DropOldest(t *testing.T) {
+	b, err := NewOutputBuffer(ReadBufferSize, OverflowDropOldest)
+	require.NoError(t, err)
+
+	half := ReadBufferSize / 2
+	for _, c := range []byte("abc") {
+		require.NoError(t, b.Push(bytes.Repeat([]byte{c}, half)))
+	}
+	assert.Equal(t, int64(half), b.Dropped())
+
+	data, ok := b.Pop()
+	require.True(t, ok)
+	assert.Equal(t, byte('b'), data[0])
+	data, ok = b.Pop()
+	require.True(t, ok)
+	assert.Equal(t, byte('c'), data[0])
+	_, ok = b.Pop()
+	assert.False(t, ok)
+}
+
+func TestOutputBuffer_Block(t *testing.T) {
+	b, err := NewOutputBuffer(ReadBufferSize, OverflowBlock)
+	require.NoError(t, err)
+	require.NoError(t, b.Push(make([]byte, ReadBufferSize)))
+
+	pushed := make(chan error, 1)
+	go func() { pushed <- b.Push([]byte("next")) }()
+	select {
+	case <-pushed:
+		t.Fatal("push into a full buffer should wait for room")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_, ok := b.Pop()
+	require.True(t, ok)
+	require.NoError(t, <-pushed)
+	data, ok := b.Pop()
+	require.True(t, ok)
+	assert.Equal(t, "next", string(data))
+	assert.Zero(t, b.Dropped())
+
+	// closing releases a waiting push
+	require.NoError(t, b.Push(make([]byte, ReadBufferSize)))
+	go func() { pushed <- b.Push([]byte("late")) }()
+	time.Sleep(20 * time.Millisecond)
+	b.Close()
+	assert.ErrorIs(t, <-pushed, ErrBufferClosed)
+	_, ok = b.Pop()
+	assert.True(t, ok, "output queued before close can still be taken")
+}
+
+func TestOutputBuffer_Ready(t *testing.T) {
+	b, err := NewOutputBuffer(ReadBufferSize, OverflowBlock)
+	require.NoError(t, err)
+	require.NoError(t, b.Push([]byte("x")))
+	select {
+	case <-b.Ready():
+	default:
+		t.Fatal("expected ready after push")
+	}
+}
+
+func TestNewOutputBuffer_Invalid(t *testing.T) {
+	_, err := NewOutputBuffer(ReadBufferSize-1, OverflowBlock)
+	assert.Error(t, err)
+	_, err = NewOutputBuffer(ReadBufferSize, "drop-newest")
+	assert.Error(t, err)
+}
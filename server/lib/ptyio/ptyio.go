@@ -17,6 +17,9 @@ const (
 
 	// MaxTerminalDimension is the maximum allowed value for terminal rows/cols.
 	MaxTerminalDimension = 65535
+
+	// ReadBufferSize is the most ReadPTYToWriter passes to its writer at once.
+	ReadBufferSize = 32 * 1024
 )
 
 // AttachMessageType represents the type of control message in the WebSocket attach protocol.
@@ -29,16 +32,19 @@ const (
 	AttachMessageExit AttachMessageType = "exit"
 	// AttachMessageError is sent by the server on errors.
 	AttachMessageError AttachMessageType = "error"
+	// AttachMessageSignal is sent by clients to signal the process's job, e.g. INT for Ctrl-C.
+	AttachMessageSignal AttachMessageType = "signal"
 )
 
 // AttachControlMessage represents control messages sent over the WebSocket attach connection.
 // Control messages use TextMessage type, while data uses BinaryMessage.
 type AttachControlMessage struct {
-	Type     AttachMessageType `json:"type"`               // "resize", "exit", "error"
+	Type     AttachMessageType `json:"type"`               // "resize", "exit", "error", "signal"
 	Rows     int               `json:"rows,omitempty"`     // For resize
 	Cols     int               `json:"cols,omitempty"`     // For resize
 	ExitCode *int              `json:"exitCode,omitempty"` // For exit
 	Message  string            `json:"message,omitempty"`  // For error
+	Signal   string            `json:"signal,omitempty"`   // For signal, e.g. "INT" or "SIGTSTP"
 }
 
 // DataWriter is a function that writes data read from the PTY.
@@ -50,7 +56,7 @@ type DataWriter func(data []byte) error
 // Returns when the PTY is closed, an error occurs, or stop is signaled.
 func ReadPTYToWriter(ptyFile *os.File, writer DataWriter, stop <-chan struct{}) error {
 	fd := int(ptyFile.Fd())
-	buf := make([]byte, 32*1024)
+	buf := make([]byte, ReadBufferSize)
 
 	for {
 		// Check for stop first to avoid extra reads after shutdown.
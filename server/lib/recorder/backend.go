@@ -0,0 +1,89 @@
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// CaptureBackend selects how screen captures grab the display, for images with different
+// display stacks. Screencast recordings always use CDP regardless of the backend.
+type CaptureBackend string
+
+const (
+	// CaptureBackendX11 grabs an X display with x11grab (avfoundation on macOS).
+	CaptureBackendX11 CaptureBackend = "x11"
+	// CaptureBackendKMS grabs the framebuffer of kmsDevice with kmsgrab, for images that
+	// render straight to KMS/DRM. ffmpeg needs CAP_SYS_ADMIN for it.
+	CaptureBackendKMS CaptureBackend = "kmsgrab"
+	// CaptureBackendPipeWire grabs a Wayland session's screen through PipeWire with the
+	// pipewiregrab source filter.
+	CaptureBackendPipeWire CaptureBackend = "pipewire"
+	// CaptureBackendCDP records Chromium's CDP screencast instead of grabbing the display,
+	// for images without one. Screen captures become CaptureScreencast recordings.
+	CaptureBackendCDP CaptureBackend = "cdp"
+)
+
+// kmsDevice is the DRM device kmsgrab captures from.
+const kmsDevice = "/dev/dri/card0"
+
+func (b CaptureBackend) validate() error {
+	switch b {
+	case "", CaptureBackendX11, CaptureBackendKMS, CaptureBackendPipeWire, CaptureBackendCDP:
+		return nil
+	default:
+		return fmt.Errorf("unknown capture backend %q", b)
+	}
+}
+
+// CheckCaptureBackend reports whether the ffmpeg at pathToFFmpeg ("ffmpeg" from PATH when
+// empty) can capture with backend on this host, so a misconfigured image fails at startup
+// rather than on its first recording.
+func CheckCaptureBackend(ctx context.Context, pathToFFmpeg string, backend CaptureBackend) error {
+	if err := backend.validate(); err != nil {
+		return err
+	}
+	if pathToFFmpeg == "" {
+		pathToFFmpeg = "ffmpeg"
+	}
+	// listing flag and the device or filter it must include
+	var list, name string
+	switch backend {
+	case CaptureBackendCDP:
+		// image2pipe and mjpeg are part of every ffmpeg build
+		return nil
+	case "", CaptureBackendX11:
+		list, name = "-devices", "x11grab"
+		if runtime.GOOS == "darwin" {
+			name = "avfoundation"
+		}
+	case CaptureBackendKMS:
+		if _, err := os.Stat(kmsDevice); err != nil {
+			return fmt.Errorf("kmsgrab needs %s: %w", kmsDevice, err)
+		}
+		list, name = "-devices", "kmsgrab"
+	case CaptureBackendPipeWire:
+		list, name = "-filters", "pipewiregrab"
+	}
+	if (backend == CaptureBackendKMS || backend == CaptureBackendPipeWire) && runtime.GOOS != "linux" {
+		return fmt.Errorf("capture backend %s is only supported on linux", backend)
+	}
+
+	out, err := exec.CommandContext(ctx, pathToFFmpeg, "-hide_banner", list).Output()
+	if err != nil {
+		return fmt.Errorf("failed to list ffmpeg %s: %w", strings.TrimPrefix(list, "-"), err)
+	}
+	// entries look like " D  x11grab   X11 screen capture" or " ... pipewiregrab  |->V  ..."
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) >= 2 && fields[1] == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("ffmpeg at %s was built without %s, needed by capture backend %s", pathToFFmpeg, name, backend)
+}
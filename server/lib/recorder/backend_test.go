@@ -0,0 +1,59 @@
+package recorder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFFmpegArgs_CaptureBackend(t *testing.T) {
+	params := defaultParams(t.TempDir())
+
+	params.Backend = CaptureBackendKMS
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	joined := strings.Join(args, " ")
+	assert.Contains(t, joined, "-device /dev/dri/card0 -f kmsgrab -framerate 5 -i - -vf hwdownload,format=bgr0")
+	off := false
+	params.DrawMouse = &off
+	assert.ErrorContains(t, params.Validate(), "not supported by the kmsgrab")
+
+	params.Backend = CaptureBackendPipeWire
+	args, err = ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "-f lavfi -i pipewiregrab=framerate=5:draw_mouse=0")
+
+	// the backend is server-wide and turns screen captures into screencasts
+	params.DrawMouse = nil
+	params.Backend = CaptureBackendCDP
+	merged := mergeFFmpegRecordingParams(params, FFmpegRecordingParams{Mode: CaptureScreen, Backend: CaptureBackendX11})
+	assert.Equal(t, CaptureBackendCDP, merged.Backend)
+	assert.Equal(t, CaptureScreencast, merged.Mode)
+
+	params.Backend = "wayland"
+	assert.ErrorContains(t, params.Validate(), "unknown capture backend")
+}
+
+func TestCheckCaptureBackend(t *testing.T) {
+	ctx := context.Background()
+	// stands in for an ffmpeg built with x11grab but without pipewiregrab
+	bin := filepath.Join(t.TempDir(), "ffmpeg")
+	script := `#!/bin/sh
+case "$2" in
+-devices) printf 'Devices:\n D. = Demuxing supported\n --\n D  lavfi           Libavfilter virtual input device\n D  x11grab         X11 screen capture, using XCB\n' ;;
+-filters) printf 'Filters:\n ... hwdownload        V->V       Download a hardware frame to a normal frame\n' ;;
+esac
+`
+	require.NoError(t, os.WriteFile(bin, []byte(script), 0o755))
+
+	assert.NoError(t, CheckCaptureBackend(ctx, bin, CaptureBackendX11))
+	assert.NoError(t, CheckCaptureBackend(ctx, bin, CaptureBackendCDP))
+	assert.ErrorContains(t, CheckCaptureBackend(ctx, bin, CaptureBackendPipeWire), "without pipewiregrab")
+	assert.Error(t, CheckCaptureBackend(ctx, bin, "wayland"))
+	assert.Error(t, CheckCaptureBackend(ctx, filepath.Join(t.TempDir(), "missing"), CaptureBackendX11))
+}
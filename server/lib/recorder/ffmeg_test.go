@@ -1,7 +1,12 @@
 package recorder
 
 import (
+	"context"
+	"io"
+	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -90,3 +95,710 @@ func TestFFmpegRecorder_ForceStop(t *testing.T) {
 	require.False(t, rec.IsRecording(t.Context()))
 	assert.Contains(t, rec.cmd.ProcessState.String(), "killed")
 }
+
+func TestFFmpegRecorder_Progress(t *testing.T) {
+	tempDir := t.TempDir()
+	outputPath := filepath.Join(tempDir, "progress.mp4")
+	rec := &FFmpegRecorder{
+		id:         "progress",
+		binaryPath: mockBin,
+		params:     defaultParams(tempDir),
+		outputPath: outputPath,
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+	p, err := rec.Progress()
+	require.NoError(t, err)
+	assert.False(t, p.Started)
+	assert.False(t, p.Finished, "a recorder that hasn't started hasn't finished")
+
+	require.NoError(t, rec.Start(t.Context()))
+
+	// the mock never writes output, so a missing file reports zero bytes
+	p, err = rec.Progress()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), p.Bytes)
+	assert.True(t, p.Started)
+	assert.False(t, p.Finished)
+
+	require.NoError(t, os.WriteFile(outputPath, make([]byte, 1234), 0644))
+	time.Sleep(20 * time.Millisecond)
+	p, err = rec.Progress()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1234), p.Bytes)
+	assert.Greater(t, p.Elapsed, time.Duration(0))
+	assert.False(t, p.Finished)
+
+	// remove the file so finalization fails fast instead of invoking the mock to remux;
+	// a failed finalization still counts as finished
+	require.NoError(t, os.Remove(outputPath))
+	require.NoError(t, rec.ForceStop(t.Context()))
+	p, err = rec.Progress()
+	require.NoError(t, err)
+	assert.True(t, p.Finished)
+
+	elapsed := p.Elapsed
+	time.Sleep(20 * time.Millisecond)
+	p, err = rec.Progress()
+	require.NoError(t, err)
+	assert.Equal(t, elapsed, p.Elapsed)
+}
+
+func TestFFmpegRecorder_FragmentedSkipsRemux(t *testing.T) {
+	tempDir := t.TempDir()
+	outputPath := filepath.Join(tempDir, "fragmented.mp4")
+	params := defaultParams(tempDir)
+	params.Fragmented = true
+	rec := &FFmpegRecorder{
+		id:         "fragmented",
+		binaryPath: mockBin,
+		params:     params,
+		outputPath: outputPath,
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+	require.NoError(t, rec.Start(t.Context()))
+	require.NoError(t, os.WriteFile(outputPath, []byte("fragmented mp4"), 0644))
+
+	// the mock would hang if invoked to remux, so a prompt stop shows finalization was skipped
+	require.NoError(t, rec.Stop(t.Context()))
+	require.NoError(t, rec.WaitForFinalization(t.Context()))
+
+	out, meta, err := rec.Recording(t.Context())
+	require.NoError(t, err)
+	defer out.Close()
+	assert.Equal(t, int64(len("fragmented mp4")), meta.Size)
+}
+
+func TestMergeFFmpegRecordingParams_Fragmented(t *testing.T) {
+	tempDir := t.TempDir()
+	config := defaultParams(tempDir)
+
+	merged := mergeFFmpegRecordingParams(config, FFmpegRecordingParams{})
+	assert.False(t, merged.Fragmented)
+
+	merged = mergeFFmpegRecordingParams(config, FFmpegRecordingParams{Fragmented: true})
+	assert.True(t, merged.Fragmented)
+
+	config.Fragmented = true
+	merged = mergeFFmpegRecordingParams(config, FFmpegRecordingParams{})
+	assert.True(t, merged.Fragmented)
+}
+
+func TestFFmpegRecorder_ResourceUsage(t *testing.T) {
+	tempDir := t.TempDir()
+	rec := &FFmpegRecorder{
+		id:         "resources",
+		binaryPath: mockBin,
+		params:     defaultParams(tempDir),
+		outputPath: filepath.Join(tempDir, "resources.mp4"),
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+
+	// nothing to sample before the process starts
+	u, err := rec.ResourceUsage()
+	require.NoError(t, err)
+	assert.Nil(t, u)
+
+	require.NoError(t, rec.Start(t.Context()))
+	u, err = rec.ResourceUsage()
+	require.NoError(t, err)
+	require.NotNil(t, u)
+	assert.Greater(t, u.RSSBytes, int64(0))
+	assert.Greater(t, u.Threads, 0)
+
+	require.NoError(t, rec.ForceStop(t.Context()))
+	u, err = rec.ResourceUsage()
+	require.NoError(t, err)
+	assert.Nil(t, u)
+}
+
+func TestFFmpegRecordingParams_ValidateFrameRate(t *testing.T) {
+	knownEncoderLimits["test-encoder"] = encoderLimits{minFrameRate: 5, maxFrameRate: 30}
+	defer delete(knownEncoderLimits, "test-encoder")
+
+	assert.NoError(t, validateFrameRate("test-encoder", 5))
+	assert.NoError(t, validateFrameRate("test-encoder", 30))
+	assert.ErrorContains(t, validateFrameRate("test-encoder", 4), "below the minimum of 5 fps")
+	assert.ErrorContains(t, validateFrameRate("test-encoder", 31), "exceeds the maximum of 30 fps")
+	assert.NoError(t, validateFrameRate("unknown-encoder", 1000), "unknown encoders are left to ffmpeg")
+
+	params := defaultParams(t.TempDir())
+	zero := 0
+	params.FrameRate = &zero
+	assert.ErrorContains(t, params.Validate(), "supported by libx264")
+}
+
+func TestFFmpegRecorderFactory_InvalidParams(t *testing.T) {
+	factory := NewFFmpegRecorderFactory(mockBin, defaultParams(t.TempDir()), nil, scaletozero.NewNoopController())
+	zero := 0
+	_, err := factory("bad", FFmpegRecordingParams{FrameRate: &zero})
+	require.ErrorIs(t, err, ErrInvalidParams)
+}
+
+func TestFFmpegRecorderFactory_InvalidID(t *testing.T) {
+	factory := NewFFmpegRecorderFactory(mockBin, defaultParams(t.TempDir()), nil, scaletozero.NewNoopController())
+	for _, id := range []string{"", "../escape", "a/b", "rec.mp4", strings.Repeat("a", 65)} {
+		_, err := factory(id, FFmpegRecordingParams{})
+		assert.ErrorIs(t, err, ErrInvalidParams, "id %q", id)
+	}
+	_, err := factory("rec_1-a", FFmpegRecordingParams{})
+	assert.NoError(t, err)
+}
+
+func TestFFmpegArgs_DropDuplicateFrames(t *testing.T) {
+	params := defaultParams(t.TempDir())
+	params.Mode = CaptureScreencast
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.NotContains(t, args, "-fps_mode")
+
+	params.DropDuplicateFrames = true
+	args, err = ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	joined := strings.Join(args, " ")
+	assert.Contains(t, joined, "-vf pad=ceil(iw/2)*2:ceil(ih/2)*2,mpdecimate,setpts=PTS-STARTPTS")
+	assert.Contains(t, joined, "-fps_mode vfr")
+
+	params.DuplicateFrameThresholds = DuplicateFrameThresholds{Hi: 1024, Frac: 0.5}
+	args, err = ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "mpdecimate=hi=1024:frac=0.5,setpts=PTS-STARTPTS")
+
+	params.DuplicateFrameThresholds = DuplicateFrameThresholds{Hi: 100, Lo: 200}
+	assert.ErrorContains(t, params.Validate(), "exceeds hi threshold")
+	params.DuplicateFrameThresholds = DuplicateFrameThresholds{Frac: 2}
+	assert.ErrorContains(t, params.Validate(), "between 0 and 1")
+}
+
+func TestMergeFFmpegRecordingParams_DropDuplicateFrames(t *testing.T) {
+	config := defaultParams(t.TempDir())
+	config.DuplicateFrameThresholds = DuplicateFrameThresholds{Hi: 1024}
+
+	merged := mergeFFmpegRecordingParams(config, FFmpegRecordingParams{DropDuplicateFrames: true})
+	assert.True(t, merged.DropDuplicateFrames)
+	assert.Equal(t, DuplicateFrameThresholds{Hi: 1024}, merged.DuplicateFrameThresholds)
+
+	merged = mergeFFmpegRecordingParams(config, FFmpegRecordingParams{DuplicateFrameThresholds: DuplicateFrameThresholds{Lo: 64}})
+	assert.False(t, merged.DropDuplicateFrames)
+	assert.Equal(t, DuplicateFrameThresholds{Lo: 64}, merged.DuplicateFrameThresholds)
+}
+
+func TestFFmpegArgs_KeyframeInterval(t *testing.T) {
+	params := defaultParams(t.TempDir())
+	params.Mode = CaptureScreencast
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.NotContains(t, args, "-force_key_frames")
+
+	interval := 2
+	params.KeyframeIntervalSeconds = &interval
+	args, err = ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "-force_key_frames expr:gte(t,n_forced*2)")
+
+	zero := 0
+	params.KeyframeIntervalSeconds = &zero
+	assert.ErrorContains(t, params.Validate(), "keyframe interval must be greater than 0")
+
+	merged := mergeFFmpegRecordingParams(defaultParams(t.TempDir()), FFmpegRecordingParams{KeyframeIntervalSeconds: &interval})
+	require.NotNil(t, merged.KeyframeIntervalSeconds)
+	assert.Equal(t, 2, *merged.KeyframeIntervalSeconds)
+}
+
+func TestFFmpegArgs_DrawMouse(t *testing.T) {
+	params := defaultParams(t.TempDir())
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.NotContains(t, args, "-draw_mouse", "ffmpeg's default is kept")
+
+	off := false
+	params.DrawMouse = &off
+	args, err = ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "-draw_mouse 0 -i :0")
+
+	on := true
+	merged := mergeFFmpegRecordingParams(params, FFmpegRecordingParams{DrawMouse: &on})
+	args, err = ffmpegArgs(merged, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "-draw_mouse 1 -i :0")
+
+	merged.Mode = CaptureScreencast
+	assert.ErrorContains(t, merged.Validate(), "only supported for screen capture")
+}
+
+func TestFFmpegArgs_Quality(t *testing.T) {
+	params := defaultParams(t.TempDir())
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.NotContains(t, args, "-preset", "the encoder's default preset is kept")
+	assert.NotContains(t, args, "-crf")
+	assert.NotContains(t, args, "-qp")
+
+	crf, qp := 28, 0
+	merged := mergeFFmpegRecordingParams(params, FFmpegRecordingParams{Preset: "veryfast", CRF: &crf})
+	require.NoError(t, merged.Validate())
+	args, err = ffmpegArgs(merged, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "-y -preset veryfast -crf 28")
+
+	merged = mergeFFmpegRecordingParams(merged, FFmpegRecordingParams{QP: &qp})
+	assert.Nil(t, merged.CRF, "overriding QP drops the default CRF")
+	require.NoError(t, merged.Validate())
+	args, err = ffmpegArgs(merged, "out.mp4")
+	require.NoError(t, err)
+	assert.Contains(t, strings.Join(args, " "), "-preset veryfast -qp 0")
+
+	c := merged.clone()
+	*c.QP = 10
+	assert.Equal(t, 0, *merged.QP, "clone copies QP")
+
+	bad, tooHigh := -1, 52
+	for _, tc := range []struct {
+		params FFmpegRecordingParams
+		err    string
+	}{
+		{FFmpegRecordingParams{Preset: "placebo"}, `unknown libx264 preset "placebo"`},
+		{FFmpegRecordingParams{CRF: &bad}, "CRF -1 is outside the range 0-51"},
+		{FFmpegRecordingParams{QP: &tooHigh}, "QP 52 is outside the range 0-51"},
+		{FFmpegRecordingParams{CRF: &crf, QP: &qp}, "only one of CRF and QP"},
+	} {
+		p := defaultParams(t.TempDir())
+		p.Preset, p.CRF, p.QP = tc.params.Preset, tc.params.CRF, tc.params.QP
+		assert.ErrorContains(t, p.Validate(), tc.err)
+	}
+
+	knownEncoderLimits["test-encoder"] = encoderLimits{minFrameRate: 1}
+	defer delete(knownEncoderLimits, "test-encoder")
+	assert.ErrorContains(t, validateQuality("test-encoder", FFmpegRecordingParams{Preset: "fast"}), "does not support presets")
+	assert.ErrorContains(t, validateQuality("test-encoder", FFmpegRecordingParams{CRF: &crf}), "does not support CRF")
+	assert.NoError(t, validateQuality("unknown-encoder", FFmpegRecordingParams{Preset: "anything"}), "unknown encoders are left to ffmpeg")
+}
+
+func TestFFmpegArgs_ExtraArgs(t *testing.T) {
+	params := mergeFFmpegRecordingParams(defaultParams(t.TempDir()), FFmpegRecordingParams{ExtraArgs: []string{"-tune", "zerolatency"}})
+	require.NoError(t, params.Validate())
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-tune", "zerolatency", "out.mp4"}, args[len(args)-3:], "extra args go right before the output file")
+
+	// filter expressions keep working
+	params.ExtraArgs = []string{"-vf", "scale=trunc(iw/4)*2:-2"}
+	require.NoError(t, params.Validate())
+
+	for _, bad := range [][]string{{"-metadata", "title=$(id)"}, {"a;b"}, {"x > /etc/passwd"}, {""}} {
+		params.ExtraArgs = bad
+		assert.Error(t, params.Validate(), "%q", bad)
+	}
+
+	c := params.clone()
+	c.ExtraArgs[0] = "changed"
+	assert.Equal(t, "", params.ExtraArgs[0], "clone copies the args")
+}
+
+func TestFFmpegRecorder_Manifest(t *testing.T) {
+	tempDir := t.TempDir()
+	outputPath := filepath.Join(tempDir, "manifest.mp4")
+	params := defaultParams(tempDir)
+	// fragmented recordings skip the remux, which the mock can't do
+	params.Fragmented = true
+	rec := &FFmpegRecorder{
+		id:         "manifest",
+		binaryPath: mockBin,
+		params:     params,
+		outputPath: outputPath,
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+	require.NoError(t, rec.Start(t.Context()))
+	_, err := rec.Manifest()
+	require.ErrorIs(t, err, os.ErrNotExist, "no manifest while recording")
+
+	require.NoError(t, os.WriteFile(outputPath, []byte("fragmented mp4"), 0644))
+	require.NoError(t, rec.Stop(t.Context()))
+
+	m, err := rec.Manifest()
+	require.NoError(t, err)
+	assert.Equal(t, "manifest", m.ID)
+	assert.Equal(t, "manifest.mp4", m.File)
+	assert.Equal(t, ExitStopped, m.ExitReason)
+	assert.Equal(t, "h264", m.Codec)
+	assert.Equal(t, int64(len("fragmented mp4")), m.Size)
+	assert.Equal(t, ManifestParams{FrameRate: 5, DisplayNum: 0, MaxSizeInMB: 1, Mode: "screen", Fragmented: true}, m.Params)
+	assert.False(t, m.StartTime.IsZero())
+	assert.False(t, m.EndTime.Before(m.StartTime))
+	assert.FileExists(t, filepath.Join(tempDir, "manifest.manifest.json"))
+
+	require.NoError(t, rec.Delete(t.Context()))
+	assert.NoFileExists(t, filepath.Join(tempDir, "manifest.manifest.json"))
+}
+
+func TestRestoreFFmpegRecorders(t *testing.T) {
+	tempDir := t.TempDir()
+	params := defaultParams(tempDir)
+	params.Fragmented = true
+	orig := &FFmpegRecorder{
+		id:         "restored",
+		binaryPath: mockBin,
+		params:     params,
+		outputPath: filepath.Join(tempDir, "restored.mp4"),
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+	require.NoError(t, orig.Start(t.Context()))
+	require.NoError(t, os.WriteFile(orig.outputPath, []byte("fragmented mp4"), 0644))
+	require.NoError(t, orig.Stop(t.Context()))
+	want, err := orig.Manifest()
+	require.NoError(t, err)
+
+	// an unfinalized recording, a manifest whose recording is gone and a corrupt manifest
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "crashed.mp4"), []byte("partial"), 0644))
+	require.NoError(t, writeManifest(filepath.Join(tempDir, "gone.manifest.json"), Manifest{ID: "gone", File: "gone.mp4"}))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "corrupt.manifest.json"), []byte("{"), 0644))
+
+	recs, err := RestoreFFmpegRecorders(t.Context(), tempDir, mockBin, scaletozero.NewNoopController())
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	rec := recs[0]
+	assert.Equal(t, "restored", rec.ID())
+	assert.False(t, rec.IsRecording(t.Context()))
+	assert.Equal(t, 5, *rec.Params().FrameRate)
+	assert.True(t, rec.Params().Fragmented)
+
+	p, err := rec.Progress()
+	require.NoError(t, err)
+	assert.True(t, p.Finished)
+	assert.Equal(t, int64(len("fragmented mp4")), p.Bytes)
+
+	m, err := rec.Manifest()
+	require.NoError(t, err)
+	assert.Equal(t, want, m)
+
+	out, meta, err := rec.Recording(t.Context())
+	require.NoError(t, err)
+	b, err := io.ReadAll(out)
+	out.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "fragmented mp4", string(b))
+	assert.True(t, meta.StartTime.Equal(want.StartTime))
+	assert.True(t, meta.EndTime.Equal(want.EndTime))
+
+	require.NoError(t, rec.Stop(t.Context()), "stopping a restored recording is a no-op")
+	require.NoError(t, rec.WaitForFinalization(t.Context()))
+	require.NoError(t, rec.Delete(t.Context()))
+	assert.NoFileExists(t, filepath.Join(tempDir, "restored.mp4"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "restored.manifest.json"))
+	assert.FileExists(t, filepath.Join(tempDir, "crashed.mp4"), "unfinalized recordings are left alone")
+
+	recs, err = RestoreFFmpegRecorders(t.Context(), filepath.Join(tempDir, "missing"), mockBin, scaletozero.NewNoopController())
+	require.NoError(t, err)
+	assert.Empty(t, recs)
+}
+
+func TestFFmpegRecorder_FinalizeTempDir(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		script  string
+		wantErr bool
+	}{
+		// args are: -i <input> ... <temp output>
+		{"remux succeeds", `for a; do out=$a; done; printf remuxed > "$out"`, false},
+		{"remux fails", `for a; do out=$a; done; printf partial > "$out"; exit 1`, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			tempDir := t.TempDir()
+			bin := filepath.Join(t.TempDir(), "ffmpeg")
+			require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\n"+tc.script+"\n"), 0755))
+
+			params := defaultParams(outputDir)
+			params.TempDir = tempDir
+			outputPath := filepath.Join(outputDir, "temp.mp4")
+			require.NoError(t, os.WriteFile(outputPath, []byte("fragmented"), 0644))
+			rec := &FFmpegRecorder{
+				id:         "temp",
+				binaryPath: bin,
+				params:     params,
+				outputPath: outputPath,
+				exitCode:   0,
+				stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+			}
+
+			err := rec.WaitForFinalization(t.Context())
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			b, err := os.ReadFile(outputPath)
+			require.NoError(t, err)
+			if tc.wantErr {
+				assert.Equal(t, "fragmented", string(b), "a failed remux keeps the recording as written")
+			} else {
+				assert.Equal(t, "remuxed", string(b))
+			}
+			entries, err := os.ReadDir(tempDir)
+			require.NoError(t, err)
+			assert.Empty(t, entries, "temp files are removed")
+			entries, err = os.ReadDir(outputDir)
+			require.NoError(t, err)
+			var names []string
+			for _, e := range entries {
+				names = append(names, e.Name())
+			}
+			assert.ElementsMatch(t, []string{"temp.mp4", "temp.manifest.json"}, names)
+		})
+	}
+}
+
+func TestFFmpegRecorder_StartTimeout(t *testing.T) {
+	tempDir := t.TempDir()
+	params := defaultParams(tempDir)
+	params.Fragmented = true
+	params.StartTimeout = 500 * time.Millisecond
+	rec := &FFmpegRecorder{
+		id:         "hung",
+		binaryPath: mockBin,
+		params:     params,
+		outputPath: filepath.Join(tempDir, "hung.mp4"),
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+
+	// the mock never writes its output, like an ffmpeg stuck opening its input
+	start := time.Now()
+	err := rec.Start(t.Context())
+	require.ErrorIs(t, err, ErrStartTimeout)
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.False(t, rec.IsRecording(t.Context()), "ffmpeg is killed")
+
+	// once ffmpeg has created the output, Start returns
+	rec = &FFmpegRecorder{
+		id:         "started",
+		binaryPath: mockBin,
+		params:     params,
+		outputPath: filepath.Join(tempDir, "started.mp4"),
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+	require.NoError(t, os.WriteFile(rec.outputPath, []byte("fragmented mp4"), 0644))
+	require.NoError(t, rec.Start(t.Context()))
+	assert.True(t, rec.IsRecording(t.Context()))
+	require.NoError(t, rec.Stop(t.Context()))
+}
+
+func TestFFmpegRecorder_StallWatchdog(t *testing.T) {
+	newRec := func(t *testing.T, forceStop bool) *FFmpegRecorder {
+		tempDir := t.TempDir()
+		params := defaultParams(tempDir)
+		params.Fragmented = true
+		params.StallTimeout = 200 * time.Millisecond
+		params.StallForceStop = forceStop
+		rec := &FFmpegRecorder{
+			id:         "stall",
+			binaryPath: mockBin,
+			params:     params,
+			outputPath: filepath.Join(tempDir, "stall.mp4"),
+			stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+		}
+		require.NoError(t, os.WriteFile(rec.outputPath, []byte("fragmented mp4"), 0644))
+		return rec
+	}
+
+	t.Run("marks stalled and recovers", func(t *testing.T) {
+		rec := newRec(t, false)
+		require.NoError(t, rec.Start(t.Context()))
+		t.Cleanup(func() { rec.ForceStop(context.Background()) })
+		assert.True(t, rec.Healthy())
+
+		// the mock never writes, so the output stops growing
+		require.Eventually(t, func() bool { return !rec.Healthy() }, 2*time.Second, 20*time.Millisecond)
+		assert.True(t, rec.IsRecording(t.Context()), "a stalled recording keeps running without StallForceStop")
+
+		f, err := os.OpenFile(rec.outputPath, os.O_APPEND|os.O_WRONLY, 0644)
+		require.NoError(t, err)
+		_, err = f.WriteString("more")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		require.Eventually(t, rec.Healthy, 2*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("force stops", func(t *testing.T) {
+		rec := newRec(t, true)
+		require.NoError(t, rec.Start(t.Context()))
+		require.Eventually(t, func() bool { return !rec.IsRecording(t.Context()) }, 2*time.Second, 20*time.Millisecond)
+		require.NoError(t, rec.WaitForFinalization(t.Context()))
+		assert.False(t, rec.Healthy())
+		m, err := rec.Manifest()
+		require.NoError(t, err)
+		assert.Equal(t, ExitKilled, m.ExitReason)
+	})
+}
+
+func TestFFmpegRecorder_Tenant(t *testing.T) {
+	tempDir := t.TempDir()
+	config := defaultParams(tempDir)
+	config.Fragmented = true
+	factory := NewFFmpegRecorderFactory(mockBin, config, nil, scaletozero.NewNoopController())
+
+	_, err := factory("escape", FFmpegRecordingParams{Tenant: "../other"})
+	require.ErrorIs(t, err, ErrInvalidParams)
+
+	r, err := factory("tenanted", FFmpegRecordingParams{Tenant: "acme"})
+	require.NoError(t, err)
+	rec := r.(*FFmpegRecorder)
+	assert.Equal(t, filepath.Join(tempDir, "acme"), rec.Params().RecordingDir())
+	assert.Equal(t, filepath.Join(tempDir, "acme", "tenanted.mp4"), rec.outputPath)
+
+	require.NoError(t, rec.Start(t.Context()))
+	require.NoError(t, os.WriteFile(rec.outputPath, []byte("fragmented mp4"), 0644))
+	require.NoError(t, rec.Stop(t.Context()))
+	m, err := rec.Manifest()
+	require.NoError(t, err)
+	assert.Equal(t, "acme", m.Tenant)
+
+	// a manifest outside its tenant's directory is not restored
+	stray := *m
+	stray.ID, stray.File, stray.Tenant = "stray", "stray.mp4", "other"
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "acme", "stray.mp4"), []byte("x"), 0644))
+	require.NoError(t, writeManifest(filepath.Join(tempDir, "acme", "stray.manifest.json"), stray))
+
+	recs, err := RestoreFFmpegRecorders(t.Context(), tempDir, mockBin, scaletozero.NewNoopController())
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.Equal(t, "tenanted", recs[0].ID())
+	assert.Equal(t, "acme", recs[0].Params().Tenant)
+	assert.Equal(t, rec.outputPath, recs[0].outputPath)
+}
+
+func TestFFmpegRecorder_LabelAndTags(t *testing.T) {
+	tempDir := t.TempDir()
+	config := defaultParams(tempDir)
+	config.Fragmented = true
+	factory := NewFFmpegRecorderFactory(mockBin, config, nil, scaletozero.NewNoopController())
+
+	_, err := factory("badtag", FFmpegRecordingParams{Tags: map[string]string{"no spaces": "x"}})
+	require.ErrorIs(t, err, ErrInvalidParams)
+	_, err = factory("longlabel", FFmpegRecordingParams{Label: strings.Repeat("a", 257)})
+	require.ErrorIs(t, err, ErrInvalidParams)
+
+	tags := map[string]string{"env": "prod", "run.id": "42"}
+	r, err := factory("labelled", FFmpegRecordingParams{Label: "checkout flow", Tags: tags})
+	require.NoError(t, err)
+	rec := r.(*FFmpegRecorder)
+
+	// Params hands out a copy of the tags
+	rec.Params().Tags["env"] = "changed"
+	assert.Equal(t, "prod", rec.Params().Tags["env"])
+
+	require.NoError(t, rec.Start(t.Context()))
+	require.NoError(t, os.WriteFile(rec.outputPath, []byte("fragmented mp4"), 0644))
+	require.NoError(t, rec.Stop(t.Context()))
+	m, err := rec.Manifest()
+	require.NoError(t, err)
+	assert.Equal(t, "checkout flow", m.Label)
+	assert.Equal(t, tags, m.Tags)
+
+	recs, err := RestoreFFmpegRecorders(t.Context(), tempDir, mockBin, scaletozero.NewNoopController())
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	assert.Equal(t, "checkout flow", recs[0].Params().Label)
+	assert.Equal(t, tags, recs[0].Params().Tags)
+}
+
+func TestFindOrphanedFiles(t *testing.T) {
+	outputDir, tempDir := t.TempDir(), t.TempDir()
+	old := time.Now().Add(-2 * time.Hour)
+	write := func(path string, modTime time.Time) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte("data"), 0o644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	write(filepath.Join(outputDir, "crashed.mp4"), old)
+	write(filepath.Join(outputDir, "crashed.manifest.json.tmp"), old)
+	write(filepath.Join(outputDir, "acme", "tenant-rec.mp4"), old)
+	write(filepath.Join(outputDir, "copy.mp4.123456.tmp"), old)
+	write(filepath.Join(outputDir, "crashed.640w-10fps.gif.tmp"), old)
+	write(filepath.Join(tempDir, "gone-rec-98765.mp4.tmp"), old)
+	// owned by registered recorders
+	write(filepath.Join(outputDir, "active.mp4"), old)
+	write(filepath.Join(outputDir, "active.manifest.json"), old)
+	write(filepath.Join(outputDir, "active.320w-5fps.gif"), old)
+	write(filepath.Join(tempDir, "my-rec-12345.mp4.tmp"), old)
+	// too new, or not a recorder's file
+	write(filepath.Join(outputDir, "fresh.mp4"), time.Now())
+	write(filepath.Join(outputDir, "notes.txt"), old)
+	write(filepath.Join(tempDir, "other.tmp"), old)
+
+	orphans, err := FindOrphanedFiles(outputDir, tempDir, []string{"active", "my-rec"}, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	var paths []string
+	for _, o := range orphans {
+		assert.Equal(t, int64(4), o.Size)
+		paths = append(paths, o.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		filepath.Join(outputDir, "crashed.mp4"),
+		filepath.Join(outputDir, "crashed.manifest.json.tmp"),
+		filepath.Join(outputDir, "acme", "tenant-rec.mp4"),
+		filepath.Join(outputDir, "copy.mp4.123456.tmp"),
+		filepath.Join(outputDir, "crashed.640w-10fps.gif.tmp"),
+		filepath.Join(tempDir, "gone-rec-98765.mp4.tmp"),
+	}, paths)
+
+	orphans, err = FindOrphanedFiles(filepath.Join(outputDir, "missing"), filepath.Join(tempDir, "missing"), nil, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func TestFFmpegManager_ConcurrentRecorders(t *testing.T) {
+	ctx := context.Background()
+	mgr := NewFFmpegManager()
+	factory := NewFFmpegRecorderFactory(mockBin, defaultParams(t.TempDir()), nil, scaletozero.NewNoopController())
+
+	// several recorders share display :0, and each id is registered from several goroutines
+	ids := []string{"full", "region", "region-2", "region_3"}
+	var (
+		mu         sync.Mutex
+		registered = map[string]int{}
+		wg         sync.WaitGroup
+	)
+	for _, id := range ids {
+		for range 3 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rec, err := factory(id, FFmpegRecordingParams{})
+				require.NoError(t, err)
+				if mgr.RegisterRecorder(ctx, rec) != nil {
+					return
+				}
+				mu.Lock()
+				registered[id]++
+				mu.Unlock()
+				assert.NoError(t, rec.Start(ctx))
+			}()
+		}
+	}
+	wg.Wait()
+
+	outputs := map[string]bool{}
+	for _, id := range ids {
+		assert.Equal(t, 1, registered[id], "id %q", id)
+		rec, ok := mgr.GetRecorder(id)
+		require.True(t, ok)
+		assert.True(t, rec.IsRecording(ctx), "id %q", id)
+		outputs[rec.(*FFmpegRecorder).outputPath] = true
+	}
+	assert.Len(t, outputs, len(ids), "recorders must not share an output file")
+
+	for _, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rec, _ := mgr.GetRecorder(id)
+			_ = rec.ForceStop(ctx)
+		}()
+	}
+	wg.Wait()
+	for _, rec := range mgr.ListActiveRecorders(ctx) {
+		assert.False(t, rec.IsRecording(ctx), "id %q", rec.ID())
+	}
+}
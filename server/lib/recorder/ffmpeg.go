@@ -9,7 +9,9 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -36,6 +38,87 @@ const (
 // currently being finalized (remuxed to add duration metadata).
 var ErrRecordingFinalizing = errors.New("recording is being finalized")
 
+// ErrInvalidParams is returned by the recorder factory when the merged recording parameters
+// fail validation, so callers can tell a bad request from a failure to create the recorder.
+var ErrInvalidParams = errors.New("invalid recording parameters")
+
+// ErrStartTimeout is returned by Start when ffmpeg does not begin writing the recording
+// within FFmpegRecordingParams.StartTimeout, e.g. because its input device is unavailable.
+var ErrStartTimeout = errors.New("ffmpeg did not start recording in time")
+
+// videoEncoder is the ffmpeg encoder recordings are written with.
+const videoEncoder = "libx264"
+
+// encoderLimits are the frame rates and quality settings an encoder accepts. A zero
+// maxFrameRate means the encoder imposes no upper bound of its own. presets lists the
+// -preset values it knows, fastest first; maxCRF and maxQP bound -crf and -qp, which start
+// at 0. An empty presets or zero maxCRF or maxQP means the encoder lacks that setting.
+type encoderLimits struct {
+	minFrameRate int
+	maxFrameRate int
+	presets      []string
+	maxCRF       int
+	maxQP        int
+}
+
+// knownEncoderLimits lists the constraints of known encoders, checked before ffmpeg is
+// started so an unsupported setting is reported as such instead of as an ffmpeg exit.
+// Encoders missing from the table are not checked.
+var knownEncoderLimits = map[string]encoderLimits{
+	"libx264": {
+		minFrameRate: 1,
+		presets:      []string{"ultrafast", "superfast", "veryfast", "faster", "fast", "medium", "slow", "slower", "veryslow"},
+		maxCRF:       51,
+		maxQP:        51,
+	},
+}
+
+// validateFrameRate checks fps against the limits of encoder, if known.
+func validateFrameRate(encoder string, fps int) error {
+	limits, ok := knownEncoderLimits[encoder]
+	if !ok {
+		return nil
+	}
+	if fps < limits.minFrameRate {
+		return fmt.Errorf("frame rate %d is below the minimum of %d fps supported by %s", fps, limits.minFrameRate, encoder)
+	}
+	if limits.maxFrameRate > 0 && fps > limits.maxFrameRate {
+		return fmt.Errorf("frame rate %d exceeds the maximum of %d fps supported by %s", fps, limits.maxFrameRate, encoder)
+	}
+	return nil
+}
+
+// validateQuality checks the preset, CRF and QP of p against the limits of encoder, if
+// known. CRF and QP select different rate control modes, so at most one may be set.
+func validateQuality(encoder string, p FFmpegRecordingParams) error {
+	if p.CRF != nil && p.QP != nil {
+		return fmt.Errorf("only one of CRF and QP may be set")
+	}
+	limits, ok := knownEncoderLimits[encoder]
+	if !ok {
+		return nil
+	}
+	if p.Preset != "" && !slices.Contains(limits.presets, p.Preset) {
+		if len(limits.presets) == 0 {
+			return fmt.Errorf("%s does not support presets", encoder)
+		}
+		return fmt.Errorf("unknown %s preset %q, must be one of %s", encoder, p.Preset, strings.Join(limits.presets, ", "))
+	}
+	if p.CRF != nil && (*p.CRF < 0 || *p.CRF > limits.maxCRF) {
+		if limits.maxCRF == 0 {
+			return fmt.Errorf("%s does not support CRF", encoder)
+		}
+		return fmt.Errorf("CRF %d is outside the range 0-%d supported by %s", *p.CRF, limits.maxCRF, encoder)
+	}
+	if p.QP != nil && (*p.QP < 0 || *p.QP > limits.maxQP) {
+		if limits.maxQP == 0 {
+			return fmt.Errorf("%s does not support QP", encoder)
+		}
+		return fmt.Errorf("QP %d is outside the range 0-%d supported by %s", *p.QP, limits.maxQP, encoder)
+	}
+	return nil
+}
+
 // FFmpegRecorder encapsulates an FFmpeg recording session with platform-specific screen capture.
 // It manages the lifecycle of a single FFmpeg process and provides thread-safe operations.
 type FFmpegRecorder struct {
@@ -53,6 +136,19 @@ type FFmpegRecorder struct {
 	exited     chan struct{}
 	deleted    bool
 	stz        *scaletozero.Oncer
+	// devtoolsURL returns Chromium's current DevTools URL for screencast capture.
+	devtoolsURL func() string
+	// stopCapture ends screencast capture; nil in screen mode.
+	stopCapture context.CancelFunc
+	// progress parses ffmpeg's -progress output; nil unless params.Progress is set.
+	progress *progressWriter
+	// stderr collects the running ffmpeg's stderr for StderrLog; nil before the first Start.
+	stderr *StderrLog
+	// stopReason is ExitStopped or ExitKilled once Stop or ForceStop ends a running
+	// recording; empty if ffmpeg exited on its own.
+	stopReason string
+	// stalled is set by watchOutput while the output file has stopped growing.
+	stalled bool
 
 	// flight coordinates concurrent operations using different keys:
 	// - "stop": prevents multiple SIGINTs from being sent to ffmpeg
@@ -62,6 +158,58 @@ type FFmpegRecorder struct {
 	finalizeResultErr error
 }
 
+// CaptureMode selects where a recording's frames come from.
+type CaptureMode string
+
+const (
+	// CaptureScreen has ffmpeg grab the display (x11grab on Linux, avfoundation on macOS).
+	CaptureScreen CaptureMode = "screen"
+	// CaptureScreencast records Chromium's CDP screencast of the first page, for
+	// environments without a usable display.
+	CaptureScreencast CaptureMode = "screencast"
+)
+
+// DuplicateFrameThresholds tune the mpdecimate filter behind DropDuplicateFrames. A frame is
+// dropped when none of its 8x8 blocks differs from the last kept frame by more than Hi and at
+// most Frac of its blocks differ by more than Lo. Zero fields keep mpdecimate's defaults
+// (hi=768, lo=320, frac=0.33).
+type DuplicateFrameThresholds struct {
+	Hi   int
+	Lo   int
+	Frac float64
+}
+
+func (t DuplicateFrameThresholds) validate() error {
+	if t.Hi < 0 || t.Lo < 0 {
+		return fmt.Errorf("duplicate frame thresholds must not be negative")
+	}
+	if t.Frac < 0 || t.Frac > 1 {
+		return fmt.Errorf("duplicate frame fraction must be between 0 and 1")
+	}
+	if t.Hi > 0 && t.Lo > t.Hi {
+		return fmt.Errorf("duplicate frame lo threshold %d exceeds hi threshold %d", t.Lo, t.Hi)
+	}
+	return nil
+}
+
+// filter returns the mpdecimate filter for the thresholds.
+func (t DuplicateFrameThresholds) filter() string {
+	var opts []string
+	if t.Hi > 0 {
+		opts = append(opts, fmt.Sprintf("hi=%d", t.Hi))
+	}
+	if t.Lo > 0 {
+		opts = append(opts, fmt.Sprintf("lo=%d", t.Lo))
+	}
+	if t.Frac > 0 {
+		opts = append(opts, "frac="+strconv.FormatFloat(t.Frac, 'f', -1, 64))
+	}
+	if len(opts) == 0 {
+		return "mpdecimate"
+	}
+	return "mpdecimate=" + strings.Join(opts, ":")
+}
+
 type FFmpegRecordingParams struct {
 	FrameRate   *int
 	DisplayNum  *int
@@ -69,6 +217,98 @@ type FFmpegRecordingParams struct {
 	// MaxDurationInSeconds optionally limits the total recording time. If nil there is no duration limit.
 	MaxDurationInSeconds *int
 	OutputDir            *string
+	// Fragmented keeps the fragmented MP4 that ffmpeg writes while recording instead of
+	// remuxing it into a standard MP4 once ffmpeg exits. The fragmented file is slightly
+	// larger and has no duration in its header, but is downloadable as soon as ffmpeg exits;
+	// the standard file (the default) is smaller and seekable but waits on the remux.
+	Fragmented bool
+	// Mode selects how frames are captured; empty means CaptureScreen.
+	Mode CaptureMode
+	// Backend selects how CaptureScreen grabs the display; empty means CaptureBackendX11.
+	// It is server-wide, so overrides can't change it.
+	Backend CaptureBackend
+	// LogLevel is passed to ffmpeg as -loglevel; empty keeps ffmpeg's default.
+	LogLevel string
+	// Progress has ffmpeg report encoder stats with -progress, surfaced through
+	// RecordingProgress.Encoder.
+	Progress bool
+	// DropDuplicateFrames drops frames that barely differ from the previous one with ffmpeg's
+	// mpdecimate filter, which shrinks recordings of mostly idle screens. Kept frames keep
+	// their capture timestamps (setpts=PTS-STARTPTS with variable frame rate output), so
+	// playback still runs in real time.
+	DropDuplicateFrames bool
+	// DuplicateFrameThresholds tunes which frames DropDuplicateFrames treats as duplicates.
+	DuplicateFrameThresholds DuplicateFrameThresholds
+	// KeyframeIntervalSeconds forces a keyframe at least this often, so players can seek
+	// to any point within that distance. Nil leaves keyframe placement to the encoder.
+	KeyframeIntervalSeconds *int
+	// DrawMouse sets whether the mouse cursor is drawn into screen captures (x11grab's
+	// -draw_mouse, avfoundation's -capture_cursor, pipewiregrab's draw_mouse). Nil keeps
+	// ffmpeg's default, which draws it with x11grab. It has no effect on screencasts or
+	// kmsgrab, so Validate rejects it there.
+	DrawMouse *bool
+	// Preset is the encoder's -preset, trading encoding speed for compression: slower
+	// presets make smaller files at the same quality but use more CPU. Empty keeps the
+	// encoder's default, medium for libx264.
+	Preset string
+	// CRF sets constant rate factor encoding with -crf, where lower values mean higher
+	// quality and larger files (0-51 for libx264, default 23). QP sets constant quantizer
+	// encoding with -qp instead (0-51 for libx264, 0 being lossless). At most one may be
+	// set; nil leaves rate control to the encoder.
+	CRF *int
+	QP  *int
+	// Tenant groups the recording with others of the same tenant: it is written to the
+	// Tenant subdirectory of OutputDir, see RecordingDir. Empty records into OutputDir.
+	Tenant string
+	// Label is a free-form label stored with the recording and in its manifest.
+	Label string
+	// Tags are key/value pairs stored with the recording and in its manifest, so recorders
+	// can be looked up by them. Keys must match tagKeyRegex.
+	Tags map[string]string
+	// ExtraArgs are passed to ffmpeg after the generated output options and before the
+	// output file, so they override earlier ones. ffmpeg is exec'd directly, but Validate
+	// still rejects tokens with shell metacharacters. Callers decide who may set them.
+	ExtraArgs []string
+	// StartTimeout bounds how long Start waits for ffmpeg to create the output file, which
+	// it does once its input is open. ffmpeg is killed if it takes longer. Zero disables
+	// the wait, so Start returns as soon as ffmpeg has launched.
+	StartTimeout time.Duration
+	// StallTimeout marks a running recording unhealthy when its output file hasn't grown for
+	// this long, which catches an ffmpeg that stopped capturing without exiting. Zero
+	// disables the check.
+	StallTimeout time.Duration
+	// StallForceStop force-stops a recording once it is marked stalled.
+	StallForceStop bool
+	// TempDir holds intermediate files such as the remuxed recording before it replaces
+	// the original, keeping OutputDir to finished recordings. Empty uses os.TempDir().
+	TempDir string
+}
+
+// tenantRegex matches tenant names, which are used as directory names.
+var tenantRegex = regexp.MustCompile(`^[a-zA-Z0-9-]{1,64}$`)
+
+// tagKeyRegex matches tag keys.
+var tagKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
+const (
+	maxLabelLength = 256
+	maxTags        = 32
+	maxTagLength   = 256
+	maxExtraArgs   = 64
+	maxExtraArgLen = 256
+)
+
+// shellMetacharacters are rejected in ExtraArgs tokens. Parentheses, brackets and '*'
+// are allowed since ffmpeg filter and option expressions use them.
+const shellMetacharacters = "`$&|;<>\\'\"\n\r\x00"
+
+// RecordingDir returns the directory recordings with these parameters are written to:
+// OutputDir, or its Tenant subdirectory. OutputDir must be set.
+func (p FFmpegRecordingParams) RecordingDir() string {
+	if p.Tenant == "" {
+		return *p.OutputDir
+	}
+	return filepath.Join(*p.OutputDir, p.Tenant)
 }
 
 func (p FFmpegRecordingParams) Validate() error {
@@ -78,6 +318,12 @@ func (p FFmpegRecordingParams) Validate() error {
 	if p.FrameRate == nil {
 		return fmt.Errorf("frame rate is required")
 	}
+	if err := validateFrameRate(videoEncoder, *p.FrameRate); err != nil {
+		return err
+	}
+	if err := validateQuality(videoEncoder, p); err != nil {
+		return err
+	}
 	if p.DisplayNum == nil {
 		return fmt.Errorf("display number is required")
 	}
@@ -87,6 +333,60 @@ func (p FFmpegRecordingParams) Validate() error {
 	if p.MaxDurationInSeconds != nil && *p.MaxDurationInSeconds <= 0 {
 		return fmt.Errorf("max duration must be greater than 0 seconds")
 	}
+	if p.KeyframeIntervalSeconds != nil && *p.KeyframeIntervalSeconds <= 0 {
+		return fmt.Errorf("keyframe interval must be greater than 0 seconds")
+	}
+	switch p.Mode {
+	case "", CaptureScreen, CaptureScreencast:
+	default:
+		return fmt.Errorf("unknown capture mode %q", p.Mode)
+	}
+	if err := p.Backend.validate(); err != nil {
+		return err
+	}
+	if p.Tenant != "" && !tenantRegex.MatchString(p.Tenant) {
+		return fmt.Errorf("tenant must be 1-64 letters, digits or hyphens")
+	}
+	if len(p.Label) > maxLabelLength {
+		return fmt.Errorf("label must be at most %d characters", maxLabelLength)
+	}
+	if len(p.Tags) > maxTags {
+		return fmt.Errorf("at most %d tags are allowed", maxTags)
+	}
+	for k, v := range p.Tags {
+		if !tagKeyRegex.MatchString(k) {
+			return fmt.Errorf("tag key %q must be 1-64 letters, digits, dots, hyphens or underscores", k)
+		}
+		if len(v) > maxTagLength {
+			return fmt.Errorf("tag %q value must be at most %d characters", k, maxTagLength)
+		}
+	}
+	if len(p.ExtraArgs) > maxExtraArgs {
+		return fmt.Errorf("at most %d extra ffmpeg args are allowed", maxExtraArgs)
+	}
+	for _, arg := range p.ExtraArgs {
+		if arg == "" || len(arg) > maxExtraArgLen {
+			return fmt.Errorf("extra ffmpeg args must be 1-%d characters", maxExtraArgLen)
+		}
+		if strings.ContainsAny(arg, shellMetacharacters) {
+			return fmt.Errorf("extra ffmpeg arg %q contains shell metacharacters", arg)
+		}
+	}
+	if p.DrawMouse != nil && p.Mode == CaptureScreencast {
+		return fmt.Errorf("drawing the mouse is only supported for screen capture")
+	}
+	if p.DrawMouse != nil && p.Backend == CaptureBackendKMS {
+		return fmt.Errorf("drawing the mouse is not supported by the kmsgrab capture backend")
+	}
+	if p.LogLevel != "" && !ffmpegLogLevels[p.LogLevel] {
+		return fmt.Errorf("unknown ffmpeg log level %q", p.LogLevel)
+	}
+	if err := p.DuplicateFrameThresholds.validate(); err != nil {
+		return err
+	}
+	if err := validateFilters(p); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -95,27 +395,58 @@ type FFmpegRecorderFactory func(id string, overrides FFmpegRecordingParams) (Rec
 
 // NewFFmpegRecorderFactory returns a factory that creates new recorders. The provided
 // pathToFFmpeg is used as the binary to execute; if empty it defaults to "ffmpeg" which
-// is expected to be discoverable on the host's PATH.
-func NewFFmpegRecorderFactory(pathToFFmpeg string, config FFmpegRecordingParams, ctrl scaletozero.Controller) FFmpegRecorderFactory {
+// is expected to be discoverable on the host's PATH. devtoolsURL returns Chromium's
+// current DevTools URL for CaptureScreencast recordings; if nil that mode is rejected.
+func NewFFmpegRecorderFactory(pathToFFmpeg string, config FFmpegRecordingParams, devtoolsURL func() string, ctrl scaletozero.Controller) FFmpegRecorderFactory {
 	return func(id string, overrides FFmpegRecordingParams) (Recorder, error) {
+		if !ValidID(id) {
+			return nil, fmt.Errorf("%w: invalid recorder id %q", ErrInvalidParams, id)
+		}
 		mergedParams := mergeFFmpegRecordingParams(config, overrides)
+		if err := mergedParams.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidParams, err)
+		}
+		if mergedParams.Mode == CaptureScreencast && devtoolsURL == nil {
+			return nil, fmt.Errorf("%w: screencast capture is not available", ErrInvalidParams)
+		}
 		return &FFmpegRecorder{
-			id:         id,
-			binaryPath: pathToFFmpeg,
-			outputPath: filepath.Join(*mergedParams.OutputDir, fmt.Sprintf("%s.mp4", id)),
-			params:     mergedParams,
-			stz:        scaletozero.NewOncer(ctrl),
+			id:          id,
+			binaryPath:  pathToFFmpeg,
+			outputPath:  filepath.Join(mergedParams.RecordingDir(), fmt.Sprintf("%s.mp4", id)),
+			params:      mergedParams,
+			stz:         scaletozero.NewOncer(scaletozero.WithReason(ctrl, scaletozero.ReasonRecording)),
+			devtoolsURL: devtoolsURL,
 		}, nil
 	}
 }
 
 func mergeFFmpegRecordingParams(config FFmpegRecordingParams, overrides FFmpegRecordingParams) FFmpegRecordingParams {
 	merged := FFmpegRecordingParams{
-		FrameRate:            config.FrameRate,
-		DisplayNum:           config.DisplayNum,
-		MaxSizeInMB:          config.MaxSizeInMB,
-		MaxDurationInSeconds: config.MaxDurationInSeconds,
-		OutputDir:            config.OutputDir,
+		FrameRate:                config.FrameRate,
+		DisplayNum:               config.DisplayNum,
+		MaxSizeInMB:              config.MaxSizeInMB,
+		MaxDurationInSeconds:     config.MaxDurationInSeconds,
+		OutputDir:                config.OutputDir,
+		Fragmented:               config.Fragmented || overrides.Fragmented,
+		Mode:                     config.Mode,
+		Backend:                  config.Backend,
+		LogLevel:                 config.LogLevel,
+		Progress:                 config.Progress || overrides.Progress,
+		DropDuplicateFrames:      config.DropDuplicateFrames || overrides.DropDuplicateFrames,
+		DuplicateFrameThresholds: config.DuplicateFrameThresholds,
+		KeyframeIntervalSeconds:  config.KeyframeIntervalSeconds,
+		DrawMouse:                config.DrawMouse,
+		Preset:                   config.Preset,
+		CRF:                      config.CRF,
+		QP:                       config.QP,
+		Tenant:                   config.Tenant,
+		Label:                    config.Label,
+		Tags:                     config.Tags,
+		ExtraArgs:                config.ExtraArgs,
+		TempDir:                  config.TempDir,
+		StartTimeout:             config.StartTimeout,
+		StallTimeout:             config.StallTimeout,
+		StallForceStop:           config.StallForceStop,
 	}
 	if overrides.FrameRate != nil {
 		merged.FrameRate = overrides.FrameRate
@@ -132,6 +463,45 @@ func mergeFFmpegRecordingParams(config FFmpegRecordingParams, overrides FFmpegRe
 	if overrides.OutputDir != nil {
 		merged.OutputDir = overrides.OutputDir
 	}
+	if overrides.Mode != "" {
+		merged.Mode = overrides.Mode
+	}
+	if overrides.LogLevel != "" {
+		merged.LogLevel = overrides.LogLevel
+	}
+	if overrides.KeyframeIntervalSeconds != nil {
+		merged.KeyframeIntervalSeconds = overrides.KeyframeIntervalSeconds
+	}
+	if overrides.DrawMouse != nil {
+		merged.DrawMouse = overrides.DrawMouse
+	}
+	if overrides.Preset != "" {
+		merged.Preset = overrides.Preset
+	}
+	// CRF and QP are alternatives, so overriding one drops the default of the other
+	if overrides.CRF != nil || overrides.QP != nil {
+		merged.CRF = overrides.CRF
+		merged.QP = overrides.QP
+	}
+	if overrides.Tenant != "" {
+		merged.Tenant = overrides.Tenant
+	}
+	if overrides.Label != "" {
+		merged.Label = overrides.Label
+	}
+	if overrides.Tags != nil {
+		merged.Tags = overrides.Tags
+	}
+	if overrides.ExtraArgs != nil {
+		merged.ExtraArgs = overrides.ExtraArgs
+	}
+	if overrides.DuplicateFrameThresholds != (DuplicateFrameThresholds{}) {
+		merged.DuplicateFrameThresholds = overrides.DuplicateFrameThresholds
+	}
+	// without a display to grab, the screen can only be captured through the screencast
+	if merged.Backend == CaptureBackendCDP {
+		merged.Mode = CaptureScreencast
+	}
 
 	return merged
 }
@@ -148,6 +518,17 @@ func (fr *FFmpegRecorder) Params() FFmpegRecordingParams {
 	return fr.params.clone()
 }
 
+// Command returns the ffmpeg binary and arguments Start would run, without running them.
+func (fr *FFmpegRecorder) Command() (string, []string, error) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	args, err := ffmpegArgs(fr.params, fr.outputPath)
+	if err != nil {
+		return "", nil, err
+	}
+	return fr.binaryPath, args, nil
+}
+
 func (p FFmpegRecordingParams) clone() FFmpegRecordingParams {
 	c := p
 	if p.FrameRate != nil {
@@ -170,6 +551,29 @@ func (p FFmpegRecordingParams) clone() FFmpegRecordingParams {
 		v := *p.OutputDir
 		c.OutputDir = &v
 	}
+	if p.KeyframeIntervalSeconds != nil {
+		v := *p.KeyframeIntervalSeconds
+		c.KeyframeIntervalSeconds = &v
+	}
+	if p.DrawMouse != nil {
+		v := *p.DrawMouse
+		c.DrawMouse = &v
+	}
+	if p.CRF != nil {
+		v := *p.CRF
+		c.CRF = &v
+	}
+	if p.QP != nil {
+		v := *p.QP
+		c.QP = &v
+	}
+	if p.Tags != nil {
+		c.Tags = make(map[string]string, len(p.Tags))
+		for k, v := range p.Tags {
+			c.Tags[k] = v
+		}
+	}
+	c.ExtraArgs = slices.Clone(p.ExtraArgs)
 	return c
 }
 
@@ -193,8 +597,21 @@ func (fr *FFmpegRecorder) Start(ctx context.Context) error {
 	fr.exitCode = exitCodeInitValue
 	fr.startTime = time.Now()
 	fr.exited = make(chan struct{})
+	fr.stalled = false
 
 	args, err := ffmpegArgs(fr.params, fr.outputPath)
+	if err == nil && fr.params.Tenant != "" {
+		// the tenant's directory is created with its first recording
+		if mkErr := os.MkdirAll(filepath.Dir(fr.outputPath), 0o755); mkErr != nil {
+			err = fmt.Errorf("failed to create tenant directory: %w", mkErr)
+		}
+	}
+	var devtoolsURL string
+	if err == nil && fr.params.Mode == CaptureScreencast {
+		if devtoolsURL = fr.devtoolsURL(); devtoolsURL == "" {
+			err = fmt.Errorf("devtools upstream not available for screencast capture")
+		}
+	}
 	if err != nil {
 		_ = fr.stz.Enable(context.WithoutCancel(ctx))
 		fr.cmd = nil
@@ -204,17 +621,37 @@ func (fr *FFmpegRecorder) Start(ctx context.Context) error {
 		return err
 	}
 	log.Info(fmt.Sprintf("%s %s", fr.binaryPath, strings.Join(args, " ")))
+	if len(fr.params.ExtraArgs) > 0 {
+		log.Warn("starting ffmpeg with extra args", "recorder_id", fr.id, "extra_args", fr.params.ExtraArgs, "command", fr.binaryPath, "args", args)
+	}
 
 	cmd := exec.Command(fr.binaryPath, args...)
 	// create process group to ensure all processes are signaled together
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-	cmd.Stderr = os.Stderr
+	fr.stderr = newStderrLog(os.Stderr)
+	cmd.Stderr = fr.stderr
 	cmd.Stdout = os.Stdout
+	fr.progress = nil
+	if fr.params.Progress {
+		fr.progress = &progressWriter{}
+		cmd.Stdout = fr.progress
+	}
+	var frames io.WriteCloser
+	if devtoolsURL != "" {
+		if frames, err = cmd.StdinPipe(); err != nil {
+			_ = fr.stz.Enable(context.WithoutCancel(ctx))
+			fr.stderr.finish(-1)
+			close(fr.exited)
+			fr.mu.Unlock()
+			return fmt.Errorf("failed to create ffmpeg stdin pipe: %w", err)
+		}
+	}
 	fr.cmd = cmd
 	fr.mu.Unlock()
 
 	if err := cmd.Start(); err != nil {
 		_ = fr.stz.Enable(context.WithoutCancel(ctx))
+		fr.stderr.finish(-1)
 		fr.mu.Lock()
 		fr.ffmpegErr = err
 		fr.cmd = nil // reset cmd on failure to start so IsRecording() remains correct
@@ -223,6 +660,21 @@ func (fr *FFmpegRecorder) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to start ffmpeg process: %w", err)
 	}
 
+	if frames != nil {
+		// Capture runs until ffmpeg exits; if capture fails first, closing ffmpeg's
+		// input ends the recording as if it had been stopped.
+		captureCtx, stopCapture := context.WithCancel(context.WithoutCancel(ctx))
+		fr.mu.Lock()
+		fr.stopCapture = stopCapture
+		fr.mu.Unlock()
+		go func() {
+			err := captureScreencast(captureCtx, devtoolsURL, *fr.params.FrameRate, frames)
+			if err != nil && captureCtx.Err() == nil {
+				log.Error("screencast capture ended, stopping recording", "err", err)
+			}
+		}()
+	}
+
 	// Launch background waiter to capture process completion.
 	go fr.waitForCommand(ctx)
 
@@ -233,12 +685,115 @@ func (fr *FFmpegRecorder) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to start ffmpeg process: %w", fr.ffmpegErr)
 	}
 
+	if fr.params.StartTimeout > 0 {
+		if err := fr.waitForOutput(ctx, cmd, fr.params.StartTimeout); err != nil {
+			return err
+		}
+	}
+	if fr.params.StallTimeout > 0 {
+		go fr.watchOutput(context.WithoutCancel(ctx), fr.params.StallTimeout, fr.params.StallForceStop)
+	}
 	return nil
 }
 
+// watchOutput checks that the output file keeps growing until ffmpeg exits. The recording
+// is marked stalled once it hasn't grown for timeout, and healthy again if it resumes;
+// with forceStop, a stalled recording is force-stopped instead.
+func (fr *FFmpegRecorder) watchOutput(ctx context.Context, timeout time.Duration, forceStop bool) {
+	log := logger.FromContext(ctx)
+
+	ticker := time.NewTicker(timeout / 4)
+	defer ticker.Stop()
+	var size int64
+	grewAt := time.Now()
+	for {
+		select {
+		case <-fr.exited:
+			return
+		case <-ticker.C:
+		}
+
+		var cur int64
+		if finfo, err := os.Stat(fr.outputPath); err == nil {
+			cur = finfo.Size()
+		}
+		if cur != size {
+			size, grewAt = cur, time.Now()
+			fr.mu.Lock()
+			if fr.stalled {
+				log.Info("recording output is growing again", "id", fr.id, "bytes", size)
+			}
+			fr.stalled = false
+			fr.mu.Unlock()
+			continue
+		}
+		if time.Since(grewAt) < timeout {
+			continue
+		}
+
+		fr.mu.Lock()
+		wasStalled := fr.stalled
+		fr.stalled = true
+		fr.mu.Unlock()
+		if wasStalled {
+			continue
+		}
+		log.Warn("recording output stopped growing", "id", fr.id, "bytes", size, "since", grewAt)
+		if forceStop {
+			log.Warn("force stopping stalled recording", "id", fr.id)
+			if err := fr.ForceStop(ctx); err != nil {
+				log.Error("failed to force stop stalled recording", "id", fr.id, "err", err)
+			}
+			return
+		}
+	}
+}
+
+// Healthy reports whether the recording's output is growing as expected. It is false
+// once a recording with a StallTimeout has stalled, including after a stalled recording
+// was force-stopped.
+func (fr *FFmpegRecorder) Healthy() bool {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return !fr.stalled
+}
+
+// waitForOutput waits up to timeout for ffmpeg to create the output file, killing it if
+// it doesn't. An ffmpeg stuck opening its input never gets that far.
+func (fr *FFmpegRecorder) waitForOutput(ctx context.Context, cmd *exec.Cmd, timeout time.Duration) error {
+	log := logger.FromContext(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if _, err := os.Stat(fr.outputPath); err == nil {
+			return nil
+		}
+		select {
+		case <-fr.exited:
+			fr.mu.Lock()
+			defer fr.mu.Unlock()
+			return fmt.Errorf("failed to start ffmpeg process: %w", fr.ffmpegErr)
+		case <-ctx.Done():
+			log.Error("ffmpeg did not create the recording in time, killing it", "timeout", timeout, "path", fr.outputPath)
+			fr.setStopReason(ExitKilled)
+			_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+			<-fr.exited
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%w: no output after %v", ErrStartTimeout, timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 // Stop gracefully stops the recording using a multi-phase shutdown process.
 func (fr *FFmpegRecorder) Stop(ctx context.Context) error {
 	defer fr.stz.Enable(context.WithoutCancel(ctx))
+	fr.setStopReason(ExitStopped)
 
 	// Use singleflight to prevent concurrent Stop() calls from sending multiple SIGINTs
 	// to ffmpeg, which causes immediate abort without proper file closure.
@@ -282,6 +837,7 @@ func (fr *FFmpegRecorder) ForceStop(ctx context.Context) error {
 	log := logger.FromContext(ctx)
 
 	defer fr.stz.Enable(context.WithoutCancel(ctx))
+	fr.setStopReason(ExitKilled)
 	shutdownErr := fr.shutdownInPhases(ctx, []shutdownPhase{
 		{"kill", []syscall.Signal{syscall.SIGKILL}, 100 * time.Millisecond, "immediate kill"},
 	})
@@ -304,6 +860,15 @@ func (fr *FFmpegRecorder) ForceStop(ctx context.Context) error {
 	return nil
 }
 
+// setStopReason records why a running recording is being stopped, for its manifest.
+func (fr *FFmpegRecorder) setStopReason(reason string) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	if fr.cmd != nil && fr.exitCode < exitCodeProcessDoneMinValue {
+		fr.stopReason = reason
+	}
+}
+
 // finalizeRecording remuxes the fragmented MP4 to create a standard MP4 with
 // proper duration metadata in the moov atom. This is necessary because fragmented
 // MP4 (used for data safety during recording) doesn't include duration in the header.
@@ -330,8 +895,20 @@ func (fr *FFmpegRecorder) finalizeRecording(ctx context.Context) error {
 		}
 		outputPath := fr.outputPath
 		binaryPath := fr.binaryPath
+		fragmented := fr.params.Fragmented
+		tempDir := fr.params.TempDir
 		fr.mu.Unlock()
 
+		// Fragmented recordings are served as written; there is nothing to remux.
+		if fragmented {
+			fr.writeManifest(ctx, nil)
+			fr.mu.Lock()
+			fr.finalizeComplete = true
+			fr.finalizeResultErr = nil
+			fr.mu.Unlock()
+			return nil, nil
+		}
+
 		// Check if the recording file exists
 		if _, err := os.Stat(outputPath); os.IsNotExist(err) {
 			result := fmt.Errorf("recording file does not exist: %w", err)
@@ -343,7 +920,20 @@ func (fr *FFmpegRecorder) finalizeRecording(ctx context.Context) error {
 		}
 
 		// Create temp file for the remuxed output
-		tempPath := outputPath + ".tmp"
+		tmp, err := os.CreateTemp(tempDir, fr.id+"-*.mp4.tmp")
+		if err != nil {
+			result := fmt.Errorf("failed to create temp file for finalization: %w", err)
+			fr.writeManifest(ctx, result)
+			fr.mu.Lock()
+			fr.finalizeComplete = true
+			fr.finalizeResultErr = result
+			fr.mu.Unlock()
+			return nil, result
+		}
+		tempPath := tmp.Name()
+		tmp.Close()
+		// a no-op once the remuxed file has been moved into place
+		defer os.Remove(tempPath)
 
 		// Remux: copy streams without re-encoding, move moov atom to start with faststart
 		args := []string{
@@ -365,15 +955,14 @@ func (fr *FFmpegRecorder) finalizeRecording(ctx context.Context) error {
 
 		var result error
 		if err := cmd.Run(); err != nil {
-			// Clean up temp file on error
-			os.Remove(tempPath)
 			result = fmt.Errorf("failed to finalize recording: %w", err)
-		} else if err := os.Rename(tempPath, outputPath); err != nil {
-			os.Remove(tempPath)
+		} else if err := moveFile(tempPath, outputPath); err != nil {
 			result = fmt.Errorf("failed to replace recording with finalized version: %w", err)
 		} else {
 			log.Info("recording finalized with proper duration metadata")
 		}
+		// written before finalizeComplete is set so a finished recording always has its manifest
+		fr.writeManifest(ctx, result)
 
 		fr.mu.Lock()
 		fr.finalizeComplete = true
@@ -385,6 +974,104 @@ func (fr *FFmpegRecorder) finalizeRecording(ctx context.Context) error {
 	return err
 }
 
+// moveFile renames src to dst. When they are on different filesystems, as a TempDir outside
+// the output directory may be, src is copied next to dst first so dst is still replaced
+// atomically; the copy is removed if anything fails.
+func moveFile(src, dst string) error {
+	err := os.Rename(src, dst)
+	if !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	copyPath := out.Name()
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(copyPath)
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(copyPath)
+		return err
+	}
+	if err := os.Rename(copyPath, dst); err != nil {
+		os.Remove(copyPath)
+		return err
+	}
+	return os.Remove(src)
+}
+
+// writeManifest writes the recording's manifest next to it. Failures are logged rather
+// than returned since the recording itself is unaffected.
+func (fr *FFmpegRecorder) writeManifest(ctx context.Context, finalizeErr error) {
+	log := logger.FromContext(ctx)
+
+	fr.mu.Lock()
+	m := Manifest{
+		ID:        fr.id,
+		Tenant:    fr.params.Tenant,
+		Label:     fr.params.Label,
+		Tags:      fr.params.Tags,
+		File:      filepath.Base(fr.outputPath),
+		Params:    manifestParams(fr.params),
+		StartTime: fr.startTime,
+		EndTime:   fr.endTime,
+		Codec:     "h264",
+		ExitCode:  fr.exitCode,
+	}
+	switch {
+	case fr.stopReason != "":
+		m.ExitReason = fr.stopReason
+	case fr.exitCode == 0:
+		m.ExitReason = ExitCompleted
+	default:
+		m.ExitReason = ExitFailed
+	}
+	outputPath := fr.outputPath
+	fr.mu.Unlock()
+
+	if finalizeErr != nil {
+		m.FinalizeError = finalizeErr.Error()
+	}
+	if finfo, err := os.Stat(outputPath); err == nil {
+		m.Size = finfo.Size()
+	}
+	if err := writeManifest(manifestPath(outputPath), m); err != nil {
+		log.Warn("failed to write recording manifest", "err", err)
+	}
+}
+
+// Manifest returns the manifest written when the recording was finalized. It returns
+// ErrRecordingFinalizing while finalization is pending, and an error wrapping
+// os.ErrNotExist when there is no manifest: the recording is still running, produced
+// no file, or was deleted.
+func (fr *FFmpegRecorder) Manifest() (*Manifest, error) {
+	fr.mu.Lock()
+	if fr.exitCode >= exitCodeProcessDoneMinValue && !fr.finalizeComplete {
+		fr.mu.Unlock()
+		return nil, ErrRecordingFinalizing
+	}
+	path := manifestPath(fr.outputPath)
+	fr.mu.Unlock()
+	return readManifest(path)
+}
+
+// RelativePath returns the recording's path relative to OutputDir, e.g. "<tenant>/<id>.mp4",
+// for reporting where it is stored without exposing host paths.
+func (fr *FFmpegRecorder) RelativePath() (string, error) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return filepath.Rel(*fr.params.OutputDir, fr.outputPath)
+}
+
 // IsRecording returns true if a recording is currently in progress.
 func (fr *FFmpegRecorder) IsRecording(ctx context.Context) bool {
 	fr.mu.Lock()
@@ -409,6 +1096,101 @@ func (fr *FFmpegRecorder) Metadata() *RecordingMetadata {
 	}
 }
 
+// RecordingProgress is a point-in-time view of a recording's output.
+type RecordingProgress struct {
+	// Bytes is the current size of the output file as reported by stat.
+	Bytes int64
+	// Elapsed is the time since the recording started, frozen once ffmpeg exits.
+	Elapsed time.Duration
+	// Started is false until ffmpeg has been started, and again if it failed to start.
+	// Recorders restored from a manifest count as started.
+	Started bool
+	// Finished is true once ffmpeg has exited and finalization has completed.
+	Finished bool
+	// Encoder is ffmpeg's latest progress report; nil unless the recording was started
+	// with Progress or before ffmpeg's first report.
+	Encoder *EncoderStats
+}
+
+// Progress reports the size of the growing output file and the elapsed recording time.
+// A missing output file is reported as zero bytes since ffmpeg may not have created it yet.
+func (fr *FFmpegRecorder) Progress() (RecordingProgress, error) {
+	fr.mu.Lock()
+	outputPath := fr.outputPath
+	startTime := fr.startTime
+	endTime := fr.endTime
+	started := fr.cmd != nil || fr.finalizeComplete
+	finished := started && fr.exitCode >= exitCodeProcessDoneMinValue && fr.finalizeComplete
+	fr.mu.Unlock()
+
+	var p RecordingProgress
+	p.Started = started
+	p.Finished = finished
+	p.Encoder = fr.EncoderStats()
+	if !startTime.IsZero() {
+		if endTime.IsZero() {
+			p.Elapsed = time.Since(startTime)
+		} else {
+			p.Elapsed = endTime.Sub(startTime)
+		}
+	}
+
+	finfo, err := os.Stat(outputPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return p, nil
+		}
+		return p, fmt.Errorf("failed to stat recording file: %w", err)
+	}
+	p.Bytes = finfo.Size()
+	return p, nil
+}
+
+// EncoderStats returns ffmpeg's latest progress report, or nil if the recording wasn't
+// started with Progress or ffmpeg hasn't reported yet.
+func (fr *FFmpegRecorder) EncoderStats() *EncoderStats {
+	fr.mu.Lock()
+	progress := fr.progress
+	fr.mu.Unlock()
+	if progress == nil {
+		return nil
+	}
+	return progress.Stats()
+}
+
+// StderrLog returns the stderr of the recording's ffmpeg process, or nil if it was never
+// started.
+func (fr *FFmpegRecorder) StderrLog() *StderrLog {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return fr.stderr
+}
+
+// ResourceUsage samples the CPU and memory used by the running ffmpeg process. It returns
+// nil without error when there is no process to sample: the recorder was never started,
+// or ffmpeg has already exited.
+func (fr *FFmpegRecorder) ResourceUsage() (*ResourceUsage, error) {
+	fr.mu.Lock()
+	pid := 0
+	if fr.cmd != nil && fr.cmd.Process != nil && fr.exitCode < exitCodeProcessDoneMinValue {
+		pid = fr.cmd.Process.Pid
+	}
+	fr.mu.Unlock()
+	if pid == 0 {
+		return nil, nil
+	}
+
+	u, err := readProcUsage(pid)
+	if err != nil {
+		// the process may exit between the check above and reading /proc
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to sample ffmpeg resource usage: %w", err)
+	}
+	return &u, nil
+}
+
 // Recording returns the recording file as an io.ReadCloser.
 // Returns ErrRecordingFinalizing if the recording is currently being finalized.
 func (fr *FFmpegRecorder) Recording(ctx context.Context) (io.ReadCloser, *RecordingMetadata, error) {
@@ -444,6 +1226,7 @@ func (fr *FFmpegRecorder) Recording(ctx context.Context) (io.ReadCloser, *Record
 		Size:      finfo.Size(),
 		StartTime: fr.startTime,
 		EndTime:   fr.endTime,
+		ModTime:   finfo.ModTime(),
 	}, nil
 }
 
@@ -464,6 +1247,12 @@ func (fr *FFmpegRecorder) Delete(ctx context.Context) error {
 	if err := os.Remove(fr.outputPath); err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("failed to delete recording file: %w", err)
 	}
+	if err := os.Remove(manifestPath(fr.outputPath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete recording manifest: %w", err)
+	}
+	if err := removeGIFs(fr.outputPath); err != nil {
+		return fmt.Errorf("failed to delete recording GIFs: %w", err)
+	}
 
 	fr.deleted = true
 	return nil
@@ -473,33 +1262,84 @@ func (fr *FFmpegRecorder) Delete(ctx context.Context) error {
 func ffmpegArgs(params FFmpegRecordingParams, outputPath string) ([]string, error) {
 	var args []string
 
-	// Input options first
-	switch runtime.GOOS {
-	case "darwin":
-		args = []string{
+	// Global options first
+	if params.LogLevel != "" {
+		args = append(args, "-loglevel", params.LogLevel)
+	}
+	if params.Progress {
+		// key=value stats on stdout for progressWriter, replacing the stats line on stderr
+		args = append(args, "-progress", "pipe:1", "-nostats")
+	}
+
+	// Input options next
+	var filters []string
+	switch {
+	case params.Mode == CaptureScreencast:
+		args = append(args, []string{
+			// JPEG frames written to stdin at a constant rate by captureScreencast
+			"-f", "image2pipe",
+			"-framerate", strconv.Itoa(*params.FrameRate),
+			"-c:v", "mjpeg",
+			// Input file
+			"-i", "pipe:0",
+		}...)
+		// libx264 needs even dimensions for yuv420p; viewports may be odd-sized
+		filters = append(filters, "pad=ceil(iw/2)*2:ceil(ih/2)*2")
+	case runtime.GOOS == "darwin" && (params.Backend == "" || params.Backend == CaptureBackendX11):
+		args = append(args, []string{
 			// Input options for AVFoundation
 			"-f", "avfoundation",
 			"-framerate", strconv.Itoa(*params.FrameRate),
 			"-pixel_format", "nv12",
-			// Input file
-			"-i", fmt.Sprintf("%d:none", *params.DisplayNum), // Screen capture, no audio
+		}...)
+		if params.DrawMouse != nil {
+			args = append(args, "-capture_cursor", boolFlag(*params.DrawMouse))
+		}
+		// Input file
+		args = append(args, "-i", fmt.Sprintf("%d:none", *params.DisplayNum)) // Screen capture, no audio
+	case runtime.GOOS == "linux" && params.Backend == CaptureBackendKMS:
+		args = append(args, []string{
+			// Input options for KMS/DRM; the display number doesn't apply
+			"-device", kmsDevice,
+			"-f", "kmsgrab",
+			"-framerate", strconv.Itoa(*params.FrameRate),
+			"-i", "-",
+		}...)
+		// frames arrive as DRM buffers and must be copied to system memory to encode
+		filters = append(filters, "hwdownload", "format=bgr0")
+	case runtime.GOOS == "linux" && params.Backend == CaptureBackendPipeWire:
+		src := fmt.Sprintf("pipewiregrab=framerate=%d", *params.FrameRate)
+		if params.DrawMouse != nil {
+			src += ":draw_mouse=" + boolFlag(*params.DrawMouse)
 		}
-	case "linux":
-		args = []string{
+		args = append(args, "-f", "lavfi", "-i", src)
+	case runtime.GOOS == "linux":
+		args = append(args, []string{
 			// Input options for X11
 			"-f", "x11grab",
 			"-framerate", strconv.Itoa(*params.FrameRate),
-			// Input file
-			"-i", fmt.Sprintf(":%d", *params.DisplayNum), // X11 display
+		}...)
+		if params.DrawMouse != nil {
+			args = append(args, "-draw_mouse", boolFlag(*params.DrawMouse))
 		}
+		// Input file
+		args = append(args, "-i", fmt.Sprintf(":%d", *params.DisplayNum)) // X11 display
 	default:
 		return nil, fmt.Errorf("unsupported platform: %s", runtime.GOOS)
 	}
 
+	if params.DropDuplicateFrames {
+		// keep each surviving frame's original timestamp so idle stretches play back in real time
+		filters = append(filters, params.DuplicateFrameThresholds.filter(), "setpts=PTS-STARTPTS")
+	}
+	if len(filters) > 0 {
+		args = append(args, "-vf", strings.Join(filters, ","))
+	}
+
 	// Output options next
 	args = append(args, []string{
 		// Video encoding
-		"-c:v", "libx264",
+		"-c:v", videoEncoder,
 		"-profile:v", "high", // Explicit web-compatible profile
 		"-pix_fmt", "yuv420p", // Web-standard pixel format
 
@@ -515,17 +1355,51 @@ func ffmpegArgs(params FFmpegRecordingParams, outputPath string) ([]string, erro
 		"-y", // Overwrite output file if it exists
 	}...)
 
+	// Quality/speed tradeoff; left unset the encoder's defaults apply
+	if params.Preset != "" {
+		args = append(args, "-preset", params.Preset)
+	}
+	if params.CRF != nil {
+		args = append(args, "-crf", strconv.Itoa(*params.CRF))
+	}
+	if params.QP != nil {
+		args = append(args, "-qp", strconv.Itoa(*params.QP))
+	}
+
+	// Keyframes by time rather than by frame count (-g), which would drift once
+	// DropDuplicateFrames makes the frame rate variable
+	if params.KeyframeIntervalSeconds != nil {
+		args = append(args, "-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%d)", *params.KeyframeIntervalSeconds))
+	}
+
+	// Without vfr ffmpeg would duplicate frames again to keep a constant frame rate
+	if params.DropDuplicateFrames {
+		args = append(args, "-fps_mode", "vfr")
+	}
+
 	// Duration limit
 	if params.MaxDurationInSeconds != nil {
 		args = append(args, "-t", strconv.Itoa(*params.MaxDurationInSeconds))
 	}
 
+	// Extra args go last so they can override any of the above; after the output file
+	// ffmpeg would ignore them
+	args = append(args, params.ExtraArgs...)
+
 	// Output file
 	args = append(args, outputPath)
 
 	return args, nil
 }
 
+// boolFlag formats b as the 0 or 1 that ffmpeg's boolean options take.
+func boolFlag(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
 // waitForCommand should be run in the background to wait for the ffmpeg process to complete and
 // update the internal state accordingly. It also triggers finalization to add proper duration
 // metadata for recordings that exit naturally (max duration, max file size, etc.).
@@ -543,6 +1417,11 @@ func (fr *FFmpegRecorder) waitForCommand(ctx context.Context) {
 	fr.exitCode = fr.cmd.ProcessState.ExitCode()
 	fr.endTime = time.Now()
 	close(fr.exited)
+	// Wait has copied all of ffmpeg's stderr by now
+	fr.stderr.finish(fr.exitCode)
+	if fr.stopCapture != nil {
+		fr.stopCapture()
+	}
 
 	if err != nil {
 		log.Info("ffmpeg process completed with error", "err", err, "exitCode", fr.exitCode)
@@ -0,0 +1,145 @@
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// availableFilters holds the filters the last ProbeFilters found. It is nil until a probe
+// succeeds, and Validate only checks filters once it is set.
+var availableFilters atomic.Pointer[map[string]bool]
+
+// ProbeFilters lists the filters of the ffmpeg at pathToFFmpeg ("ffmpeg" from PATH when
+// empty) with ffmpeg -filters and caches them, so Validate can reject recordings needing a
+// filter a stripped-down build lacks instead of ffmpeg failing once started. It returns
+// the filter names, sorted.
+func ProbeFilters(ctx context.Context, pathToFFmpeg string) ([]string, error) {
+	if pathToFFmpeg == "" {
+		pathToFFmpeg = "ffmpeg"
+	}
+	out, err := exec.CommandContext(ctx, pathToFFmpeg, "-hide_banner", "-filters").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ffmpeg filters: %w", err)
+	}
+	filters := parseFilters(out)
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("ffmpeg at %s listed no filters", pathToFFmpeg)
+	}
+	set := make(map[string]bool, len(filters))
+	for _, f := range filters {
+		set[f] = true
+	}
+	availableFilters.Store(&set)
+	return filters, nil
+}
+
+// parseFilters returns the sorted filter names in ffmpeg -filters output, whose entries
+// look like " TSC pad               V->V       Pad the input video." after a legend.
+func parseFilters(out []byte) []string {
+	var filters []string
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) >= 3 && strings.Contains(fields[2], "->") {
+			filters = append(filters, fields[1])
+		}
+	}
+	slices.Sort(filters)
+	return slices.Compact(filters)
+}
+
+// AvailableFilters returns the sorted filters found by ProbeFilters, or nil if ffmpeg
+// hasn't been probed.
+func AvailableFilters() []string {
+	set := availableFilters.Load()
+	if set == nil {
+		return nil
+	}
+	filters := make([]string, 0, len(*set))
+	for f := range *set {
+		filters = append(filters, f)
+	}
+	slices.Sort(filters)
+	return filters
+}
+
+// FiltersHandler serves the filters found by ProbeFilters as JSON, for debugging
+// recordings that fail validation on a missing filter.
+func FiltersHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filters := AvailableFilters()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(struct {
+			Probed  bool     `json:"probed"`
+			Filters []string `json:"filters"`
+		}{filters != nil, filters})
+	})
+}
+
+// filterOptions are the ffmpeg options whose value is a filter graph.
+var filterOptions = map[string]bool{
+	"-vf":             true,
+	"-filter:v":       true,
+	"-filter":         true,
+	"-filter_complex": true,
+	"-lavfi":          true,
+}
+
+// argFilters returns the names of the filters used by ffmpeg args, in filter graph options
+// and lavfi inputs, in order of first use.
+func argFilters(args []string) []string {
+	var filters []string
+	for i := 0; i+1 < len(args); i++ {
+		isGraph := filterOptions[args[i]] ||
+			(args[i] == "-i" && i >= 2 && args[i-2] == "-f" && args[i-1] == "lavfi")
+		if !isGraph {
+			continue
+		}
+		for _, chain := range strings.Split(args[i+1], ";") {
+			for _, filter := range strings.Split(chain, ",") {
+				// strip the "[in]" pad labels, then the "=args" and "@instance" suffixes
+				filter = strings.TrimSpace(filter)
+				for strings.HasPrefix(filter, "[") {
+					end := strings.Index(filter, "]")
+					if end < 0 {
+						break
+					}
+					filter = strings.TrimSpace(filter[end+1:])
+				}
+				name, _, _ := strings.Cut(filter, "=")
+				name, _, _ = strings.Cut(name, "@")
+				name, _, _ = strings.Cut(name, "[")
+				if name = strings.TrimSpace(name); name != "" && !slices.Contains(filters, name) {
+					filters = append(filters, name)
+				}
+			}
+		}
+	}
+	return filters
+}
+
+// validateFilters checks that the ffmpeg args p runs with only use filters ProbeFilters
+// found, if it has been run.
+func validateFilters(p FFmpegRecordingParams) error {
+	set := availableFilters.Load()
+	if set == nil {
+		return nil
+	}
+	args, err := ffmpegArgs(p, "")
+	if err != nil {
+		return err
+	}
+	for _, f := range argFilters(args) {
+		if !(*set)[f] {
+			return fmt.Errorf("ffmpeg was built without the %s filter, needed by this recording", f)
+		}
+	}
+	return nil
+}
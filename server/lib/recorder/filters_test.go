@@ -0,0 +1,65 @@
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgFilters(t *testing.T) {
+	assert.Equal(t, []string{"pad", "mpdecimate", "setpts"},
+		argFilters([]string{"-i", ":0", "-vf", "pad=ceil(iw/2)*2:ceil(ih/2)*2,mpdecimate=hi=768,setpts=PTS-STARTPTS"}))
+	assert.Equal(t, []string{"pipewiregrab", "crop"},
+		argFilters([]string{"-f", "lavfi", "-i", "pipewiregrab=framerate=5", "-filter:v", "crop=100:100"}))
+	assert.Equal(t, []string{"split", "scale", "overlay"},
+		argFilters([]string{"-filter_complex", "[0:v]split[a][b];[a]scale@small=64:-1[s]; [b][s] overlay"}))
+	assert.Empty(t, argFilters([]string{"-f", "x11grab", "-i", ":0", "-c:v", "libx264"}))
+}
+
+func TestProbeFilters(t *testing.T) {
+	defer availableFilters.Store(nil)
+	ctx := context.Background()
+
+	params := defaultParams(t.TempDir())
+	params.DropDuplicateFrames = true
+	require.NoError(t, params.Validate(), "filters aren't checked before a probe")
+
+	// stands in for a stripped-down ffmpeg without mpdecimate
+	bin := filepath.Join(t.TempDir(), "ffmpeg")
+	script := `#!/bin/sh
+printf 'Filters:\n  T.. = Timeline support\n  A = Audio input/output\n ..C pad               V->V       Pad the input video.\n ... setpts            V->V       Set PTS for the output video frame.\n T.. crop              V->V       Crop the input video.\n'
+`
+	require.NoError(t, os.WriteFile(bin, []byte(script), 0o755))
+	filters, err := ProbeFilters(ctx, bin)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"crop", "pad", "setpts"}, filters)
+	assert.Equal(t, filters, AvailableFilters())
+
+	assert.ErrorContains(t, params.Validate(), "without the mpdecimate filter")
+	params.DropDuplicateFrames = false
+	assert.NoError(t, params.Validate())
+	params.ExtraArgs = []string{"-vf", "drawtext=text=hi"}
+	assert.ErrorContains(t, params.Validate(), "without the drawtext filter")
+
+	rec := httptest.NewRecorder()
+	FiltersHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/ffmpeg/filters", nil))
+	var body struct {
+		Probed  bool     `json:"probed"`
+		Filters []string `json:"filters"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	assert.True(t, body.Probed)
+	assert.Equal(t, filters, body.Filters)
+
+	// a failed probe keeps the earlier list
+	_, err = ProbeFilters(ctx, filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+	assert.Equal(t, filters, AvailableFilters())
+}
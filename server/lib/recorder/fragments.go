@@ -0,0 +1,65 @@
+package recorder
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// CompleteFragmentsSize returns the length of the longest prefix of the first size bytes
+// of a fragmented MP4 that ends on a complete fragment, i.e. that holds the init segment
+// and whole moof/mdat pairs and so plays on its own. It is 0 until the first fragment is
+// complete. ffmpeg writes recordings this way (+frag_keyframe+empty_moov) until they are
+// finalized, so this is what can be served of a recording still in progress.
+func CompleteFragmentsSize(r io.ReaderAt, size int64) (int64, error) {
+	var (
+		off, complete int64
+		seenMoov      bool
+		hdr           [16]byte
+	)
+	for off+8 <= size {
+		if _, err := r.ReadAt(hdr[:8], off); err != nil {
+			return 0, err
+		}
+		boxSize := int64(binary.BigEndian.Uint32(hdr[:4]))
+		boxType := string(hdr[4:8])
+		switch boxSize {
+		case 0:
+			// the box runs to the end of the file, which is still being written
+			return complete, nil
+		case 1:
+			if off+16 > size {
+				return complete, nil
+			}
+			if _, err := r.ReadAt(hdr[8:16], off+8); err != nil {
+				return 0, err
+			}
+			largeSize := binary.BigEndian.Uint64(hdr[8:16])
+			if largeSize < 16 {
+				return 0, fmt.Errorf("invalid %q box size %d at offset %d", boxType, largeSize, off)
+			}
+			if largeSize > uint64(size-off) {
+				return complete, nil
+			}
+			boxSize = int64(largeSize)
+		default:
+			if boxSize < 8 {
+				return 0, fmt.Errorf("invalid %q box size %d at offset %d", boxType, boxSize, off)
+			}
+		}
+		if boxSize > size-off {
+			// the rest of the box hasn't been written yet
+			return complete, nil
+		}
+		off += boxSize
+		switch boxType {
+		case "moov":
+			seenMoov = true
+		case "mdat":
+			if seenMoov {
+				complete = off
+			}
+		}
+	}
+	return complete, nil
+}
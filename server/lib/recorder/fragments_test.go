@@ -0,0 +1,54 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// box returns an MP4 box of boxType around payload.
+func box(boxType string, payload []byte) []byte {
+	b := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint32(b, uint32(8+len(payload)))
+	copy(b[4:], boxType)
+	return append(b, payload...)
+}
+
+func TestCompleteFragmentsSize(t *testing.T) {
+	init := append(box("ftyp", []byte("isom")), box("moov", make([]byte, 32))...)
+	fragment := append(box("moof", make([]byte, 16)), box("mdat", make([]byte, 64))...)
+	file := append(append(append([]byte{}, init...), fragment...), fragment...)
+
+	size := func(data []byte) int64 {
+		n, err := CompleteFragmentsSize(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		return n
+	}
+
+	assert.Equal(t, int64(0), size(nil))
+	assert.Equal(t, int64(0), size(init), "no fragment yet")
+	assert.Equal(t, int64(len(init)+len(fragment)), size(file[:len(file)-1]), "second mdat still being written")
+	assert.Equal(t, int64(len(init)+len(fragment)), size(file[:len(init)+len(fragment)+20]), "second moof without its mdat")
+	assert.Equal(t, int64(len(file)), size(file))
+
+	// an mdat before any moov is not a fragment
+	assert.Equal(t, int64(0), size(append(box("ftyp", nil), box("mdat", make([]byte, 8))...)))
+
+	// a 64-bit box size
+	large := make([]byte, 16, 24)
+	binary.BigEndian.PutUint32(large, 1)
+	copy(large[4:], "mdat")
+	binary.BigEndian.PutUint64(large[8:], 24)
+	large = append(large, make([]byte, 8)...)
+	withLarge := append(append([]byte{}, init...), append(box("moof", nil), large...)...)
+	assert.Equal(t, int64(len(withLarge)), size(withLarge))
+
+	_, err := CompleteFragmentsSize(bytes.NewReader(box("ftyp", nil)[:4]), 4)
+	require.NoError(t, err)
+	bad := []byte{0, 0, 0, 4, 'm', 'd', 'a', 't'}
+	_, err = CompleteFragmentsSize(bytes.NewReader(bad), int64(len(bad)))
+	require.Error(t, err)
+}
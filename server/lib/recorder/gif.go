@@ -0,0 +1,175 @@
+package recorder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+)
+
+const (
+	// DefaultGIFFPS and DefaultGIFWidth are used for GIFs whose options leave them unset.
+	DefaultGIFFPS   = 10
+	DefaultGIFWidth = 640
+
+	maxGIFFPS   = 30
+	minGIFWidth = 16
+	maxGIFWidth = 1920
+)
+
+// GIFOptions are the options of an animated GIF made from a recording.
+type GIFOptions struct {
+	// FPS is the frame rate of the GIF, DefaultGIFFPS when 0.
+	FPS int
+	// Width is the width of the GIF in pixels, DefaultGIFWidth when 0. The height keeps the
+	// recording's aspect ratio.
+	Width int
+}
+
+func (o GIFOptions) withDefaults() GIFOptions {
+	if o.FPS == 0 {
+		o.FPS = DefaultGIFFPS
+	}
+	if o.Width == 0 {
+		o.Width = DefaultGIFWidth
+	}
+	return o
+}
+
+// Validate checks the options are within the bounds GIF exports accept. Unlike GIF, it
+// takes zero values as given, not as the defaults.
+func (o GIFOptions) Validate() error {
+	if o.FPS < 1 || o.FPS > maxGIFFPS {
+		return fmt.Errorf("GIF frame rate must be between 1 and %d", maxGIFFPS)
+	}
+	if o.Width < minGIFWidth || o.Width > maxGIFWidth {
+		return fmt.Errorf("GIF width must be between %d and %d", minGIFWidth, maxGIFWidth)
+	}
+	return nil
+}
+
+// gifPath is where the GIF of the recording at outputPath is cached for opts, next to the
+// recording, e.g. "<id>.640w-10fps.gif".
+func gifPath(outputPath string, opts GIFOptions) string {
+	return fmt.Sprintf("%s.%dw-%dfps.gif", strings.TrimSuffix(outputPath, filepath.Ext(outputPath)), opts.Width, opts.FPS)
+}
+
+// gifFilter scales the recording's frames for the GIF, ahead of palettegen or paletteuse.
+func gifFilter(opts GIFOptions) string {
+	return fmt.Sprintf("fps=%d,scale=%d:-1:flags=lanczos", opts.FPS, opts.Width)
+}
+
+// gifArgs returns the ffmpeg args of the two passes making the GIF at dst from the
+// recording at src: the first generates a palette of the recording's colors, the second
+// maps the frames onto it, which looks far better than GIF's default palette.
+func gifArgs(src, palette, dst string, opts GIFOptions) (paletteArgs, gifArgs []string) {
+	filter := gifFilter(opts)
+	paletteArgs = []string{
+		"-hide_banner", "-loglevel", "error",
+		"-i", src,
+		"-vf", filter + ",palettegen",
+		"-y", palette,
+	}
+	gifArgs = []string{
+		"-hide_banner", "-loglevel", "error",
+		"-i", src,
+		"-i", palette,
+		"-lavfi", filter + "[x];[x][1:v]paletteuse",
+		"-f", "gif", // Explicitly specify format since .tmp extension isn't recognized
+		"-y", dst,
+	}
+	return paletteArgs, gifArgs
+}
+
+// GIF returns the path of the finalized recording converted to an animated GIF with opts,
+// converting it unless a GIF newer than the recording is cached. Concurrent calls for the
+// same options share one conversion, which is not cut short by ctx. It returns
+// ErrRecordingFinalizing while finalization is pending, and an error wrapping
+// os.ErrNotExist if the recording was deleted or produced no file.
+func (fr *FFmpegRecorder) GIF(ctx context.Context, opts GIFOptions) (string, error) {
+	opts = opts.withDefaults()
+	if err := opts.Validate(); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidParams, err)
+	}
+
+	fr.mu.Lock()
+	if fr.deleted {
+		fr.mu.Unlock()
+		return "", fmt.Errorf("recording deleted: %w", os.ErrNotExist)
+	}
+	if fr.cmd != nil && fr.exitCode < exitCodeProcessDoneMinValue {
+		fr.mu.Unlock()
+		return "", fmt.Errorf("cannot convert: recording is still in progress")
+	}
+	if fr.exitCode >= exitCodeProcessDoneMinValue && !fr.finalizeComplete {
+		fr.mu.Unlock()
+		return "", ErrRecordingFinalizing
+	}
+	outputPath := fr.outputPath
+	binaryPath := fr.binaryPath
+	tempDir := fr.params.TempDir
+	fr.mu.Unlock()
+
+	dst := gifPath(outputPath, opts)
+	v, err, _ := fr.flight.Do("gif:"+dst, func() (any, error) {
+		src, err := os.Stat(outputPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat recording file: %w", err)
+		}
+		if cached, err := os.Stat(dst); err == nil && !cached.ModTime().Before(src.ModTime()) {
+			return dst, nil
+		}
+
+		dir, err := os.MkdirTemp(tempDir, fr.id+"-gif-*")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create temp dir for GIF conversion: %w", err)
+		}
+		defer os.RemoveAll(dir)
+		palette := filepath.Join(dir, "palette.png")
+		tmp := dst + ".tmp"
+		// a no-op once the GIF has been moved into place
+		defer os.Remove(tmp)
+
+		log := logger.FromContext(ctx)
+		paletteArgs, convertArgs := gifArgs(outputPath, palette, tmp, opts)
+		for _, args := range [][]string{paletteArgs, convertArgs} {
+			log.Info("converting recording to GIF", "cmd", fmt.Sprintf("%s %s", binaryPath, strings.Join(args, " ")))
+			if out, err := exec.CommandContext(context.WithoutCancel(ctx), binaryPath, args...).CombinedOutput(); err != nil {
+				return nil, fmt.Errorf("failed to convert recording to GIF: %w: %s", err, strings.TrimSpace(string(out)))
+			}
+		}
+
+		// moved under the lock so a Delete racing the conversion can't leave the GIF behind
+		fr.mu.Lock()
+		defer fr.mu.Unlock()
+		if fr.deleted {
+			return nil, fmt.Errorf("recording deleted: %w", os.ErrNotExist)
+		}
+		if err := os.Rename(tmp, dst); err != nil {
+			return nil, fmt.Errorf("failed to move GIF into place: %w", err)
+		}
+		return dst, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// removeGIFs deletes the GIFs cached for the recording at outputPath.
+func removeGIFs(outputPath string) error {
+	matches, err := filepath.Glob(strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + ".*w-*fps.gif")
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		if err := os.Remove(m); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package recorder
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGIFArgs(t *testing.T) {
+	paletteArgs, convertArgs := gifArgs("in.mp4", "palette.png", "out.gif.tmp", GIFOptions{FPS: 12, Width: 480})
+	assert.Equal(t, "-hide_banner -loglevel error -i in.mp4 -vf fps=12,scale=480:-1:flags=lanczos,palettegen -y palette.png", strings.Join(paletteArgs, " "))
+	assert.Equal(t, "-hide_banner -loglevel error -i in.mp4 -i palette.png -lavfi fps=12,scale=480:-1:flags=lanczos[x];[x][1:v]paletteuse -f gif -y out.gif.tmp", strings.Join(convertArgs, " "))
+}
+
+func TestGIFOptions_Validate(t *testing.T) {
+	assert.NoError(t, GIFOptions{FPS: 1, Width: 1920}.Validate())
+	assert.NoError(t, GIFOptions{FPS: 30, Width: 16}.Validate())
+	assert.ErrorContains(t, GIFOptions{FPS: 0, Width: 640}.Validate(), "frame rate")
+	assert.ErrorContains(t, GIFOptions{FPS: 31, Width: 640}.Validate(), "frame rate")
+	assert.ErrorContains(t, GIFOptions{FPS: 10, Width: 15}.Validate(), "width")
+	assert.ErrorContains(t, GIFOptions{FPS: 10, Width: 1921}.Validate(), "width")
+}
+
+func TestFFmpegRecorder_GIF(t *testing.T) {
+	outputDir, tempDir := t.TempDir(), t.TempDir()
+	calls := filepath.Join(t.TempDir(), "calls")
+	// writes GIF89a to its last arg, the palette or GIF, and logs each call
+	bin := filepath.Join(t.TempDir(), "ffmpeg")
+	script := `#!/bin/sh
+echo "$@" >> "` + calls + `"
+for a; do out=$a; done
+printf GIF89a > "$out"
+`
+	require.NoError(t, os.WriteFile(bin, []byte(script), 0o755))
+	countCalls := func() int {
+		b, err := os.ReadFile(calls)
+		if os.IsNotExist(err) {
+			return 0
+		}
+		require.NoError(t, err)
+		return strings.Count(string(b), "\n")
+	}
+
+	params := defaultParams(outputDir)
+	params.TempDir = tempDir
+	outputPath := filepath.Join(outputDir, "gif.mp4")
+	require.NoError(t, os.WriteFile(outputPath, []byte("finalized"), 0o644))
+	rec := &FFmpegRecorder{
+		id:               "gif",
+		binaryPath:       bin,
+		params:           params,
+		outputPath:       outputPath,
+		exitCode:         0,
+		finalizeComplete: true,
+		stz:              scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+
+	_, err := rec.GIF(t.Context(), GIFOptions{FPS: 60})
+	require.ErrorIs(t, err, ErrInvalidParams)
+
+	path, err := rec.GIF(t.Context(), GIFOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(outputDir, "gif.640w-10fps.gif"), path)
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "GIF89a", string(b))
+	assert.Equal(t, 2, countCalls(), "a palette pass and a GIF pass")
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "the palette is removed")
+
+	_, err = rec.GIF(t.Context(), GIFOptions{FPS: 10, Width: 640})
+	require.NoError(t, err)
+	assert.Equal(t, 2, countCalls(), "the cached GIF is reused")
+
+	_, err = rec.GIF(t.Context(), GIFOptions{Width: 320})
+	require.NoError(t, err)
+	assert.Equal(t, 4, countCalls(), "other options are converted separately")
+	assert.FileExists(t, filepath.Join(outputDir, "gif.320w-10fps.gif"))
+
+	// a recording newer than its GIF is converted again
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(outputPath, later, later))
+	_, err = rec.GIF(t.Context(), GIFOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 6, countCalls())
+
+	require.NoError(t, rec.Delete(t.Context()))
+	entries, err = os.ReadDir(outputDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "deleting the recording deletes its GIFs")
+	_, err = rec.GIF(t.Context(), GIFOptions{})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFFmpegRecorder_GIFFails(t *testing.T) {
+	outputDir := t.TempDir()
+	bin := filepath.Join(t.TempDir(), "ffmpeg")
+	require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\nfor a; do out=$a; done; printf partial > \"$out\"; echo 'No such filter' >&2; exit 1\n"), 0o755))
+
+	params := defaultParams(outputDir)
+	params.TempDir = t.TempDir()
+	outputPath := filepath.Join(outputDir, "fail.mp4")
+	require.NoError(t, os.WriteFile(outputPath, []byte("finalized"), 0o644))
+	rec := &FFmpegRecorder{
+		id:               "fail",
+		binaryPath:       bin,
+		params:           params,
+		outputPath:       outputPath,
+		exitCode:         0,
+		finalizeComplete: true,
+		stz:              scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+
+	_, err := rec.GIF(t.Context(), GIFOptions{})
+	require.ErrorContains(t, err, "No such filter")
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "nothing is left besides the recording")
+	assert.Equal(t, "fail.mp4", entries[0].Name())
+
+	rec.finalizeComplete = false
+	_, err = rec.GIF(t.Context(), GIFOptions{})
+	assert.ErrorIs(t, err, ErrRecordingFinalizing)
+}
@@ -0,0 +1,255 @@
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+)
+
+// Exit reasons recorded in a Manifest.
+const (
+	// ExitStopped means the recording was stopped gracefully with Stop.
+	ExitStopped = "stopped"
+	// ExitKilled means the recording was ended with ForceStop.
+	ExitKilled = "killed"
+	// ExitCompleted means ffmpeg exited cleanly on its own, e.g. on reaching the
+	// maximum duration or file size.
+	ExitCompleted = "completed"
+	// ExitFailed means ffmpeg exited on its own with an error.
+	ExitFailed = "failed"
+)
+
+// ManifestParams are the recording parameters stored in a Manifest.
+type ManifestParams struct {
+	FrameRate               int    `json:"framerate"`
+	DisplayNum              int    `json:"displayNum"`
+	MaxSizeInMB             int    `json:"maxFileSizeInMB"`
+	MaxDurationInSeconds    *int   `json:"maxDurationInSeconds,omitempty"`
+	Mode                    string `json:"mode"`
+	Fragmented              bool   `json:"fragmented"`
+	DropDuplicateFrames     bool   `json:"dropDuplicateFrames"`
+	KeyframeIntervalSeconds *int   `json:"keyframeIntervalSeconds,omitempty"`
+	DrawMouse               *bool  `json:"drawMouse,omitempty"`
+	Preset                  string `json:"preset,omitempty"`
+	CRF                     *int   `json:"crf,omitempty"`
+	QP                      *int   `json:"qp,omitempty"`
+}
+
+// Manifest is the JSON sidecar written next to a recording once it is finalized. It
+// describes the recording independently of the server's in-memory state.
+type Manifest struct {
+	ID string `json:"id"`
+	// Tenant is the tenant the recording was made for; the recording is in its directory.
+	Tenant string `json:"tenant,omitempty"`
+	// Label and Tags are the label and tags the recording was started with.
+	Label string            `json:"label,omitempty"`
+	Tags  map[string]string `json:"tags,omitempty"`
+	// File is the recording's file name, relative to the manifest's directory.
+	File      string         `json:"file"`
+	Params    ManifestParams `json:"params"`
+	StartTime time.Time      `json:"startedAt"`
+	EndTime   time.Time      `json:"finishedAt"`
+	Size      int64          `json:"size"`
+	Codec     string         `json:"codec"`
+	ExitCode  int            `json:"exitCode"`
+	// ExitReason is one of ExitStopped, ExitKilled, ExitCompleted or ExitFailed.
+	ExitReason string `json:"exitReason"`
+	// FinalizeError is set when the recording could not be remuxed and is kept as written.
+	FinalizeError string `json:"finalizeError,omitempty"`
+}
+
+// manifestPath returns the sidecar path for the recording at outputPath.
+func manifestPath(outputPath string) string {
+	return strings.TrimSuffix(outputPath, filepath.Ext(outputPath)) + ".manifest.json"
+}
+
+// writeManifest writes m to path through a temporary file, so readers never see a
+// partially written manifest.
+func writeManifest(path string, m Manifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, b, 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// readManifest reads the manifest at path. A missing manifest is reported as os.ErrNotExist.
+func readManifest(path string) (*Manifest, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// manifestParams converts merged recording parameters to their manifest form.
+func manifestParams(p FFmpegRecordingParams) ManifestParams {
+	mp := ManifestParams{
+		Mode:                    string(p.Mode),
+		Fragmented:              p.Fragmented,
+		DropDuplicateFrames:     p.DropDuplicateFrames,
+		MaxDurationInSeconds:    p.MaxDurationInSeconds,
+		KeyframeIntervalSeconds: p.KeyframeIntervalSeconds,
+		DrawMouse:               p.DrawMouse,
+		Preset:                  p.Preset,
+		CRF:                     p.CRF,
+		QP:                      p.QP,
+	}
+	if mp.Mode == "" {
+		mp.Mode = string(CaptureScreen)
+	}
+	if p.FrameRate != nil {
+		mp.FrameRate = *p.FrameRate
+	}
+	if p.DisplayNum != nil {
+		mp.DisplayNum = *p.DisplayNum
+	}
+	if p.MaxSizeInMB != nil {
+		mp.MaxSizeInMB = *p.MaxSizeInMB
+	}
+	return mp
+}
+
+// recordingParams converts manifest parameters back to recording parameters for a
+// recording of tenant stored under outputDir.
+func (mp ManifestParams) recordingParams(outputDir, tenant string) FFmpegRecordingParams {
+	return FFmpegRecordingParams{
+		FrameRate:               &mp.FrameRate,
+		DisplayNum:              &mp.DisplayNum,
+		MaxSizeInMB:             &mp.MaxSizeInMB,
+		MaxDurationInSeconds:    mp.MaxDurationInSeconds,
+		OutputDir:               &outputDir,
+		Fragmented:              mp.Fragmented,
+		Mode:                    CaptureMode(mp.Mode),
+		DropDuplicateFrames:     mp.DropDuplicateFrames,
+		KeyframeIntervalSeconds: mp.KeyframeIntervalSeconds,
+		DrawMouse:               mp.DrawMouse,
+		Preset:                  mp.Preset,
+		CRF:                     mp.CRF,
+		QP:                      mp.QP,
+		Tenant:                  tenant,
+	}
+}
+
+// RestoreFFmpegRecorders returns a finalized recorder for every recording in outputDir
+// and its tenant subdirectories that has a manifest, so recordings made before a restart
+// can still be listed, downloaded and deleted. Recordings without a manifest were not
+// finalized, e.g. because the server crashed mid-recording, and are skipped, as are
+// manifests whose recording file is gone. A missing outputDir yields no recorders.
+func RestoreFFmpegRecorders(ctx context.Context, outputDir, pathToFFmpeg string, ctrl scaletozero.Controller) ([]*FFmpegRecorder, error) {
+	entries, err := os.ReadDir(outputDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read output directory: %w", err)
+	}
+
+	recorders := restoreDir(ctx, entries, outputDir, "", pathToFFmpeg, ctrl)
+	for _, e := range entries {
+		if !e.IsDir() || !tenantRegex.MatchString(e.Name()) {
+			continue
+		}
+		tenantEntries, err := os.ReadDir(filepath.Join(outputDir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tenant directory: %w", err)
+		}
+		recorders = append(recorders, restoreDir(ctx, tenantEntries, outputDir, e.Name(), pathToFFmpeg, ctrl)...)
+	}
+	return recorders, nil
+}
+
+// restoreDir restores the recordings among entries, the contents of tenant's directory
+// in outputDir.
+func restoreDir(ctx context.Context, entries []os.DirEntry, outputDir, tenant, pathToFFmpeg string, ctrl scaletozero.Controller) []*FFmpegRecorder {
+	log := logger.FromContext(ctx)
+	dir := filepath.Join(outputDir, tenant)
+
+	var recorders []*FFmpegRecorder
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() {
+			continue
+		}
+		if filepath.Ext(name) == ".mp4" {
+			if _, err := os.Stat(manifestPath(filepath.Join(dir, name))); errors.Is(err, os.ErrNotExist) {
+				log.Warn("skipping unfinalized recording", "file", name, "tenant", tenant)
+			}
+			continue
+		}
+		if !strings.HasSuffix(name, ".manifest.json") {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		m, err := readManifest(path)
+		if err != nil {
+			log.Warn("skipping unreadable recording manifest", "file", name, "tenant", tenant, "err", err)
+			continue
+		}
+		outputPath := filepath.Join(dir, m.File)
+		if m.ID == "" || m.File != m.ID+".mp4" || manifestPath(outputPath) != path || m.Tenant != tenant {
+			log.Warn("skipping recording manifest that does not match its location", "file", name, "tenant", tenant, "id", m.ID)
+			continue
+		}
+		if m.ExitCode < exitCodeProcessDoneMinValue {
+			log.Warn("skipping recording manifest with invalid exit code", "file", name, "tenant", tenant, "exit_code", m.ExitCode)
+			continue
+		}
+		if _, err := os.Stat(outputPath); err != nil {
+			log.Warn("skipping recording manifest without its recording", "file", name, "tenant", tenant, "err", err)
+			continue
+		}
+		recorders = append(recorders, restoredFFmpegRecorder(m, outputDir, outputPath, pathToFFmpeg, ctrl))
+	}
+	return recorders
+}
+
+// restoredFFmpegRecorder returns a recorder in the state a recording is left in once it
+// has exited and been finalized.
+func restoredFFmpegRecorder(m *Manifest, outputDir, outputPath, pathToFFmpeg string, ctrl scaletozero.Controller) *FFmpegRecorder {
+	exited := make(chan struct{})
+	close(exited)
+
+	fr := &FFmpegRecorder{
+		id:               m.ID,
+		binaryPath:       pathToFFmpeg,
+		params:           m.Params.recordingParams(outputDir, m.Tenant),
+		outputPath:       outputPath,
+		startTime:        m.StartTime,
+		endTime:          m.EndTime,
+		exitCode:         m.ExitCode,
+		exited:           exited,
+		stz:              scaletozero.NewOncer(scaletozero.WithReason(ctrl, scaletozero.ReasonRecording)),
+		finalizeComplete: true,
+	}
+	fr.params.Label = m.Label
+	fr.params.Tags = m.Tags
+	if m.ExitReason == ExitStopped || m.ExitReason == ExitKilled {
+		fr.stopReason = m.ExitReason
+	}
+	if m.FinalizeError != "" {
+		fr.finalizeResultErr = errors.New(m.FinalizeError)
+	}
+	return fr
+}
@@ -0,0 +1,108 @@
+package recorder
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// OrphanedFile is a recording or intermediate file that no registered recorder owns, e.g.
+// one left behind when the server crashed mid-recording or mid-finalization.
+type OrphanedFile struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+var (
+	// outputFileRegex matches the names of the files a recorder writes in its recording
+	// directory: the recording, its manifest, its cached GIFs, and the temporary files they
+	// are written through. Names are all there is to go on, so any <name>.mp4 matches.
+	outputFileRegex = regexp.MustCompile(`^([a-zA-Z0-9_-]+)\.(mp4|manifest\.json|manifest\.json\.tmp|mp4\.[0-9]+\.tmp|[0-9]+w-[0-9]+fps\.gif(\.tmp)?)$`)
+	// tempFileRegex matches the remuxed recordings finalization writes to TempDir.
+	tempFileRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+-[0-9]+\.mp4\.tmp$`)
+)
+
+// FindOrphanedFiles lists the recorder files in outputDir, its tenant subdirectories and
+// tempDir (os.TempDir() when empty) that were last modified before olderThan and belong to
+// none of activeIDs, the IDs of the registered recorders. Files are recognized by name
+// alone, so an .mp4 another program left in outputDir or a tenant subdirectory is matched
+// too. Missing directories have no files.
+func FindOrphanedFiles(outputDir, tempDir string, activeIDs []string, olderThan time.Time) ([]OrphanedFile, error) {
+	active := make(map[string]bool, len(activeIDs))
+	for _, id := range activeIDs {
+		active[id] = true
+	}
+
+	var orphans []OrphanedFile
+	collect := func(dir string, owned func(name string) (bool, bool)) error {
+		entries, err := os.ReadDir(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", dir, err)
+		}
+		for _, e := range entries {
+			if !e.Type().IsRegular() {
+				continue
+			}
+			if ours, isOwned := owned(e.Name()); !ours || isOwned {
+				continue
+			}
+			info, err := e.Info()
+			if err != nil || !info.ModTime().Before(olderThan) {
+				continue
+			}
+			orphans = append(orphans, OrphanedFile{Path: filepath.Join(dir, e.Name()), Size: info.Size(), ModTime: info.ModTime()})
+		}
+		return nil
+	}
+
+	// ownedOutput reports whether name is a recorder's output file, and whether its recorder is active.
+	ownedOutput := func(name string) (bool, bool) {
+		m := outputFileRegex.FindStringSubmatch(name)
+		if m == nil {
+			return false, false
+		}
+		return true, active[m[1]]
+	}
+	if err := collect(outputDir, ownedOutput); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(outputDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read %s: %w", outputDir, err)
+	}
+	for _, e := range entries {
+		if e.IsDir() && tenantRegex.MatchString(e.Name()) {
+			if err := collect(filepath.Join(outputDir, e.Name()), ownedOutput); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if tempDir == "" {
+		tempDir = os.TempDir()
+	}
+	err = collect(tempDir, func(name string) (bool, bool) {
+		if !tempFileRegex.MatchString(name) {
+			return false, false
+		}
+		// IDs may contain dashes, so match every active ID as a prefix.
+		for id := range active {
+			if strings.HasPrefix(name, id+"-") {
+				return true, true
+			}
+		}
+		return true, false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orphans, nil
+}
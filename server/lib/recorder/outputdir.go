@@ -0,0 +1,27 @@
+package recorder
+
+import (
+	"fmt"
+	"os"
+)
+
+// CheckWritable reports whether recordings can be written to dir, by creating, writing
+// and removing a small file in it. The error wraps the underlying cause, so callers can
+// tell a full disk (syscall.ENOSPC) from a permission or read-only filesystem problem.
+func CheckWritable(dir string) error {
+	f, err := os.CreateTemp(dir, ".write-check-*")
+	if err != nil {
+		return fmt.Errorf("output directory %s is not writable: %w", dir, err)
+	}
+	_, err = f.Write([]byte("ok"))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if removeErr := os.Remove(f.Name()); err == nil {
+		err = removeErr
+	}
+	if err != nil {
+		return fmt.Errorf("output directory %s is not writable: %w", dir, err)
+	}
+	return nil
+}
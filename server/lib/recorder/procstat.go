@@ -0,0 +1,88 @@
+package recorder
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// userHZ is the kernel's USER_HZ, the unit of the CPU times in /proc/<pid>/stat. It is
+// 100 on every architecture Linux exposes to userspace.
+const userHZ = 100
+
+// procRoot is where process information is read from; tests point it elsewhere.
+var procRoot = "/proc"
+
+// ResourceUsage is a point-in-time sample of a recorder process's resource consumption.
+type ResourceUsage struct {
+	// CPUSeconds is user plus system CPU time consumed since the process started.
+	CPUSeconds float64
+	// RSSBytes is the resident set size.
+	RSSBytes int64
+	// Threads is the number of threads in the process.
+	Threads int
+}
+
+// readProcUsage samples CPU time from /proc/<pid>/stat and memory and thread counts
+// from /proc/<pid>/status.
+func readProcUsage(pid int) (ResourceUsage, error) {
+	var u ResourceUsage
+	dir := filepath.Join(procRoot, strconv.Itoa(pid))
+
+	stat, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return u, err
+	}
+	// comm (field 2) may contain spaces and parens, so split after its closing paren.
+	end := bytes.LastIndexByte(stat, ')')
+	if end < 0 {
+		return u, fmt.Errorf("malformed stat for pid %d", pid)
+	}
+	fields := strings.Fields(string(stat[end+1:]))
+	// fields[0] is state (field 3); utime and stime are fields 14 and 15.
+	if len(fields) < 13 {
+		return u, fmt.Errorf("malformed stat for pid %d", pid)
+	}
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return u, fmt.Errorf("parse utime: %w", err)
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return u, fmt.Errorf("parse stime: %w", err)
+	}
+	u.CPUSeconds = float64(utime+stime) / userHZ
+
+	status, err := os.Open(filepath.Join(dir, "status"))
+	if err != nil {
+		return u, err
+	}
+	defer status.Close()
+	scanner := bufio.NewScanner(status)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch key {
+		case "VmRSS":
+			kb, err := strconv.ParseInt(strings.TrimSuffix(value, " kB"), 10, 64)
+			if err != nil {
+				return u, fmt.Errorf("parse VmRSS: %w", err)
+			}
+			u.RSSBytes = kb * 1024
+		case "Threads":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return u, fmt.Errorf("parse Threads: %w", err)
+			}
+			u.Threads = n
+		}
+	}
+	return u, scanner.Err()
+}
@@ -0,0 +1,34 @@
+package recorder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProcUsage(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "42")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	// comm with spaces and parens must not shift the field positions
+	stat := "42 (ff (mpeg) x) S 1 42 42 0 -1 4194560 100 0 0 0 250 50 0 0 20 0 7 0 100 0 0\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0644))
+	status := "Name:\tffmpeg\nVmRSS:\t   2048 kB\nThreads:\t7\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "status"), []byte(status), 0644))
+
+	orig := procRoot
+	procRoot = root
+	t.Cleanup(func() { procRoot = orig })
+
+	u, err := readProcUsage(42)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, u.CPUSeconds)
+	assert.Equal(t, int64(2048*1024), u.RSSBytes)
+	assert.Equal(t, 7, u.Threads)
+
+	_, err = readProcUsage(43)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,98 @@
+package recorder
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ffmpegLogLevels are the values ffmpeg accepts for -loglevel.
+var ffmpegLogLevels = map[string]bool{
+	"quiet": true, "panic": true, "fatal": true, "error": true, "warning": true,
+	"info": true, "verbose": true, "debug": true, "trace": true,
+}
+
+// EncoderStats is ffmpeg's latest -progress report for a recording.
+type EncoderStats struct {
+	// Frames is the number of frames encoded so far.
+	Frames int64
+	// FPS is the current encoding rate in frames per second.
+	FPS float64
+	// BitrateKbps is the output bitrate in kbit/s; 0 until ffmpeg can compute it.
+	BitrateKbps float64
+	// Speed is encoding speed relative to real time; below 1 means ffmpeg is falling behind.
+	Speed float64
+	// OutTime is the timestamp of the latest encoded frame.
+	OutTime time.Duration
+	// DroppedFrames and DuplicatedFrames count frames ffmpeg dropped or repeated to
+	// keep the output's frame rate.
+	DroppedFrames    int64
+	DuplicatedFrames int64
+}
+
+// progressWriter parses the key=value blocks ffmpeg writes with -progress and keeps
+// the last complete one. Each block ends with a progress=continue or progress=end line.
+type progressWriter struct {
+	mu      sync.Mutex
+	partial []byte
+	block   EncoderStats
+	latest  *EncoderStats
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		w.parseLine(strings.TrimSpace(string(w.partial[:i])))
+		w.partial = w.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *progressWriter) parseLine(line string) {
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return
+	}
+	value = strings.TrimSpace(value)
+	switch key {
+	case "frame":
+		w.block.Frames, _ = strconv.ParseInt(value, 10, 64)
+	case "fps":
+		w.block.FPS, _ = strconv.ParseFloat(value, 64)
+	case "bitrate":
+		// e.g. "2048.3kbits/s", or "N/A" before anything has been written
+		w.block.BitrateKbps, _ = strconv.ParseFloat(strings.TrimSuffix(value, "kbits/s"), 64)
+	case "speed":
+		w.block.Speed, _ = strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64)
+	case "out_time_us":
+		us, _ := strconv.ParseInt(value, 10, 64)
+		w.block.OutTime = time.Duration(us) * time.Microsecond
+	case "drop_frames":
+		w.block.DroppedFrames, _ = strconv.ParseInt(value, 10, 64)
+	case "dup_frames":
+		w.block.DuplicatedFrames, _ = strconv.ParseInt(value, 10, 64)
+	case "progress":
+		stats := w.block
+		w.latest = &stats
+		w.block = EncoderStats{}
+	}
+}
+
+// Stats returns the last complete progress report, or nil if there is none yet.
+func (w *progressWriter) Stats() *EncoderStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.latest == nil {
+		return nil
+	}
+	stats := *w.latest
+	return &stats
+}
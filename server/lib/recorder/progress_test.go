@@ -0,0 +1,57 @@
+package recorder
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressWriter(t *testing.T) {
+	w := &progressWriter{}
+	assert.Nil(t, w.Stats())
+
+	block := "frame=42\nfps=9.98\nstream_0_0_q=28.0\nbitrate=N/A\ntotal_size=48\nout_time_us=4100000\n" +
+		"out_time=00:00:04.100000\ndup_frames=2\ndrop_frames=1\nspeed=0.997x\nprogress=continue\n"
+	// ffmpeg's writes don't line up with lines
+	for _, chunk := range []string{block[:7], block[7:50], block[50:]} {
+		_, err := w.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, &EncoderStats{
+		Frames:           42,
+		FPS:              9.98,
+		Speed:            0.997,
+		OutTime:          4100 * time.Millisecond,
+		DroppedFrames:    1,
+		DuplicatedFrames: 2,
+	}, w.Stats())
+
+	// an incomplete block leaves the last complete report in place
+	_, _ = w.Write([]byte("frame=50\nbitrate=2048.5kbits/s\n"))
+	assert.Equal(t, int64(42), w.Stats().Frames)
+	_, _ = w.Write([]byte("progress=end\n"))
+	assert.Equal(t, int64(50), w.Stats().Frames)
+	assert.Equal(t, 2048.5, w.Stats().BitrateKbps)
+}
+
+func TestFFmpegArgs_LogLevelAndProgress(t *testing.T) {
+	params := defaultParams(t.TempDir())
+	params.Mode = CaptureScreencast
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	assert.NotContains(t, args, "-loglevel")
+	assert.NotContains(t, args, "-progress")
+
+	params.LogLevel = "warning"
+	params.Progress = true
+	args, err = ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	// global options must precede the input
+	assert.True(t, strings.HasPrefix(strings.Join(args, " "), "-loglevel warning -progress pipe:1 -nostats -f image2pipe"))
+
+	params.LogLevel = "loud"
+	assert.ErrorContains(t, params.Validate(), "unknown ffmpeg log level")
+}
@@ -3,9 +3,19 @@ package recorder
 import (
 	"context"
 	"io"
+	"regexp"
 	"time"
 )
 
+// idRegex matches recorder IDs. IDs name the files a recorder writes, so they are limited
+// to characters that can't escape or collide within the recording directory.
+var idRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// ValidID reports whether id is 1-64 letters, digits, hyphens or underscores.
+func ValidID(id string) bool {
+	return idRegex.MatchString(id)
+}
+
 // Recorder defines the interface for recording functionality.
 type Recorder interface {
 	ID() string
@@ -23,6 +33,8 @@ type RecordingMetadata struct {
 	Size      int64
 	StartTime time.Time
 	EndTime   time.Time
+	// ModTime is the recording file's modification time; only set by Recording.
+	ModTime time.Time
 }
 
 // RecordManager defines the interface for managing multiple recorder instances.
@@ -0,0 +1,59 @@
+package recorder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/cdpclient"
+)
+
+// captureScreencast writes Chromium's screencast to w as JPEG frames at fps frames per
+// second, for ffmpeg's image2pipe input. Chromium only sends a frame when the page
+// changes, so the latest frame is repeated in between to keep the output's timing.
+// It closes w when it returns: when ctx is done, the connection fails or a write fails.
+func captureScreencast(ctx context.Context, devtoolsURL string, fps int, w io.WriteCloser) error {
+	defer w.Close()
+
+	client, err := cdpclient.Dial(ctx, devtoolsURL)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var (
+		mu     sync.Mutex
+		latest []byte
+	)
+	screencastErr := make(chan error, 1)
+	go func() {
+		screencastErr <- client.Screencast(ctx, func(frame []byte) {
+			mu.Lock()
+			latest = frame
+			mu.Unlock()
+		})
+	}()
+
+	ticker := time.NewTicker(time.Second / time.Duration(fps))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-screencastErr:
+			return fmt.Errorf("screencast: %w", err)
+		case <-ticker.C:
+			mu.Lock()
+			frame := latest
+			mu.Unlock()
+			if frame == nil {
+				continue
+			}
+			if _, err := w.Write(frame); err != nil {
+				return fmt.Errorf("write frame: %w", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package recorder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScreencast serves a CDP endpoint with one page whose screencast sends a
+// numbered frame each time the previous one is acknowledged.
+func fakeScreencast(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.CloseNow()
+		ctx := r.Context()
+		send := func(v any) {
+			b, _ := json.Marshal(v)
+			_ = c.Write(ctx, websocket.MessageText, b)
+		}
+		frame := 0
+		for {
+			_, msg, err := c.Read(ctx)
+			if err != nil {
+				return
+			}
+			var req struct {
+				ID     int64  `json:"id"`
+				Method string `json:"method"`
+			}
+			_ = json.Unmarshal(msg, &req)
+			switch req.Method {
+			case "Target.getTargets":
+				send(map[string]any{"id": req.ID, "result": map[string]any{"targetInfos": []map[string]string{{"targetId": "page-1", "type": "page"}}}})
+			case "Target.attachToTarget":
+				send(map[string]any{"id": req.ID, "result": map[string]string{"sessionId": "s1"}})
+			case "Page.startScreencast", "Page.screencastFrameAck":
+				if req.Method == "Page.startScreencast" {
+					send(map[string]any{"id": req.ID, "result": map[string]any{}})
+				}
+				frame++
+				time.Sleep(10 * time.Millisecond)
+				send(map[string]any{"method": "Page.screencastFrame", "sessionId": "s1", "params": map[string]any{
+					"data":      []byte("<frame>"),
+					"sessionId": frame,
+				}})
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func TestFFmpegRecorder_Screencast(t *testing.T) {
+	tempDir := t.TempDir()
+	outputPath := filepath.Join(tempDir, "screencast.mp4")
+	params := defaultParams(tempDir)
+	fps := 20
+	params.FrameRate = &fps
+	params.Mode = CaptureScreencast
+	// fragmented output is served as written, so the mock isn't invoked to remux
+	params.Fragmented = true
+	url := fakeScreencast(t)
+	rec := &FFmpegRecorder{
+		id:          "screencast",
+		binaryPath:  filepath.Join("testdata", "mock_ffmpeg_pipe.sh"),
+		params:      params,
+		outputPath:  outputPath,
+		stz:         scaletozero.NewOncer(scaletozero.NewNoopController()),
+		devtoolsURL: func() string { return url },
+	}
+	require.NoError(t, rec.Start(t.Context()))
+	require.True(t, rec.IsRecording(t.Context()))
+
+	require.Eventually(t, func() bool {
+		b, _ := os.ReadFile(outputPath)
+		return strings.Count(string(b), "<frame>") >= 3
+	}, 5*time.Second, 20*time.Millisecond)
+
+	require.NoError(t, rec.Stop(t.Context()))
+	require.False(t, rec.IsRecording(t.Context()))
+
+	out, meta, err := rec.Recording(t.Context())
+	require.NoError(t, err)
+	defer out.Close()
+	b, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(b)), meta.Size)
+	assert.Empty(t, strings.ReplaceAll(string(b), "<frame>", ""), "output should hold only whole frames")
+}
+
+func TestFFmpegRecorder_ScreencastWithoutUpstream(t *testing.T) {
+	tempDir := t.TempDir()
+	params := defaultParams(tempDir)
+	params.Mode = CaptureScreencast
+	rec := &FFmpegRecorder{
+		id:          "no-upstream",
+		binaryPath:  filepath.Join("testdata", "mock_ffmpeg_pipe.sh"),
+		params:      params,
+		outputPath:  filepath.Join(tempDir, "no-upstream.mp4"),
+		stz:         scaletozero.NewOncer(scaletozero.NewNoopController()),
+		devtoolsURL: func() string { return "" },
+	}
+	require.ErrorContains(t, rec.Start(t.Context()), "devtools upstream not available")
+	require.False(t, rec.IsRecording(t.Context()))
+
+	factory := NewFFmpegRecorderFactory(mockBin, defaultParams(tempDir), nil, scaletozero.NewNoopController())
+	_, err := factory("no-devtools", FFmpegRecordingParams{Mode: CaptureScreencast})
+	require.ErrorIs(t, err, ErrInvalidParams)
+}
+
+func TestFFmpegArgs_Screencast(t *testing.T) {
+	params := defaultParams(t.TempDir())
+	params.Mode = CaptureScreencast
+	args, err := ffmpegArgs(params, "out.mp4")
+	require.NoError(t, err)
+	joined := strings.Join(args, " ")
+	assert.Contains(t, joined, "-f image2pipe -framerate 5 -c:v mjpeg -i pipe:0")
+	assert.NotContains(t, joined, "x11grab")
+}
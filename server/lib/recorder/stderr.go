@@ -0,0 +1,127 @@
+package recorder
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+const (
+	// stderrBacklogLines is how many of ffmpeg's most recent stderr lines a new subscriber
+	// receives first.
+	stderrBacklogLines = 200
+	// stderrSubscriberBuffer bounds the lines queued for a slow subscriber; lines beyond it
+	// are dropped for that subscriber rather than stalling ffmpeg.
+	stderrSubscriberBuffer = 256
+)
+
+// StderrLog collects the stderr of one ffmpeg process as lines, passing it through to the
+// server's own stderr, and fans them out to subscribers until the process exits.
+type StderrLog struct {
+	out io.Writer
+
+	mu       sync.Mutex
+	partial  []byte
+	backlog  []string
+	subs     map[chan string]struct{}
+	done     chan struct{}
+	exitCode int
+}
+
+func newStderrLog(out io.Writer) *StderrLog {
+	return &StderrLog{out: out, subs: make(map[chan string]struct{}), done: make(chan struct{})}
+}
+
+func (l *StderrLog) Write(p []byte) (int, error) {
+	if l.out != nil {
+		_, _ = l.out.Write(p)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.partial = append(l.partial, p...)
+	for {
+		// ffmpeg ends its stats line with \r to redraw it in place
+		i := bytes.IndexAny(l.partial, "\r\n")
+		if i < 0 {
+			break
+		}
+		if i > 0 {
+			l.publishLocked(string(l.partial[:i]))
+		}
+		l.partial = l.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+// publishLocked records line in the backlog and queues it for every subscriber. The caller
+// holds l.mu.
+func (l *StderrLog) publishLocked(line string) {
+	if len(l.backlog) == stderrBacklogLines {
+		l.backlog = append(l.backlog[:0], l.backlog[1:]...)
+	}
+	l.backlog = append(l.backlog, line)
+	for ch := range l.subs {
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+}
+
+// finish publishes any unterminated last line and ends the subscriptions with the
+// process's exit code. It must be called once ffmpeg's stderr has been fully copied.
+func (l *StderrLog) finish(exitCode int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	select {
+	case <-l.done:
+		return
+	default:
+	}
+	if len(l.partial) > 0 {
+		l.publishLocked(string(l.partial))
+		l.partial = nil
+	}
+	l.exitCode = exitCode
+	for ch := range l.subs {
+		close(ch)
+	}
+	l.subs = nil
+	close(l.done)
+}
+
+// Subscribe returns the recent lines and a channel of the lines written after them. The
+// channel is closed when the process exits, after which ExitCode is set; unsubscribe
+// releases it early. A subscription taken after the process exited gets the backlog and
+// a closed channel.
+func (l *StderrLog) Subscribe() (backlog []string, lines <-chan string, unsubscribe func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	backlog = append([]string(nil), l.backlog...)
+	ch := make(chan string, stderrSubscriberBuffer)
+	if l.subs == nil {
+		close(ch)
+		return backlog, ch, func() {}
+	}
+	l.subs[ch] = struct{}{}
+	return backlog, ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.subs[ch]; ok {
+			delete(l.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Done is closed once the process has exited.
+func (l *StderrLog) Done() <-chan struct{} {
+	return l.done
+}
+
+// ExitCode returns the process's exit code once Done is closed.
+func (l *StderrLog) ExitCode() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.exitCode
+}
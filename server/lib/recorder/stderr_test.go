@@ -0,0 +1,92 @@
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onkernel/kernel-images/server/lib/scaletozero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStderrLog(t *testing.T) {
+	var out bytes.Buffer
+	l := newStderrLog(&out)
+
+	_, _ = l.Write([]byte("Input #0, x11grab\nframe=  1 fps=0.0\rframe=  2"))
+	backlog, lines, unsubscribe := l.Subscribe()
+	defer unsubscribe()
+	assert.Equal(t, []string{"Input #0, x11grab", "frame=  1 fps=0.0"}, backlog)
+
+	_, _ = l.Write([]byte(" fps=10\r\n"))
+	assert.Equal(t, "frame=  2 fps=10", <-lines)
+
+	_, _ = l.Write([]byte("exiting normally"))
+	l.finish(255)
+	assert.Equal(t, "exiting normally", <-lines, "the unterminated last line is published on exit")
+	_, ok := <-lines
+	assert.False(t, ok)
+	assert.Equal(t, 255, l.ExitCode())
+	assert.Equal(t, "Input #0, x11grab\nframe=  1 fps=0.0\rframe=  2 fps=10\r\nexiting normally", out.String())
+
+	// late subscribers get the recent lines and a closed channel
+	backlog, lines, _ = l.Subscribe()
+	assert.Equal(t, []string{"Input #0, x11grab", "frame=  1 fps=0.0", "frame=  2 fps=10", "exiting normally"}, backlog)
+	_, ok = <-lines
+	assert.False(t, ok)
+	select {
+	case <-l.Done():
+	default:
+		t.Fatal("expected Done to be closed")
+	}
+}
+
+func TestStderrLog_Bounds(t *testing.T) {
+	l := newStderrLog(nil)
+	_, lines, unsubscribe := l.Subscribe()
+	for i := range stderrBacklogLines + stderrSubscriberBuffer {
+		_, _ = fmt.Fprintf(l, "line %d\n", i)
+	}
+
+	backlog, _, _ := l.Subscribe()
+	require.Len(t, backlog, stderrBacklogLines)
+	assert.Equal(t, fmt.Sprintf("line %d", stderrSubscriberBuffer), backlog[0])
+
+	// a subscriber that doesn't keep up misses lines instead of blocking writes
+	assert.Len(t, lines, stderrSubscriberBuffer)
+	assert.Equal(t, "line 0", <-lines)
+
+	unsubscribe()
+	unsubscribe()
+	_, _ = l.Write([]byte("after unsubscribe\n"))
+	l.finish(0)
+}
+
+func TestFFmpegRecorder_StderrLog(t *testing.T) {
+	tempDir := t.TempDir()
+	rec := &FFmpegRecorder{
+		id:         "stderr",
+		binaryPath: mockBin,
+		params:     defaultParams(tempDir),
+		outputPath: filepath.Join(tempDir, "stderr.mp4"),
+		stz:        scaletozero.NewOncer(scaletozero.NewNoopController()),
+	}
+	assert.Nil(t, rec.StderrLog())
+
+	require.NoError(t, rec.Start(t.Context()))
+	stderrLog := rec.StderrLog()
+	require.NotNil(t, stderrLog)
+	_ = rec.Stop(t.Context())
+
+	select {
+	case <-stderrLog.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("stderr log was not finished after ffmpeg exited")
+	}
+	backlog, _, _ := stderrLog.Subscribe()
+	assert.Equal(t, []string{"mock ffmpeg started"}, backlog)
+	assert.Equal(t, 101, stderrLog.ExitCode())
+}
@@ -2,6 +2,7 @@
 
 set -euo pipefail
 echo "$@"
+echo "mock ffmpeg started" >&2
 
 sleep_pid=""
 
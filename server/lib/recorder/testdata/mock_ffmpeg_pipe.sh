@@ -0,0 +1,8 @@
+#!/usr/bin/env bash
+
+# Mock ffmpeg for screencast capture: copies the frames written to stdin into the
+# output file (the last argument) until stdin closes or a signal arrives.
+set -euo pipefail
+
+trap 'exit 255' INT TERM
+cat > "${@: -1}"
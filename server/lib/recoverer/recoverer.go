@@ -0,0 +1,45 @@
+// Package recoverer turns handler panics into JSON error responses.
+package recoverer
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime/debug"
+
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+)
+
+// Middleware recovers from panics in later handlers, logs them with their stack through
+// the request's context logger and answers with a 500 in the API's standard error shape,
+// so clients get a consistent body even then. It belongs after logger.Middleware. The
+// request ID is logged when chi's RequestID middleware set one. As with chi's Recoverer,
+// http.ErrAbortHandler is re-panicked and upgraded connections get no response.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rvr := recover()
+			if rvr == nil {
+				return
+			}
+			if rvr == http.ErrAbortHandler {
+				panic(rvr)
+			}
+
+			attrs := []any{"panic", rvr, "method", r.Method, "path", r.URL.Path, "stack", string(debug.Stack())}
+			if id := chiMiddleware.GetReqID(r.Context()); id != "" {
+				attrs = append(attrs, "request_id", id)
+			}
+			logger.FromContext(r.Context()).Error("panic serving request", attrs...)
+
+			if r.Header.Get("Connection") == "Upgrade" {
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(oapi.InternalErrorJSONResponse{Message: "internal server error"})
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,69 @@
+package recoverer
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	chiMiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/onkernel/kernel-images/server/lib/logger"
+	oapi "github.com/onkernel/kernel-images/server/lib/oapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var panics = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	panic("boom")
+})
+
+func TestMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(slog.NewTextHandler(&buf, nil))
+	h := chiMiddleware.RequestID(logger.Middleware(log, nil)(Middleware(panics)))
+
+	req := httptest.NewRequest(http.MethodPost, "/recording/start", nil)
+	req.Header.Set(chiMiddleware.RequestIDHeader, "req-123")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var body oapi.Error
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "internal server error", body.Message)
+
+	out := buf.String()
+	assert.Contains(t, out, "panic serving request")
+	assert.Contains(t, out, "panic=boom")
+	assert.Contains(t, out, "request_id=req-123")
+	assert.Contains(t, out, "recoverer_test.go", "stack should be logged")
+}
+
+func TestMiddlewareWithoutPanic(t *testing.T) {
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestMiddlewareUpgrade(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Connection", "Upgrade")
+	rec := httptest.NewRecorder()
+	Middleware(panics).ServeHTTP(rec, req)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestMiddlewareAbortHandler(t *testing.T) {
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
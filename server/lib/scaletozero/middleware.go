@@ -11,8 +11,9 @@ import (
 // Middleware returns a standard net/http middleware that disables scale-to-zero
 // at the start of each request and re-enables it after the handler completes.
 // Connections from loopback addresses are ignored and do not affect the
-// scale-to-zero state.
+// scale-to-zero state. Holds are attributed to ReasonHTTP.
 func Middleware(ctrl Controller) func(http.Handler) http.Handler {
+	ctrl = WithReason(ctrl, ReasonHTTP)
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if isLoopbackAddr(r.RemoteAddr) {
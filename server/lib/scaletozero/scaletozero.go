@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/onkernel/kernel-images/server/lib/logger"
 )
@@ -65,6 +66,75 @@ func NewNoopController() *NoopController { return &NoopController{} }
 
 func (NoopController) Disable(context.Context) error { return nil }
 func (NoopController) Enable(context.Context) error  { return nil }
+func (NoopController) Status() Status                { return Status{Holds: map[Reason]int{}} }
+
+// Reason says what is holding scale-to-zero off.
+type Reason string
+
+const (
+	// ReasonHTTP is an in-flight request from a non-loopback client.
+	ReasonHTTP Reason = "http"
+	// ReasonRecording is a running or finalizing recording.
+	ReasonRecording Reason = "recording"
+	// ReasonProof is an in-flight Reclaim proof.
+	ReasonProof Reason = "proof"
+	// ReasonProcess is a spawned process that is still running.
+	ReasonProcess Reason = "process"
+	// ReasonManual is an operator's pause through the API.
+	ReasonManual Reason = "manual"
+	// ReasonOther is a hold taken without a reason.
+	ReasonOther Reason = "other"
+)
+
+type reasonKey struct{}
+
+// reasonController attributes the holds it takes on a Controller to a Reason.
+type reasonController struct {
+	ctrl   Controller
+	reason Reason
+}
+
+// WithReason returns a Controller whose holds on ctrl are attributed to reason in the
+// Status of the DebouncedController beneath it.
+func WithReason(ctrl Controller, reason Reason) Controller {
+	return &reasonController{ctrl: ctrl, reason: reason}
+}
+
+func (c *reasonController) Disable(ctx context.Context) error {
+	return c.ctrl.Disable(context.WithValue(ctx, reasonKey{}, c.reason))
+}
+
+func (c *reasonController) Enable(ctx context.Context) error {
+	return c.ctrl.Enable(context.WithValue(ctx, reasonKey{}, c.reason))
+}
+
+func reasonFromContext(ctx context.Context) Reason {
+	if r, ok := ctx.Value(reasonKey{}).(Reason); ok {
+		return r
+	}
+	return ReasonOther
+}
+
+// Event is a hold taken on scale-to-zero.
+type Event struct {
+	Reason Reason
+	At     time.Time
+}
+
+// Status describes why scale-to-zero is currently held off.
+type Status struct {
+	// Suppressed is set while at least one hold is active.
+	Suppressed bool
+	// Holds counts the active holds by reason.
+	Holds map[Reason]int
+	// LastDisable is the most recent hold taken, active or not; nil before the first.
+	LastDisable *Event
+}
+
+// StatusReporter is implemented by controllers that track why scale-to-zero is held off.
+type StatusReporter interface {
+	Status() Status
+}
 
 // Oncer wraps a Controller and ensures that Disable and Enable are called at most once.
 type Oncer struct {
@@ -92,23 +162,29 @@ type DebouncedController struct {
 	mu          sync.Mutex
 	disabled    bool
 	activeCount int
+	holds       map[Reason]int
+	lastDisable *Event
 }
 
 func NewDebouncedController(ctrl Controller) Controller {
-	return &DebouncedController{ctrl: ctrl}
+	return &DebouncedController{ctrl: ctrl, holds: make(map[Reason]int)}
 }
 
 func (c *DebouncedController) Disable(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	reason := reasonFromContext(ctx)
 	c.activeCount++
+	c.holds[reason]++
+	c.lastDisable = &Event{Reason: reason, At: time.Now()}
 	if c.disabled {
 		return nil
 	}
 
 	if err := c.ctrl.Disable(ctx); err != nil {
 		c.activeCount--
+		c.release(reason)
 		return err
 	}
 
@@ -122,6 +198,7 @@ func (c *DebouncedController) Enable(ctx context.Context) error {
 
 	if c.activeCount > 0 {
 		c.activeCount--
+		c.release(reasonFromContext(ctx))
 	}
 
 	// nothing to do
@@ -136,3 +213,27 @@ func (c *DebouncedController) Enable(ctx context.Context) error {
 	c.disabled = false
 	return nil
 }
+
+// release drops a hold of reason. The caller holds c.mu.
+func (c *DebouncedController) release(reason Reason) {
+	if c.holds[reason] > 1 {
+		c.holds[reason]--
+	} else {
+		delete(c.holds, reason)
+	}
+}
+
+// Status reports the active holds by reason and the most recent one taken.
+func (c *DebouncedController) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	st := Status{Suppressed: c.disabled, Holds: make(map[Reason]int, len(c.holds))}
+	for r, n := range c.holds {
+		st.Holds[r] = n
+	}
+	if c.lastDisable != nil {
+		ev := *c.lastDisable
+		st.LastDisable = &ev
+	}
+	return st
+}
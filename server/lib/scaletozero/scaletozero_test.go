@@ -127,6 +127,54 @@ func (m *mockScaleToZeroer) Enable(ctx context.Context) error {
 	m.enableCalls++
 	return m.enableErr
 }
+func TestDebouncedControllerStatusTracksReasons(t *testing.T) {
+	t.Parallel()
+	mock := &mockScaleToZeroer{}
+	c := NewDebouncedController(mock)
+	reporter := c.(StatusReporter)
+
+	st := reporter.Status()
+	assert.False(t, st.Suppressed)
+	assert.Empty(t, st.Holds)
+	assert.Nil(t, st.LastDisable)
+
+	recording := WithReason(c, ReasonRecording)
+	require.NoError(t, recording.Disable(t.Context()))
+	require.NoError(t, WithReason(c, ReasonHTTP).Disable(t.Context()))
+	require.NoError(t, c.Disable(t.Context()))
+
+	st = reporter.Status()
+	assert.True(t, st.Suppressed)
+	assert.Equal(t, map[Reason]int{ReasonRecording: 1, ReasonHTTP: 1, ReasonOther: 1}, st.Holds)
+	require.NotNil(t, st.LastDisable)
+	assert.Equal(t, ReasonOther, st.LastDisable.Reason)
+
+	require.NoError(t, WithReason(c, ReasonHTTP).Enable(t.Context()))
+	require.NoError(t, c.Enable(t.Context()))
+	st = reporter.Status()
+	assert.True(t, st.Suppressed)
+	assert.Equal(t, map[Reason]int{ReasonRecording: 1}, st.Holds)
+
+	require.NoError(t, recording.Enable(t.Context()))
+	st = reporter.Status()
+	assert.False(t, st.Suppressed)
+	assert.Empty(t, st.Holds)
+	// the last hold taken is kept once released
+	require.NotNil(t, st.LastDisable)
+	assert.Equal(t, ReasonOther, st.LastDisable.Reason)
+}
+
+func TestDebouncedControllerStatusDisableFailure(t *testing.T) {
+	t.Parallel()
+	mock := &mockScaleToZeroer{disableErr: assert.AnError}
+	c := NewDebouncedController(mock)
+
+	require.Error(t, WithReason(c, ReasonProof).Disable(t.Context()))
+	st := c.(StatusReporter).Status()
+	assert.False(t, st.Suppressed)
+	assert.Empty(t, st.Holds)
+}
+
 func TestUnikraftCloudControllerNoFileNoError(t *testing.T) {
 	t.Parallel()
 	p := filepath.Join(t.TempDir(), "scale_to_zero_disable")
@@ -0,0 +1,84 @@
+// Package tlsutil serves TLS certificates that are reloaded when their files change.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader holds a certificate loaded from a cert and key file pair and reloads it
+// when either file's modification time changes, so certificates can be rotated without a
+// restart.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+	logger   *slog.Logger
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+	// lastErr is the last reload error logged, so a bad file is reported once rather
+	// than on every handshake.
+	lastErr string
+}
+
+// NewCertReloader loads the certificate in certFile and keyFile.
+func NewCertReloader(certFile, keyFile string, logger *slog.Logger) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile, logger: logger}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload loads the key pair if either file changed since it was last loaded. r.mu must be
+// held or r not yet shared.
+func (r *CertReloader) reload() error {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return err
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return err
+	}
+	if r.cert != nil && certInfo.ModTime().Equal(r.certMod) && keyInfo.ModTime().Equal(r.keyMod) {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load tls key pair: %w", err)
+	}
+	r.cert, r.certMod, r.keyMod = &cert, certInfo.ModTime(), keyInfo.ModTime()
+	return nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate. If the files changed but cannot be
+// loaded (e.g. the cert was replaced before its key), the previous certificate is served.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.reload(); err != nil {
+		if err.Error() != r.lastErr {
+			r.logger.Error("failed to reload tls certificate, serving previous one", "err", err)
+			r.lastErr = err.Error()
+		}
+	} else {
+		r.lastErr = ""
+	}
+	return r.cert, nil
+}
+
+// ServerConfig returns a TLS server config that serves r's certificate and requires
+// TLS 1.2 or later.
+func (r *CertReloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}
+}
@@ -0,0 +1,205 @@
+// Package xdisplay queries the geometry of an X display.
+package xdisplay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Geometry describes the root window of an X display.
+type Geometry struct {
+	Width  int
+	Height int
+	// Depth is the color depth in bits per pixel, or 0 if it could not be determined.
+	Depth int
+	// Source names where the geometry came from: xdpyinfo, xrandr, xvfb or config.
+	Source string
+}
+
+// procRoot is where running processes are looked up; tests point it elsewhere.
+var procRoot = "/proc"
+
+var (
+	xdpyinfoDimensions = regexp.MustCompile(`dimensions:\s+(\d+)x(\d+) pixels`)
+	xdpyinfoDepth      = regexp.MustCompile(`depth of root window:\s+(\d+) planes`)
+	xrandrCurrent      = regexp.MustCompile(`current (\d+) x (\d+)`)
+	xvfbScreen         = regexp.MustCompile(`^(\d+)x(\d+)(?:x(\d+))?$`)
+)
+
+// Query reads the geometry of display (e.g. ":1"). It asks the X server through xdpyinfo,
+// then xrandr, and for headless displays falls back to the framebuffer size Xvfb was
+// started with, which is what the server reports anyway and needs no X connection.
+func Query(ctx context.Context, display string) (Geometry, error) {
+	var errs []string
+	out, err := run(ctx, display, "xdpyinfo")
+	if err == nil {
+		var g Geometry
+		if g, err = parseXdpyinfo(out); err == nil {
+			return g, nil
+		}
+	}
+	errs = append(errs, fmt.Sprintf("xdpyinfo: %v", err))
+
+	out, err = run(ctx, display, "xrandr", "--current")
+	if err == nil {
+		var g Geometry
+		if g, err = parseXrandr(out); err == nil {
+			return g, nil
+		}
+	}
+	errs = append(errs, fmt.Sprintf("xrandr: %v", err))
+
+	g, err := xvfbGeometry(display)
+	if err == nil {
+		return g, nil
+	}
+	errs = append(errs, fmt.Sprintf("xvfb: %v", err))
+	return Geometry{}, fmt.Errorf("query display %s: %s", display, strings.Join(errs, "; "))
+}
+
+func run(ctx context.Context, display, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = append(os.Environ(), "DISPLAY="+display)
+	out, err := cmd.Output()
+	return string(out), err
+}
+
+func parseXdpyinfo(out string) (Geometry, error) {
+	m := xdpyinfoDimensions.FindStringSubmatch(out)
+	if m == nil {
+		return Geometry{}, fmt.Errorf("no dimensions in output")
+	}
+	g := Geometry{Source: "xdpyinfo"}
+	g.Width, _ = strconv.Atoi(m[1])
+	g.Height, _ = strconv.Atoi(m[2])
+	if d := xdpyinfoDepth.FindStringSubmatch(out); d != nil {
+		g.Depth, _ = strconv.Atoi(d[1])
+	}
+	return g, nil
+}
+
+func parseXrandr(out string) (Geometry, error) {
+	m := xrandrCurrent.FindStringSubmatch(out)
+	if m == nil {
+		return Geometry{}, fmt.Errorf("no current screen size in output")
+	}
+	g := Geometry{Source: "xrandr"}
+	g.Width, _ = strconv.Atoi(m[1])
+	g.Height, _ = strconv.Atoi(m[2])
+	return g, nil
+}
+
+// xvfbGeometry finds the Xvfb process serving display and parses its "-screen 0 WxHxD"
+// argument.
+func xvfbGeometry(display string) (Geometry, error) {
+	cmdlines, err := filepath.Glob(filepath.Join(procRoot, "[0-9]*", "cmdline"))
+	if err != nil {
+		return Geometry{}, err
+	}
+	for _, path := range cmdlines {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		args := strings.Split(string(bytes.TrimRight(data, "\x00")), "\x00")
+		if len(args) == 0 || filepath.Base(args[0]) != "Xvfb" || !containsArg(args[1:], display) {
+			continue
+		}
+		if g, ok := parseXvfbArgs(args[1:]); ok {
+			return g, nil
+		}
+	}
+	return Geometry{}, fmt.Errorf("no Xvfb process with a screen size found for %s", display)
+}
+
+func containsArg(args []string, want string) bool {
+	for _, a := range args {
+		if a == want {
+			return true
+		}
+	}
+	return false
+}
+
+func parseXvfbArgs(args []string) (Geometry, bool) {
+	for i := 0; i+2 < len(args); i++ {
+		if args[i] != "-screen" || args[i+1] != "0" {
+			continue
+		}
+		m := xvfbScreen.FindStringSubmatch(args[i+2])
+		if m == nil {
+			return Geometry{}, false
+		}
+		g := Geometry{Source: "xvfb"}
+		g.Width, _ = strconv.Atoi(m[1])
+		g.Height, _ = strconv.Atoi(m[2])
+		if m[3] != "" {
+			g.Depth, _ = strconv.Atoi(m[3])
+		}
+		return g, true
+	}
+	return Geometry{}, false
+}
+
+// Cache queries a display's geometry once and serves the cached value until invalidated.
+type Cache struct {
+	display  string
+	fallback Geometry
+	query    func(ctx context.Context, display string) (Geometry, error)
+
+	mu       sync.Mutex
+	geometry *Geometry
+}
+
+// NewCache returns a Cache for display. fallback, if it has a non-zero size, is returned
+// when the display cannot be queried.
+func NewCache(display string, fallback Geometry) *Cache {
+	fallback.Source = "config"
+	return &Cache{display: display, fallback: fallback, query: Query}
+}
+
+// Get returns the cached geometry, querying the display if nothing is cached. Failed
+// queries are not cached.
+func (c *Cache) Get(ctx context.Context) (Geometry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.geometry != nil {
+		return *c.geometry, nil
+	}
+	g, err := c.query(ctx, c.display)
+	if err != nil {
+		if c.fallback.Width > 0 && c.fallback.Height > 0 {
+			return c.fallback, nil
+		}
+		return Geometry{}, err
+	}
+	c.geometry = &g
+	return g, nil
+}
+
+// Invalidate drops the cached geometry; call it after the display is resized.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	c.geometry = nil
+	c.mu.Unlock()
+}
+
+// ValidateRegion checks that the rectangle at (x, y) of size w x h lies within the display,
+// for features such as cropping that address part of the screen.
+func (g Geometry) ValidateRegion(x, y, w, h int) error {
+	if x < 0 || y < 0 || w <= 0 || h <= 0 {
+		return fmt.Errorf("region %dx%d+%d+%d must have a non-negative offset and positive size", w, h, x, y)
+	}
+	if x+w > g.Width || y+h > g.Height {
+		return fmt.Errorf("region %dx%d+%d+%d exceeds the %dx%d display", w, h, x, y, g.Width, g.Height)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package xdisplay
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXdpyinfo(t *testing.T) {
+	out := `name of display:    :1
+screen #0:
+  dimensions:    1920x1080 pixels (508x285 millimeters)
+  resolution:    96x96 dots per inch
+  depths (7):    24, 1, 4, 8, 15, 16, 32
+  root window id:    0x3e3
+  depth of root window:    24 planes
+`
+	g, err := parseXdpyinfo(out)
+	require.NoError(t, err)
+	assert.Equal(t, Geometry{Width: 1920, Height: 1080, Depth: 24, Source: "xdpyinfo"}, g)
+
+	_, err = parseXdpyinfo("name of display:    :1\n")
+	assert.Error(t, err)
+}
+
+func TestParseXrandr(t *testing.T) {
+	out := "Screen 0: minimum 1 x 1, current 1280 x 720, maximum 32767 x 32767\n"
+	g, err := parseXrandr(out)
+	require.NoError(t, err)
+	assert.Equal(t, Geometry{Width: 1280, Height: 720, Source: "xrandr"}, g)
+}
+
+func TestXvfbGeometry(t *testing.T) {
+	root := t.TempDir()
+	writeCmdline := func(pid string, args ...string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, pid), 0o755))
+		var data []byte
+		for _, a := range args {
+			data = append(append(data, a...), 0)
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(root, pid, "cmdline"), data, 0o644))
+	}
+	writeCmdline("10", "/usr/bin/chromium", "--no-sandbox")
+	writeCmdline("11", "Xvfb", ":2", "-screen", "0", "800x600x16")
+	writeCmdline("12", "/usr/bin/Xvfb", ":1", "-ac", "-screen", "0", "1024x768x24", "-retro")
+
+	orig := procRoot
+	procRoot = root
+	t.Cleanup(func() { procRoot = orig })
+
+	g, err := xvfbGeometry(":1")
+	require.NoError(t, err)
+	assert.Equal(t, Geometry{Width: 1024, Height: 768, Depth: 24, Source: "xvfb"}, g)
+
+	_, err = xvfbGeometry(":3")
+	assert.Error(t, err)
+}
+
+func TestCache(t *testing.T) {
+	calls := 0
+	fail := true
+	c := NewCache(":1", Geometry{})
+	c.query = func(ctx context.Context, display string) (Geometry, error) {
+		calls++
+		if fail {
+			return Geometry{}, errors.New("no display")
+		}
+		return Geometry{Width: 640 * calls, Height: 480}, nil
+	}
+
+	_, err := c.Get(context.Background())
+	require.Error(t, err)
+
+	fail = false
+	g, err := c.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1280, g.Width)
+	g, err = c.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1280, g.Width, "expected cached geometry")
+
+	c.Invalidate()
+	g, err = c.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1920, g.Width)
+}
+
+func TestValidateRegion(t *testing.T) {
+	g := Geometry{Width: 1920, Height: 1080}
+	assert.NoError(t, g.ValidateRegion(0, 0, 1920, 1080))
+	assert.NoError(t, g.ValidateRegion(100, 100, 640, 480))
+	assert.Error(t, g.ValidateRegion(-1, 0, 10, 10))
+	assert.Error(t, g.ValidateRegion(0, 0, 0, 10))
+	assert.Error(t, g.ValidateRegion(1900, 0, 40, 10))
+}
+
+func TestCacheFallback(t *testing.T) {
+	c := NewCache(":1", Geometry{Width: 1024, Height: 768, Depth: 24})
+	c.query = func(ctx context.Context, display string) (Geometry, error) {
+		return Geometry{}, errors.New("no display")
+	}
+	g, err := c.Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Geometry{Width: 1024, Height: 768, Depth: 24, Source: "config"}, g)
+}
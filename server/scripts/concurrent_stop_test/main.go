@@ -133,7 +133,7 @@ func runTest(baseURL, replayID string, duration, concurrency int) error {
 }
 
 func startRecording(ctx context.Context, client *oapi.ClientWithResponses, replayID string) error {
-	resp, err := client.StartRecordingWithResponse(ctx, oapi.StartRecordingJSONRequestBody{
+	resp, err := client.StartRecordingWithResponse(ctx, nil, oapi.StartRecordingJSONRequestBody{
 		Id: &replayID,
 	})
 	if err != nil {